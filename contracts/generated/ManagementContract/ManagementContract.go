@@ -61,8 +61,8 @@ type StructsValueTransferMessage struct {
 
 // ManagementContractMetaData contains all meta data concerning the ManagementContract contract.
 var ManagementContractMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"RollupAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveRevoked\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structStructs.ValueTransferMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"ExtractNativeValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetRollupByNumber\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetUniqueForkID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"GrantSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_enclaveID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"IsSequencerEnclave\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"RevokeSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_lastBatchHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"blockNum\",\"type\":\"uint256\"},{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"rollupNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"forkID\",\"type\":\"bytes32\"}],\"name\":\"addCrossChainMessagesRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"}],\"name\":\"isBundleAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isBundleSaved\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isWithdrawalSpent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"merkleMessageBus\",\"outputs\":[{\"internalType\":\"contractIMerkleTreeMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
-	Bin: "0x608060405234801561001057600080fd5b5061001a3361001f565b610090565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b614cfe80620000a06000396000f3fe60806040523480156200001157600080fd5b5060043610620002005760003560e01c80638129fc1c1162000119578063a25eb31c11620000af578063db5d91b1116200007a578063db5d91b114620004f9578063e34fbfc81462000528578063e874eb20146200053d578063f2fde38b146200055157600080fd5b8063a25eb31c14620004a3578063a4ab2faa14620004ba578063a52f433c14620004d1578063d4fab88714620004e257600080fd5b806387059edb11620000f057806387059edb14620004125780638da5cb5b146200042957806398077e86146200045a578063a1a227fa146200048057600080fd5b80638129fc1c14620003bb5780638236a7ba14620003c55780638415482614620003ec57600080fd5b806347665738116200019b5780636a30d26c11620001665780636a30d26c14620003775780636b9707d61462000390578063715018a614620003a75780637281099614620003b157600080fd5b806347665738146200030b5780635371a2161462000322578063568699c8146200033957806368e10383146200036057600080fd5b80632f0cb9e311620001dc5780632f0cb9e314620002575780633e60a22f146200028c57806343348b2f14620002d2578063440c953b146200030157600080fd5b80620ddd27146200020557806303e72e481462000227578063073b6ef31462000240575b600080fd5b6200020f600e5481565b6040516200021e919062001b7d565b60405180910390f35b6200023e6200023836600462001cd3565b62000568565b005b6200023e6200025136600462001e6e565b6200067b565b6200027d6200026836600462001f5b565b600c6020526000908152604090205460ff1681565b6040516200021e919062001f89565b620002c36200029d36600462001f99565b80516020818301810180516003825292820191909301209152546001600160a01b031681565b6040516200021e919062001fe5565b6200027d620002e336600462001ff5565b6001600160a01b031660009081526020819052604090205460ff1690565b6200020f60055481565b6200023e6200031c36600462001ff5565b62000899565b6200023e6200033336600462002088565b62000940565b620003506200034a36600462001f5b565b62000af6565b6040516200021e929190620021a4565b6200023e62000371366004620021c8565b62000b4f565b6200038162000bf8565b6040516200021e9190620022e9565b6200023e620003a136600462001ff5565b62000cdb565b6200023e62000d72565b6200023e62000d8a565b6200023e62000e15565b620003dc620003d636600462001f5b565b62000fff565b6040516200021e929190620022fc565b6200027d620003fd36600462001f5b565b600d6020526000908152604090205460ff1681565b620003dc6200042336600462001f5b565b620010ef565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b0316620002c3565b620004716200046b36600462001f5b565b62001169565b6040516200021e91906200230c565b600a5462000494906001600160a01b031681565b6040516200021e919062002369565b6200023e620004b4366004620023a7565b6200121e565b6200027d620004cb36600462002419565b62001334565b600454610100900460ff166200027d565b6200023e620004f336600462002470565b620013c6565b6200027d6200050a36600462001ff5565b6001600160a01b031660009081526001602052604090205460ff1690565b6200023e620005393660046200252e565b5050565b600b5462000494906001600160a01b031681565b6200023e6200056236600462001ff5565b620014af565b620005726200150d565b60006001600160a01b03166003836040516200058f9190620025a1565b908152604051908190036020019020546001600160a01b031603620005ee57600280546001810182556000919091527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01620005ec838262002691565b505b80600383604051620006019190620025a1565b90815260405190819003602001812080546001600160a01b039390931673ffffffffffffffffffffffffffffffffffffffff19909316929092179091557f17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5906200066f90849084906200275e565b60405180910390a15050565b6000828152600860205260409020548114620006b45760405162461bcd60e51b8152600401620006ab90620027b5565b60405180910390fd5b60006200072689898989604051602001620006d3949392919062002825565b6040516020818303038152906040528051906020012086868080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506200158592505050565b6001600160a01b03811660009081526020819052604090205490915060ff16620007645760405162461bcd60e51b8152600401620006ab90620028a4565b600e8990556000805b87518110156200087457600b5488516001600160a01b039091169063b6aed0cb908a9084908110620007a357620007a3620028b6565b6020026020010151620007b690620028d7565b426040518363ffffffff1660e01b8152600401620007d692919062002911565b600060405180830381600087803b158015620007f157600080fd5b505af115801562000806573d6000803e3d6000fd5b5050505081888281518110620008205762000820620028b6565b60200260200101516200083390620028d7565b6040516020016200084692919062002911565b60405160208183030381529060405280519060200120915080806200086b9062002946565b9150506200076d565b506000908152600d60205260409020805460ff19166001179055505050505050505050565b620008a36200150d565b6001600160a01b03811660009081526020819052604090205460ff16620008de5760405162461bcd60e51b8152600401620006ab90620028a4565b6001600160a01b038116600090815260016020819052604091829020805460ff19169091179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e760936906200093590839062001fe5565b60405180910390a150565b600b546040517fb201246f0000000000000000000000000000000000000000000000000000000081526001600160a01b039091169063b201246f906200099190879087908790879060040162002a9b565b60006040518083038186803b158015620009aa57600080fd5b505afa158015620009bf573d6000803e3d6000fd5b50505050600084604051602001620009d8919062002ada565b60408051601f1981840301815291815281516020928301206000818152600c90935291205490915060ff161562000a235760405162461bcd60e51b8152600401620006ab9062002b1d565b6001600c60008760405160200162000a3c919062002ada565b60408051808303601f190181529181528151602092830120835282820193909352908201600020805460ff191693151593909317909255600a546001600160a01b0316916399a3ad219162000a979190890190890162001ff5565b87604001356040518363ffffffff1660e01b815260040162000abb92919062002b2f565b600060405180830381600087803b15801562000ad657600080fd5b505af115801562000aeb573d6000803e3d6000fd5b505050505050505050565b60408051606080820183526000808352602083019190915291810182905260008062000b2285620010ef565b915091508162000b385760009590945092505050565b600094855260086020526040909420549492505050565b60045460ff161562000b755760405162461bcd60e51b8152600401620006ab9062002b99565b60048054600160ff1991821681179092556001600160a01b0387166000908152602081815260408083208054851686179055908490529081902080549092169092179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e7609369062000be990879062001fe5565b60405180910390a15050505050565b60606002805480602002602001604051908101604052809291908181526020016000905b8282101562000cd257838290600052602060002001805462000c3e90620025c3565b80601f016020809104026020016040519081016040528092919081815260200182805462000c6c90620025c3565b801562000cbd5780601f1062000c915761010080835404028352916020019162000cbd565b820191906000526020600020905b81548152906001019060200180831162000c9f57829003601f168201915b50505050508152602001906001019062000c1c565b50505050905090565b62000ce56200150d565b6001600160a01b03811660009081526001602052604090205460ff1662000d205760405162461bcd60e51b8152600401620006ab9062002bde565b6001600160a01b03811660009081526001602052604090819020805460ff19169055517f0f279980343c7ca542fde9fa5396555068efb5cd560d9cf9c191aa2911079b47906200093590839062001fe5565b62000d7c6200150d565b62000d886000620015b5565b565b62000d946200150d565b600a546040517f36d2da900000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906336d2da909062000ddf90339060040162001fe5565b600060405180830381600087803b15801562000dfa57600080fd5b505af115801562000e0f573d6000803e3d6000fd5b50505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff1660008115801562000e615750825b905060008267ffffffffffffffff16600114801562000e7f5750303b155b90508115801562000e8e575080155b1562000ec6576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff19166001178555831562000efb57845468ff00000000000000001916680100000000000000001785555b62000f063362001633565b6000600555600160095560405162000f1e9062001b67565b604051809103906000f08015801562000f3b573d6000803e3d6000fd5b50600b80546001600160a01b039290921673ffffffffffffffffffffffffffffffffffffffff199283168117909155600a805490921681179091556040517fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf9162000fa69162001fe5565b60405180910390a1831562000ff857845468ff0000000000000000191685556040517fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29062000be99060019062002c0e565b5050505050565b6040805160608082018352600080835260208084018390528385018290528582526006815284822085519384019095528454835260018501805492958694939092840191906200104f90620025c3565b80601f01602080910402602001604051908101604052809291908181526020018280546200107d90620025c3565b8015620010ce5780601f10620010a257610100808354040283529160200191620010ce565b820191906000526020600020905b815481529060010190602001808311620010b057829003601f168201915b50505091835250506002919091015460209091015280519094149492505050565b604080516060808201835260008083526020830191909152918101829052600083815260076020526040812054908190036200115457505060408051606081018252600080825282516020818101855282825283015291810182905290939092509050565b6200115f8162000fff565b9250925050915091565b600281815481106200117a57600080fd5b9060005260206000200160009150905080546200119790620025c3565b80601f0160208091040260200160405190810160405280929190818152602001828054620011c590620025c3565b8015620012165780601f10620011ea5761010080835404028352916020019162001216565b820191906000526020600020905b815481529060010190602001808311620011f857829003601f168201915b505050505081565b600062001270833562001235602086018662002c1e565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506200158592505050565b6001600160a01b03811660009081526020819052604090205490915060ff16620012ae5760405162461bcd60e51b8152600401620006ab90620028a4565b6001600160a01b03811660009081526001602052604090205460ff16620012e95760405162461bcd60e51b8152600401620006ab9062002bde565b620012f48362001648565b6040517fd6555bff8670bd3008dc064c30bb56d6ac7cb14ae801e36146fe4e7c6a504a5890620013279085359062001b7d565b60405180910390a1505050565b600080805b8351811015620013ad5781848281518110620013595762001359620028b6565b60200260200101516200136c90620028d7565b6040516020016200137f92919062002911565b6040516020818303038152906040528051906020012091508080620013a49062002946565b91505062001339565b506000908152600d602052604090205460ff1692915050565b6001600160a01b03851660009081526020819052604090205460ff1680620014025760405162461bcd60e51b8152600401620006ab9062002cd2565b8115620014845760006200143b878786604051602001620014269392919062002d13565b604051602081830303815290604052620016f5565b905060006200144b828762001585565b9050876001600160a01b0316816001600160a01b031614620014815760405162461bcd60e51b8152600401620006ab9062002d96565b50505b5050506001600160a01b039091166000908152602081905260409020805460ff191660011790555050565b620014b96200150d565b6001600160a01b038116620014ff5760006040517f1e4fbdf7000000000000000000000000000000000000000000000000000000008152600401620006ab919062001fe5565b6200150a81620015b5565b50565b33620015407f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b03161462000d8857336040517f118cdaa7000000000000000000000000000000000000000000000000000000008152600401620006ab919062001fe5565b60008060008062001597868662001734565b925092509250620015a9828262001785565b50909150505b92915050565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300805473ffffffffffffffffffffffffffffffffffffffff1981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b6200163d6200189b565b6200150a8162001903565b80356000908152600660205260409020819062001666828262002f39565b505060095460009081526007602052604090208135908190556200168c60014362002f45565b40604051602001620016a092919062002911565b60408051601f198184030181529181528151602092830120600980546000908152600890945291832055805491620016d88362002946565b9190505550600554816040013511156200150a5760400135600555565b60006200170382516200190d565b826040516020016200171792919062002f5b565b604051602081830303815290604052805190602001209050919050565b60008060008351604103620017725760208401516040850151606086015160001a6200176388828585620019b5565b9550955095505050506200177e565b50508151600091506002905b9250925092565b60008260038111156200179c576200179c62002f9b565b03620017a6575050565b6001826003811115620017bd57620017bd62002f9b565b03620017f5576040517ff645eedf00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b60028260038111156200180c576200180c62002f9b565b0362001848576040517ffce698f7000000000000000000000000000000000000000000000000000000008152620006ab90829060040162001b7d565b60038260038111156200185f576200185f62002f9b565b036200053957806040517fd78bce0c000000000000000000000000000000000000000000000000000000008152600401620006ab919062001b7d565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff1662000d88576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b620014b96200189b565b606060006200191c8362001a7e565b600101905060008167ffffffffffffffff8111156200193f576200193f62001b8d565b6040519080825280601f01601f1916602001820160405280156200196a576020820181803683370190505b5090508181016020015b600019017f3031323334353637383961626364656600000000000000000000000000000000600a86061a8153600a850494508462001974575b509392505050565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0841115620019f2575060009150600390508262001a74565b60006001888888886040516000815260200160405260405162001a19949392919062002fbb565b6020604051602081039080840390855afa15801562001a3c573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811662001a6a5750600092506001915082905062001a74565b9250600091508190505b9450945094915050565b6000807a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000831062001ac8577a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000830492506040015b6d04ee2d6d415b85acef8100000000831062001af5576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc10000831062001b1457662386f26fc10000830492506010015b6305f5e100831062001b2d576305f5e100830492506008015b612710831062001b4257612710830492506004015b6064831062001b55576064830492506002015b600a8310620015af5760010192915050565b611cd08062002ff983390190565b805b82525050565b60208101620015af828462001b75565b634e487b7160e01b600052604160045260246000fd5b601f19601f830116810181811067ffffffffffffffff8211171562001bcc5762001bcc62001b8d565b6040525050565b600062001bdf60405190565b905062001bed828262001ba3565b919050565b600067ffffffffffffffff82111562001c0f5762001c0f62001b8d565b601f19601f83011660200192915050565b82818337506000910152565b600062001c4362001c3d8462001bf2565b62001bd3565b90508281526020810184848401111562001c605762001c60600080fd5b620019ad84828562001c20565b600082601f83011262001c835762001c83600080fd5b813562001c9584826020860162001c2c565b949350505050565b60006001600160a01b038216620015af565b62001cba8162001c9d565b81146200150a57600080fd5b8035620015af8162001caf565b6000806040838503121562001ceb5762001ceb600080fd5b823567ffffffffffffffff81111562001d075762001d07600080fd5b62001d158582860162001c6d565b925050602062001d288582860162001cc6565b9150509250929050565b8062001cba565b8035620015af8162001d32565b600067ffffffffffffffff82111562001d635762001d6362001b8d565b5060209081020190565b600062001d7e62001c3d8462001d46565b8381529050602080820190840283018581111562001d9f5762001d9f600080fd5b835b8181101562001de457803567ffffffffffffffff81111562001dc65762001dc6600080fd5b850162001dd4888262001c6d565b8452506020928301920162001da1565b5050509392505050565b600082601f83011262001e045762001e04600080fd5b813562001c9584826020860162001d6d565b60008083601f84011262001e2d5762001e2d600080fd5b50813567ffffffffffffffff81111562001e4a5762001e4a600080fd5b60208301915083600182028301111562001e675762001e67600080fd5b9250929050565b60008060008060008060008060e0898b03121562001e8f5762001e8f600080fd5b600062001e9d8b8b62001d39565b985050602062001eb08b828c0162001d39565b975050604062001ec38b828c0162001d39565b965050606089013567ffffffffffffffff81111562001ee55762001ee5600080fd5b62001ef38b828c0162001dee565b955050608089013567ffffffffffffffff81111562001f155762001f15600080fd5b62001f238b828c0162001e16565b945094505060a062001f388b828c0162001d39565b92505060c062001f4b8b828c0162001d39565b9150509295985092959890939650565b60006020828403121562001f725762001f72600080fd5b600062001c95848462001d39565b80151562001b77565b60208101620015af828462001f80565b60006020828403121562001fb05762001fb0600080fd5b813567ffffffffffffffff81111562001fcc5762001fcc600080fd5b62001c958482850162001c6d565b62001b778162001c9d565b60208101620015af828462001fda565b6000602082840312156200200c576200200c600080fd5b600062001c95848462001cc6565b600060808284031215620020315762002031600080fd5b50919050565b60008083601f8401126200204e576200204e600080fd5b50813567ffffffffffffffff8111156200206b576200206b600080fd5b60208301915083602082028301111562001e675762001e67600080fd5b60008060008060c08587031215620020a357620020a3600080fd5b6000620020b187876200201a565b945050608085013567ffffffffffffffff811115620020d357620020d3600080fd5b620020e18782880162002037565b935093505060a0620020f68782880162001d39565b91505092959194509250565b60005b838110156200211f57818101518382015260200162002105565b50506000910152565b600062002133825190565b8084526020840193506200214c81856020860162002102565b601f01601f19169290920192915050565b8051600090606084019062002173858262001b75565b50602083015184820360208601526200218d828262002128565b9150506040830151620019ad604086018262001b75565b60408101620021b4828562001b75565b818103602083015262001c9581846200215d565b600080600080600060608688031215620021e557620021e5600080fd5b6000620021f3888862001cc6565b955050602086013567ffffffffffffffff811115620022155762002215600080fd5b620022238882890162001e16565b9450945050604086013567ffffffffffffffff811115620022475762002247600080fd5b620022558882890162001e16565b92509250509295509295909350565b600062002272838362002128565b9392505050565b60200190565b60006200228a825190565b80845260208401935083602082028501620022a58560200190565b60005b84811015620022dd5783830388528151620022c4848262002264565b93505060208201602098909801979150600101620022a8565b50909695505050505050565b602080825281016200227281846200227f565b60408101620021b4828562001f80565b6020808252810162002272818462002128565b6000620015af6001600160a01b03831662002338565b90565b6001600160a01b031690565b6000620015af826200231f565b6000620015af8262002344565b62001b778162002351565b60208101620015af82846200235e565b600060608284031215620020315762002031600080fd5b600060208284031215620020315762002031600080fd5b60008060408385031215620023bf57620023bf600080fd5b823567ffffffffffffffff811115620023db57620023db600080fd5b620023e98582860162002379565b925050602083013567ffffffffffffffff8111156200240b576200240b600080fd5b62001d288582860162002390565b600060208284031215620024305762002430600080fd5b813567ffffffffffffffff8111156200244c576200244c600080fd5b62001c958482850162001dee565b80151562001cba565b8035620015af816200245a565b600080600080600060a086880312156200248d576200248d600080fd5b60006200249b888862001cc6565b9550506020620024ae8882890162001cc6565b945050604086013567ffffffffffffffff811115620024d057620024d0600080fd5b620024de8882890162001c6d565b935050606086013567ffffffffffffffff811115620025005762002500600080fd5b6200250e8882890162001c6d565b9250506080620025218882890162002463565b9150509295509295909350565b60008060208385031215620025465762002546600080fd5b823567ffffffffffffffff811115620025625762002562600080fd5b620025708582860162001e16565b92509250509250929050565b600062002587825190565b6200259781856020860162002102565b9290920192915050565b620015af81836200257c565b634e487b7160e01b600052602260045260246000fd5b600281046001821680620025d857607f821691505b602082108103620020315762002031620025ad565b6000620015af620023358381565b6200260683620025ed565b815460001960089490940293841b1916921b91909117905550565b600062002630818484620025fb565b505050565b8181101562000539576200264b60008262002621565b60010162002635565b601f82111562002630576000818152602090206020601f850104810160208510156200267d5750805b62000ff86020601f86010483018262002635565b815167ffffffffffffffff811115620026ae57620026ae62001b8d565b620026ba8254620025c3565b620026c782828562002654565b506020601f821160018114620026ff5760008315620026e65750848201515b600019600885021c198116600285021785555062000ff8565b600084815260208120601f198516915b828110156200273157878501518255602094850194600190920191016200270f565b50848210156200274f5783870151600019601f87166008021c191681555b50505050600202600101905550565b6040808252810162002771818562002128565b905062002272602083018462001fda565b600e8152602081017f496e76616c696420666f726b49440000000000000000000000000000000000008152905062002279565b60208082528101620015af8162002782565b6000620027d2825190565b80845260208401935083602082028501620027ed8560200190565b60005b84811015620022dd57838303885281516200280c848262002264565b93505060208201602098909801979150600101620027f0565b6080810162002835828762001b75565b62002844602083018662001b75565b62002853604083018562001b75565b8181036060830152620028678184620027c7565b9695505050505050565b60168152602081017f656e636c6176654944206e6f74206174746573746564000000000000000000008152905062002279565b60208082528101620015af8162002871565b634e487b7160e01b600052603260045260246000fd5b6000620015af825190565b6000620028e2825190565b60208301620028f181620028cc565b925050602081101562002031576000196020919091036008021b16919050565b6040810162002921828562001b75565b62002272602083018462001b75565b634e487b7160e01b600052601160045260246000fd5b6000600182016200295b576200295b62002930565b5060010190565b506000620015af602083018362001cc6565b506000620015af602083018362001d39565b67ffffffffffffffff811662001cba565b8035620015af8162002986565b506000620015af602083018362002997565b67ffffffffffffffff811662001b77565b620029d3818062002962565b620029df838262001fda565b50620029ef602082018262002962565b620029fe602084018262001fda565b5062002a0e604082018262002974565b62002a1d604084018262001b75565b5062002a2d6060820182620029a4565b620026306060840182620029b6565b82818337505050565b81835260208301925060007f07ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff83111562002a825762002a82600080fd5b60208302925062002a9583858462002a3c565b50500190565b60c0810162002aab8287620029c7565b818103608083015262002ac081858762002a45565b905062002ad160a083018462001b75565b95945050505050565b60808101620015af8284620029c7565b60188152602081017f7769746864726177616c20616c7265616479207370656e7400000000000000008152905062002279565b60208082528101620015af8162002aea565b6040810162002921828562001fda565b60228152602081017f6e6574776f726b2073656372657420616c726561647920696e697469616c697a81527f6564000000000000000000000000000000000000000000000000000000000000602082015290505b60400190565b60208082528101620015af8162002b3f565b60198152602081017f656e636c6176654944206e6f7420612073657175656e636572000000000000008152905062002279565b60208082528101620015af8162002bab565b600067ffffffffffffffff8216620015af565b62001b778162002bf0565b60208101620015af828462002c03565b6000808335601e193685900301811262002c3b5762002c3b600080fd5b8301915050803567ffffffffffffffff81111562002c5c5762002c5c600080fd5b60208201915060018102360382131562001e675762001e67600080fd5b60238152602081017f726573706f6e64696e67206174746573746572206973206e6f7420617474657381527f74656400000000000000000000000000000000000000000000000000000000006020820152905062002b93565b60208082528101620015af8162002c79565b6000620015af8260601b90565b6000620015af8262002ce4565b62001b7762002d0d8262001c9d565b62002cf1565b62002d1f818562002cfe565b60140162002d2e818462002cfe565b60140162001c9581836200257c565b602c8152602081017f63616c63756c61746564206164647265737320616e642061747465737465724981527f4420646f6e74206d6174636800000000000000000000000000000000000000006020820152905062002b93565b60208082528101620015af8162002d3d565b60008135620015af8162001d32565b600081620015af565b62002dcb8262002db7565b62002dda620023358262002db7565b8255505050565b8267ffffffffffffffff81111562002dfd5762002dfd62001b8d565b62002e098254620025c3565b62002e1682828562002654565b506000601f82116001811462002e4e576000831562002e355750848201355b600019600885021c198116600285021785555062002eab565b600084815260209020601f19841690835b8281101562002e81578785013582556020948501946001909201910162002e5f565b508482101562002e9f57600019601f86166008021c19848801351681555b50506001600284020184555b505050505050565b6200263083838362002de1565b62002ecb82620025ed565b8062002dda565b80828062002ee08162002da8565b905062002eee818462002dc0565b505050600181016020830162002f05818562002c1e565b915062002f1482828562002eb3565b50505060028101604083018062002f2b8262002da8565b905062000ff8818462002ec0565b62000539828262002ed2565b81810381811115620015af57620015af62002930565b7f19457468657265756d205369676e6564204d6573736167653a0a0000000000008152601a0162002f8d81846200257c565b90506200227281836200257c565b634e487b7160e01b600052602160045260246000fd5b60ff811662001b77565b6080810162002fcb828762001b75565b62002fda602083018662002fb1565b62002fe9604083018562001b75565b62002ad1606083018462001b7556fe60806040523480156200001157600080fd5b50338062000040576000604051631e4fbdf760e01b8152600401620000379190620000c6565b60405180910390fd5b6200004b8162000052565b50620000d6565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b60006001600160a01b0382165b92915050565b620000c081620000a2565b82525050565b60208101620000af8284620000b5565b611bea80620000e66000396000f3fe6080604052600436106100e15760003560e01c80639730886d1161007f578063b201246f11610059578063b201246f146102d4578063b6aed0cb146102f4578063e138a8d214610314578063f2fde38b1461033457610155565b80639730886d1461026757806399a3ad2114610287578063b1454caa146102a757610155565b8063346633fb116100bb578063346633fb146101f957806336d2da901461020c578063715018a61461022c5780638da5cb5b1461024157610155565b80630fcfbd11146101765780630fe9188e146101ac57806333a88c72146101cc57610155565b36610155576040517f346633fb000000000000000000000000000000000000000000000000000000008152309063346633fb9034906101269033908390600401610b86565b6000604051808303818588803b15801561013f57600080fd5b505af1158015610153573d6000803e3d6000fd5b005b60405162461bcd60e51b815260040161016d90610bd5565b60405180910390fd5b34801561018257600080fd5b50610196610191366004610c00565b610354565b6040516101a39190610c3b565b60405180910390f35b3480156101b857600080fd5b506101536101c7366004610c61565b6103b4565b3480156101d857600080fd5b506101ec6101e7366004610c00565b6103fa565b6040516101a39190610c8a565b610153610207366004610cac565b61044d565b34801561021857600080fd5b50610153610227366004610ce9565b6104d7565b34801561023857600080fd5b50610153610556565b34801561024d57600080fd5b506000546001600160a01b03166040516101a39190610d0a565b34801561027357600080fd5b50610153610282366004610d18565b61056a565b34801561029357600080fd5b506101536102a2366004610cac565b610666565b3480156102b357600080fd5b506102c76102c2366004610dd1565b6106e6565b6040516101a39190610e65565b3480156102e057600080fd5b506101536102ef366004610ed3565b61073f565b34801561030057600080fd5b5061015361030f366004610f43565b610840565b34801561032057600080fd5b5061015361032f366004610f65565b610886565b34801561034057600080fd5b5061015361034f366004610ce9565b610965565b600080826040516020016103689190611182565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150806103ad5760405162461bcd60e51b815260040161016d906111d1565b9392505050565b6103bc6109bc565b60008181526004602052604081205490036103e95760405162461bcd60e51b815260040161016d90611213565b600090815260046020526040812055565b6000808260405160200161040e9190611182565b60408051601f1981840301815291815281516020928301206000818152600190935291205490915080158015906104455750428111155b949350505050565b60003411801561045c57508034145b6104785760405162461bcd60e51b815260040161016d9061127b565b600061048333610a02565b9050826001600160a01b0316336001600160a01b03167f50c536ac33a920f00755865b831d17bf4cff0b2e0345f65b16d52bfc004068b634846040516104ca92919061128b565b60405180910390a3505050565b6104df6109bc565b6000816001600160a01b03164760405160006040518083038185875af1925050503d806000811461052c576040519150601f19603f3d011682016040523d82523d6000602084013e610531565b606091505b50509050806105525760405162461bcd60e51b815260040161016d906112d8565b5050565b61055e6109bc565b6105686000610a60565b565b6105726109bc565b600061057e82426112fe565b90506000836040516020016105939190611182565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150156105d85760405162461bcd60e51b815260040161016d90611369565b60008181526001602090815260408220849055600291906105fb90870187610ce9565b6001600160a01b0316815260208101919091526040016000908120906106276080870160608801611379565b63ffffffff1681526020808201929092526040016000908120805460018101825590825291902085916004020161065e82826117e0565b505050505050565b61066e6109bc565b6000826001600160a01b03168260405160006040518083038185875af1925050503d80600081146106bb576040519150601f19603f3d011682016040523d82523d6000602084013e6106c0565b606091505b50509050806106e15760405162461bcd60e51b815260040161016d906112d8565b505050565b60006106f133610a02565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef7759373382888888888860405161072e97969594939291906117ea565b60405180910390a195945050505050565b600081815260046020526040812054900361076c5760405162461bcd60e51b815260040161016d906118a5565b60008181526004602052604090205442101561079a5760405162461bcd60e51b815260040161016d906118f1565b6000846040516020016107ad9190611976565b604051602081830303815290604052805190602001206040516020016107d391906119b6565b60405160208183030381529060405280519060200120905061081d8484848460405160200161080291906119d5565b60405160208183030381529060405280519060200120610ac8565b6108395760405162461bcd60e51b815260040161016d90611a3f565b5050505050565b6108486109bc565b600082815260046020526040902054156108745760405162461bcd60e51b815260040161016d90611aa7565b60009182526004602052604090912055565b60008181526004602052604081205490036108b35760405162461bcd60e51b815260040161016d906118a5565b6000818152600460205260409020544210156108e15760405162461bcd60e51b815260040161016d906118f1565b6000846040516020016108f49190611182565b6040516020818303038152906040528051906020012060405160200161091a9190611ae9565b6040516020818303038152906040528051906020012090506109498484848460405160200161080291906119d5565b6108395760405162461bcd60e51b815260040161016d90611b51565b61096d6109bc565b6001600160a01b0381166109b05760006040517f1e4fbdf700000000000000000000000000000000000000000000000000000000815260040161016d9190610d0a565b6109b981610a60565b50565b6000546001600160a01b0316331461056857336040517f118cdaa700000000000000000000000000000000000000000000000000000000815260040161016d9190610d0a565b6001600160a01b0381166000908152600360205260408120805467ffffffffffffffff169160019190610a358385611b61565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b600080546001600160a01b038381167fffffffffffffffffffffffff0000000000000000000000000000000000000000831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b600082610ad6868685610ae0565b1495945050505050565b600081815b84811015610b2357610b0f82878784818110610b0357610b03611b85565b90506020020135610b2c565b915080610b1b81611b9b565b915050610ae5565b50949350505050565b6000818310610b48576000828152602084905260409020610b57565b60008381526020839052604090205b90505b92915050565b60006001600160a01b038216610b5a565b610b7a81610b60565b82525050565b80610b7a565b60408101610b948285610b71565b6103ad6020830184610b80565b600b8152602081017f756e737570706f72746564000000000000000000000000000000000000000000815290505b60200190565b60208082528101610b5a81610ba1565b600060c08284031215610bfa57610bfa600080fd5b50919050565b600060208284031215610c1557610c15600080fd5b813567ffffffffffffffff811115610c2f57610c2f600080fd5b61044584828501610be5565b60208101610b5a8284610b80565b805b81146109b957600080fd5b8035610b5a81610c49565b600060208284031215610c7657610c76600080fd5b60006104458484610c56565b801515610b7a565b60208101610b5a8284610c82565b610c4b81610b60565b8035610b5a81610c98565b60008060408385031215610cc257610cc2600080fd5b6000610cce8585610ca1565b9250506020610cdf85828601610c56565b9150509250929050565b600060208284031215610cfe57610cfe600080fd5b60006104458484610ca1565b60208101610b5a8284610b71565b60008060408385031215610d2e57610d2e600080fd5b823567ffffffffffffffff811115610d4857610d48600080fd5b610cce85828601610be5565b63ffffffff8116610c4b565b8035610b5a81610d54565b60008083601f840112610d8057610d80600080fd5b50813567ffffffffffffffff811115610d9b57610d9b600080fd5b602083019150836001820283011115610db657610db6600080fd5b9250929050565b60ff8116610c4b565b8035610b5a81610dbd565b600080600080600060808688031215610dec57610dec600080fd5b6000610df88888610d60565b9550506020610e0988828901610d60565b945050604086013567ffffffffffffffff811115610e2957610e29600080fd5b610e3588828901610d6b565b93509350506060610e4888828901610dc6565b9150509295509295909350565b67ffffffffffffffff8116610b7a565b60208101610b5a8284610e55565b600060808284031215610bfa57610bfa600080fd5b60008083601f840112610e9d57610e9d600080fd5b50813567ffffffffffffffff811115610eb857610eb8600080fd5b602083019150836020820283011115610db657610db6600080fd5b60008060008060c08587031215610eec57610eec600080fd5b6000610ef88787610e73565b945050608085013567ffffffffffffffff811115610f1857610f18600080fd5b610f2487828801610e88565b935093505060a0610f3787828801610c56565b91505092959194509250565b60008060408385031215610f5957610f59600080fd5b6000610cce8585610c56565b60008060008060608587031215610f7e57610f7e600080fd5b843567ffffffffffffffff811115610f9857610f98600080fd5b610fa487828801610be5565b945050602085013567ffffffffffffffff811115610fc457610fc4600080fd5b610fd087828801610e88565b93509350506040610f3787828801610c56565b506000610b5a6020830183610ca1565b67ffffffffffffffff8116610c4b565b8035610b5a81610ff3565b506000610b5a6020830183611003565b506000610b5a6020830183610d60565b63ffffffff8116610b7a565b6000808335601e193685900301811261105557611055600080fd5b830160208101925035905067ffffffffffffffff81111561107857611078600080fd5b36819003821315610db657610db6600080fd5b82818337506000910152565b8183526020830192506110ab82848361108b565b50601f01601f19160190565b506000610b5a6020830183610dc6565b60ff8116610b7a565b600060c083016110e08380610fe3565b6110ea8582610b71565b506110f8602084018461100e565b6111056020860182610e55565b50611113604084018461101e565b611120604086018261102e565b5061112e606084018461101e565b61113b606086018261102e565b50611149608084018461103a565b858303608087015261115c838284611097565b9250505061116d60a08401846110b7565b61117a60a08601826110c7565b509392505050565b60208082528101610b5781846110d0565b60218152602081017f54686973206d65737361676520776173206e65766572207375626d69747465648152601760f91b602082015290505b60400190565b60208082528101610b5a81611193565b601a8152602081017f537461746520726f6f7420646f6573206e6f742065786973742e00000000000081529050610bcf565b60208082528101610b5a816111e1565b60308152602081017f417474656d7074696e6720746f2073656e642076616c756520776974686f757481527f2070726f766964696e6720457468657200000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611223565b604081016112998285610b80565b6103ad6020830184610e55565b60148152602081017f6661696c65642073656e64696e672076616c756500000000000000000000000081529050610bcf565b60208082528101610b5a816112a6565b634e487b7160e01b600052601160045260246000fd5b80820180821115610b5a57610b5a6112e8565b60218152602081017f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636581527f2100000000000000000000000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611311565b60006020828403121561138e5761138e600080fd5b60006104458484610d60565b60008135610b5a81610c98565b60006001600160a01b03835b81169019929092169190911792915050565b6000610b5a6001600160a01b0383166113dc565b90565b6001600160a01b031690565b6000610b5a826113c5565b6000610b5a826113e8565b611407826113f3565b6114128183546113a7565b8255505050565b60008135610b5a81610ff3565b60007bffffffffffffffff00000000000000000000000000000000000000006113b38460a01b90565b600067ffffffffffffffff8216610b5a565b61146a8261144f565b611412818354611426565b60008135610b5a81610d54565b60007fffffffff000000000000000000000000000000000000000000000000000000006113b38460e01b90565b600063ffffffff8216610b5a565b6114c6826114af565b611412818354611482565b600063ffffffff836113b3565b6114e7826114af565b6114128183546114d1565b6000808335601e193685900301811261150d5761150d600080fd5b8301915050803567ffffffffffffffff81111561152c5761152c600080fd5b602082019150600181023603821315610db657610db6600080fd5b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052602260045260246000fd5b60028104600182168061158757607f821691505b602082108103610bfa57610bfa61155d565b6000610b5a6113d98381565b6115ae83611599565b815460001960089490940293841b1916921b91909117905550565b60006106e18184846115a5565b81811015610552576115e96000826115c9565b6001016115d6565b601f8211156106e1576000818152602090206020601f850104810160208510156116185750805b6108396020601f8601048301826115d6565b8267ffffffffffffffff81111561164357611643611547565b61164d8254611573565b6116588282856115f1565b506000601f82116001811461168d57600083156116755750848201355b600019600885021c198116600285021785555061065e565b600084815260209020601f19841690835b828110156116be578785013582556020948501946001909201910161169e565b50848210156116db57600019601f86166008021c19848801351681555b5050505060020260010190555050565b6106e183838361162a565b60008135610b5a81610dbd565b600060ff836113b3565b600060ff8216610b5a565b6117218261170d565b611412818354611703565b8082806117388161139a565b905061174481846113fe565b5050602083018061175482611419565b90506117608184611461565b5050604083018061177082611475565b905061177c81846114bd565b50505060018101606083018061179182611475565b905061179d81846114de565b50505060028101608083016117b281856114f2565b91506117bf8282856116eb565b5050506003810160a08301806117d4826116f6565b90506108398184611718565b610552828261172c565b60c081016117f8828a610b71565b6118056020830189610e55565b611812604083018861102e565b61181f606083018761102e565b8181036080830152611832818587611097565b905061184160a08301846110c7565b98975050505050505050565b602a8152602081017f526f6f74206973206e6f74207075626c6973686564206f6e2074686973206d6581527f7373616765206275732e00000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a8161184d565b60218152602081017f526f6f74206973206e6f7420636f6e736964657265642066696e616c207965748152601760f91b602082015290506111cb565b60208082528101610b5a816118b5565b506000610b5a6020830183610c56565b61191b8180610fe3565b6119258382610b71565b506119336020820182610fe3565b6119406020840182610b71565b5061194e6040820182611901565b61195b6040840182610b80565b50611969606082018261100e565b6106e16060840182610e55565b60808101610b5a8284611911565b60018152602081017f760000000000000000000000000000000000000000000000000000000000000081529050610bcf565b604080825281016119c681611984565b9050610b5a6020830184610b80565b6119df8183610b80565b602001919050565b60338152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722076616c7581527f65207472616e73666572206d6573736167652e00000000000000000000000000602082015290506111cb565b60208082528101610b5a816119e7565b60258152602081017f526f6f7420616c726561647920616464656420746f20746865206d657373616781527f6520627573000000000000000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611a4f565b60018152602081017f6d0000000000000000000000000000000000000000000000000000000000000081529050610bcf565b604080825281016119c681611ab7565b60308152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722063726f7381527f7320636861696e206d6573736167652e00000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611af9565b67ffffffffffffffff918216919081169082820190811115610b5a57610b5a6112e8565b634e487b7160e01b600052603260045260246000fd5b600060018201611bad57611bad6112e8565b506001019056fea2646970667358221220c8293ff525ddcfd01a52b0bf26c4071757f9277603389aebc8011c7267aa4e7064736f6c63430008140033a2646970667358221220307774f9e39aef6bee804a0b42596a02f401aafd89ea5697b72f7adfd5d9696c64736f6c63430008140033",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"lastBatchHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"}],\"name\":\"CrossChainMessagesRootAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"EnclaveRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"initSecret\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"genesisAttestation\",\"type\":\"string\"}],\"name\":\"NetworkSecretInitialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"NetworkSecretRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"}],\"name\":\"NetworkSecretResponded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"generation\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"}],\"name\":\"NetworkSecretRotated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"RollupAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"}],\"name\":\"SecretRotationRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveRevoked\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structStructs.ValueTransferMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"ExtractNativeValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetRollupByNumber\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetUniqueForkID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"GrantSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_enclaveID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"IsRevoked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"IsSequencerEnclave\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"}],\"name\":\"RequestSecretRotation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"RevokeEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"RevokeSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"generation\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"}],\"name\":\"RotateNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_lastBatchHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"blockNum\",\"type\":\"uint256\"},{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"rollupNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"forkID\",\"type\":\"bytes32\"}],\"name\":\"addCrossChainMessagesRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"}],\"name\":\"isBundleAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isBundleSaved\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isWithdrawalSpent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"merkleMessageBus\",\"outputs\":[{\"internalType\":\"contractIMerkleTreeMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"secretGeneration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b506200001d3362000023565b62000094565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b6157c780620000a46000396000f3fe60806040523480156200001157600080fd5b50600436106200026f5760003560e01c80638236a7ba116200015f578063a52f433c11620000cc578063db5d91b11162000097578063e874eb20116200007a578063e874eb201462000615578063f2fde38b1462000629578063f34da4fe146200064057600080fd5b8063db5d91b114620005cf578063e34fbfc814620005fe57600080fd5b8063a52f433c146200056e578063bb938f00146200057f578063c1fd018c1462000589578063d4fab88714620005b857600080fd5b806398077e86116200012a578063a1a227fa116200010d578063a1a227fa146200051d578063a25eb31c1462000540578063a4ab2faa146200055757600080fd5b806398077e8614620004e05780639cc53d0c146200050657600080fd5b80638236a7ba146200044b57806384154826146200047257806387059edb14620004985780638da5cb5b14620004af57600080fd5b80634766573811620001fe5780636a30d26c11620001c9578063715018a611620001ac578063715018a6146200042d5780637281099614620004375780638129fc1c146200044157600080fd5b80636a30d26c14620003fd5780636b9707d6146200041657600080fd5b80634766573814620003915780635371a21614620003a8578063568699c814620003bf57806368e1038314620003e657600080fd5b80633c4ba33d116200023f5780633c4ba33d14620002fb5780633e60a22f146200031257806343348b2f1462000358578063440c953b146200038757600080fd5b80620ddd27146200027457806303e72e481462000296578063073b6ef314620002af5780632f0cb9e314620002c6575b600080fd5b6200027e60115481565b6040516200028d9190620020fc565b60405180910390f35b620002ad620002a736600462002252565b62000657565b005b620002ad620002c0366004620023ed565b6200076a565b620002ec620002d7366004620024da565b600f6020526000908152604090205460ff1681565b6040516200028d919062002508565b620002ad6200030c36600462002518565b620009c5565b6200034962000323366004620025ea565b80516020818301810180516006825292820191909301209152546001600160a01b031681565b6040516200028d919062002636565b620002ec6200036936600462002646565b6001600160a01b031660009081526020819052604090205460ff1690565b6200027e60085481565b620002ad620003a236600462002646565b62000be6565b620002ad620003b9366004620026d9565b62000c8d565b620003d6620003d0366004620024da565b62000e43565b6040516200028d929190620027f5565b620002ad620003f736600462002819565b62000e9c565b6200040762000f86565b6040516200028d91906200293a565b620002ad6200042736600462002646565b62001069565b620002ad62001100565b620002ad62001118565b620002ad620011a3565b620004626200045c366004620024da565b6200138d565b6040516200028d9291906200294d565b620002ec62000483366004620024da565b60106020526000908152604090205460ff1681565b62000462620004a9366004620024da565b6200147d565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031662000349565b620004f7620004f1366004620024da565b620014f7565b6040516200028d91906200295d565b620002ad6200051736600462002646565b620015ac565b600d5462000531906001600160a01b031681565b6040516200028d9190620029ba565b620002ad62000551366004620029f8565b62001667565b620002ec6200056836600462002a6a565b6200177d565b600754610100900460ff16620002ec565b6200027e60035481565b620002ec6200059a36600462002646565b6001600160a01b031660009081526002602052604090205460ff1690565b620002ad620005c936600462002ac1565b6200180f565b620002ec620005e036600462002646565b6001600160a01b031660009081526001602052604090205460ff1690565b620002ad6200060f36600462002b7f565b6200195f565b600e5462000531906001600160a01b031681565b620002ad6200063a36600462002646565b620019a8565b620002ad62000651366004620024da565b62001a06565b6200066162001a88565b60006001600160a01b03166006836040516200067e919062002bf2565b908152604051908190036020019020546001600160a01b031603620006dd57600580546001810182556000919091527f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db001620006db838262002ce2565b505b80600683604051620006f0919062002bf2565b90815260405190819003602001812080546001600160a01b039390931673ffffffffffffffffffffffffffffffffffffffff19909316929092179091557f17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5906200075e908490849062002daf565b60405180910390a15050565b6000828152600b60205260409020548114620007a35760405162461bcd60e51b81526004016200079a9062002e06565b60405180910390fd5b60006200081589898989604051602001620007c2949392919062002e76565b6040516020818303038152906040528051906020012086868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525062001b0092505050565b6001600160a01b03811660009081526020819052604090205490915060ff16620008535760405162461bcd60e51b81526004016200079a9062002ef5565b60118990556000805b87518110156200096357600e5488516001600160a01b039091169063b6aed0cb908a908490811062000892576200089262002f07565b6020026020010151620008a59062002f28565b426040518363ffffffff1660e01b8152600401620008c592919062002f62565b600060405180830381600087803b158015620008e057600080fd5b505af1158015620008f5573d6000803e3d6000fd5b50505050818882815181106200090f576200090f62002f07565b6020026020010151620009229062002f28565b6040516020016200093592919062002f62565b60405160208183030381529060405280519060200120915080806200095a9062002f97565b9150506200085c565b5060008181526010602052604090819020805460ff19166001179055517fa2d439b8dc41e7d35edf1afa64b5364832132a64407dba04b898d34bb0fac3e890620009b1908c908a9062002fb3565b60405180910390a150505050505050505050565b6001600160a01b03861660009081526001602052604090205460ff1662000a005760405162461bcd60e51b81526004016200079a9062003031565b6001600160a01b03851660009081526020819052604090205460ff1662000a3b5760405162461bcd60e51b81526004016200079a906200309c565b6000821162000a5e5760405162461bcd60e51b81526004016200079a9062003107565b600082815260046020526040812054900362000ac057600354821162000a985760405162461bcd60e51b81526004016200079a906200314c565b43811162000aba5760405162461bcd60e51b81526004016200079a90620031b7565b62000af0565b600082815260046020526040902054811462000af05760405162461bcd60e51b81526004016200079a9062003222565b600062000b26878785858860405160200162000b119594939291906200326a565b60405160208183030381529060405262001b30565b9050600062000b36828762001b00565b9050876001600160a01b0316816001600160a01b03161462000b6c5760405162461bcd60e51b81526004016200079a906200330b565b600084815260046020526040812054900362000b9957600084815260046020526040902083905560038490555b7f595ef08502511233e57a7b205b72c1e7a4b814bb403cb96381e62e7d8ba139ce88888888888860405162000bd4969594939291906200331d565b60405180910390a15050505050505050565b62000bf062001a88565b6001600160a01b03811660009081526020819052604090205460ff1662000c2b5760405162461bcd60e51b81526004016200079a9062002ef5565b6001600160a01b038116600090815260016020819052604091829020805460ff19169091179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e7609369062000c8290839062002636565b60405180910390a150565b600e546040517fb201246f0000000000000000000000000000000000000000000000000000000081526001600160a01b039091169063b201246f9062000cde908790879087908790600401620034ca565b60006040518083038186803b15801562000cf757600080fd5b505afa15801562000d0c573d6000803e3d6000fd5b5050505060008460405160200162000d25919062003509565b60408051601f1981840301815291815281516020928301206000818152600f90935291205490915060ff161562000d705760405162461bcd60e51b81526004016200079a906200354c565b6001600f60008760405160200162000d89919062003509565b60408051808303601f190181529181528151602092830120835282820193909352908201600020805460ff191693151593909317909255600d546001600160a01b0316916399a3ad219162000de49190890190890162002646565b87604001356040518363ffffffff1660e01b815260040162000e089291906200355e565b600060405180830381600087803b15801562000e2357600080fd5b505af115801562000e38573d6000803e3d6000fd5b505050505050505050565b60408051606080820183526000808352602083019190915291810182905260008062000e6f856200147d565b915091508162000e855760009590945092505050565b6000948552600b6020526040909420549492505050565b60075460ff161562000ec25760405162461bcd60e51b81526004016200079a90620035ac565b60078054600160ff1991821681179092556001600160a01b0387166000908152602081815260408083208054851686179055908490529081902080549092169092179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e7609369062000f3690879062002636565b60405180910390a17fe0f100302fc76f7504507324709f48d439e22faa2d67fd7ee47c6959410f76c5858585858560405162000f77959493929190620035e0565b60405180910390a15050505050565b60606005805480602002602001604051908101604052809291908181526020016000905b828210156200106057838290600052602060002001805462000fcc9062002c14565b80601f016020809104026020016040519081016040528092919081815260200182805462000ffa9062002c14565b80156200104b5780601f106200101f576101008083540402835291602001916200104b565b820191906000526020600020905b8154815290600101906020018083116200102d57829003601f168201915b50505050508152602001906001019062000faa565b50505050905090565b6200107362001a88565b6001600160a01b03811660009081526001602052604090205460ff16620010ae5760405162461bcd60e51b81526004016200079a906200364f565b6001600160a01b03811660009081526001602052604090819020805460ff19169055517f0f279980343c7ca542fde9fa5396555068efb5cd560d9cf9c191aa2911079b479062000c8290839062002636565b6200110a62001a88565b62001116600062001b6f565b565b6200112262001a88565b600d546040517f36d2da900000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906336d2da90906200116d90339060040162002636565b600060405180830381600087803b1580156200118857600080fd5b505af11580156200119d573d6000803e3d6000fd5b50505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff16600081158015620011ef5750825b905060008267ffffffffffffffff1660011480156200120d5750303b155b9050811580156200121c575080155b1562001254576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff1916600117855583156200128957845468ff00000000000000001916680100000000000000001785555b620012943362001bed565b60006008556001600c55604051620012ac90620020e6565b604051809103906000f080158015620012c9573d6000803e3d6000fd5b50600e80546001600160a01b039290921673ffffffffffffffffffffffffffffffffffffffff199283168117909155600d805490921681179091556040517fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf91620013349162002636565b60405180910390a183156200138657845468ff0000000000000000191685556040517fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29062000f77906001906200367f565b5050505050565b604080516060808201835260008083526020808401839052838501829052858252600981528482208551938401909552845483526001850180549295869493909284019190620013dd9062002c14565b80601f01602080910402602001604051908101604052809291908181526020018280546200140b9062002c14565b80156200145c5780601f1062001430576101008083540402835291602001916200145c565b820191906000526020600020905b8154815290600101906020018083116200143e57829003601f168201915b50505091835250506002919091015460209091015280519094149492505050565b6040805160608082018352600080835260208301919091529181018290526000838152600a602052604081205490819003620014e257505060408051606081018252600080825282516020818101855282825283015291810182905290939092509050565b620014ed816200138d565b9250925050915091565b600581815481106200150857600080fd5b906000526020600020016000915090508054620015259062002c14565b80601f0160208091040260200160405190810160405280929190818152602001828054620015539062002c14565b8015620015a45780601f106200157857610100808354040283529160200191620015a4565b820191906000526020600020905b8154815290600101906020018083116200158657829003601f168201915b505050505081565b620015b662001a88565b6001600160a01b03811660009081526020819052604090205460ff16620015f15760405162461bcd60e51b81526004016200079a9062002ef5565b6001600160a01b038116600090815260208181526040808320805460ff1990811690915560018084528285208054831690556002909352928190208054909316909117909155517f014328d04215ef25a11c630f007f31516a065906981503bcb8cdf4998651c18b9062000c8290839062002636565b6000620016b983356200167e60208601866200368f565b8080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525062001b0092505050565b6001600160a01b03811660009081526020819052604090205490915060ff16620016f75760405162461bcd60e51b81526004016200079a9062002ef5565b6001600160a01b03811660009081526001602052604090205460ff16620017325760405162461bcd60e51b81526004016200079a906200364f565b6200173d8362001c02565b6040517fd6555bff8670bd3008dc064c30bb56d6ac7cb14ae801e36146fe4e7c6a504a58906200177090853590620020fc565b60405180910390a1505050565b600080805b8351811015620017f65781848281518110620017a257620017a262002f07565b6020026020010151620017b59062002f28565b604051602001620017c892919062002f62565b6040516020818303038152906040528051906020012091508080620017ed9062002f97565b91505062001782565b5060009081526010602052604090205460ff1692915050565b6001600160a01b03851660009081526020819052604090205460ff16806200184b5760405162461bcd60e51b81526004016200079a9062003743565b6001600160a01b03851660009081526002602052604090205460ff1615620018875760405162461bcd60e51b81526004016200079a9062003793565b8115620018f4576000620018ab87878660405160200162000b1193929190620037a5565b90506000620018bb828762001b00565b9050876001600160a01b0316816001600160a01b031614620018f15760405162461bcd60e51b81526004016200079a906200330b565b50505b6001600160a01b03851660009081526020819052604090819020805460ff19166001179055517f686403995c0f8cb5e01d938e743b0cca053ffee94de7b24013c8b2ed0b10d265906200194f908890889088908890620037cf565b60405180910390a1505050505050565b336001600160a01b03167f0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d430183836040516200199c92919062003818565b60405180910390a25050565b620019b262001a88565b6001600160a01b038116620019f85760006040517f1e4fbdf70000000000000000000000000000000000000000000000000000000081526004016200079a919062002636565b62001a038162001b6f565b50565b62001a1062001a88565b60075460ff1662001a355760405162461bcd60e51b81526004016200079a906200385f565b43811162001a575760405162461bcd60e51b81526004016200079a90620031b7565b7f448764bb6ca12563d53377348a63f1e779125ac48d53ce7685981fbe7172c6508160405162000c829190620020fc565b3362001abb7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316146200111657336040517f118cdaa70000000000000000000000000000000000000000000000000000000081526004016200079a919062002636565b60008060008062001b12868662001caf565b92509250925062001b24828262001d00565b50909150505b92915050565b600062001b3e825162001e1a565b8260405160200162001b5292919062003871565b604051602081830303815290604052805190602001209050919050565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300805473ffffffffffffffffffffffffffffffffffffffff1981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b62001bf762001ec2565b62001a038162001f2a565b80356000908152600960205260409020819062001c20828262003a42565b5050600c546000908152600a6020526040902081359081905562001c4660014362003a4e565b4060405160200162001c5a92919062002f62565b60408051601f198184030181529181528151602092830120600c80546000908152600b9094529183205580549162001c928362002f97565b91905055506008548160400135111562001a035760400135600855565b6000806000835160410362001ced5760208401516040850151606086015160001a62001cde8882858562001f34565b95509550955050505062001cf9565b50508151600091506002905b9250925092565b600082600381111562001d175762001d1762003a64565b0362001d21575050565b600182600381111562001d385762001d3862003a64565b0362001d70576040517ff645eedf00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600282600381111562001d875762001d8762003a64565b0362001dc3576040517ffce698f70000000000000000000000000000000000000000000000000000000081526200079a908290600401620020fc565b600382600381111562001dda5762001dda62003a64565b0362001e1657806040517fd78bce0c0000000000000000000000000000000000000000000000000000000081526004016200079a9190620020fc565b5050565b6060600062001e298362001ffd565b600101905060008167ffffffffffffffff81111562001e4c5762001e4c6200210c565b6040519080825280601f01601f19166020018201604052801562001e77576020820181803683370190505b5090508181016020015b600019017f3031323334353637383961626364656600000000000000000000000000000000600a86061a8153600a850494508462001e81575b509392505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff1662001116576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b620019b262001ec2565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a084111562001f71575060009150600390508262001ff3565b60006001888888886040516000815260200160405260405162001f98949392919062003a84565b6020604051602081039080840390855afa15801562001fbb573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811662001fe95750600092506001915082905062001ff3565b9250600091508190505b9450945094915050565b6000807a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000831062002047577a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000830492506040015b6d04ee2d6d415b85acef8100000000831062002074576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc1000083106200209357662386f26fc10000830492506010015b6305f5e1008310620020ac576305f5e100830492506008015b6127108310620020c157612710830492506004015b60648310620020d4576064830492506002015b600a831062001b2a5760010192915050565b611cd08062003ac283390190565b805b82525050565b6020810162001b2a8284620020f4565b634e487b7160e01b600052604160045260246000fd5b601f19601f830116810181811067ffffffffffffffff821117156200214b576200214b6200210c565b6040525050565b60006200215e60405190565b90506200216c828262002122565b919050565b600067ffffffffffffffff8211156200218e576200218e6200210c565b601f19601f83011660200192915050565b82818337506000910152565b6000620021c2620021bc8462002171565b62002152565b905082815260208101848484011115620021df57620021df600080fd5b62001eba8482856200219f565b600082601f830112620022025762002202600080fd5b813562002214848260208601620021ab565b949350505050565b60006001600160a01b03821662001b2a565b62002239816200221c565b811462001a0357600080fd5b803562001b2a816200222e565b600080604083850312156200226a576200226a600080fd5b823567ffffffffffffffff811115620022865762002286600080fd5b6200229485828601620021ec565b9250506020620022a78582860162002245565b9150509250929050565b8062002239565b803562001b2a81620022b1565b600067ffffffffffffffff821115620022e257620022e26200210c565b5060209081020190565b6000620022fd620021bc84620022c5565b838152905060208082019084028301858111156200231e576200231e600080fd5b835b818110156200236357803567ffffffffffffffff811115620023455762002345600080fd5b8501620023538882620021ec565b8452506020928301920162002320565b5050509392505050565b600082601f830112620023835762002383600080fd5b813562002214848260208601620022ec565b60008083601f840112620023ac57620023ac600080fd5b50813567ffffffffffffffff811115620023c957620023c9600080fd5b602083019150836001820283011115620023e657620023e6600080fd5b9250929050565b60008060008060008060008060e0898b0312156200240e576200240e600080fd5b60006200241c8b8b620022b8565b98505060206200242f8b828c01620022b8565b9750506040620024428b828c01620022b8565b965050606089013567ffffffffffffffff811115620024645762002464600080fd5b620024728b828c016200236d565b955050608089013567ffffffffffffffff811115620024945762002494600080fd5b620024a28b828c0162002395565b945094505060a0620024b78b828c01620022b8565b92505060c0620024ca8b828c01620022b8565b9150509295985092959890939650565b600060208284031215620024f157620024f1600080fd5b6000620022148484620022b8565b801515620020f6565b6020810162001b2a8284620024ff565b60008060008060008060c08789031215620025365762002536600080fd5b600062002544898962002245565b96505060206200255789828a0162002245565b955050604087013567ffffffffffffffff811115620025795762002579600080fd5b6200258789828a01620021ec565b945050606087013567ffffffffffffffff811115620025a957620025a9600080fd5b620025b789828a01620021ec565b9350506080620025ca89828a01620022b8565b92505060a0620025dd89828a01620022b8565b9150509295509295509295565b600060208284031215620026015762002601600080fd5b813567ffffffffffffffff8111156200261d576200261d600080fd5b6200221484828501620021ec565b620020f6816200221c565b6020810162001b2a82846200262b565b6000602082840312156200265d576200265d600080fd5b600062002214848462002245565b600060808284031215620026825762002682600080fd5b50919050565b60008083601f8401126200269f576200269f600080fd5b50813567ffffffffffffffff811115620026bc57620026bc600080fd5b602083019150836020820283011115620023e657620023e6600080fd5b60008060008060c08587031215620026f457620026f4600080fd5b60006200270287876200266b565b945050608085013567ffffffffffffffff811115620027245762002724600080fd5b620027328782880162002688565b935093505060a06200274787828801620022b8565b91505092959194509250565b60005b838110156200277057818101518382015260200162002756565b50506000910152565b600062002784825190565b8084526020840193506200279d81856020860162002753565b601f01601f19169290920192915050565b80516000906060840190620027c48582620020f4565b5060208301518482036020860152620027de828262002779565b915050604083015162001eba6040860182620020f4565b60408101620028058285620020f4565b8181036020830152620022148184620027ae565b600080600080600060608688031215620028365762002836600080fd5b600062002844888862002245565b955050602086013567ffffffffffffffff811115620028665762002866600080fd5b620028748882890162002395565b9450945050604086013567ffffffffffffffff811115620028985762002898600080fd5b620028a68882890162002395565b92509250509295509295909350565b6000620028c3838362002779565b9392505050565b60200190565b6000620028db825190565b80845260208401935083602082028501620028f68560200190565b60005b848110156200292e5783830388528151620029158482620028b5565b93505060208201602098909801979150600101620028f9565b50909695505050505050565b60208082528101620028c38184620028d0565b60408101620028058285620024ff565b60208082528101620028c3818462002779565b600062001b2a6001600160a01b03831662002989565b90565b6001600160a01b031690565b600062001b2a8262002970565b600062001b2a8262002995565b620020f681620029a2565b6020810162001b2a8284620029af565b600060608284031215620026825762002682600080fd5b600060208284031215620026825762002682600080fd5b6000806040838503121562002a105762002a10600080fd5b823567ffffffffffffffff81111562002a2c5762002a2c600080fd5b62002a3a85828601620029ca565b925050602083013567ffffffffffffffff81111562002a5c5762002a5c600080fd5b620022a785828601620029e1565b60006020828403121562002a815762002a81600080fd5b813567ffffffffffffffff81111562002a9d5762002a9d600080fd5b62002214848285016200236d565b80151562002239565b803562001b2a8162002aab565b600080600080600060a0868803121562002ade5762002ade600080fd5b600062002aec888862002245565b955050602062002aff8882890162002245565b945050604086013567ffffffffffffffff81111562002b215762002b21600080fd5b62002b2f88828901620021ec565b935050606086013567ffffffffffffffff81111562002b515762002b51600080fd5b62002b5f88828901620021ec565b925050608062002b728882890162002ab4565b9150509295509295909350565b6000806020838503121562002b975762002b97600080fd5b823567ffffffffffffffff81111562002bb35762002bb3600080fd5b62002bc18582860162002395565b92509250509250929050565b600062002bd8825190565b62002be881856020860162002753565b9290920192915050565b62001b2a818362002bcd565b634e487b7160e01b600052602260045260246000fd5b60028104600182168062002c2957607f821691505b60208210810362002682576200268262002bfe565b600062001b2a620029868381565b62002c578362002c3e565b815460001960089490940293841b1916921b91909117905550565b600062002c8181848462002c4c565b505050565b8181101562001e165762002c9c60008262002c72565b60010162002c86565b601f82111562002c81576000818152602090206020601f8501048101602085101562002cce5750805b620013866020601f86010483018262002c86565b815167ffffffffffffffff81111562002cff5762002cff6200210c565b62002d0b825462002c14565b62002d1882828562002ca5565b506020601f82116001811462002d50576000831562002d375750848201515b600019600885021c198116600285021785555062001386565b600084815260208120601f198516915b8281101562002d82578785015182556020948501946001909201910162002d60565b508482101562002da05783870151600019601f87166008021c191681555b50505050600202600101905550565b6040808252810162002dc2818562002779565b9050620028c360208301846200262b565b600e8152602081017f496e76616c696420666f726b494400000000000000000000000000000000000081529050620028ca565b6020808252810162001b2a8162002dd3565b600062002e23825190565b8084526020840193508360208202850162002e3e8560200190565b60005b848110156200292e578383038852815162002e5d8482620028b5565b9350506020820160209890980197915060010162002e41565b6080810162002e868287620020f4565b62002e956020830186620020f4565b62002ea46040830185620020f4565b818103606083015262002eb8818462002e18565b9695505050505050565b60168152602081017f656e636c6176654944206e6f742061747465737465640000000000000000000081529050620028ca565b6020808252810162001b2a8162002ec2565b634e487b7160e01b600052603260045260246000fd5b600062001b2a825190565b600062002f33825190565b6020830162002f428162002f1d565b925050602081101562002682576000196020919091036008021b16919050565b6040810162002f728285620020f4565b620028c36020830184620020f4565b634e487b7160e01b600052601160045260246000fd5b60006001820162002fac5762002fac62002f81565b5060010190565b6040810162002fc38285620020f4565b818103602083015262002214818462002e18565b60248152602081017f726f746174696e67206174746573746572206973206e6f74206120736571756581527f6e63657200000000000000000000000000000000000000000000000000000000602082015290505b60400190565b6020808252810162001b2a8162002fd7565b60218152602081017f72657175657374657220656e636c617665206973206e6f74206174746573746581527f6400000000000000000000000000000000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a8162003043565b60228152602081017f67656e65726174696f6e20302069732074686520696e697469616c207365637281527f6574000000000000000000000000000000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a81620030ae565b601d8152602081017f7365637265742067656e65726174696f6e206973206f7574646174656400000081529050620028ca565b6020808252810162001b2a8162003119565b60278152602081017f61637469766174696f6e20686569676874206d75737420626520696e2074686581527f2066757475726500000000000000000000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a816200315e565b602f8152602081017f61637469766174696f6e2068656967687420646f6573206e6f74206d6174636881527f207468652067656e65726174696f6e0000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a81620031c9565b600062001b2a8260601b90565b600062001b2a8262003234565b620020f66200325d826200221c565b62003241565b80620020f6565b6200327681876200324e565b6014016200328581866200324e565b60140162003294818562003263565b602001620032a3818462003263565b60200162002eb8818362002bcd565b602c8152602081017f63616c63756c61746564206164647265737320616e642061747465737465724981527f4420646f6e74206d617463680000000000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a81620032b2565b60c081016200332d82896200262b565b6200333c60208301886200262b565b818103604083015262003350818762002779565b9050818103606083015262003366818662002779565b9050620033776080830185620020f4565b6200338660a0830184620020f4565b979650505050505050565b50600062001b2a602083018362002245565b50600062001b2a6020830183620022b8565b67ffffffffffffffff811662002239565b803562001b2a81620033b5565b50600062001b2a6020830183620033c6565b67ffffffffffffffff8116620020f6565b62003402818062003391565b6200340e83826200262b565b506200341e602082018262003391565b6200342d60208401826200262b565b506200343d6040820182620033a3565b6200344c6040840182620020f4565b506200345c6060820182620033d3565b62002c816060840182620033e5565b82818337505050565b81835260208301925060007f07ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff831115620034b157620034b1600080fd5b602083029250620034c48385846200346b565b50500190565b60c08101620034da8287620033f6565b8181036080830152620034ef81858762003474565b90506200350060a0830184620020f4565b95945050505050565b6080810162001b2a8284620033f6565b60188152602081017f7769746864726177616c20616c7265616479207370656e74000000000000000081529050620028ca565b6020808252810162001b2a8162003519565b6040810162002f7282856200262b565b60228152602081017f6e6574776f726b2073656372657420616c726561647920696e697469616c697a815261195960f21b602082015290506200302b565b6020808252810162001b2a816200356e565b818352602083019250620035d48284836200219f565b50601f01601f19160190565b60608101620035f082886200262b565b818103602083015262003605818688620035be565b9050818103604083015262003386818486620035be565b60198152602081017f656e636c6176654944206e6f7420612073657175656e6365720000000000000081529050620028ca565b6020808252810162001b2a816200361c565b600067ffffffffffffffff821662001b2a565b620020f68162003661565b6020810162001b2a828462003674565b6000808335601e1936859003018112620036ac57620036ac600080fd5b8301915050803567ffffffffffffffff811115620036cd57620036cd600080fd5b602082019150600181023603821315620023e657620023e6600080fd5b60238152602081017f726573706f6e64696e67206174746573746572206973206e6f7420617474657381527f7465640000000000000000000000000000000000000000000000000000000000602082015290506200302b565b6020808252810162001b2a81620036ea565b60228152602081017f72657175657374657220656e636c61766520686173206265656e207265766f6b815261195960f21b602082015290506200302b565b6020808252810162001b2a8162003755565b620037b181856200324e565b601401620037c081846200324e565b60140162002214818362002bcd565b60808101620037df82876200262b565b620037ee60208301866200262b565b818103604083015262003802818562002779565b9050818103606083015262002eb8818462002779565b6020808252810162002214818486620035be565b601e8152602081017f6e6574776f726b20736563726574206e6f7420696e697469616c697a6564000081529050620028ca565b6020808252810162001b2a816200382c565b7f19457468657265756d205369676e6564204d6573736167653a0a0000000000008152601a01620038a3818462002bcd565b9050620028c3818362002bcd565b6000813562001b2a81620022b1565b60008162001b2a565b620038d482620038c0565b620038e36200298682620038c0565b8255505050565b8267ffffffffffffffff8111156200390657620039066200210c565b62003912825462002c14565b6200391f82828562002ca5565b506000601f8211600181146200395757600083156200393e5750848201355b600019600885021c1981166002850217855550620039b4565b600084815260209020601f19841690835b828110156200398a578785013582556020948501946001909201910162003968565b5084821015620039a857600019601f86166008021c19848801351681555b50506001600284020184555b505050505050565b62002c81838383620038ea565b620039d48262002c3e565b80620038e3565b808280620039e981620038b1565b9050620039f78184620038c9565b505050600181016020830162003a0e81856200368f565b915062003a1d828285620039bc565b50505060028101604083018062003a3482620038b1565b9050620013868184620039c9565b62001e168282620039db565b8181038181111562001b2a5762001b2a62002f81565b634e487b7160e01b600052602160045260246000fd5b60ff8116620020f6565b6080810162003a948287620020f4565b62003aa3602083018662003a7a565b62003ab26040830185620020f4565b620035006060830184620020f456fe60806040523480156200001157600080fd5b50338062000040576000604051631e4fbdf760e01b8152600401620000379190620000c6565b60405180910390fd5b6200004b8162000052565b50620000d6565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b60006001600160a01b0382165b92915050565b620000c081620000a2565b82525050565b60208101620000af8284620000b5565b611bea80620000e66000396000f3fe6080604052600436106100e15760003560e01c80639730886d1161007f578063b201246f11610059578063b201246f146102d4578063b6aed0cb146102f4578063e138a8d214610314578063f2fde38b1461033457610155565b80639730886d1461026757806399a3ad2114610287578063b1454caa146102a757610155565b8063346633fb116100bb578063346633fb146101f957806336d2da901461020c578063715018a61461022c5780638da5cb5b1461024157610155565b80630fcfbd11146101765780630fe9188e146101ac57806333a88c72146101cc57610155565b36610155576040517f346633fb000000000000000000000000000000000000000000000000000000008152309063346633fb9034906101269033908390600401610b86565b6000604051808303818588803b15801561013f57600080fd5b505af1158015610153573d6000803e3d6000fd5b005b60405162461bcd60e51b815260040161016d90610bd5565b60405180910390fd5b34801561018257600080fd5b50610196610191366004610c00565b610354565b6040516101a39190610c3b565b60405180910390f35b3480156101b857600080fd5b506101536101c7366004610c61565b6103b4565b3480156101d857600080fd5b506101ec6101e7366004610c00565b6103fa565b6040516101a39190610c8a565b610153610207366004610cac565b61044d565b34801561021857600080fd5b50610153610227366004610ce9565b6104d7565b34801561023857600080fd5b50610153610556565b34801561024d57600080fd5b506000546001600160a01b03166040516101a39190610d0a565b34801561027357600080fd5b50610153610282366004610d18565b61056a565b34801561029357600080fd5b506101536102a2366004610cac565b610666565b3480156102b357600080fd5b506102c76102c2366004610dd1565b6106e6565b6040516101a39190610e65565b3480156102e057600080fd5b506101536102ef366004610ed3565b61073f565b34801561030057600080fd5b5061015361030f366004610f43565b610840565b34801561032057600080fd5b5061015361032f366004610f65565b610886565b34801561034057600080fd5b5061015361034f366004610ce9565b610965565b600080826040516020016103689190611182565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150806103ad5760405162461bcd60e51b815260040161016d906111d1565b9392505050565b6103bc6109bc565b60008181526004602052604081205490036103e95760405162461bcd60e51b815260040161016d90611213565b600090815260046020526040812055565b6000808260405160200161040e9190611182565b60408051601f1981840301815291815281516020928301206000818152600190935291205490915080158015906104455750428111155b949350505050565b60003411801561045c57508034145b6104785760405162461bcd60e51b815260040161016d9061127b565b600061048333610a02565b9050826001600160a01b0316336001600160a01b03167f50c536ac33a920f00755865b831d17bf4cff0b2e0345f65b16d52bfc004068b634846040516104ca92919061128b565b60405180910390a3505050565b6104df6109bc565b6000816001600160a01b03164760405160006040518083038185875af1925050503d806000811461052c576040519150601f19603f3d011682016040523d82523d6000602084013e610531565b606091505b50509050806105525760405162461bcd60e51b815260040161016d906112d8565b5050565b61055e6109bc565b6105686000610a60565b565b6105726109bc565b600061057e82426112fe565b90506000836040516020016105939190611182565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150156105d85760405162461bcd60e51b815260040161016d90611369565b60008181526001602090815260408220849055600291906105fb90870187610ce9565b6001600160a01b0316815260208101919091526040016000908120906106276080870160608801611379565b63ffffffff1681526020808201929092526040016000908120805460018101825590825291902085916004020161065e82826117e0565b505050505050565b61066e6109bc565b6000826001600160a01b03168260405160006040518083038185875af1925050503d80600081146106bb576040519150601f19603f3d011682016040523d82523d6000602084013e6106c0565b606091505b50509050806106e15760405162461bcd60e51b815260040161016d906112d8565b505050565b60006106f133610a02565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef7759373382888888888860405161072e97969594939291906117ea565b60405180910390a195945050505050565b600081815260046020526040812054900361076c5760405162461bcd60e51b815260040161016d906118a5565b60008181526004602052604090205442101561079a5760405162461bcd60e51b815260040161016d906118f1565b6000846040516020016107ad9190611976565b604051602081830303815290604052805190602001206040516020016107d391906119b6565b60405160208183030381529060405280519060200120905061081d8484848460405160200161080291906119d5565b60405160208183030381529060405280519060200120610ac8565b6108395760405162461bcd60e51b815260040161016d90611a3f565b5050505050565b6108486109bc565b600082815260046020526040902054156108745760405162461bcd60e51b815260040161016d90611aa7565b60009182526004602052604090912055565b60008181526004602052604081205490036108b35760405162461bcd60e51b815260040161016d906118a5565b6000818152600460205260409020544210156108e15760405162461bcd60e51b815260040161016d906118f1565b6000846040516020016108f49190611182565b6040516020818303038152906040528051906020012060405160200161091a9190611ae9565b6040516020818303038152906040528051906020012090506109498484848460405160200161080291906119d5565b6108395760405162461bcd60e51b815260040161016d90611b51565b61096d6109bc565b6001600160a01b0381166109b05760006040517f1e4fbdf700000000000000000000000000000000000000000000000000000000815260040161016d9190610d0a565b6109b981610a60565b50565b6000546001600160a01b0316331461056857336040517f118cdaa700000000000000000000000000000000000000000000000000000000815260040161016d9190610d0a565b6001600160a01b0381166000908152600360205260408120805467ffffffffffffffff169160019190610a358385611b61565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b600080546001600160a01b038381167fffffffffffffffffffffffff0000000000000000000000000000000000000000831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b600082610ad6868685610ae0565b1495945050505050565b600081815b84811015610b2357610b0f82878784818110610b0357610b03611b85565b90506020020135610b2c565b915080610b1b81611b9b565b915050610ae5565b50949350505050565b6000818310610b48576000828152602084905260409020610b57565b60008381526020839052604090205b90505b92915050565b60006001600160a01b038216610b5a565b610b7a81610b60565b82525050565b80610b7a565b60408101610b948285610b71565b6103ad6020830184610b80565b600b8152602081017f756e737570706f72746564000000000000000000000000000000000000000000815290505b60200190565b60208082528101610b5a81610ba1565b600060c08284031215610bfa57610bfa600080fd5b50919050565b600060208284031215610c1557610c15600080fd5b813567ffffffffffffffff811115610c2f57610c2f600080fd5b61044584828501610be5565b60208101610b5a8284610b80565b805b81146109b957600080fd5b8035610b5a81610c49565b600060208284031215610c7657610c76600080fd5b60006104458484610c56565b801515610b7a565b60208101610b5a8284610c82565b610c4b81610b60565b8035610b5a81610c98565b60008060408385031215610cc257610cc2600080fd5b6000610cce8585610ca1565b9250506020610cdf85828601610c56565b9150509250929050565b600060208284031215610cfe57610cfe600080fd5b60006104458484610ca1565b60208101610b5a8284610b71565b60008060408385031215610d2e57610d2e600080fd5b823567ffffffffffffffff811115610d4857610d48600080fd5b610cce85828601610be5565b63ffffffff8116610c4b565b8035610b5a81610d54565b60008083601f840112610d8057610d80600080fd5b50813567ffffffffffffffff811115610d9b57610d9b600080fd5b602083019150836001820283011115610db657610db6600080fd5b9250929050565b60ff8116610c4b565b8035610b5a81610dbd565b600080600080600060808688031215610dec57610dec600080fd5b6000610df88888610d60565b9550506020610e0988828901610d60565b945050604086013567ffffffffffffffff811115610e2957610e29600080fd5b610e3588828901610d6b565b93509350506060610e4888828901610dc6565b9150509295509295909350565b67ffffffffffffffff8116610b7a565b60208101610b5a8284610e55565b600060808284031215610bfa57610bfa600080fd5b60008083601f840112610e9d57610e9d600080fd5b50813567ffffffffffffffff811115610eb857610eb8600080fd5b602083019150836020820283011115610db657610db6600080fd5b60008060008060c08587031215610eec57610eec600080fd5b6000610ef88787610e73565b945050608085013567ffffffffffffffff811115610f1857610f18600080fd5b610f2487828801610e88565b935093505060a0610f3787828801610c56565b91505092959194509250565b60008060408385031215610f5957610f59600080fd5b6000610cce8585610c56565b60008060008060608587031215610f7e57610f7e600080fd5b843567ffffffffffffffff811115610f9857610f98600080fd5b610fa487828801610be5565b945050602085013567ffffffffffffffff811115610fc457610fc4600080fd5b610fd087828801610e88565b93509350506040610f3787828801610c56565b506000610b5a6020830183610ca1565b67ffffffffffffffff8116610c4b565b8035610b5a81610ff3565b506000610b5a6020830183611003565b506000610b5a6020830183610d60565b63ffffffff8116610b7a565b6000808335601e193685900301811261105557611055600080fd5b830160208101925035905067ffffffffffffffff81111561107857611078600080fd5b36819003821315610db657610db6600080fd5b82818337506000910152565b8183526020830192506110ab82848361108b565b50601f01601f19160190565b506000610b5a6020830183610dc6565b60ff8116610b7a565b600060c083016110e08380610fe3565b6110ea8582610b71565b506110f8602084018461100e565b6111056020860182610e55565b50611113604084018461101e565b611120604086018261102e565b5061112e606084018461101e565b61113b606086018261102e565b50611149608084018461103a565b858303608087015261115c838284611097565b9250505061116d60a08401846110b7565b61117a60a08601826110c7565b509392505050565b60208082528101610b5781846110d0565b60218152602081017f54686973206d65737361676520776173206e65766572207375626d69747465648152601760f91b602082015290505b60400190565b60208082528101610b5a81611193565b601a8152602081017f537461746520726f6f7420646f6573206e6f742065786973742e00000000000081529050610bcf565b60208082528101610b5a816111e1565b60308152602081017f417474656d7074696e6720746f2073656e642076616c756520776974686f757481527f2070726f766964696e6720457468657200000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611223565b604081016112998285610b80565b6103ad6020830184610e55565b60148152602081017f6661696c65642073656e64696e672076616c756500000000000000000000000081529050610bcf565b60208082528101610b5a816112a6565b634e487b7160e01b600052601160045260246000fd5b80820180821115610b5a57610b5a6112e8565b60218152602081017f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636581527f2100000000000000000000000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611311565b60006020828403121561138e5761138e600080fd5b60006104458484610d60565b60008135610b5a81610c98565b60006001600160a01b03835b81169019929092169190911792915050565b6000610b5a6001600160a01b0383166113dc565b90565b6001600160a01b031690565b6000610b5a826113c5565b6000610b5a826113e8565b611407826113f3565b6114128183546113a7565b8255505050565b60008135610b5a81610ff3565b60007bffffffffffffffff00000000000000000000000000000000000000006113b38460a01b90565b600067ffffffffffffffff8216610b5a565b61146a8261144f565b611412818354611426565b60008135610b5a81610d54565b60007fffffffff000000000000000000000000000000000000000000000000000000006113b38460e01b90565b600063ffffffff8216610b5a565b6114c6826114af565b611412818354611482565b600063ffffffff836113b3565b6114e7826114af565b6114128183546114d1565b6000808335601e193685900301811261150d5761150d600080fd5b8301915050803567ffffffffffffffff81111561152c5761152c600080fd5b602082019150600181023603821315610db657610db6600080fd5b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052602260045260246000fd5b60028104600182168061158757607f821691505b602082108103610bfa57610bfa61155d565b6000610b5a6113d98381565b6115ae83611599565b815460001960089490940293841b1916921b91909117905550565b60006106e18184846115a5565b81811015610552576115e96000826115c9565b6001016115d6565b601f8211156106e1576000818152602090206020601f850104810160208510156116185750805b6108396020601f8601048301826115d6565b8267ffffffffffffffff81111561164357611643611547565b61164d8254611573565b6116588282856115f1565b506000601f82116001811461168d57600083156116755750848201355b600019600885021c198116600285021785555061065e565b600084815260209020601f19841690835b828110156116be578785013582556020948501946001909201910161169e565b50848210156116db57600019601f86166008021c19848801351681555b5050505060020260010190555050565b6106e183838361162a565b60008135610b5a81610dbd565b600060ff836113b3565b600060ff8216610b5a565b6117218261170d565b611412818354611703565b8082806117388161139a565b905061174481846113fe565b5050602083018061175482611419565b90506117608184611461565b5050604083018061177082611475565b905061177c81846114bd565b50505060018101606083018061179182611475565b905061179d81846114de565b50505060028101608083016117b281856114f2565b91506117bf8282856116eb565b5050506003810160a08301806117d4826116f6565b90506108398184611718565b610552828261172c565b60c081016117f8828a610b71565b6118056020830189610e55565b611812604083018861102e565b61181f606083018761102e565b8181036080830152611832818587611097565b905061184160a08301846110c7565b98975050505050505050565b602a8152602081017f526f6f74206973206e6f74207075626c6973686564206f6e2074686973206d6581527f7373616765206275732e00000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a8161184d565b60218152602081017f526f6f74206973206e6f7420636f6e736964657265642066696e616c207965748152601760f91b602082015290506111cb565b60208082528101610b5a816118b5565b506000610b5a6020830183610c56565b61191b8180610fe3565b6119258382610b71565b506119336020820182610fe3565b6119406020840182610b71565b5061194e6040820182611901565b61195b6040840182610b80565b50611969606082018261100e565b6106e16060840182610e55565b60808101610b5a8284611911565b60018152602081017f760000000000000000000000000000000000000000000000000000000000000081529050610bcf565b604080825281016119c681611984565b9050610b5a6020830184610b80565b6119df8183610b80565b602001919050565b60338152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722076616c7581527f65207472616e73666572206d6573736167652e00000000000000000000000000602082015290506111cb565b60208082528101610b5a816119e7565b60258152602081017f526f6f7420616c726561647920616464656420746f20746865206d657373616781527f6520627573000000000000000000000000000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611a4f565b60018152602081017f6d0000000000000000000000000000000000000000000000000000000000000081529050610bcf565b604080825281016119c681611ab7565b60308152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722063726f7381527f7320636861696e206d6573736167652e00000000000000000000000000000000602082015290506111cb565b60208082528101610b5a81611af9565b67ffffffffffffffff918216919081169082820190811115610b5a57610b5a6112e8565b634e487b7160e01b600052603260045260246000fd5b600060018201611bad57611bad6112e8565b506001019056fea2646970667358221220589f4fb95a6e7168f1e8b99c98e725b1a8e7e51f947a22622396b0f9172d14e864736f6c63430008150033a2646970667358221220b42118c4c84c5fc2c69e85eb6ceb60e744a47b15d1719185e0276b9c3bf4d2c264736f6c63430008150033",
 }
 
 // ManagementContractABI is the input ABI used to generate the binding from.
@@ -390,6 +390,37 @@ func (_ManagementContract *ManagementContractCallerSession) GetUniqueForkID(numb
 	return _ManagementContract.Contract.GetUniqueForkID(&_ManagementContract.CallOpts, number)
 }
 
+// IsRevoked is a free data retrieval call binding the contract method 0xc1fd018c.
+//
+// Solidity: function IsRevoked(address _addr) view returns(bool)
+func (_ManagementContract *ManagementContractCaller) IsRevoked(opts *bind.CallOpts, _addr common.Address) (bool, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "IsRevoked", _addr)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsRevoked is a free data retrieval call binding the contract method 0xc1fd018c.
+//
+// Solidity: function IsRevoked(address _addr) view returns(bool)
+func (_ManagementContract *ManagementContractSession) IsRevoked(_addr common.Address) (bool, error) {
+	return _ManagementContract.Contract.IsRevoked(&_ManagementContract.CallOpts, _addr)
+}
+
+// IsRevoked is a free data retrieval call binding the contract method 0xc1fd018c.
+//
+// Solidity: function IsRevoked(address _addr) view returns(bool)
+func (_ManagementContract *ManagementContractCallerSession) IsRevoked(_addr common.Address) (bool, error) {
+	return _ManagementContract.Contract.IsRevoked(&_ManagementContract.CallOpts, _addr)
+}
+
 // IsSequencerEnclave is a free data retrieval call binding the contract method 0xdb5d91b1.
 //
 // Solidity: function IsSequencerEnclave(address _addr) view returns(bool)
@@ -762,6 +793,37 @@ func (_ManagementContract *ManagementContractCallerSession) Owner() (common.Addr
 	return _ManagementContract.Contract.Owner(&_ManagementContract.CallOpts)
 }
 
+// SecretGeneration is a free data retrieval call binding the contract method 0xbb938f00.
+//
+// Solidity: function secretGeneration() view returns(uint256)
+func (_ManagementContract *ManagementContractCaller) SecretGeneration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "secretGeneration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SecretGeneration is a free data retrieval call binding the contract method 0xbb938f00.
+//
+// Solidity: function secretGeneration() view returns(uint256)
+func (_ManagementContract *ManagementContractSession) SecretGeneration() (*big.Int, error) {
+	return _ManagementContract.Contract.SecretGeneration(&_ManagementContract.CallOpts)
+}
+
+// SecretGeneration is a free data retrieval call binding the contract method 0xbb938f00.
+//
+// Solidity: function secretGeneration() view returns(uint256)
+func (_ManagementContract *ManagementContractCallerSession) SecretGeneration() (*big.Int, error) {
+	return _ManagementContract.Contract.SecretGeneration(&_ManagementContract.CallOpts)
+}
+
 // AddRollup is a paid mutator transaction binding the contract method 0xa25eb31c.
 //
 // Solidity: function AddRollup((bytes32,bytes,uint256) r, ((address,uint64,uint32,uint32,bytes,uint8)[]) ) returns()
@@ -867,6 +929,27 @@ func (_ManagementContract *ManagementContractTransactorSession) RequestNetworkSe
 	return _ManagementContract.Contract.RequestNetworkSecret(&_ManagementContract.TransactOpts, requestReport)
 }
 
+// RequestSecretRotation is a paid mutator transaction binding the contract method 0xf34da4fe.
+//
+// Solidity: function RequestSecretRotation(uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractTransactor) RequestSecretRotation(opts *bind.TransactOpts, activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "RequestSecretRotation", activationHeight)
+}
+
+// RequestSecretRotation is a paid mutator transaction binding the contract method 0xf34da4fe.
+//
+// Solidity: function RequestSecretRotation(uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractSession) RequestSecretRotation(activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RequestSecretRotation(&_ManagementContract.TransactOpts, activationHeight)
+}
+
+// RequestSecretRotation is a paid mutator transaction binding the contract method 0xf34da4fe.
+//
+// Solidity: function RequestSecretRotation(uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractTransactorSession) RequestSecretRotation(activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RequestSecretRotation(&_ManagementContract.TransactOpts, activationHeight)
+}
+
 // RespondNetworkSecret is a paid mutator transaction binding the contract method 0xd4fab887.
 //
 // Solidity: function RespondNetworkSecret(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, bool verifyAttester) returns()
@@ -909,6 +992,27 @@ func (_ManagementContract *ManagementContractTransactorSession) RetrieveAllBridg
 	return _ManagementContract.Contract.RetrieveAllBridgeFunds(&_ManagementContract.TransactOpts)
 }
 
+// RevokeEnclave is a paid mutator transaction binding the contract method 0x9cc53d0c.
+//
+// Solidity: function RevokeEnclave(address _addr) returns()
+func (_ManagementContract *ManagementContractTransactor) RevokeEnclave(opts *bind.TransactOpts, _addr common.Address) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "RevokeEnclave", _addr)
+}
+
+// RevokeEnclave is a paid mutator transaction binding the contract method 0x9cc53d0c.
+//
+// Solidity: function RevokeEnclave(address _addr) returns()
+func (_ManagementContract *ManagementContractSession) RevokeEnclave(_addr common.Address) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RevokeEnclave(&_ManagementContract.TransactOpts, _addr)
+}
+
+// RevokeEnclave is a paid mutator transaction binding the contract method 0x9cc53d0c.
+//
+// Solidity: function RevokeEnclave(address _addr) returns()
+func (_ManagementContract *ManagementContractTransactorSession) RevokeEnclave(_addr common.Address) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RevokeEnclave(&_ManagementContract.TransactOpts, _addr)
+}
+
 // RevokeSequencerEnclave is a paid mutator transaction binding the contract method 0x6b9707d6.
 //
 // Solidity: function RevokeSequencerEnclave(address _addr) returns()
@@ -930,6 +1034,27 @@ func (_ManagementContract *ManagementContractTransactorSession) RevokeSequencerE
 	return _ManagementContract.Contract.RevokeSequencerEnclave(&_ManagementContract.TransactOpts, _addr)
 }
 
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0x3c4ba33d.
+//
+// Solidity: function RotateNetworkSecret(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractTransactor) RotateNetworkSecret(opts *bind.TransactOpts, attesterID common.Address, requesterID common.Address, attesterSig []byte, responseSecret []byte, generation *big.Int, activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "RotateNetworkSecret", attesterID, requesterID, attesterSig, responseSecret, generation, activationHeight)
+}
+
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0x3c4ba33d.
+//
+// Solidity: function RotateNetworkSecret(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractSession) RotateNetworkSecret(attesterID common.Address, requesterID common.Address, attesterSig []byte, responseSecret []byte, generation *big.Int, activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RotateNetworkSecret(&_ManagementContract.TransactOpts, attesterID, requesterID, attesterSig, responseSecret, generation, activationHeight)
+}
+
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0x3c4ba33d.
+//
+// Solidity: function RotateNetworkSecret(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight) returns()
+func (_ManagementContract *ManagementContractTransactorSession) RotateNetworkSecret(attesterID common.Address, requesterID common.Address, attesterSig []byte, responseSecret []byte, generation *big.Int, activationHeight *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.RotateNetworkSecret(&_ManagementContract.TransactOpts, attesterID, requesterID, attesterSig, responseSecret, generation, activationHeight)
+}
+
 // SetImportantContractAddress is a paid mutator transaction binding the contract method 0x03e72e48.
 //
 // Solidity: function SetImportantContractAddress(string key, address newAddress) returns()
@@ -1035,9 +1160,9 @@ func (_ManagementContract *ManagementContractTransactorSession) TransferOwnershi
 	return _ManagementContract.Contract.TransferOwnership(&_ManagementContract.TransactOpts, newOwner)
 }
 
-// ManagementContractImportantContractAddressUpdatedIterator is returned from FilterImportantContractAddressUpdated and is used to iterate over the raw logs and unpacked data for ImportantContractAddressUpdated events raised by the ManagementContract contract.
-type ManagementContractImportantContractAddressUpdatedIterator struct {
-	Event *ManagementContractImportantContractAddressUpdated // Event containing the contract specifics and raw log
+// ManagementContractCrossChainMessagesRootAddedIterator is returned from FilterCrossChainMessagesRootAdded and is used to iterate over the raw logs and unpacked data for CrossChainMessagesRootAdded events raised by the ManagementContract contract.
+type ManagementContractCrossChainMessagesRootAddedIterator struct {
+	Event *ManagementContractCrossChainMessagesRootAdded // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1051,7 +1176,7 @@ type ManagementContractImportantContractAddressUpdatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool {
+func (it *ManagementContractCrossChainMessagesRootAddedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1060,7 +1185,7 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractImportantContractAddressUpdated)
+			it.Event = new(ManagementContractCrossChainMessagesRootAdded)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1075,7 +1200,7 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractImportantContractAddressUpdated)
+		it.Event = new(ManagementContractCrossChainMessagesRootAdded)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1091,42 +1216,42 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Error() error {
+func (it *ManagementContractCrossChainMessagesRootAddedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Close() error {
+func (it *ManagementContractCrossChainMessagesRootAddedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractImportantContractAddressUpdated represents a ImportantContractAddressUpdated event raised by the ManagementContract contract.
-type ManagementContractImportantContractAddressUpdated struct {
-	Key        string
-	NewAddress common.Address
-	Raw        types.Log // Blockchain specific contextual infos
+// ManagementContractCrossChainMessagesRootAdded represents a CrossChainMessagesRootAdded event raised by the ManagementContract contract.
+type ManagementContractCrossChainMessagesRootAdded struct {
+	LastBatchHash    [32]byte
+	CrossChainHashes [][]byte
+	Raw              types.Log // Blockchain specific contextual infos
 }
 
-// FilterImportantContractAddressUpdated is a free log retrieval operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// FilterCrossChainMessagesRootAdded is a free log retrieval operation binding the contract event 0xa2d439b8dc41e7d35edf1afa64b5364832132a64407dba04b898d34bb0fac3e8.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) FilterImportantContractAddressUpdated(opts *bind.FilterOpts) (*ManagementContractImportantContractAddressUpdatedIterator, error) {
+// Solidity: event CrossChainMessagesRootAdded(bytes32 lastBatchHash, bytes[] crossChainHashes)
+func (_ManagementContract *ManagementContractFilterer) FilterCrossChainMessagesRootAdded(opts *bind.FilterOpts) (*ManagementContractCrossChainMessagesRootAddedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "ImportantContractAddressUpdated")
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "CrossChainMessagesRootAdded")
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractImportantContractAddressUpdatedIterator{contract: _ManagementContract.contract, event: "ImportantContractAddressUpdated", logs: logs, sub: sub}, nil
+	return &ManagementContractCrossChainMessagesRootAddedIterator{contract: _ManagementContract.contract, event: "CrossChainMessagesRootAdded", logs: logs, sub: sub}, nil
 }
 
-// WatchImportantContractAddressUpdated is a free log subscription operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// WatchCrossChainMessagesRootAdded is a free log subscription operation binding the contract event 0xa2d439b8dc41e7d35edf1afa64b5364832132a64407dba04b898d34bb0fac3e8.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAddressUpdated(opts *bind.WatchOpts, sink chan<- *ManagementContractImportantContractAddressUpdated) (event.Subscription, error) {
+// Solidity: event CrossChainMessagesRootAdded(bytes32 lastBatchHash, bytes[] crossChainHashes)
+func (_ManagementContract *ManagementContractFilterer) WatchCrossChainMessagesRootAdded(opts *bind.WatchOpts, sink chan<- *ManagementContractCrossChainMessagesRootAdded) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "ImportantContractAddressUpdated")
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "CrossChainMessagesRootAdded")
 	if err != nil {
 		return nil, err
 	}
@@ -1136,8 +1261,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAdd
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractImportantContractAddressUpdated)
-				if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
+				event := new(ManagementContractCrossChainMessagesRootAdded)
+				if err := _ManagementContract.contract.UnpackLog(event, "CrossChainMessagesRootAdded", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1158,21 +1283,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAdd
 	}), nil
 }
 
-// ParseImportantContractAddressUpdated is a log parse operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// ParseCrossChainMessagesRootAdded is a log parse operation binding the contract event 0xa2d439b8dc41e7d35edf1afa64b5364832132a64407dba04b898d34bb0fac3e8.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) ParseImportantContractAddressUpdated(log types.Log) (*ManagementContractImportantContractAddressUpdated, error) {
-	event := new(ManagementContractImportantContractAddressUpdated)
-	if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
+// Solidity: event CrossChainMessagesRootAdded(bytes32 lastBatchHash, bytes[] crossChainHashes)
+func (_ManagementContract *ManagementContractFilterer) ParseCrossChainMessagesRootAdded(log types.Log) (*ManagementContractCrossChainMessagesRootAdded, error) {
+	event := new(ManagementContractCrossChainMessagesRootAdded)
+	if err := _ManagementContract.contract.UnpackLog(event, "CrossChainMessagesRootAdded", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ManagementContract contract.
-type ManagementContractInitializedIterator struct {
-	Event *ManagementContractInitialized // Event containing the contract specifics and raw log
+// ManagementContractEnclaveRevokedIterator is returned from FilterEnclaveRevoked and is used to iterate over the raw logs and unpacked data for EnclaveRevoked events raised by the ManagementContract contract.
+type ManagementContractEnclaveRevokedIterator struct {
+	Event *ManagementContractEnclaveRevoked // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1186,7 +1311,7 @@ type ManagementContractInitializedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractInitializedIterator) Next() bool {
+func (it *ManagementContractEnclaveRevokedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1195,7 +1320,7 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractInitialized)
+			it.Event = new(ManagementContractEnclaveRevoked)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1210,7 +1335,7 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractInitialized)
+		it.Event = new(ManagementContractEnclaveRevoked)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1226,41 +1351,41 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractInitializedIterator) Error() error {
+func (it *ManagementContractEnclaveRevokedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractInitializedIterator) Close() error {
+func (it *ManagementContractEnclaveRevokedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractInitialized represents a Initialized event raised by the ManagementContract contract.
-type ManagementContractInitialized struct {
-	Version uint64
-	Raw     types.Log // Blockchain specific contextual infos
+// ManagementContractEnclaveRevoked represents a EnclaveRevoked event raised by the ManagementContract contract.
+type ManagementContractEnclaveRevoked struct {
+	EnclaveID common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// FilterEnclaveRevoked is a free log retrieval operation binding the contract event 0x014328d04215ef25a11c630f007f31516a065906981503bcb8cdf4998651c18b.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ManagementContractInitializedIterator, error) {
+// Solidity: event EnclaveRevoked(address enclaveID)
+func (_ManagementContract *ManagementContractFilterer) FilterEnclaveRevoked(opts *bind.FilterOpts) (*ManagementContractEnclaveRevokedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "Initialized")
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "EnclaveRevoked")
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractInitializedIterator{contract: _ManagementContract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+	return &ManagementContractEnclaveRevokedIterator{contract: _ManagementContract.contract, event: "EnclaveRevoked", logs: logs, sub: sub}, nil
 }
 
-// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// WatchEnclaveRevoked is a free log subscription operation binding the contract event 0x014328d04215ef25a11c630f007f31516a065906981503bcb8cdf4998651c18b.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractInitialized) (event.Subscription, error) {
+// Solidity: event EnclaveRevoked(address enclaveID)
+func (_ManagementContract *ManagementContractFilterer) WatchEnclaveRevoked(opts *bind.WatchOpts, sink chan<- *ManagementContractEnclaveRevoked) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "Initialized")
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "EnclaveRevoked")
 	if err != nil {
 		return nil, err
 	}
@@ -1270,8 +1395,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bi
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractInitialized)
-				if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+				event := new(ManagementContractEnclaveRevoked)
+				if err := _ManagementContract.contract.UnpackLog(event, "EnclaveRevoked", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1292,21 +1417,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bi
 	}), nil
 }
 
-// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// ParseEnclaveRevoked is a log parse operation binding the contract event 0x014328d04215ef25a11c630f007f31516a065906981503bcb8cdf4998651c18b.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) ParseInitialized(log types.Log) (*ManagementContractInitialized, error) {
-	event := new(ManagementContractInitialized)
-	if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+// Solidity: event EnclaveRevoked(address enclaveID)
+func (_ManagementContract *ManagementContractFilterer) ParseEnclaveRevoked(log types.Log) (*ManagementContractEnclaveRevoked, error) {
+	event := new(ManagementContractEnclaveRevoked)
+	if err := _ManagementContract.contract.UnpackLog(event, "EnclaveRevoked", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractLogManagementContractCreatedIterator is returned from FilterLogManagementContractCreated and is used to iterate over the raw logs and unpacked data for LogManagementContractCreated events raised by the ManagementContract contract.
-type ManagementContractLogManagementContractCreatedIterator struct {
-	Event *ManagementContractLogManagementContractCreated // Event containing the contract specifics and raw log
+// ManagementContractImportantContractAddressUpdatedIterator is returned from FilterImportantContractAddressUpdated and is used to iterate over the raw logs and unpacked data for ImportantContractAddressUpdated events raised by the ManagementContract contract.
+type ManagementContractImportantContractAddressUpdatedIterator struct {
+	Event *ManagementContractImportantContractAddressUpdated // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1320,7 +1445,7 @@ type ManagementContractLogManagementContractCreatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1329,7 +1454,7 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractLogManagementContractCreated)
+			it.Event = new(ManagementContractImportantContractAddressUpdated)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1344,7 +1469,7 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractLogManagementContractCreated)
+		it.Event = new(ManagementContractImportantContractAddressUpdated)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1360,41 +1485,42 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractLogManagementContractCreatedIterator) Error() error {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractLogManagementContractCreatedIterator) Close() error {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractLogManagementContractCreated represents a LogManagementContractCreated event raised by the ManagementContract contract.
-type ManagementContractLogManagementContractCreated struct {
-	MessageBusAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// ManagementContractImportantContractAddressUpdated represents a ImportantContractAddressUpdated event raised by the ManagementContract contract.
+type ManagementContractImportantContractAddressUpdated struct {
+	Key        string
+	NewAddress common.Address
+	Raw        types.Log // Blockchain specific contextual infos
 }
 
-// FilterLogManagementContractCreated is a free log retrieval operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// FilterImportantContractAddressUpdated is a free log retrieval operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) FilterLogManagementContractCreated(opts *bind.FilterOpts) (*ManagementContractLogManagementContractCreatedIterator, error) {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterImportantContractAddressUpdated(opts *bind.FilterOpts) (*ManagementContractImportantContractAddressUpdatedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "LogManagementContractCreated")
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "ImportantContractAddressUpdated")
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractLogManagementContractCreatedIterator{contract: _ManagementContract.contract, event: "LogManagementContractCreated", logs: logs, sub: sub}, nil
+	return &ManagementContractImportantContractAddressUpdatedIterator{contract: _ManagementContract.contract, event: "ImportantContractAddressUpdated", logs: logs, sub: sub}, nil
 }
 
-// WatchLogManagementContractCreated is a free log subscription operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// WatchImportantContractAddressUpdated is a free log subscription operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContractCreated(opts *bind.WatchOpts, sink chan<- *ManagementContractLogManagementContractCreated) (event.Subscription, error) {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAddressUpdated(opts *bind.WatchOpts, sink chan<- *ManagementContractImportantContractAddressUpdated) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "LogManagementContractCreated")
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "ImportantContractAddressUpdated")
 	if err != nil {
 		return nil, err
 	}
@@ -1404,8 +1530,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContrac
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractLogManagementContractCreated)
-				if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+				event := new(ManagementContractImportantContractAddressUpdated)
+				if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1426,21 +1552,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContrac
 	}), nil
 }
 
-// ParseLogManagementContractCreated is a log parse operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// ParseImportantContractAddressUpdated is a log parse operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) ParseLogManagementContractCreated(log types.Log) (*ManagementContractLogManagementContractCreated, error) {
-	event := new(ManagementContractLogManagementContractCreated)
-	if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseImportantContractAddressUpdated(log types.Log) (*ManagementContractImportantContractAddressUpdated, error) {
+	event := new(ManagementContractImportantContractAddressUpdated)
+	if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ManagementContract contract.
-type ManagementContractOwnershipTransferredIterator struct {
-	Event *ManagementContractOwnershipTransferred // Event containing the contract specifics and raw log
+// ManagementContractInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ManagementContract contract.
+type ManagementContractInitializedIterator struct {
+	Event *ManagementContractInitialized // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1454,7 +1580,7 @@ type ManagementContractOwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractOwnershipTransferredIterator) Next() bool {
+func (it *ManagementContractInitializedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1463,7 +1589,7 @@ func (it *ManagementContractOwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractOwnershipTransferred)
+			it.Event = new(ManagementContractInitialized)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1478,7 +1604,7 @@ func (it *ManagementContractOwnershipTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractOwnershipTransferred)
+		it.Event = new(ManagementContractInitialized)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1494,60 +1620,41 @@ func (it *ManagementContractOwnershipTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractOwnershipTransferredIterator) Error() error {
+func (it *ManagementContractInitializedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractOwnershipTransferredIterator) Close() error {
+func (it *ManagementContractInitializedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractOwnershipTransferred represents a OwnershipTransferred event raised by the ManagementContract contract.
-type ManagementContractOwnershipTransferred struct {
-	PreviousOwner common.Address
-	NewOwner      common.Address
-	Raw           types.Log // Blockchain specific contextual infos
+// ManagementContractInitialized represents a Initialized event raised by the ManagementContract contract.
+type ManagementContractInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_ManagementContract *ManagementContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ManagementContractOwnershipTransferredIterator, error) {
-
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
-	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
-	}
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ManagementContractInitializedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "Initialized")
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractOwnershipTransferredIterator{contract: _ManagementContract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+	return &ManagementContractInitializedIterator{contract: _ManagementContract.contract, event: "Initialized", logs: logs, sub: sub}, nil
 }
 
-// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_ManagementContract *ManagementContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ManagementContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
-
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
-	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
-	}
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractInitialized) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "Initialized")
 	if err != nil {
 		return nil, err
 	}
@@ -1557,8 +1664,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchOwnershipTransferred
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractOwnershipTransferred)
-				if err := _ManagementContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+				event := new(ManagementContractInitialized)
+				if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1579,12 +1686,856 @@ func (_ManagementContract *ManagementContractFilterer) WatchOwnershipTransferred
 	}), nil
 }
 
-// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_ManagementContract *ManagementContractFilterer) ParseOwnershipTransferred(log types.Log) (*ManagementContractOwnershipTransferred, error) {
-	event := new(ManagementContractOwnershipTransferred)
-	if err := _ManagementContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) ParseInitialized(log types.Log) (*ManagementContractInitialized, error) {
+	event := new(ManagementContractInitialized)
+	if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractLogManagementContractCreatedIterator is returned from FilterLogManagementContractCreated and is used to iterate over the raw logs and unpacked data for LogManagementContractCreated events raised by the ManagementContract contract.
+type ManagementContractLogManagementContractCreatedIterator struct {
+	Event *ManagementContractLogManagementContractCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractLogManagementContractCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractLogManagementContractCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractLogManagementContractCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractLogManagementContractCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractLogManagementContractCreated represents a LogManagementContractCreated event raised by the ManagementContract contract.
+type ManagementContractLogManagementContractCreated struct {
+	MessageBusAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogManagementContractCreated is a free log retrieval operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterLogManagementContractCreated(opts *bind.FilterOpts) (*ManagementContractLogManagementContractCreatedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "LogManagementContractCreated")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractLogManagementContractCreatedIterator{contract: _ManagementContract.contract, event: "LogManagementContractCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchLogManagementContractCreated is a free log subscription operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContractCreated(opts *bind.WatchOpts, sink chan<- *ManagementContractLogManagementContractCreated) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "LogManagementContractCreated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractLogManagementContractCreated)
+				if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogManagementContractCreated is a log parse operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseLogManagementContractCreated(log types.Log) (*ManagementContractLogManagementContractCreated, error) {
+	event := new(ManagementContractLogManagementContractCreated)
+	if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretInitializedIterator is returned from FilterNetworkSecretInitialized and is used to iterate over the raw logs and unpacked data for NetworkSecretInitialized events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretInitializedIterator struct {
+	Event *ManagementContractNetworkSecretInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretInitialized represents a NetworkSecretInitialized event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretInitialized struct {
+	EnclaveID          common.Address
+	InitSecret         []byte
+	GenesisAttestation string
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretInitialized is a free log retrieval operation binding the contract event 0xe0f100302fc76f7504507324709f48d439e22faa2d67fd7ee47c6959410f76c5.
+//
+// Solidity: event NetworkSecretInitialized(address enclaveID, bytes initSecret, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretInitialized(opts *bind.FilterOpts) (*ManagementContractNetworkSecretInitializedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretInitialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretInitializedIterator{contract: _ManagementContract.contract, event: "NetworkSecretInitialized", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretInitialized is a free log subscription operation binding the contract event 0xe0f100302fc76f7504507324709f48d439e22faa2d67fd7ee47c6959410f76c5.
+//
+// Solidity: event NetworkSecretInitialized(address enclaveID, bytes initSecret, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretInitialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretInitialized)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretInitialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretInitialized is a log parse operation binding the contract event 0xe0f100302fc76f7504507324709f48d439e22faa2d67fd7ee47c6959410f76c5.
+//
+// Solidity: event NetworkSecretInitialized(address enclaveID, bytes initSecret, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretInitialized(log types.Log) (*ManagementContractNetworkSecretInitialized, error) {
+	event := new(ManagementContractNetworkSecretInitialized)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretInitialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRequestedIterator is returned from FilterNetworkSecretRequested and is used to iterate over the raw logs and unpacked data for NetworkSecretRequested events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRequestedIterator struct {
+	Event *ManagementContractNetworkSecretRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretRequested represents a NetworkSecretRequested event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRequested struct {
+	Requester     common.Address
+	RequestReport string
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretRequested is a free log retrieval operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretRequested(opts *bind.FilterOpts, requester []common.Address) (*ManagementContractNetworkSecretRequestedIterator, error) {
+
+	var requesterRule []interface{}
+	for _, requesterItem := range requester {
+		requesterRule = append(requesterRule, requesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretRequested", requesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRequestedIterator{contract: _ManagementContract.contract, event: "NetworkSecretRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretRequested is a free log subscription operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretRequested(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretRequested, requester []common.Address) (event.Subscription, error) {
+
+	var requesterRule []interface{}
+	for _, requesterItem := range requester {
+		requesterRule = append(requesterRule, requesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretRequested", requesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretRequested)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretRequested is a log parse operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRequested(log types.Log) (*ManagementContractNetworkSecretRequested, error) {
+	event := new(ManagementContractNetworkSecretRequested)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRespondedIterator is returned from FilterNetworkSecretResponded and is used to iterate over the raw logs and unpacked data for NetworkSecretResponded events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRespondedIterator struct {
+	Event *ManagementContractNetworkSecretResponded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRespondedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretResponded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretResponded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRespondedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRespondedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretResponded represents a NetworkSecretResponded event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretResponded struct {
+	AttesterID     common.Address
+	RequesterID    common.Address
+	AttesterSig    []byte
+	ResponseSecret []byte
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretResponded is a free log retrieval operation binding the contract event 0x686403995c0f8cb5e01d938e743b0cca053ffee94de7b24013c8b2ed0b10d265.
+//
+// Solidity: event NetworkSecretResponded(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretResponded(opts *bind.FilterOpts) (*ManagementContractNetworkSecretRespondedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretResponded")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRespondedIterator{contract: _ManagementContract.contract, event: "NetworkSecretResponded", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretResponded is a free log subscription operation binding the contract event 0x686403995c0f8cb5e01d938e743b0cca053ffee94de7b24013c8b2ed0b10d265.
+//
+// Solidity: event NetworkSecretResponded(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretResponded(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretResponded) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretResponded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretResponded)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretResponded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretResponded is a log parse operation binding the contract event 0x686403995c0f8cb5e01d938e743b0cca053ffee94de7b24013c8b2ed0b10d265.
+//
+// Solidity: event NetworkSecretResponded(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretResponded(log types.Log) (*ManagementContractNetworkSecretResponded, error) {
+	event := new(ManagementContractNetworkSecretResponded)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretResponded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRotatedIterator is returned from FilterNetworkSecretRotated and is used to iterate over the raw logs and unpacked data for NetworkSecretRotated events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotatedIterator struct {
+	Event *ManagementContractNetworkSecretRotated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRotatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRotated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretRotated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRotatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRotatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretRotated represents a NetworkSecretRotated event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotated struct {
+	AttesterID       common.Address
+	RequesterID      common.Address
+	AttesterSig      []byte
+	ResponseSecret   []byte
+	Generation       *big.Int
+	ActivationHeight *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretRotated is a free log retrieval operation binding the contract event 0x595ef08502511233e57a7b205b72c1e7a4b814bb403cb96381e62e7d8ba139ce.
+//
+// Solidity: event NetworkSecretRotated(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretRotated(opts *bind.FilterOpts) (*ManagementContractNetworkSecretRotatedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretRotated")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRotatedIterator{contract: _ManagementContract.contract, event: "NetworkSecretRotated", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretRotated is a free log subscription operation binding the contract event 0x595ef08502511233e57a7b205b72c1e7a4b814bb403cb96381e62e7d8ba139ce.
+//
+// Solidity: event NetworkSecretRotated(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretRotated(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretRotated) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretRotated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretRotated)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretRotated is a log parse operation binding the contract event 0x595ef08502511233e57a7b205b72c1e7a4b814bb403cb96381e62e7d8ba139ce.
+//
+// Solidity: event NetworkSecretRotated(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRotated(log types.Log) (*ManagementContractNetworkSecretRotated, error) {
+	event := new(ManagementContractNetworkSecretRotated)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ManagementContract contract.
+type ManagementContractOwnershipTransferredIterator struct {
+	Event *ManagementContractOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractOwnershipTransferred represents a OwnershipTransferred event raised by the ManagementContract contract.
+type ManagementContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ManagementContract *ManagementContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ManagementContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractOwnershipTransferredIterator{contract: _ManagementContract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ManagementContract *ManagementContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ManagementContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractOwnershipTransferred)
+				if err := _ManagementContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ManagementContract *ManagementContractFilterer) ParseOwnershipTransferred(log types.Log) (*ManagementContractOwnershipTransferred, error) {
+	event := new(ManagementContractOwnershipTransferred)
+	if err := _ManagementContract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -1725,6 +2676,140 @@ func (_ManagementContract *ManagementContractFilterer) ParseRollupAdded(log type
 	return event, nil
 }
 
+// ManagementContractSecretRotationRequestedIterator is returned from FilterSecretRotationRequested and is used to iterate over the raw logs and unpacked data for SecretRotationRequested events raised by the ManagementContract contract.
+type ManagementContractSecretRotationRequestedIterator struct {
+	Event *ManagementContractSecretRotationRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractSecretRotationRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractSecretRotationRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractSecretRotationRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractSecretRotationRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractSecretRotationRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractSecretRotationRequested represents a SecretRotationRequested event raised by the ManagementContract contract.
+type ManagementContractSecretRotationRequested struct {
+	ActivationHeight *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterSecretRotationRequested is a free log retrieval operation binding the contract event 0x448764bb6ca12563d53377348a63f1e779125ac48d53ce7685981fbe7172c650.
+//
+// Solidity: event SecretRotationRequested(uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) FilterSecretRotationRequested(opts *bind.FilterOpts) (*ManagementContractSecretRotationRequestedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "SecretRotationRequested")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractSecretRotationRequestedIterator{contract: _ManagementContract.contract, event: "SecretRotationRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchSecretRotationRequested is a free log subscription operation binding the contract event 0x448764bb6ca12563d53377348a63f1e779125ac48d53ce7685981fbe7172c650.
+//
+// Solidity: event SecretRotationRequested(uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) WatchSecretRotationRequested(opts *bind.WatchOpts, sink chan<- *ManagementContractSecretRotationRequested) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "SecretRotationRequested")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractSecretRotationRequested)
+				if err := _ManagementContract.contract.UnpackLog(event, "SecretRotationRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSecretRotationRequested is a log parse operation binding the contract event 0x448764bb6ca12563d53377348a63f1e779125ac48d53ce7685981fbe7172c650.
+//
+// Solidity: event SecretRotationRequested(uint256 activationHeight)
+func (_ManagementContract *ManagementContractFilterer) ParseSecretRotationRequested(log types.Log) (*ManagementContractSecretRotationRequested, error) {
+	event := new(ManagementContractSecretRotationRequested)
+	if err := _ManagementContract.contract.UnpackLog(event, "SecretRotationRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ManagementContractSequencerEnclaveGrantedIterator is returned from FilterSequencerEnclaveGranted and is used to iterate over the raw logs and unpacked data for SequencerEnclaveGranted events raised by the ManagementContract contract.
 type ManagementContractSequencerEnclaveGrantedIterator struct {
 	Event *ManagementContractSequencerEnclaveGranted // Event containing the contract specifics and raw log
@@ -27,6 +27,15 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     event SequencerEnclaveGranted(address enclaveID);
     event SequencerEnclaveRevoked(address enclaveID);
     event RollupAdded(bytes32 rollupHash);
+    event EnclaveRevoked(address enclaveID);
+    event SecretRotationRequested(uint256 activationHeight);
+    event NetworkSecretRotated(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret, uint256 generation, uint256 activationHeight);
+    // the events below carry the full payload of the call, so the hosts and enclaves can follow the network secret
+    // from the logs, whichever account or contract (e.g. a multisig) made the call
+    event NetworkSecretInitialized(address enclaveID, bytes initSecret, string genesisAttestation);
+    event NetworkSecretRequested(address indexed requester, string requestReport);
+    event NetworkSecretResponded(address attesterID, address requesterID, bytes attesterSig, bytes responseSecret);
+    event CrossChainMessagesRootAdded(bytes32 lastBatchHash, bytes[] crossChainHashes);
 
     // mapping of enclaveID to whether it is attested
     mapping(address => bool) private attested;
@@ -36,6 +45,16 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     //       Beyond that, the contract owner can grant and revoke sequencer status.
     mapping(address => bool) private sequencerEnclave;
 
+    // mapping of enclaveID to whether its attestation has been revoked. Revoked enclaves can never become attested again.
+    mapping(address => bool) private revoked;
+
+    // the latest generation of the network secret that has been distributed. The initial secret is generation 0.
+    uint256 public secretGeneration;
+
+    // mapping of each generation of the network secret to its activation height
+    // the generation is sent with the same activation height to the enclaves attested after it was first distributed
+    mapping(uint256 => uint256) private secretActivationHeights;
+
     // In the near-term it is convenient to have an accessible source of truth for important contract addresses
     // TODO - this is probably not appropriate long term but currently useful for testnets. Look to remove.
     // We store the keys as well as the mapping for the key-value store for important contract addresses for convenience
@@ -139,6 +158,7 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         }
 
         isBundleSaved[bundleHash] = true;
+        emit CrossChainMessagesRootAdded(_lastBatchHash, crossChainHashes);
     }
 
 // TODO: ensure challenge period is added on top of block timestamp.
@@ -162,7 +182,6 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     }
 
     // InitializeNetworkSecret kickstarts the network secret, can only be called once
-    // solc-ignore-next-line unused-param
     function InitializeNetworkSecret(address _enclaveID, bytes calldata  _initSecret, string calldata _genesisAttestation) public {
         require(!networkSecretInitialized, "network secret already initialized");
 
@@ -175,11 +194,13 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         // the enclave that starts the network with this call is implicitly a sequencer so doesn't need adding
         sequencerEnclave[_enclaveID] = true;
         emit SequencerEnclaveGranted(_enclaveID);
+        emit NetworkSecretInitialized(_enclaveID, _initSecret, _genesisAttestation);
     }
 
     // Enclaves can request the Network Secret given an attestation request report
     function RequestNetworkSecret(string calldata requestReport) public {
-        // currently this is a no-op, nodes will monitor for these transactions and respond to them
+        // nodes monitor for these events and respond to them
+        emit NetworkSecretRequested(msg.sender, requestReport);
     }
 
     function ExtractNativeValue(MessageStructs.Structs.ValueTransferMessage calldata _msg, bytes32[] calldata proof, bytes32 root) external {
@@ -199,6 +220,7 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         // only attested enclaves can respond to Network Secret Requests
         bool isEnclAttested = attested[attesterID];
         require(isEnclAttested, "responding attester is not attested");
+        require(!revoked[requesterID], "requester enclave has been revoked");
 
         if (verifyAttester) {
             
@@ -214,8 +236,51 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
 
         // mark the requesterID enclave as an attested enclave and store its host address
         attested[requesterID] = true;
+        emit NetworkSecretResponded(attesterID, requesterID, attesterSig, responseSecret);
+    }
+
+
+    // The contract owner asks the sequencer enclaves to issue a new generation of the Network Secret,
+    // which will be used for batches and rollups from the activationHeight onwards
+    function RequestSecretRotation(uint256 activationHeight) public onlyOwner {
+        require(networkSecretInitialized, "network secret not initialized");
+        require(activationHeight > block.number, "activation height must be in the future");
+        emit SecretRotationRequested(activationHeight);
+    }
+
+    // A sequencer enclave distributes a new generation of the Network Secret to an attested enclave
+    // The attester signs over the payload so that the requester can verify who issued the new generation
+    function RotateNetworkSecret(address attesterID, address requesterID, bytes memory attesterSig, bytes memory responseSecret, uint256 generation, uint256 activationHeight) public {
+        require(sequencerEnclave[attesterID], "rotating attester is not a sequencer");
+        require(attested[requesterID], "requester enclave is not attested");
+        require(generation > 0, "generation 0 is the initial secret");
+        if (secretActivationHeights[generation] == 0) {
+            require(generation > secretGeneration, "secret generation is outdated");
+            require(activationHeight > block.number, "activation height must be in the future");
+        } else {
+            require(secretActivationHeights[generation] == activationHeight, "activation height does not match the generation");
+        }
+
+        bytes32 calculatedHashSigned = abi.encodePacked(attesterID, requesterID, generation, activationHeight, responseSecret).toEthSignedMessageHash();
+        address recoveredAddrSignedCalculated = ECDSA.recover(calculatedHashSigned, attesterSig);
+        require(recoveredAddrSignedCalculated == attesterID, "calculated address and attesterID dont match");
+
+        if (secretActivationHeights[generation] == 0) {
+            secretActivationHeights[generation] = activationHeight;
+            secretGeneration = generation;
+        }
+        emit NetworkSecretRotated(attesterID, requesterID, attesterSig, responseSecret, generation, activationHeight);
     }
 
+    // Function to revoke the attestation of a compromised enclave - contract owner only
+    // The enclave loses its attested and sequencer status, and will be excluded from future secret generations
+    function RevokeEnclave(address _addr) public onlyOwner {
+        require(attested[_addr], "enclaveID not attested");
+        delete attested[_addr];
+        delete sequencerEnclave[_addr];
+        revoked[_addr] = true;
+        emit EnclaveRevoked(_addr);
+    }
 
     // Accessor to check if the contract is locked or not
     function IsWithdrawalAvailable() view public returns (bool) {
@@ -227,6 +292,11 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         return attested[_addr];
     }
 
+    // Accessor that checks if the attestation of an address has been revoked
+    function IsRevoked(address _addr) view public returns (bool) {
+        return revoked[_addr];
+    }
+
     // Accessor that checks if an address is permissioned as a sequencer
     function IsSequencerEnclave(address _addr) view public returns (bool) {
         return sequencerEnclave[_addr];
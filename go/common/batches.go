@@ -49,4 +49,6 @@ func (b *ExtBatch) SDump() string {
 type BatchRequest struct {
 	Requester string   // The address of the requester, used to direct the response
 	FromSeqNo *big.Int // The requester's view of the current head seq no, or nil if they haven't stored any batches.
+	ToSeqNo   *big.Int `rlp:"optional"` // The last seq no requested, the peer caps the range it serves
+	Snapshot  bool     `rlp:"optional"` // Requests a bulk transfer of the range, used to close large gaps
 }
@@ -1,9 +1,12 @@
 package common
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"strings"
 
+	smt "github.com/FantasyJony/openzeppelin-merkle-tree-go/standard_merkle_tree"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -12,6 +15,60 @@ import (
 
 type CrossChainRootHashes [][]byte
 
+// CrossChainEncodings are the solidity types of a cross chain tree leaf - ["v" or "m", keccak256 of the packed message]
+var CrossChainEncodings = []string{smt.SOL_STRING, smt.SOL_BYTES32}
+
+// CrossChainBundleStatus describes how far the bundle containing a cross chain message has progressed towards the L1.
+type CrossChainBundleStatus string
+
+const (
+	CrossChainBundlePending   CrossChainBundleStatus = "pending"   // the batch containing the message is not yet part of a rollup
+	CrossChainBundleRolledUp  CrossChainBundleStatus = "rolled_up" // the batch is rolled up, but its bundle is not yet on the L1
+	CrossChainBundlePublished CrossChainBundleStatus = "published" // the bundle root is available on the L1 and can be proven against
+)
+
+// CrossChainDirection tells whether a message travels from the L1 to the L2 (inbound) or the other way around (outbound)
+type CrossChainDirection string
+
+const (
+	CrossChainInbound  CrossChainDirection = "inbound"
+	CrossChainOutbound CrossChainDirection = "outbound"
+)
+
+// CrossChainMessageStatus is the lifecycle state of a cross chain message or value transfer, as indexed by the host.
+// Inbound messages go observed -> executed -> bundled -> finalised.
+// Outbound messages go executed -> bundled -> published -> finalised.
+type CrossChainMessageStatus string
+
+const (
+	CrossChainMessageObserved  CrossChainMessageStatus = "observed"  // inbound only - the message was emitted in an L1 block
+	CrossChainMessageExecuted  CrossChainMessageStatus = "executed"  // the message was emitted by (outbound) or delivered in (inbound) a batch
+	CrossChainMessageBundled   CrossChainMessageStatus = "bundled"   // the batch was included in a rollup on the L1
+	CrossChainMessagePublished CrossChainMessageStatus = "published" // outbound only - the cross chain bundle containing the batch root is on the L1
+	CrossChainMessageFinalised CrossChainMessageStatus = "finalised" // the L1 block that bundled (inbound) or published (outbound) the message is final
+)
+
+// CrossChainMessageRecord is the data the host indexes when it first sees a cross chain message or value transfer
+type CrossChainMessageRecord struct {
+	MessageHash gethcommon.Hash
+	Direction   CrossChainDirection
+	LeafType    string              // "v" for value transfers, "m" for messages
+	Sender      *gethcommon.Address // the messages are public on the L1 or in the batch header, the value transfers are streamed by the enclave
+	Receiver    *gethcommon.Address // only known for value transfers
+	L1Height    uint64              // the L1 block where an inbound message was observed
+}
+
+// CrossChainProof contains everything a client needs to prove the inclusion of an outbound message or value transfer on the L1.
+type CrossChainProof struct {
+	MessageHash gethcommon.Hash        `json:"messageHash"`
+	LeafType    string                 `json:"leafType"` // "v" for value transfers, "m" for messages
+	BatchHash   gethcommon.Hash        `json:"batchHash"`
+	BatchSeqNo  uint64                 `json:"batchSeqNo"`
+	Root        gethcommon.Hash        `json:"root"`
+	Proof       []gethcommon.Hash      `json:"proof"`
+	Status      CrossChainBundleStatus `json:"status"`
+}
+
 type ExtCrossChainBundle struct {
 	LastBatchHash        gethcommon.Hash
 	Signature            []byte
@@ -58,3 +115,126 @@ func (bundle ExtCrossChainBundle) HashPacked() common.Hash {
 	hash := crypto.Keccak256Hash(bytes)
 	return hash
 }
+
+// HashCrossChainMessage returns the keccak256 of the abi packed message. This is the value of the "m" leaves of the cross chain tree.
+func HashCrossChainMessage(message CrossChainMessage) gethcommon.Hash {
+	addrType, _ := abi.NewType("address", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	uint32Type, _ := abi.NewType("uint32", "", nil)
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{
+		{
+			Type: addrType,
+		},
+		{
+			Type: uint64Type,
+		},
+		{
+			Type: uint32Type,
+		},
+		{
+			Type: uint32Type,
+		},
+		{
+			Type: bytesType,
+		},
+		{
+			Type: uint8Type,
+		},
+	}
+
+	// todo @siliev: err
+	packed, err := args.Pack(message.Sender, message.Sequence, message.Nonce, message.Topic, message.Payload, message.ConsistencyLevel)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(packed)
+}
+
+// HashValueTransfer returns the keccak256 of the abi packed value transfer. This is the value of the "v" leaves of the cross chain tree.
+func HashValueTransfer(valueTransfer ValueTransferEvent) gethcommon.Hash {
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	addrType, _ := abi.NewType("address", "", nil)
+
+	args := abi.Arguments{
+		{
+			Type: addrType,
+		},
+		{
+			Type: addrType,
+		},
+		{
+			Type: uint256Type,
+		},
+		{
+			Type: uint64Type,
+		},
+	}
+
+	bytes, err := args.Pack(valueTransfer.Sender, valueTransfer.Receiver, valueTransfer.Amount, valueTransfer.Sequence)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bytes)
+}
+
+// DeserializeCrossChainTree decodes the leaves of a serialized cross chain tree into the format expected by the merkle tree library.
+func DeserializeCrossChainTree(serializedTree SerializedCrossChainTree) ([][]interface{}, error) {
+	leaves := make([][]interface{}, 0)
+	if len(serializedTree) == 0 {
+		return leaves, nil
+	}
+	if err := json.Unmarshal(serializedTree, &leaves); err != nil {
+		return nil, fmt.Errorf("could not unmarshal cross chain tree. Cause: %w", err)
+	}
+	for i, leaf := range leaves {
+		if len(leaf) != 2 {
+			return nil, fmt.Errorf("unexpected cross chain leaf length %d", len(leaf))
+		}
+		hashStr, ok := leaf[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected cross chain leaf value %v", leaf[1])
+		}
+		leaves[i][1] = gethcommon.HexToHash(hashStr)
+	}
+	return leaves, nil
+}
+
+// CrossChainTreeProof builds the merkle proof for the leaf with the given message hash. It returns the tree root, the leaf
+// type and the proof path.
+func CrossChainTreeProof(serializedTree SerializedCrossChainTree, messageHash gethcommon.Hash) (gethcommon.Hash, string, []gethcommon.Hash, error) {
+	leaves, err := DeserializeCrossChainTree(serializedTree)
+	if err != nil {
+		return gethcommon.Hash{}, "", nil, err
+	}
+
+	var leaf []interface{}
+	for _, l := range leaves {
+		if l[1].(gethcommon.Hash) == messageHash {
+			leaf = l
+			break
+		}
+	}
+	if leaf == nil {
+		return gethcommon.Hash{}, "", nil, fmt.Errorf("message %s is not part of the cross chain tree", messageHash)
+	}
+
+	tree, err := smt.Of(leaves, CrossChainEncodings)
+	if err != nil {
+		return gethcommon.Hash{}, "", nil, fmt.Errorf("could not build cross chain tree. Cause: %w", err)
+	}
+
+	proof, err := tree.GetProof(leaf)
+	if err != nil {
+		return gethcommon.Hash{}, "", nil, fmt.Errorf("could not get cross chain proof. Cause: %w", err)
+	}
+
+	proofHashes := make([]gethcommon.Hash, len(proof))
+	for i, p := range proof {
+		proofHashes[i] = gethcommon.BytesToHash(p)
+	}
+	leafType, _ := leaf[0].(string)
+	return gethcommon.BytesToHash(tree.GetRoot()), leafType, proofHashes, nil
+}
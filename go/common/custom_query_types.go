@@ -26,4 +26,38 @@ const (
 type ListPrivateTransactionsQueryParams struct {
 	Address    common.Address  `json:"address"`
 	Pagination QueryPagination `json:"pagination"`
+	PersonalTransactionsFilter
+	// Before restricts the results to the transactions preceding the cursor in the history, which is ordered latest
+	// first. Unlike an offset, it stays valid as new transactions are added.
+	Before *PersonalTransactionCursor `json:"before,omitempty"`
+	// IncludeLogs adds the logs of each transaction the account can view to its receipt
+	IncludeLogs bool `json:"includeLogs,omitempty"`
+}
+
+// PersonalTransactionsFilter restricts the transactions of an account. The ranges are inclusive.
+type PersonalTransactionsFilter struct {
+	// Contract is the contract the transactions were sent to
+	Contract  *common.Address `json:"contract,omitempty"`
+	FromBatch *uint64         `json:"fromBatch,omitempty"`
+	ToBatch   *uint64         `json:"toBatch,omitempty"`
+	// Status is the receipt status, 1 for success and 0 for failure
+	Status *uint64 `json:"status,omitempty"`
+	// FromTime and ToTime are unix timestamps, compared with the timestamp of the batch of each transaction
+	FromTime *uint64 `json:"fromTime,omitempty"`
+	ToTime   *uint64 `json:"toTime,omitempty"`
+}
+
+// PersonalTransactionCursor is the position of a transaction in the history of an account
+type PersonalTransactionCursor struct {
+	BatchHeight uint64 `json:"batchHeight"`
+	TxIndex     uint   `json:"txIndex"`
+}
+
+// PersonalTransactionsQuery is a query of the transaction history of all the accounts a gateway user registered
+type PersonalTransactionsQuery struct {
+	PersonalTransactionsFilter
+	Size uint `json:"size"`
+	// Cursor is the NextCursor of the previous page, or nil for the first page
+	Cursor      *PersonalTransactionCursor `json:"cursor,omitempty"`
+	IncludeLogs bool                       `json:"includeLogs,omitempty"`
 }
@@ -0,0 +1,45 @@
+package common
+
+import "fmt"
+
+const (
+	blobDA     = "blob"
+	calldataDA = "calldata"
+	externalDA = "external"
+)
+
+// DAMode identifies the data availability layer a rollup payload was published to.
+// It is recorded in the rollup header, so validators know where to fetch the payload from.
+type DAMode uint8
+
+const (
+	BlobDA     DAMode = iota // the payload is carried in EIP-4844 blobs
+	CalldataDA               // the payload is appended to the calldata of the rollup transaction
+	ExternalDA               // only the payload hash is published on the L1, the data is served by a file or HTTP store
+)
+
+func (m DAMode) String() string {
+	switch m {
+	case BlobDA:
+		return blobDA
+	case CalldataDA:
+		return calldataDA
+	case ExternalDA:
+		return externalDA
+	default:
+		return unknown
+	}
+}
+
+func ToDAMode(s string) (DAMode, error) {
+	switch s {
+	case blobDA:
+		return BlobDA, nil
+	case calldataDA:
+		return CalldataDA, nil
+	case externalDA:
+		return ExternalDA, nil
+	default:
+		return BlobDA, fmt.Errorf("string '%s' cannot be converted to a data availability mode", s)
+	}
+}
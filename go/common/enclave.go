@@ -29,9 +29,10 @@ type Status struct {
 }
 
 type TxAndReceiptAndBlobs struct {
-	Tx      *types.Transaction
-	Receipt *types.Receipt
-	Blobs   []*kzg4844.Blob
+	Tx         *types.Transaction
+	Receipt    *types.Receipt
+	Blobs      []*kzg4844.Blob
+	RollupData []byte `rlp:"optional"` // the rollup payload retrieved from an external DA layer
 }
 
 const (
@@ -119,6 +120,10 @@ type Enclave interface {
 	// HealthCheck returns whether the enclave is in a healthy state
 	HealthCheck(context.Context) (bool, SystemError)
 
+	// Metrics returns the metrics collected by the enclave in the Prometheus text format. The enclave cannot open
+	// ports, so the host serves them alongside its own.
+	Metrics(context.Context) ([]byte, SystemError)
+
 	// GetBatch - retrieve a batch if existing within the enclave db.
 	GetBatch(ctx context.Context, hash L2BatchHash) (*ExtBatch, SystemError)
 
@@ -132,9 +137,13 @@ type Enclave interface {
 	// a sequencer. Will panic otherwise.
 	CreateBatch(ctx context.Context, skipIfEmpty bool) SystemError
 
+	// SetActiveSequencer - allows or stops the batch production of a sequencer enclave. The host demotes an enclave with
+	// it so a batch creation call it gave up on cannot produce a batch competing with the ones of the new active enclave.
+	SetActiveSequencer(ctx context.Context, active bool) SystemError
+
 	// CreateRollup - will create a new rollup by going through the sequencer if the node is a sequencer
-	// or panic otherwise.
-	CreateRollup(ctx context.Context, fromSeqNo uint64) (*ExtRollup, SystemError)
+	// or panic otherwise. The data availability mode the host will publish the rollup with is recorded in its header.
+	CreateRollup(ctx context.Context, fromSeqNo uint64, daMode DAMode) (*ExtRollup, SystemError)
 
 	// DebugTraceTransaction returns the trace of a transaction
 	DebugTraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, SystemError)
@@ -158,20 +167,34 @@ type EnclaveScan interface {
 
 	// EnclavePublicConfig returns network data that is known to the enclave but can be shared publicly
 	EnclavePublicConfig(context.Context) (*EnclavePublicConfig, SystemError)
+
+	// GetPublicContract returns the creation and visibility configuration of the contract, or nil if it is unknown
+	GetPublicContract(ctx context.Context, address gethcommon.Address) (*PublicContract, SystemError)
+
+	// GetPublicContractEvents returns the events of the contract that everyone can view
+	GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *QueryPagination) (*PublicEventListingResponse, SystemError)
+
+	// GetPublicAddressActivity returns the contracts created by the address and its transactions with transparent contracts
+	GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *QueryPagination) (*AddressActivity, SystemError)
 }
 
 // BlockSubmissionResponse is the response sent from the enclave back to the node after ingesting a block
 type BlockSubmissionResponse struct {
 	ProducedSecretResponses []*ProducedSecretResponse // The responses to any secret requests in the ingested L1 block.
 	RejectError             *errutil.BlockRejectError // If block was rejected, contains information about what block to submit next.
+	L1Fork                  *L1Fork                   // Set if the block caused an L1 reorg, in which case the batches built on the abandoned blocks are re-created.
 }
 
 // ProducedSecretResponse contains the data to publish to L1 in response to a secret request discovered while processing an L1 block
+// A non-zero Generation marks a rotated secret, which is published as a rotation rather than as a response to a request.
 type ProducedSecretResponse struct {
-	Secret      []byte
-	RequesterID gethcommon.Address // enclaveID of the enclave that requested the secret
-	AttesterID  gethcommon.Address // enclaveID of the enclave that produced the secret
-	HostAddress string
+	Secret           []byte
+	RequesterID      gethcommon.Address // enclaveID of the enclave that requested the secret
+	AttesterID       gethcommon.Address // enclaveID of the enclave that produced the secret
+	HostAddress      string
+	Generation       uint64 // the generation of the secret, the initial secret being generation 0
+	ActivationHeight uint64 // the L1 height from which the generation is used
+	AttesterSig      []byte // signature of the attester enclave over a rotated secret
 }
 
 type EnclavePublicConfig struct {
@@ -258,6 +258,27 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 	return callMsg, nil
 }
 
+// batchSecret - returns the generation of the shared secret that is active at the L1 height of the batch's L1 proof
+func (enc *gethEncodingServiceImpl) batchSecret(ctx context.Context, h *common.BatchHeader) (*crypto.SharedEnclaveSecret, error) {
+	generations, err := enc.storage.FetchSecretGenerations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// avoid looking up the block while the secret was never rotated
+	if len(generations) == 1 {
+		return &generations[0].Secret, nil
+	}
+	block, err := enc.storage.FetchBlock(ctx, h.L1Proof)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch L1 proof %s of batch. Cause: %w", h.L1Proof, err)
+	}
+	gen, err := enc.storage.FetchSecretForHeight(ctx, block.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	return &gen.Secret, nil
+}
+
 // CreateEthHeaderForBatch - the EVM requires an Ethereum header.
 // We convert the Batch headers to Ethereum headers to be able to use the Geth EVM.
 // Special care must be taken to maintain a valid chain of these converted headers.
@@ -265,7 +286,7 @@ func (enc *gethEncodingServiceImpl) CreateEthHeaderForBatch(ctx context.Context,
 	// wrap in a caching layer
 	return enc.cachingService.ReadConvertedHeader(ctx, h.Hash(), func(a any) (*types.Header, error) {
 		// deterministically calculate the private randomness that will be exposed to the EVM
-		secret, err := enc.storage.FetchSecret(ctx)
+		secret, err := enc.batchSecret(ctx, h)
 		if err != nil {
 			enc.logger.Crit("Could not fetch shared secret. Exiting.", log.ErrKey, err)
 		}
@@ -182,6 +182,8 @@ type RollupHeader struct {
 	Signature   []byte      // The signature of the sequencer enclave on the payload hash
 
 	LastBatchSeqNo uint64
+
+	DAMode DAMode `rlp:"optional"` // the data availability layer the rollup payload is published to
 }
 
 // CalldataRollupHeader contains all information necessary to reconstruct the batches included in the rollup.
@@ -3,6 +3,7 @@ package host
 import (
 	"context"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/config"
@@ -14,6 +15,7 @@ import (
 // Host is the half of the Obscuro node that lives outside the enclave.
 type Host interface {
 	Config() *config.HostConfig
+	// EnclaveClient returns the client of the enclave serving the next read request
 	EnclaveClient() common.Enclave
 	Storage() storage.Storage
 	// Start initializes the main loop of the host.
@@ -33,6 +35,9 @@ type Host interface {
 	// TenConfig returns the info of the Obscuro network
 	TenConfig() (*common.TenNetworkInfo, error)
 
+	// CrossChainProof returns the merkle proof of an outbound cross chain message or value transfer and the L1 status of its bundle
+	CrossChainProof(messageHash gethcommon.Hash) (*common.CrossChainProof, error)
+
 	// NewHeadsChan returns live batch headers
 	// Note - do not use directly. This is meant only for the NewHeadsManager, which multiplexes the headers
 	NewHeadsChan() chan *common.BatchHeader
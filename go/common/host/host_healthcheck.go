@@ -1,15 +1,46 @@
 package host
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/config"
+)
+
+// HealthLevel grades the health of a service
+type HealthLevel string
+
+const (
+	HealthOK        HealthLevel = "ok"
+	HealthDegraded  HealthLevel = "degraded" // the service works but one of its measurements is past the degraded threshold
+	HealthUnhealthy HealthLevel = "unhealthy"
+)
+
 // HealthStatus is an interface supported by all Services on the host
 type HealthStatus interface {
+	// OK returns false if the service is unhealthy, a degraded service is still OK
 	OK() bool
 	Message() string
+	Level() HealthLevel
+	// Measurements returns the values the service health was graded on, by name (nil if the service has none)
+	Measurements() map[string]string
 }
 
 // HealthCheck is the object returned by the host API with the Health of the Node
 type HealthCheck struct {
 	OverallHealth bool
 	Errors        []string
+	// Services reports the health of each host service, including the degraded ones
+	Services map[string]*ServiceHealth
+}
+
+// ServiceHealth is the health reported by a host service
+type ServiceHealth struct {
+	Level        HealthLevel
+	Message      string
+	Measurements map[string]string
 }
 
 // BasicErrHealthStatus is a simple health status implementation, if the ErrMsg is non-empty then OK() returns false
@@ -25,6 +56,17 @@ func (l *BasicErrHealthStatus) Message() string {
 	return l.ErrMsg
 }
 
+func (l *BasicErrHealthStatus) Level() HealthLevel {
+	if l.OK() {
+		return HealthOK
+	}
+	return HealthUnhealthy
+}
+
+func (l *BasicErrHealthStatus) Measurements() map[string]string {
+	return nil
+}
+
 type GroupErrsHealthStatus struct {
 	Errors []error
 }
@@ -43,3 +85,111 @@ func (g *GroupErrsHealthStatus) Message() string {
 	}
 	return msg
 }
+
+func (g *GroupErrsHealthStatus) Level() HealthLevel {
+	if g.OK() {
+		return HealthOK
+	}
+	return HealthUnhealthy
+}
+
+func (g *GroupErrsHealthStatus) Measurements() map[string]string {
+	return nil
+}
+
+// MeasuredHealthStatus grades a service on measurements compared to their configured thresholds. The errors make the
+// service unhealthy regardless of the measurements.
+type MeasuredHealthStatus struct {
+	Errors       []error
+	measurements map[string]string
+	problems     []string
+	level        HealthLevel
+}
+
+// CheckDuration records the measurement and grades it, a zero limit in the threshold is not checked
+func (m *MeasuredHealthStatus) CheckDuration(name string, value time.Duration, threshold config.DurationThreshold) {
+	value = value.Truncate(time.Millisecond)
+	switch {
+	case threshold.Unhealthy > 0 && value > threshold.Unhealthy:
+		m.record(name, value.String(), HealthUnhealthy, threshold.Unhealthy.String())
+	case threshold.Degraded > 0 && value > threshold.Degraded:
+		m.record(name, value.String(), HealthDegraded, threshold.Degraded.String())
+	default:
+		m.record(name, value.String(), HealthOK, "")
+	}
+}
+
+// CheckCount records the measurement and grades it, a zero limit in the threshold is not checked
+func (m *MeasuredHealthStatus) CheckCount(name string, value uint64, threshold config.CountThreshold) {
+	switch {
+	case threshold.Unhealthy > 0 && value >= threshold.Unhealthy:
+		m.record(name, strconv.FormatUint(value, 10), HealthUnhealthy, strconv.FormatUint(threshold.Unhealthy, 10))
+	case threshold.Degraded > 0 && value >= threshold.Degraded:
+		m.record(name, strconv.FormatUint(value, 10), HealthDegraded, strconv.FormatUint(threshold.Degraded, 10))
+	default:
+		m.record(name, strconv.FormatUint(value, 10), HealthOK, "")
+	}
+}
+
+// Measure records a measurement that is reported but not graded
+func (m *MeasuredHealthStatus) Measure(name string, value string) {
+	m.record(name, value, HealthOK, "")
+}
+
+// Include adds the measurements of a component of the service, under the given name, and grades the service on them
+func (m *MeasuredHealthStatus) Include(component string, status HealthStatus) {
+	for name, value := range status.Measurements() {
+		m.Measure(component+"."+name, value)
+	}
+	switch status.Level() {
+	case HealthUnhealthy:
+		m.Errors = append(m.Errors, fmt.Errorf("%s: %s", component, status.Message()))
+	case HealthDegraded:
+		m.problems = append(m.problems, fmt.Sprintf("%s: %s", component, status.Message()))
+		if m.level != HealthUnhealthy {
+			m.level = HealthDegraded
+		}
+	case HealthOK:
+	}
+}
+
+func (m *MeasuredHealthStatus) record(name string, value string, level HealthLevel, limit string) {
+	if m.measurements == nil {
+		m.measurements = map[string]string{}
+	}
+	m.measurements[name] = value
+	if level == HealthOK {
+		return
+	}
+	m.problems = append(m.problems, fmt.Sprintf("%s %s is %s (limit %s)", name, value, level, limit))
+	if m.level != HealthUnhealthy {
+		m.level = level
+	}
+}
+
+func (m *MeasuredHealthStatus) OK() bool {
+	return m.Level() != HealthUnhealthy
+}
+
+func (m *MeasuredHealthStatus) Message() string {
+	msgs := make([]string, 0, len(m.Errors)+len(m.problems))
+	for _, err := range m.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	msgs = append(msgs, m.problems...)
+	return strings.Join(msgs, ", ")
+}
+
+func (m *MeasuredHealthStatus) Level() HealthLevel {
+	if len(m.Errors) > 0 {
+		return HealthUnhealthy
+	}
+	if m.level == "" {
+		return HealthOK
+	}
+	return m.level
+}
+
+func (m *MeasuredHealthStatus) Measurements() map[string]string {
+	return m.measurements
+}
@@ -0,0 +1,49 @@
+package host
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/config"
+)
+
+func TestMeasuredHealthStatusGradesOnThresholds(t *testing.T) {
+	ageThreshold := config.DurationThreshold{Degraded: time.Minute, Unhealthy: 5 * time.Minute}
+	countThreshold := config.CountThreshold{Degraded: 3, Unhealthy: 10}
+
+	status := &MeasuredHealthStatus{}
+	status.CheckDuration("headAge", 10*time.Second, ageThreshold)
+	status.CheckCount("failedTxs", 0, countThreshold)
+	require.Equal(t, HealthOK, status.Level())
+	require.Equal(t, "10s", status.Measurements()["headAge"])
+
+	status.CheckCount("failedTxs", 3, countThreshold)
+	require.Equal(t, HealthDegraded, status.Level())
+	require.True(t, status.OK(), "a degraded service is still ok")
+
+	status.CheckDuration("headAge", 6*time.Minute, ageThreshold)
+	require.Equal(t, HealthUnhealthy, status.Level())
+	require.False(t, status.OK())
+	require.Contains(t, status.Message(), "headAge 6m0s is unhealthy (limit 5m0s)")
+
+	// a zero threshold disables the check
+	disabled := &MeasuredHealthStatus{}
+	disabled.CheckDuration("headAge", time.Hour, config.DurationThreshold{})
+	require.Equal(t, HealthOK, disabled.Level())
+}
+
+func TestMeasuredHealthStatusIncludesComponents(t *testing.T) {
+	component := &MeasuredHealthStatus{}
+	component.CheckCount("l2Lag", 25, config.CountThreshold{Degraded: 20, Unhealthy: 200})
+
+	status := &MeasuredHealthStatus{}
+	status.Include("enclave[0]", component)
+	require.Equal(t, HealthDegraded, status.Level())
+	require.Equal(t, "25", status.Measurements()["enclave[0].l2Lag"])
+
+	status.Include("enclave[1]", &MeasuredHealthStatus{Errors: []error{errors.New("enclave not in sync with L1")}})
+	require.Equal(t, HealthUnhealthy, status.Level())
+	require.Contains(t, status.Message(), "enclave[1]: enclave not in sync with L1")
+}
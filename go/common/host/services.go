@@ -45,8 +45,9 @@ type P2P interface {
 	// SendTxToSequencer sends the encrypted transaction to the sequencer.
 	SendTxToSequencer(tx common.EncryptedTx) error
 
-	// RequestBatchesFromSequencer asynchronously requests batches from the sequencer, from the given sequence number
-	RequestBatchesFromSequencer(fromSeqNo *big.Int) error
+	// RequestBatches asynchronously requests a range of batches from a peer that advertised it (falling back to the
+	// sequencer), a snapshot request asks for a bulk transfer of a large range
+	RequestBatches(fromSeqNo *big.Int, toSeqNo *big.Int, snapshot bool) error
 	// RespondToBatchRequest sends the requested batches to the requesting peer
 	RespondToBatchRequest(requestID string, batches []*common.ExtBatch) error
 
@@ -73,7 +74,7 @@ type P2PTxHandler interface {
 
 type P2PBatchRequestHandler interface {
 	// HandleBatchRequest will be called in a new goroutine for each new batch request as it arrives
-	HandleBatchRequest(requestID string, fromSeqNo *big.Int)
+	HandleBatchRequest(requestID string, request *common.BatchRequest)
 }
 
 // L1BlockRepository provides an interface for the host to request L1 block data (live-streaming and historical)
@@ -85,7 +86,7 @@ type L1BlockRepository interface {
 	// FetchNextBlock returns the next canonical block after a given block hash
 	// It returns the new block, a bool which is true if the block is the current L1 head and a bool if the block is on a different fork to prevBlock
 	FetchNextBlock(prevBlock gethcommon.Hash) (*types.Block, bool, error)
-	// FetchObscuroReceipts returns the receipts for a given L1 block
+	// FetchObscuroReceipts returns the receipts of the TEN relevant transactions of a given L1 block
 	FetchObscuroReceipts(block *common.L1Block) (types.Receipts, error)
 }
 
@@ -102,9 +103,9 @@ type L1Publisher interface {
 	// RequestSecret will send a management contract transaction to request a secret from the enclave, returning the L1 head at time of sending
 	RequestSecret(report *common.AttestationReport) (gethcommon.Hash, error)
 	// ExtractRelevantTenTransactions will return all TEN relevant tx from an L1 block
-	ExtractRelevantTenTransactions(block *types.Block, receipts types.Receipts) ([]*common.TxAndReceiptAndBlobs, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx)
-	// FindSecretResponseTx will return the secret response tx from an L1 block
-	FindSecretResponseTx(block *types.Block) []*ethadapter.L1RespondSecretTx
+	ExtractRelevantTenTransactions(block *types.Block, receipts types.Receipts) ([]*common.TxAndReceiptAndBlobs, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx, []*ethadapter.L1CrossChainBundleTx)
+	// FindSecretResponseTx will return the secret responses from the receipts of an L1 block
+	FindSecretResponseTx(block *types.Block, receipts types.Receipts) []*ethadapter.L1RespondSecretTx
 	// PublishRollup will create and publish a rollup tx to the management contract - fire and forget we don't wait for receipt
 	// todo (#1624) - With a single sequencer, it is problematic if rollup publication fails; handle this case better
 	PublishRollup(producedRollup *common.ExtRollup)
@@ -123,6 +124,12 @@ type L1Publisher interface {
 
 	// GetBundleRangeFromManagementContract returns the range of batches for which to build a bundle
 	GetBundleRangeFromManagementContract(lastRollupNumber *big.Int, lastRollupUID gethcommon.Hash) (*gethcommon.Hash, *big.Int, *big.Int, error)
+	// DAMode returns the data availability mode rollups are published with
+	DAMode() common.DAMode
+	// IsBundlePublished returns true if the bundle made of the given cross chain roots is available on the management contract
+	IsBundlePublished(crossChainRoots common.CrossChainRootHashes) (bool, error)
+	// IsSequencerEnclave returns true if the management contract lists the enclave as a sequencer enclave
+	IsSequencerEnclave(enclaveID gethcommon.Address) (bool, error)
 }
 
 // L2BatchRepository provides an interface for the host to request L2 batch data (live-streaming and historical)
@@ -141,6 +148,10 @@ type L2BatchRepository interface {
 
 	// NotifyNewValidatedHead - called after an enclave validates a batch, to update the repo's validated head and notify subscribers
 	NotifyNewValidatedHead(batch *common.ExtBatch)
+
+	// LatestValidatedSeqNo returns the seq no of the latest batch validated by an enclave, the host can serve peers the
+	// batches up to it
+	LatestValidatedSeqNo() *big.Int
 }
 
 // L2BatchHandler is an interface for receiving new batches from the publisher as they arrive
@@ -159,6 +170,10 @@ type EnclaveService interface {
 	// GetEnclaveClient returns an enclave client // todo (@matt) we probably don't want to expose this
 	GetEnclaveClient() common.Enclave
 
+	// GetReadEnclaveClient returns the client of an enclave that is caught up with the L2 head, so read requests are
+	// load balanced across the host enclaves
+	GetReadEnclaveClient() common.Enclave
+
 	// SubmitAndBroadcastTx submits an encrypted transaction to the enclave, and broadcasts it to other hosts on the network (in particular, to the sequencer)
 	SubmitAndBroadcastTx(ctx context.Context, encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error)
 
@@ -0,0 +1,26 @@
+package common
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// L1TxStatus is the state of an L1 transaction issued by the host
+type L1TxStatus string
+
+const (
+	L1TxPending   L1TxStatus = "pending"   // broadcast, waiting for a receipt for one of its attempts
+	L1TxConfirmed L1TxStatus = "confirmed" // a successful receipt was found
+	L1TxFailed    L1TxStatus = "failed"    // the transaction was mined but reverted
+	L1TxCancelled L1TxStatus = "cancelled" // the nonce was taken by a no-op replacement after the transaction was no longer needed
+)
+
+// L1TxRecord is the persisted state of an L1 transaction, so a restarted host can resume tracking it instead of
+// issuing it again
+type L1TxRecord struct {
+	Nonce    uint64
+	Key      string // identifies the logical transaction (e.g. the rollup hash), empty for no-op gap fillers
+	Status   L1TxStatus
+	Retries  int
+	SignedTx []byte            // binary encoding of the latest signed attempt
+	TxHashes []gethcommon.Hash // the hashes of every attempt, any of which may be the one that is mined
+}
@@ -1,12 +1,17 @@
 package metrics
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"runtime"
 	"runtime/pprof"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/metrics/exp"
+	"github.com/ten-protocol/go-ten/go/common/log"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethmetrics "github.com/ethereum/go-ethereum/metrics"
@@ -15,21 +20,32 @@ import (
 var (
 	_collectProcessMetricsRefreshDuration = 3 * time.Second
 	_threadCreateProfile                  = pprof.Lookup("threadcreate")
+	// how long a scrape waits for the metrics of a source (e.g. an enclave) before serving without them
+	_sourceTimeout = 5 * time.Second
 )
 
-// Service provides the metrics for the host
+// Service provides the metrics for the host and the gateway
 // it registers the gethmetrics Registry
-// and handles the metrics server
+// and handles the metrics server, which serves the metrics in the Prometheus format on /metrics
 type Service struct {
 	registry gethmetrics.Registry
+	enabled  bool
 	port     uint
+	server   *http.Server
 	logger   gethlog.Logger
+
+	sources   []labelledSource
+	sourcesMu sync.RWMutex
 }
 
 func New(enabled bool, port uint, logger gethlog.Logger) *Service {
-	gethmetrics.Enabled = enabled
+	// several services can share a process (e.g. in tests), the metrics stay enabled if any of them enables them
+	if enabled {
+		gethmetrics.Enabled = true
+	}
 	return &Service{
 		registry: gethmetrics.NewRegistry(),
+		enabled:  enabled,
 		port:     port,
 		logger:   logger,
 	}
@@ -38,18 +54,26 @@ func New(enabled bool, port uint, logger gethlog.Logger) *Service {
 // Start starts the metrics server
 func (m *Service) Start() {
 	// metrics not enabled
-	if !gethmetrics.Enabled {
+	if !m.enabled {
 		return
 	}
 
 	// start the process collection metric on it's own thread
 	go m.CollectProcessMetrics()
 
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.prometheusHandler())
+	mux.Handle("/debug/metrics", exp.ExpHandler(m.registry))
+
 	// starts the metric server
 	address := fmt.Sprintf("%s:%d", "0.0.0.0", m.port)
+	m.server = &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: _sourceTimeout}
+	go func() {
+		if err := m.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			m.logger.Error("HTTP Metric server failed", log.ErrKey, err)
+		}
+	}()
 	m.logger.Info("HTTP Metric server started", "address", address)
-	// todo - re-write this http server so to have a stop method
-	exp.Setup(address)
 }
 
 // Registry returns the registry for the metrics service
@@ -57,8 +81,50 @@ func (m *Service) Registry() gethmetrics.Registry {
 	return m.registry
 }
 
+// AddSource serves the metrics of the source alongside the metrics of this process, with the label added to each of
+// its samples. The enclaves cannot open ports, so the host serves their metrics, labelled with the enclave index.
+func (m *Service) AddSource(labelName, labelValue string, source Source) {
+	m.sourcesMu.Lock()
+	defer m.sourcesMu.Unlock()
+	m.sources = append(m.sources, labelledSource{labelName: labelName, labelValue: labelValue, source: source})
+}
+
 func (m *Service) Stop() {
-	// todo - re-write this http server so to have a stop method
+	if m.server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), _sourceTimeout)
+	defer cancel()
+	if err := m.server.Shutdown(ctx); err != nil {
+		m.logger.Warn("Could not stop the HTTP Metric server", log.ErrKey, err)
+	}
+}
+
+// prometheusHandler serves the metrics of the registry, the metrics geth registers by default (e.g. the per-method
+// RPC latencies) and the metrics of the sources
+func (m *Service) prometheusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e := newExposition()
+		e.add(Gather(m.registry), "", "")
+		e.add(Gather(gethmetrics.DefaultRegistry), "", "")
+
+		m.sourcesMu.RLock()
+		sources := m.sources
+		m.sourcesMu.RUnlock()
+		for _, s := range sources {
+			ctx, cancel := context.WithTimeout(r.Context(), _sourceTimeout)
+			text, err := s.source(ctx)
+			cancel()
+			if err != nil {
+				m.logger.Warn("Could not collect metrics.", s.labelName, s.labelValue, log.ErrKey, err)
+				continue
+			}
+			e.add(text, s.labelName, s.labelValue)
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(e.bytes())
+	})
 }
 
 // CollectProcessMetrics collect process and system metrics
@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	gethprometheus "github.com/ethereum/go-ethereum/metrics/prometheus"
+)
+
+// Source returns metrics collected outside this process (e.g. by an enclave), in the Prometheus text format
+type Source func(ctx context.Context) ([]byte, error)
+
+// labelledSource is a source whose samples are labelled to tell them apart from the samples of the other sources
+type labelledSource struct {
+	labelName  string
+	labelValue string
+	source     Source
+}
+
+// Gather returns the metrics of the registry in the Prometheus text format
+func Gather(registry gethmetrics.Registry) []byte {
+	w := &bufferResponseWriter{header: http.Header{}}
+	// the handler ignores the request, it only writes the metrics of the registry
+	gethprometheus.Handler(registry).ServeHTTP(w, nil)
+	return w.buf.Bytes()
+}
+
+// bufferResponseWriter captures the output of the geth Prometheus handler
+type bufferResponseWriter struct {
+	header http.Header
+	buf    bytes.Buffer
+}
+
+func (w *bufferResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferResponseWriter) Write(b []byte) (int, error) {
+	return w.buf.Write(b)
+}
+
+func (w *bufferResponseWriter) WriteHeader(int) {}
+
+// exposition merges metrics in the Prometheus text format coming from several sources, so that a metric family
+// reported by more than one source (e.g. by each enclave) is declared only once
+type exposition struct {
+	families map[string]*family
+	order    []string
+}
+
+type family struct {
+	typeLine string
+	samples  []string
+}
+
+func newExposition() *exposition {
+	return &exposition{families: map[string]*family{}}
+}
+
+// add adds the samples of the text, with the label added to each of them if the label name is not empty
+func (e *exposition) add(text []byte, labelName, labelValue string) {
+	var current *family
+	for _, line := range strings.Split(string(text), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			current = nil
+		case strings.HasPrefix(line, "# TYPE "):
+			fields := strings.Fields(line)
+			if len(fields) != 4 {
+				current = nil
+				continue
+			}
+			current = e.family(fields[2], line)
+		case strings.HasPrefix(line, "#"):
+			// HELP lines and comments are not needed to scrape the metrics
+		default:
+			name, _ := splitSample(line)
+			if current == nil {
+				current = e.family(name, "")
+			}
+			current.samples = append(current.samples, withLabel(line, labelName, labelValue))
+		}
+	}
+}
+
+func (e *exposition) family(name string, typeLine string) *family {
+	f, ok := e.families[name]
+	if !ok {
+		f = &family{}
+		e.families[name] = f
+		e.order = append(e.order, name)
+	}
+	if f.typeLine == "" {
+		f.typeLine = typeLine
+	}
+	return f
+}
+
+func (e *exposition) bytes() []byte {
+	var buf bytes.Buffer
+	for _, name := range e.order {
+		f := e.families[name]
+		if f.typeLine != "" {
+			buf.WriteString(f.typeLine + "\n")
+		}
+		for _, sample := range f.samples {
+			buf.WriteString(sample + "\n")
+		}
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+// splitSample splits a sample line into the metric name and the rest of the line (labels and value)
+func splitSample(sample string) (string, string) {
+	end := strings.IndexAny(sample, "{ ")
+	if end < 0 {
+		return sample, ""
+	}
+	return sample[:end], strings.TrimLeft(sample[end:], " ")
+}
+
+// withLabel adds the label to the sample, keeping the labels it already has
+func withLabel(sample string, labelName, labelValue string) string {
+	name, rest := splitSample(sample)
+	if labelName == "" {
+		if strings.HasPrefix(rest, "{") {
+			return name + rest
+		}
+		return name + " " + rest
+	}
+	label := fmt.Sprintf("%s=%q", labelName, labelValue)
+	switch {
+	case strings.HasPrefix(rest, "{}"):
+		return name + "{" + label + rest[1:]
+	case strings.HasPrefix(rest, "{"):
+		return name + "{" + label + "," + rest[1:]
+	default:
+		return name + "{" + label + "} " + rest
+	}
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpositionLabelsEachSourceAndDeclaresFamiliesOnce(t *testing.T) {
+	enclave := []byte("# TYPE enclave_txpool_pending gauge\nenclave_txpool_pending 3\n\n" +
+		"# TYPE enclave_batch_production summary\nenclave_batch_production {quantile=\"0.5\"} 12\n\n")
+
+	e := newExposition()
+	e.add([]byte("# TYPE host_l1_publication_gas counter\nhost_l1_publication_gas 21000\n\n"), "", "")
+	e.add(enclave, "enclave", "0")
+	e.add(enclave, "enclave", "1")
+
+	expected := "# TYPE host_l1_publication_gas counter\nhost_l1_publication_gas 21000\n\n" +
+		"# TYPE enclave_txpool_pending gauge\nenclave_txpool_pending{enclave=\"0\"} 3\nenclave_txpool_pending{enclave=\"1\"} 3\n\n" +
+		"# TYPE enclave_batch_production summary\n" +
+		"enclave_batch_production{enclave=\"0\",quantile=\"0.5\"} 12\nenclave_batch_production{enclave=\"1\",quantile=\"0.5\"} 12\n\n"
+	require.Equal(t, expected, string(e.bytes()))
+}
+
+func TestGatherWritesRegistryInPrometheusFormat(t *testing.T) {
+	registry := gethmetrics.NewRegistry()
+	gethmetrics.GetOrRegisterCounterForced("gateway/cache/hits", registry).Inc(2)
+
+	text := string(Gather(registry))
+	require.True(t, strings.Contains(text, "# TYPE gateway_cache_hits gauge"), text)
+	require.True(t, strings.Contains(text, "gateway_cache_hits 2"), text)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type PrivateTransactionsQueryResponse struct {
@@ -15,6 +16,16 @@ type PrivateTransactionsQueryResponse struct {
 	Total    uint64
 }
 
+// PersonalTransactionsPage is a page of the transaction history of the accounts of a gateway user, latest first
+type PersonalTransactionsPage struct {
+	Receipts types.Receipts `json:"receipts"`
+	// Total is the number of transactions matching the filter across all the accounts
+	Total uint64 `json:"total"`
+	// NextCursor requests the following page. It is nil once the history is exhausted, although a page that ends
+	// exactly with the history may still return one, leading to an empty page.
+	NextCursor *PersonalTransactionCursor `json:"nextCursor,omitempty"`
+}
+
 type TransactionListingResponse struct {
 	TransactionsData []PublicTransaction
 	Total            uint64
@@ -40,6 +51,41 @@ type RollupListingResponse struct {
 	Total       uint64
 }
 
+type CrossChainMessageListingResponse struct {
+	MessagesData []PublicCrossChainMessage
+	Total        uint64
+}
+
+type PersonalCrossChainMessageListingResponse struct {
+	MessagesData []PersonalCrossChainMessage
+	Total        uint64
+}
+
+type L1ForkListingResponse struct {
+	ForksData []L1Fork
+	Total     uint64
+}
+
+type ReorgedBatchListingResponse struct {
+	BatchesData []ReorgedBatch
+	Total       uint64
+}
+
+type MovedTransactionListingResponse struct {
+	TransactionsData []MovedTransaction
+	Total            uint64
+}
+
+type PublicEventListingResponse struct {
+	EventsData []types.Log
+	Total      uint64
+}
+
+type SearchResponse struct {
+	ResultsData []SearchResult
+	Total       uint64
+}
+
 type PublicTransaction struct {
 	TransactionHash TxHash
 	BatchHeight     *big.Int
@@ -72,6 +118,140 @@ type PublicRollup struct {
 	L1Hash    string
 }
 
+// PublicCrossChainMessage is the host view of a cross chain message. It deliberately contains no participant addresses.
+type PublicCrossChainMessage struct {
+	MessageHash common.Hash
+	Direction   CrossChainDirection
+	LeafType    string
+	Status      CrossChainMessageStatus
+	L1Height    uint64   // the L1 block where an inbound message was observed
+	BatchSeqNo  *big.Int // the batch that emitted or delivered the message, nil while only observed
+}
+
+// PersonalCrossChainMessage is a cross chain message returned to one of its participants
+type PersonalCrossChainMessage struct {
+	PublicCrossChainMessage
+	Sender   *common.Address
+	Receiver *common.Address
+}
+
+// L1Fork is an L1 reorg observed by the enclave. The batches built on the abandoned blocks become non-canonical, and
+// the sequencer re-creates them with the same transactions on the new canonical chain.
+type L1Fork struct {
+	NewHead              L1BlockHash   `json:"newHead"`
+	NewHeight            uint64        `json:"newHeight"`
+	OldHead              L1BlockHash   `json:"oldHead"`
+	CommonAncestor       L1BlockHash   `json:"commonAncestor"`
+	CommonAncestorHeight uint64        `json:"commonAncestorHeight"`
+	CanonicalPath        []L1BlockHash `json:"canonicalPath"`
+	NonCanonicalPath     []L1BlockHash `json:"nonCanonicalPath"`
+}
+
+// ReorgedBatch is a batch that is no longer canonical, together with the batch that replaced it at the same height.
+// The replacement is nil while the host has not received it yet.
+type ReorgedBatch struct {
+	SequencerOrderNo  *big.Int     `json:"sequence"`
+	FullHash          common.Hash  `json:"fullHash"`
+	Height            *big.Int     `json:"height"`
+	ReplacedBySeqNo   *big.Int     `json:"replacedBySequence"`
+	ReplacedByHash    *common.Hash `json:"replacedByHash"`
+	ReorgedAtBatchSeq *big.Int     `json:"reorgedAtSequence"` // the sequence of the batch that made it non-canonical
+}
+
+// MovedTransaction is a transaction of a non-canonical batch, with the canonical batch it was included in again (nil
+// if it was not included again yet)
+type MovedTransaction struct {
+	TransactionHash TxHash       `json:"transactionHash"`
+	OldBatchSeqNo   *big.Int     `json:"oldBatchSequence"`
+	OldBatchHash    common.Hash  `json:"oldBatchHash"`
+	NewBatchSeqNo   *big.Int     `json:"newBatchSequence"`
+	NewBatchHash    *common.Hash `json:"newBatchHash"`
+}
+
+// PublicContract is what is public about a contract: its creation and its visibility configuration
+type PublicContract struct {
+	Address        common.Address    `json:"address"`
+	Creator        common.Address    `json:"creator"`
+	CreationTx     TxHash            `json:"creationTx"`
+	CreationBatch  *big.Int          `json:"creationBatchHeight"` // nil if the batch that created it is no longer canonical
+	Transparent    bool              `json:"transparent"`         // whether all the data of the contract is public
+	AutoVisibility bool              `json:"autoVisibility"`      // whether the contract has no visibility configuration
+	EventTypes     []PublicEventType `json:"eventTypes"`
+}
+
+// PublicEventType is the visibility of an event type emitted by a contract. The "can view" flags are only set for
+// event types configured by the contract.
+type PublicEventType struct {
+	EventSignature common.Hash `json:"eventSignature"`
+	Public         bool        `json:"public"` // whether everyone can view the events
+	AutoVisibility bool        `json:"autoVisibility"`
+	Topic1CanView  *bool       `json:"topic1CanView,omitempty"`
+	Topic2CanView  *bool       `json:"topic2CanView,omitempty"`
+	Topic3CanView  *bool       `json:"topic3CanView,omitempty"`
+	SenderCanView  *bool       `json:"senderCanView,omitempty"`
+}
+
+// AddressActivity is what is public about the activity of an address: the contracts it created, and its transactions
+// with transparent contracts
+type AddressActivity struct {
+	Address           common.Address       `json:"address"`
+	IsContract        bool                 `json:"isContract"`
+	CreatedContracts  []common.Address     `json:"createdContracts"`
+	TransactionsData  []AddressTransaction `json:"transactionsData"`
+	TransactionsTotal uint64               `json:"transactionsTotal"`
+}
+
+// AddressTransaction is a transaction of an address with a transparent contract, either sent by it or, for a
+// contract, received by it
+type AddressTransaction struct {
+	TransactionHash TxHash         `json:"transactionHash"`
+	BatchHeight     *big.Int       `json:"batchHeight"`
+	BatchHash       common.Hash    `json:"batchHash"`
+	From            common.Address `json:"from"`
+	To              common.Address `json:"to"`
+	Status          uint64         `json:"status"`
+}
+
+type SearchResultType string
+
+const (
+	SearchResultBatch       SearchResultType = "batch"
+	SearchResultRollup      SearchResultType = "rollup"
+	SearchResultTransaction SearchResultType = "transaction"
+	SearchResultBlock       SearchResultType = "block"
+	SearchResultContract    SearchResultType = "contract"
+)
+
+type SearchMatch string
+
+const (
+	MatchedByHash     SearchMatch = "hash"
+	MatchedByHeight   SearchMatch = "height"
+	MatchedBySequence SearchMatch = "sequence"
+	MatchedByAddress  SearchMatch = "address"
+)
+
+// SearchResult is an item matching a Tenscan search, with what is needed to link to its page. The height is the L1
+// block number for blocks, and the batch height otherwise. The sequence is the batch sequence number, or the first
+// one in a rollup.
+type SearchResult struct {
+	Type      SearchResultType `json:"type"`
+	MatchedBy SearchMatch      `json:"matchedBy"`
+	Hash      *common.Hash     `json:"hash,omitempty"`
+	Address   *common.Address  `json:"address,omitempty"`
+	Height    *big.Int         `json:"height,omitempty"`
+	Sequence  *big.Int         `json:"sequence,omitempty"`
+}
+
+// StoredBlob is an L1 blob cached by the host, together with the L1 block it was fetched for
+type StoredBlob struct {
+	VersionedHash common.Hash   `json:"versionedHash"`
+	L1Hash        common.Hash   `json:"l1Hash"`
+	L1Height      uint64        `json:"l1Height"`
+	L1Time        uint64        `json:"l1Time"`
+	Blob          hexutil.Bytes `json:"blob"`
+}
+
 type PublicBlock struct {
 	BlockHeader types.Header `json:"blockHeader"`
 	RollupHash  common.Hash  `json:"rollupHash"`
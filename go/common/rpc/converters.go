@@ -34,20 +34,70 @@ func ToBlockSubmissionResponseMsg(response *common.BlockSubmissionResponse) (*ge
 
 	msg := &generated.BlockSubmissionResponseMsg{
 		ProducedSecretResponses: ToSecretRespMsg(response.ProducedSecretResponses),
+		L1Fork:                  ToL1ForkMsg(response.L1Fork),
 	}
 
 	return msg, nil
 }
 
+func ToL1ForkMsg(fork *common.L1Fork) *generated.L1ForkMsg {
+	if fork == nil {
+		return nil
+	}
+	return &generated.L1ForkMsg{
+		NewHead:              fork.NewHead.Bytes(),
+		NewHeight:            fork.NewHeight,
+		OldHead:              fork.OldHead.Bytes(),
+		CommonAncestor:       fork.CommonAncestor.Bytes(),
+		CommonAncestorHeight: fork.CommonAncestorHeight,
+		CanonicalPath:        toHashBytes(fork.CanonicalPath),
+		NonCanonicalPath:     toHashBytes(fork.NonCanonicalPath),
+	}
+}
+
+func FromL1ForkMsg(msg *generated.L1ForkMsg) *common.L1Fork {
+	if msg == nil {
+		return nil
+	}
+	return &common.L1Fork{
+		NewHead:              gethcommon.BytesToHash(msg.NewHead),
+		NewHeight:            msg.NewHeight,
+		OldHead:              gethcommon.BytesToHash(msg.OldHead),
+		CommonAncestor:       gethcommon.BytesToHash(msg.CommonAncestor),
+		CommonAncestorHeight: msg.CommonAncestorHeight,
+		CanonicalPath:        fromHashBytes(msg.CanonicalPath),
+		NonCanonicalPath:     fromHashBytes(msg.NonCanonicalPath),
+	}
+}
+
+func toHashBytes(hashes []gethcommon.Hash) [][]byte {
+	hashBytes := make([][]byte, len(hashes))
+	for i, h := range hashes {
+		hashBytes[i] = h.Bytes()
+	}
+	return hashBytes
+}
+
+func fromHashBytes(hashBytes [][]byte) []gethcommon.Hash {
+	hashes := make([]gethcommon.Hash, len(hashBytes))
+	for i, b := range hashBytes {
+		hashes[i] = gethcommon.BytesToHash(b)
+	}
+	return hashes
+}
+
 func ToSecretRespMsg(responses []*common.ProducedSecretResponse) []*generated.SecretResponseMsg {
 	respMsgs := make([]*generated.SecretResponseMsg, len(responses))
 
 	for i, resp := range responses {
 		msg := generated.SecretResponseMsg{
-			Secret:      resp.Secret,
-			RequesterID: resp.RequesterID.Bytes(),
-			AttesterID:  resp.AttesterID.Bytes(),
-			HostAddress: resp.HostAddress,
+			Secret:           resp.Secret,
+			RequesterID:      resp.RequesterID.Bytes(),
+			AttesterID:       resp.AttesterID.Bytes(),
+			HostAddress:      resp.HostAddress,
+			Generation:       resp.Generation,
+			ActivationHeight: resp.ActivationHeight,
+			AttesterSig:      resp.AttesterSig,
 		}
 		respMsgs[i] = &msg
 	}
@@ -60,10 +110,13 @@ func FromSecretRespMsg(secretResponses []*generated.SecretResponseMsg) []*common
 
 	for i, msgResp := range secretResponses {
 		r := common.ProducedSecretResponse{
-			Secret:      msgResp.Secret,
-			RequesterID: gethcommon.BytesToAddress(msgResp.RequesterID),
-			AttesterID:  gethcommon.BytesToAddress(msgResp.AttesterID),
-			HostAddress: msgResp.HostAddress,
+			Secret:           msgResp.Secret,
+			RequesterID:      gethcommon.BytesToAddress(msgResp.RequesterID),
+			AttesterID:       gethcommon.BytesToAddress(msgResp.AttesterID),
+			HostAddress:      msgResp.HostAddress,
+			Generation:       msgResp.Generation,
+			ActivationHeight: msgResp.ActivationHeight,
+			AttesterSig:      msgResp.AttesterSig,
 		}
 		respList[i] = &r
 	}
@@ -73,6 +126,7 @@ func FromSecretRespMsg(secretResponses []*generated.SecretResponseMsg) []*common
 func FromBlockSubmissionResponseMsg(msg *generated.BlockSubmissionResponseMsg) (*common.BlockSubmissionResponse, error) {
 	return &common.BlockSubmissionResponse{
 		ProducedSecretResponses: FromSecretRespMsg(msg.ProducedSecretResponses),
+		L1Fork:                  FromL1ForkMsg(msg.L1Fork),
 	}, nil
 }
 
@@ -224,6 +278,7 @@ func ToRollupHeaderMsg(header *common.RollupHeader) *generated.RollupHeaderMsg {
 		Signature:          header.Signature,
 		CrossChainMessages: ToCrossChainMsgs(header.CrossChainMessages),
 		LastBatchSeqNo:     header.LastBatchSeqNo,
+		DAMode:             uint32(header.DAMode),
 	}
 
 	return &headerMsg
@@ -253,6 +308,7 @@ func FromRollupHeaderMsg(header *generated.RollupHeaderMsg) *common.RollupHeader
 		Signature:          header.Signature,
 		CrossChainMessages: FromCrossChainMsgs(header.CrossChainMessages),
 		LastBatchSeqNo:     header.LastBatchSeqNo,
+		DAMode:             common.DAMode(header.DAMode),
 	}
 }
 
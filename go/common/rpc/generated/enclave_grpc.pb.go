@@ -19,39 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EnclaveProto_Status_FullMethodName                 = "/generated.EnclaveProto/Status"
-	EnclaveProto_Attestation_FullMethodName            = "/generated.EnclaveProto/Attestation"
-	EnclaveProto_GenerateSecret_FullMethodName         = "/generated.EnclaveProto/GenerateSecret"
-	EnclaveProto_InitEnclave_FullMethodName            = "/generated.EnclaveProto/InitEnclave"
-	EnclaveProto_EnclaveID_FullMethodName              = "/generated.EnclaveProto/EnclaveID"
-	EnclaveProto_SubmitL1Block_FullMethodName          = "/generated.EnclaveProto/SubmitL1Block"
-	EnclaveProto_SubmitTx_FullMethodName               = "/generated.EnclaveProto/SubmitTx"
-	EnclaveProto_SubmitBatch_FullMethodName            = "/generated.EnclaveProto/SubmitBatch"
-	EnclaveProto_ObsCall_FullMethodName                = "/generated.EnclaveProto/ObsCall"
-	EnclaveProto_GetTransactionCount_FullMethodName    = "/generated.EnclaveProto/GetTransactionCount"
-	EnclaveProto_Stop_FullMethodName                   = "/generated.EnclaveProto/Stop"
-	EnclaveProto_GetTransaction_FullMethodName         = "/generated.EnclaveProto/GetTransaction"
-	EnclaveProto_GetTransactionReceipt_FullMethodName  = "/generated.EnclaveProto/GetTransactionReceipt"
-	EnclaveProto_GetBalance_FullMethodName             = "/generated.EnclaveProto/GetBalance"
-	EnclaveProto_GetCode_FullMethodName                = "/generated.EnclaveProto/GetCode"
-	EnclaveProto_GetStorageSlot_FullMethodName         = "/generated.EnclaveProto/GetStorageSlot"
-	EnclaveProto_Subscribe_FullMethodName              = "/generated.EnclaveProto/Subscribe"
-	EnclaveProto_Unsubscribe_FullMethodName            = "/generated.EnclaveProto/Unsubscribe"
-	EnclaveProto_EstimateGas_FullMethodName            = "/generated.EnclaveProto/EstimateGas"
-	EnclaveProto_GetLogs_FullMethodName                = "/generated.EnclaveProto/GetLogs"
-	EnclaveProto_HealthCheck_FullMethodName            = "/generated.EnclaveProto/HealthCheck"
-	EnclaveProto_GetBatch_FullMethodName               = "/generated.EnclaveProto/GetBatch"
-	EnclaveProto_GetBatchBySeqNo_FullMethodName        = "/generated.EnclaveProto/GetBatchBySeqNo"
-	EnclaveProto_GetRollupData_FullMethodName          = "/generated.EnclaveProto/GetRollupData"
-	EnclaveProto_CreateBatch_FullMethodName            = "/generated.EnclaveProto/CreateBatch"
-	EnclaveProto_CreateRollup_FullMethodName           = "/generated.EnclaveProto/CreateRollup"
-	EnclaveProto_ExportCrossChainData_FullMethodName   = "/generated.EnclaveProto/ExportCrossChainData"
-	EnclaveProto_DebugTraceTransaction_FullMethodName  = "/generated.EnclaveProto/DebugTraceTransaction"
-	EnclaveProto_StreamL2Updates_FullMethodName        = "/generated.EnclaveProto/StreamL2Updates"
-	EnclaveProto_DebugEventLogRelevancy_FullMethodName = "/generated.EnclaveProto/DebugEventLogRelevancy"
-	EnclaveProto_GetTotalContractCount_FullMethodName  = "/generated.EnclaveProto/GetTotalContractCount"
-	EnclaveProto_GetReceiptsByAddress_FullMethodName   = "/generated.EnclaveProto/GetReceiptsByAddress"
-	EnclaveProto_EnclavePublicConfig_FullMethodName    = "/generated.EnclaveProto/EnclavePublicConfig"
+	EnclaveProto_Status_FullMethodName                   = "/generated.EnclaveProto/Status"
+	EnclaveProto_Attestation_FullMethodName              = "/generated.EnclaveProto/Attestation"
+	EnclaveProto_GenerateSecret_FullMethodName           = "/generated.EnclaveProto/GenerateSecret"
+	EnclaveProto_InitEnclave_FullMethodName              = "/generated.EnclaveProto/InitEnclave"
+	EnclaveProto_EnclaveID_FullMethodName                = "/generated.EnclaveProto/EnclaveID"
+	EnclaveProto_SubmitL1Block_FullMethodName            = "/generated.EnclaveProto/SubmitL1Block"
+	EnclaveProto_SubmitTx_FullMethodName                 = "/generated.EnclaveProto/SubmitTx"
+	EnclaveProto_SubmitBatch_FullMethodName              = "/generated.EnclaveProto/SubmitBatch"
+	EnclaveProto_ObsCall_FullMethodName                  = "/generated.EnclaveProto/ObsCall"
+	EnclaveProto_GetTransactionCount_FullMethodName      = "/generated.EnclaveProto/GetTransactionCount"
+	EnclaveProto_Stop_FullMethodName                     = "/generated.EnclaveProto/Stop"
+	EnclaveProto_GetTransaction_FullMethodName           = "/generated.EnclaveProto/GetTransaction"
+	EnclaveProto_GetTransactionReceipt_FullMethodName    = "/generated.EnclaveProto/GetTransactionReceipt"
+	EnclaveProto_GetBalance_FullMethodName               = "/generated.EnclaveProto/GetBalance"
+	EnclaveProto_GetCode_FullMethodName                  = "/generated.EnclaveProto/GetCode"
+	EnclaveProto_GetStorageSlot_FullMethodName           = "/generated.EnclaveProto/GetStorageSlot"
+	EnclaveProto_Subscribe_FullMethodName                = "/generated.EnclaveProto/Subscribe"
+	EnclaveProto_Unsubscribe_FullMethodName              = "/generated.EnclaveProto/Unsubscribe"
+	EnclaveProto_EstimateGas_FullMethodName              = "/generated.EnclaveProto/EstimateGas"
+	EnclaveProto_GetLogs_FullMethodName                  = "/generated.EnclaveProto/GetLogs"
+	EnclaveProto_HealthCheck_FullMethodName              = "/generated.EnclaveProto/HealthCheck"
+	EnclaveProto_Metrics_FullMethodName                  = "/generated.EnclaveProto/Metrics"
+	EnclaveProto_GetBatch_FullMethodName                 = "/generated.EnclaveProto/GetBatch"
+	EnclaveProto_GetBatchBySeqNo_FullMethodName          = "/generated.EnclaveProto/GetBatchBySeqNo"
+	EnclaveProto_GetRollupData_FullMethodName            = "/generated.EnclaveProto/GetRollupData"
+	EnclaveProto_CreateBatch_FullMethodName              = "/generated.EnclaveProto/CreateBatch"
+	EnclaveProto_SetActiveSequencer_FullMethodName       = "/generated.EnclaveProto/SetActiveSequencer"
+	EnclaveProto_CreateRollup_FullMethodName             = "/generated.EnclaveProto/CreateRollup"
+	EnclaveProto_ExportCrossChainData_FullMethodName     = "/generated.EnclaveProto/ExportCrossChainData"
+	EnclaveProto_DebugTraceTransaction_FullMethodName    = "/generated.EnclaveProto/DebugTraceTransaction"
+	EnclaveProto_StreamL2Updates_FullMethodName          = "/generated.EnclaveProto/StreamL2Updates"
+	EnclaveProto_DebugEventLogRelevancy_FullMethodName   = "/generated.EnclaveProto/DebugEventLogRelevancy"
+	EnclaveProto_GetTotalContractCount_FullMethodName    = "/generated.EnclaveProto/GetTotalContractCount"
+	EnclaveProto_GetReceiptsByAddress_FullMethodName     = "/generated.EnclaveProto/GetReceiptsByAddress"
+	EnclaveProto_EnclavePublicConfig_FullMethodName      = "/generated.EnclaveProto/EnclavePublicConfig"
+	EnclaveProto_GetPublicContract_FullMethodName        = "/generated.EnclaveProto/GetPublicContract"
+	EnclaveProto_GetPublicContractEvents_FullMethodName  = "/generated.EnclaveProto/GetPublicContractEvents"
+	EnclaveProto_GetPublicAddressActivity_FullMethodName = "/generated.EnclaveProto/GetPublicAddressActivity"
 )
 
 // EnclaveProtoClient is the client API for EnclaveProto service.
@@ -106,6 +111,8 @@ type EnclaveProtoClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// Metrics returns the metrics collected by the enclave in the Prometheus text format
+	Metrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
@@ -113,6 +120,8 @@ type EnclaveProtoClient interface {
 	// GetRollupData returns the first batch sequence number in the rollup and the timestamp
 	GetRollupData(ctx context.Context, in *GetRollupDataRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error)
 	CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error)
+	// SetActiveSequencer allows or stops the batch production of a sequencer enclave
+	SetActiveSequencer(ctx context.Context, in *SetActiveSequencerRequest, opts ...grpc.CallOption) (*SetActiveSequencerResponse, error)
 	CreateRollup(ctx context.Context, in *CreateRollupRequest, opts ...grpc.CallOption) (*CreateRollupResponse, error)
 	ExportCrossChainData(ctx context.Context, in *ExportCrossChainDataRequest, opts ...grpc.CallOption) (*ExportCrossChainDataResponse, error)
 	DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error)
@@ -122,6 +131,12 @@ type EnclaveProtoClient interface {
 	GetReceiptsByAddress(ctx context.Context, in *GetReceiptsByAddressRequest, opts ...grpc.CallOption) (*GetReceiptsByAddressResponse, error)
 	// EnclavePublicConfig returns public network data that is known to the enclave but may not be known to the host
 	EnclavePublicConfig(ctx context.Context, in *EnclavePublicConfigRequest, opts ...grpc.CallOption) (*EnclavePublicConfigResponse, error)
+	// GetPublicContract returns the creation and visibility configuration of a contract
+	GetPublicContract(ctx context.Context, in *GetPublicContractRequest, opts ...grpc.CallOption) (*GetPublicContractResponse, error)
+	// GetPublicContractEvents returns the events of a contract that everyone can view
+	GetPublicContractEvents(ctx context.Context, in *GetPublicContractEventsRequest, opts ...grpc.CallOption) (*GetPublicContractEventsResponse, error)
+	// GetPublicAddressActivity returns the contracts created by an address and its transactions with transparent contracts
+	GetPublicAddressActivity(ctx context.Context, in *GetPublicAddressActivityRequest, opts ...grpc.CallOption) (*GetPublicAddressActivityResponse, error)
 }
 
 type enclaveProtoClient struct {
@@ -321,6 +336,15 @@ func (c *enclaveProtoClient) HealthCheck(ctx context.Context, in *EmptyArgs, opt
 	return out, nil
 }
 
+func (c *enclaveProtoClient) Metrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_Metrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error) {
 	out := new(GetBatchResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_GetBatch_FullMethodName, in, out, opts...)
@@ -357,6 +381,15 @@ func (c *enclaveProtoClient) CreateBatch(ctx context.Context, in *CreateBatchReq
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SetActiveSequencer(ctx context.Context, in *SetActiveSequencerRequest, opts ...grpc.CallOption) (*SetActiveSequencerResponse, error) {
+	out := new(SetActiveSequencerResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_SetActiveSequencer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) CreateRollup(ctx context.Context, in *CreateRollupRequest, opts ...grpc.CallOption) (*CreateRollupResponse, error) {
 	out := new(CreateRollupResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_CreateRollup_FullMethodName, in, out, opts...)
@@ -452,6 +485,33 @@ func (c *enclaveProtoClient) EnclavePublicConfig(ctx context.Context, in *Enclav
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetPublicContract(ctx context.Context, in *GetPublicContractRequest, opts ...grpc.CallOption) (*GetPublicContractResponse, error) {
+	out := new(GetPublicContractResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetPublicContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetPublicContractEvents(ctx context.Context, in *GetPublicContractEventsRequest, opts ...grpc.CallOption) (*GetPublicContractEventsResponse, error) {
+	out := new(GetPublicContractEventsResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetPublicContractEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetPublicAddressActivity(ctx context.Context, in *GetPublicAddressActivityRequest, opts ...grpc.CallOption) (*GetPublicAddressActivityResponse, error) {
+	out := new(GetPublicAddressActivityResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetPublicAddressActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnclaveProtoServer is the server API for EnclaveProto service.
 // All implementations must embed UnimplementedEnclaveProtoServer
 // for forward compatibility
@@ -504,6 +564,8 @@ type EnclaveProtoServer interface {
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(context.Context, *EmptyArgs) (*HealthCheckResponse, error)
+	// Metrics returns the metrics collected by the enclave in the Prometheus text format
+	Metrics(context.Context, *MetricsRequest) (*MetricsResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
@@ -511,6 +573,8 @@ type EnclaveProtoServer interface {
 	// GetRollupData returns the first batch sequence number in the rollup and the timestamp
 	GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error)
 	CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error)
+	// SetActiveSequencer allows or stops the batch production of a sequencer enclave
+	SetActiveSequencer(context.Context, *SetActiveSequencerRequest) (*SetActiveSequencerResponse, error)
 	CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error)
 	ExportCrossChainData(context.Context, *ExportCrossChainDataRequest) (*ExportCrossChainDataResponse, error)
 	DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error)
@@ -520,6 +584,12 @@ type EnclaveProtoServer interface {
 	GetReceiptsByAddress(context.Context, *GetReceiptsByAddressRequest) (*GetReceiptsByAddressResponse, error)
 	// EnclavePublicConfig returns public network data that is known to the enclave but may not be known to the host
 	EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error)
+	// GetPublicContract returns the creation and visibility configuration of a contract
+	GetPublicContract(context.Context, *GetPublicContractRequest) (*GetPublicContractResponse, error)
+	// GetPublicContractEvents returns the events of a contract that everyone can view
+	GetPublicContractEvents(context.Context, *GetPublicContractEventsRequest) (*GetPublicContractEventsResponse, error)
+	// GetPublicAddressActivity returns the contracts created by an address and its transactions with transparent contracts
+	GetPublicAddressActivity(context.Context, *GetPublicAddressActivityRequest) (*GetPublicAddressActivityResponse, error)
 	mustEmbedUnimplementedEnclaveProtoServer()
 }
 
@@ -590,6 +660,9 @@ func (UnimplementedEnclaveProtoServer) GetLogs(context.Context, *GetLogsRequest)
 func (UnimplementedEnclaveProtoServer) HealthCheck(context.Context, *EmptyArgs) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedEnclaveProtoServer) Metrics(context.Context, *MetricsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
 }
@@ -602,6 +675,9 @@ func (UnimplementedEnclaveProtoServer) GetRollupData(context.Context, *GetRollup
 func (UnimplementedEnclaveProtoServer) CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SetActiveSequencer(context.Context, *SetActiveSequencerRequest) (*SetActiveSequencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActiveSequencer not implemented")
+}
 func (UnimplementedEnclaveProtoServer) CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRollup not implemented")
 }
@@ -626,6 +702,15 @@ func (UnimplementedEnclaveProtoServer) GetReceiptsByAddress(context.Context, *Ge
 func (UnimplementedEnclaveProtoServer) EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnclavePublicConfig not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetPublicContract(context.Context, *GetPublicContractRequest) (*GetPublicContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicContract not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetPublicContractEvents(context.Context, *GetPublicContractEventsRequest) (*GetPublicContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicContractEvents not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetPublicAddressActivity(context.Context, *GetPublicAddressActivityRequest) (*GetPublicAddressActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicAddressActivity not implemented")
+}
 func (UnimplementedEnclaveProtoServer) mustEmbedUnimplementedEnclaveProtoServer() {}
 
 // UnsafeEnclaveProtoServer may be embedded to opt out of forward compatibility for this service.
@@ -1017,6 +1102,24 @@ func _EnclaveProto_HealthCheck_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).Metrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_Metrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).Metrics(ctx, req.(*MetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBatchRequest)
 	if err := dec(in); err != nil {
@@ -1089,6 +1192,24 @@ func _EnclaveProto_CreateBatch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SetActiveSequencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActiveSequencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SetActiveSequencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_SetActiveSequencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SetActiveSequencer(ctx, req.(*SetActiveSequencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_CreateRollup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRollupRequest)
 	if err := dec(in); err != nil {
@@ -1236,6 +1357,60 @@ func _EnclaveProto_EnclavePublicConfig_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetPublicContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetPublicContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetPublicContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetPublicContract(ctx, req.(*GetPublicContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetPublicContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetPublicContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetPublicContractEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetPublicContractEvents(ctx, req.(*GetPublicContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetPublicAddressActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicAddressActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetPublicAddressActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetPublicAddressActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetPublicAddressActivity(ctx, req.(*GetPublicAddressActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnclaveProto_ServiceDesc is the grpc.ServiceDesc for EnclaveProto service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1327,6 +1502,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _EnclaveProto_HealthCheck_Handler,
 		},
+		{
+			MethodName: "Metrics",
+			Handler:    _EnclaveProto_Metrics_Handler,
+		},
 		{
 			MethodName: "GetBatch",
 			Handler:    _EnclaveProto_GetBatch_Handler,
@@ -1343,6 +1522,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBatch",
 			Handler:    _EnclaveProto_CreateBatch_Handler,
 		},
+		{
+			MethodName: "SetActiveSequencer",
+			Handler:    _EnclaveProto_SetActiveSequencer_Handler,
+		},
 		{
 			MethodName: "CreateRollup",
 			Handler:    _EnclaveProto_CreateRollup_Handler,
@@ -1371,6 +1554,18 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnclavePublicConfig",
 			Handler:    _EnclaveProto_EnclavePublicConfig_Handler,
 		},
+		{
+			MethodName: "GetPublicContract",
+			Handler:    _EnclaveProto_GetPublicContract_Handler,
+		},
+		{
+			MethodName: "GetPublicContractEvents",
+			Handler:    _EnclaveProto_GetPublicContractEvents_Handler,
+		},
+		{
+			MethodName: "GetPublicAddressActivity",
+			Handler:    _EnclaveProto_GetPublicAddressActivity_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
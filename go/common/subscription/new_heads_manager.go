@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"sync"
 	"sync/atomic"
@@ -17,6 +18,9 @@ import (
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
 
+// the number of recent heads remembered to tell the subscribers which of them a reorg removed
+const _recentHeadsWindow = 128
+
 // NewHeadsService multiplexes new batch header messages from an input channel into multiple subscribers
 // also handles unsubscribe
 // Note: this is a service which must be Started and Stopped
@@ -26,6 +30,7 @@ type NewHeadsService struct {
 	notifiersMutex     *sync.RWMutex
 	newHeadNotifiers   map[rpc.ID]*rpc.Notifier
 	onMessage          func(*common.BatchHeader) error
+	recentHeads        []sentHead // the latest heads sent, to detect the ones replaced after an L1 fork
 	stopped            *atomic.Bool
 	logger             gethlog.Logger
 }
@@ -86,29 +91,67 @@ func (nhs *NewHeadsService) onNewBatch(head *common.BatchHeader) error {
 		}
 	}
 
-	var msg any = head
-	if nhs.convertToEthHeader {
-		msg = ConvertBatchHeader(head)
-	}
-
 	nhs.notifiersMutex.Lock()
 	defer nhs.notifiersMutex.Unlock()
 
+	var msgs []any
+	if nhs.convertToEthHeader {
+		// the eth format stream is the one served to dApps, so it announces the heads removed by a reorg first.
+		// The raw batch header stream is consumed by gateways, which detect the reorg themselves.
+		ethHeader := ConvertBatchHeader(head)
+		for _, removed := range nhs.replaceRecentHeads(head, ethHeader) {
+			msgs = append(msgs, &RemovedHead{Header: removed})
+		}
+		msgs = append(msgs, ethHeader)
+	} else {
+		msgs = append(msgs, head)
+	}
+
 	// for each new head, notify all registered subscriptions
 	for id, notifier := range nhs.newHeadNotifiers {
-		if nhs.stopped.Load() {
-			return nil
-		}
-		err := notifier.Notify(id, msg)
-		if err != nil {
-			// on error, remove the notification
-			nhs.logger.Info("failed to notify newHead subscription", log.ErrKey, err, log.SubIDKey, id)
-			delete(nhs.newHeadNotifiers, id)
+		for _, msg := range msgs {
+			if nhs.stopped.Load() {
+				return nil
+			}
+			err := notifier.Notify(id, msg)
+			if err != nil {
+				// on error, remove the notification
+				nhs.logger.Info("failed to notify newHead subscription", log.ErrKey, err, log.SubIDKey, id)
+				delete(nhs.newHeadNotifiers, id)
+				break
+			}
 		}
 	}
 	return nil
 }
 
+// replaceRecentHeads records the new head and returns the heads it removed from the chain, latest first. After an L1
+// fork the sequencer re-creates the batches built on the abandoned blocks at the same heights, so a head that is not
+// above the previous one replaces the heads sent from its height onwards.
+func (nhs *NewHeadsService) replaceRecentHeads(head *common.BatchHeader, sent *types.Header) []*types.Header {
+	i := len(nhs.recentHeads)
+	for i > 0 && nhs.recentHeads[i-1].header.Number.Cmp(head.Number) >= 0 {
+		i--
+	}
+	if i < len(nhs.recentHeads) && nhs.recentHeads[i].batchHash == head.Hash() {
+		// the same head delivered again, e.g. after reconnecting
+		return nil
+	}
+
+	var removed []*types.Header
+	for j := len(nhs.recentHeads) - 1; j >= i; j-- {
+		removed = append(removed, nhs.recentHeads[j].header)
+	}
+	if len(removed) > 0 {
+		nhs.logger.Info("New head replaces previous heads", log.BatchHeightKey, head.Number, "removed", len(removed))
+	}
+	nhs.recentHeads = append(nhs.recentHeads[:i], sentHead{batchHash: head.Hash(), header: sent})
+	if len(nhs.recentHeads) > _recentHeadsWindow {
+		nhs.recentHeads = nhs.recentHeads[len(nhs.recentHeads)-_recentHeadsWindow:]
+	}
+	return removed
+}
+
 func (nhs *NewHeadsService) RegisterNotifier(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 	nhs.notifiersMutex.Lock()
 	defer nhs.notifiersMutex.Unlock()
@@ -130,6 +173,32 @@ func (nhs *NewHeadsService) HealthStatus(context.Context) host.HealthStatus {
 	return &host.BasicErrHealthStatus{}
 }
 
+// sentHead is a head sent to the subscribers, with the hash of the batch it was converted from
+type sentHead struct {
+	batchHash common.L2BatchHash
+	header    *types.Header
+}
+
+// RemovedHead is sent to the subscribers for each head they received that is no longer canonical, before the head
+// that replaces it. Like the logs of a reorged block it is the original head marked with `"removed": true`, so dApps
+// can roll back the state they derived from it.
+type RemovedHead struct {
+	*types.Header
+}
+
+func (h *RemovedHead) MarshalJSON() ([]byte, error) {
+	enc, err := h.Header.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	fields["removed"] = json.RawMessage("true")
+	return json.Marshal(fields)
+}
+
 func ConvertBatchHeader(head *common.BatchHeader) *types.Header {
 	return &types.Header{
 		ParentHash:  head.ParentHash,
@@ -0,0 +1,46 @@
+package subscription
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestHeadsReplacedAfterForkAreRemoved(t *testing.T) {
+	nhs := NewNewHeadsService(nil, true, gethlog.New(), nil)
+	heads := make([]*common.BatchHeader, 4)
+	sent := make([]*types.Header, 4)
+	for i := range heads {
+		heads[i] = &common.BatchHeader{Number: big.NewInt(int64(i + 1)), SequencerOrderNo: big.NewInt(int64(i + 1)), Time: uint64(i)}
+		sent[i] = ConvertBatchHeader(heads[i])
+		assert.Empty(t, nhs.replaceRecentHeads(heads[i], sent[i]))
+	}
+
+	// the same head delivered twice is not a reorg
+	assert.Empty(t, nhs.replaceRecentHeads(heads[3], sent[3]))
+
+	// a batch re-created at height 3 replaces the heads at heights 3 and 4
+	recreated := &common.BatchHeader{Number: big.NewInt(3), SequencerOrderNo: big.NewInt(5), Time: 2}
+	removed := nhs.replaceRecentHeads(recreated, ConvertBatchHeader(recreated))
+	require.Len(t, removed, 2)
+	assert.Equal(t, sent[3].Hash(), removed[0].Hash())
+	assert.Equal(t, sent[2].Hash(), removed[1].Hash())
+	assert.Equal(t, recreated.Hash(), nhs.recentHeads[len(nhs.recentHeads)-1].batchHash)
+}
+
+func TestRemovedHeadIsMarked(t *testing.T) {
+	head := ConvertBatchHeader(&common.BatchHeader{Number: big.NewInt(3), SequencerOrderNo: big.NewInt(3)})
+	enc, err := json.Marshal(&RemovedHead{Header: head})
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	assert.Equal(t, true, fields["removed"])
+	assert.Equal(t, head.Hash().Hex(), fields["hash"])
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// The trace context is carried in the W3C `traceparent` header, both in the JSON-RPC requests and in the gRPC
+// metadata. It is propagated even when this process does not export spans, so that the trace is not broken.
+var _propagator = propagation.TraceContext{}
+
+// InjectHTTP adds the trace context of the context to the headers of an outgoing request.
+func InjectHTTP(ctx context.Context, header http.Header) {
+	_propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ExtractHTTP returns the context with the trace context found in the headers of an incoming request.
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return _propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// UnaryClientInterceptor creates a client span for each call and sends the trace context in the call metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := start(ctx, method, trace.SpanKindClient)
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if !ok {
+			md = metadata.MD{}
+		} else {
+			md = md.Copy()
+		}
+		_propagator.Inject(ctx, metadataCarrier(md))
+		err := invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+		End(span, err)
+		return err
+	}
+}
+
+// UnaryServerInterceptor continues the trace found in the call metadata with a server span for each call.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			ctx = _propagator.Extract(ctx, metadataCarrier(md))
+		}
+		ctx, span := start(ctx, info.FullMethod, trace.SpanKindServer)
+		resp, err := handler(ctx, req)
+		End(span, err)
+		return resp, err
+	}
+}
+
+// metadataCarrier adapts the gRPC metadata to the carrier expected by the propagator
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceIsCarriedInHTTPHeaders(t *testing.T) {
+	recordSpans(t)
+	ctx, span := Start(context.Background(), "eth_call")
+	defer span.End()
+
+	header := http.Header{}
+	InjectHTTP(ctx, header)
+	remote := trace.SpanContextFromContext(ExtractHTTP(context.Background(), header))
+
+	require.True(t, remote.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+}
+
+func TestTraceIsCarriedInGRPCMetadata(t *testing.T) {
+	recorder := recordSpans(t)
+	ctx, span := Start(context.Background(), "eth_call")
+
+	serverHandler := func(ctx context.Context, _ any) (any, error) {
+		_, handlerSpan := Start(ctx, "enclave/rpc/execute")
+		handlerSpan.End()
+		return nil, nil
+	}
+	// the invoker hands the outgoing metadata to the server interceptor, as the gRPC transport would
+	invoker := func(ctx context.Context, method string, req, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), md), req, info, serverHandler)
+		return err
+	}
+	err := UnaryClientInterceptor()(ctx, "/generated.EnclaveProto/ObsCall", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	span.End()
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range recorder.Ended() {
+		require.Equal(t, span.SpanContext().TraceID(), s.SpanContext().TraceID(), s.Name())
+		spans[s.Name()+"/"+s.SpanKind().String()] = s
+	}
+	require.Len(t, spans, 4)
+	client := spans["/generated.EnclaveProto/ObsCall/client"]
+	server := spans["/generated.EnclaveProto/ObsCall/server"]
+	require.Equal(t, span.SpanContext().SpanID(), client.Parent().SpanID())
+	require.Equal(t, client.SpanContext().SpanID(), server.Parent().SpanID())
+	require.Equal(t, server.SpanContext().SpanID(), spans["enclave/rpc/execute/internal"].Parent().SpanID())
+}
+
+// recordSpans installs a tracer provider recording the spans in memory for the duration of the test
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	previous := otel.GetTracerProvider()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
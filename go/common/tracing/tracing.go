@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The exporters the spans can be sent to.
+const (
+	NoExporter   = ""     // tracing is disabled, but the trace context is still propagated
+	OTLPExporter = "otlp" // the spans are sent over gRPC to an OpenTelemetry collector (e.g. Jaeger or Tempo)
+	FileExporter = "file" // the spans are appended as JSON to a local file
+)
+
+const _tracerName = "github.com/ten-protocol/go-ten"
+
+// Setup installs the tracer provider of the process. With the OTLP exporter, the endpoint is the host:port of the
+// collector. With the file exporter, it is the path of the file. It returns the function that flushes the pending
+// spans and stops the exporter, to be called when the service stops.
+func Setup(serviceName string, exporter string, endpoint string) (func(context.Context) error, error) {
+	var spanExporter sdktrace.SpanExporter
+	closeOutput := func() error { return nil }
+
+	switch exporter {
+	case NoExporter:
+		return func(context.Context) error { return nil }, nil
+	case OTLPExporter:
+		if endpoint == "" {
+			return nil, errors.New("the OTLP tracing exporter requires the endpoint of the collector")
+		}
+		exp, err := otlptracegrpc.New(context.Background(), otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("could not create the OTLP tracing exporter - %w", err)
+		}
+		spanExporter = exp
+	case FileExporter:
+		if endpoint == "" {
+			return nil, errors.New("the file tracing exporter requires the path of the file")
+		}
+		f, err := os.OpenFile(endpoint, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("could not open the tracing file - %w", err)
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("could not create the file tracing exporter - %w", err)
+		}
+		spanExporter = exp
+		closeOutput = f.Close
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, expected %q or %q", exporter, OTLPExporter, FileExporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		return errors.Join(provider.Shutdown(ctx), closeOutput())
+	}, nil
+}
+
+// Start starts a span as a child of the span in the context, if any. The attributes must never hold request
+// parameters or results, which are private to the user.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, name, trace.SpanKindInternal, attrs...)
+}
+
+func start(ctx context.Context, name string, kind trace.SpanKind, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(_tracerName).Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// End ends the span, marking it as failed if there was an error. The error text is not recorded, since errors
+// returned while serving a request can contain the request data.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, "")
+	}
+	span.End()
+}
@@ -24,6 +24,9 @@ type (
 	StreamL2UpdatesResponse struct {
 		Batch *ExtBatch
 		Logs  EncryptedSubscriptionLogs
+		// ValueTransfers are the outbound value transfers of the batch, which are only hashed in its cross chain tree.
+		// The host indexes them by sender and receiver, and only shows them to these.
+		ValueTransfers ValueTransferEvents
 	}
 
 	// MainNet aliases
@@ -181,6 +184,19 @@ func (cf *ChainFork) IsFork() bool {
 	return len(cf.NonCanonicalPath) > 0
 }
 
+// ToL1Fork summarises the fork for the host, which only needs the hashes and heights
+func (cf *ChainFork) ToL1Fork() *L1Fork {
+	return &L1Fork{
+		NewHead:              cf.NewCanonical.Hash(),
+		NewHeight:            cf.NewCanonical.Number.Uint64(),
+		OldHead:              cf.OldCanonical.Hash(),
+		CommonAncestor:       cf.CommonAncestor.Hash(),
+		CommonAncestorHeight: cf.CommonAncestor.Number.Uint64(),
+		CanonicalPath:        cf.CanonicalPath,
+		NonCanonicalPath:     cf.NonCanonicalPath,
+	}
+}
+
 func (cf *ChainFork) String() string {
 	if cf == nil {
 		return ""
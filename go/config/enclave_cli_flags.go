@@ -24,6 +24,9 @@ const (
 	EdgelessDBHostFlag            = "edgelessDBHost"
 	SQLiteDBPathFlag              = "sqliteDBPath"
 	ProfilerEnabledFlag           = "profilerEnabled"
+	MetricsEnabledFlag            = "metricsEnabled"
+	TracingExporterFlag           = "tracingExporter"
+	TracingEndpointFlag           = "tracingEndpoint"
 	MinGasPriceFlag               = "minGasPrice"
 	MessageBusAddressFlag         = "messageBusAddress"
 	TenGenesisFlag                = "tenGenesis"
@@ -61,6 +64,9 @@ var EnclaveFlags = map[string]*flag.TenFlag{
 	ObscuroChainIDFlag:            flag.NewInt64Flag(ObscuroChainIDFlag, 443, "An integer representing the unique chain id of the Obscuro chain (default 443)"),
 	UseInMemoryDBFlag:             flag.NewBoolFlag(UseInMemoryDBFlag, true, "Whether the enclave will use an in-memory DB rather than persist data"),
 	ProfilerEnabledFlag:           flag.NewBoolFlag(ProfilerEnabledFlag, false, "Runs a profiler instance (Defaults to false)"),
+	MetricsEnabledFlag:            flag.NewBoolFlag(MetricsEnabledFlag, true, "Whether the enclave collects metrics, which the host fetches and serves (Defaults to true)"),
+	TracingExporterFlag:           flag.NewStringFlag(TracingExporterFlag, "", "Where the request traces are sent: otlp, file, or empty to disable tracing (Defaults to empty)"),
+	TracingEndpointFlag:           flag.NewStringFlag(TracingEndpointFlag, "", "The address of the OTLP collector, or the path of the file the traces are written to"),
 	DebugNamespaceEnabledFlag:     flag.NewBoolFlag(DebugNamespaceEnabledFlag, false, "Whether the debug namespace is enabled"),
 	GasLocalExecutionCapFlag:      flag.NewUint64Flag(GasLocalExecutionCapFlag, 4_000_000_000, "Max gas usage when executing local transactions"),
 	L1BeaconUrlFlag:               flag.NewStringFlag(L1BeaconUrlFlag, "127.0.0.1:12600", "The beacon chain gateway endpoint used in docker config"),
@@ -48,6 +48,12 @@ type EnclaveConfig struct {
 	SqliteDBPath string
 	// ProfilerEnabled starts a profiler instance
 	ProfilerEnabled bool
+	// MetricsEnabled defines whether the enclave collects metrics (they are served by the host)
+	MetricsEnabled bool
+	// TracingExporter is where the spans of the requests are sent: "otlp", "file", or "" to disable tracing
+	TracingExporter string
+	// TracingEndpoint is the address of the OTLP collector, or the path of the file the spans are written to
+	TracingEndpoint string
 	// MinGasPrice is the minimum gas price for mining a transaction
 	MinGasPrice *big.Int
 	// MessageBus L1 Address
@@ -184,6 +190,9 @@ func newConfig(flags map[string]*flag.TenFlag) (*EnclaveConfig, error) {
 	cfg.EdgelessDBHost = flags[EdgelessDBHostFlag].String()
 	cfg.SqliteDBPath = flags[SQLiteDBPathFlag].String()
 	cfg.ProfilerEnabled = flags[ProfilerEnabledFlag].Bool()
+	cfg.MetricsEnabled = flags[MetricsEnabledFlag].Bool()
+	cfg.TracingExporter = flags[TracingExporterFlag].String()
+	cfg.TracingEndpoint = flags[TracingEndpointFlag].String()
 	cfg.MinGasPrice = big.NewInt(flags[MinGasPriceFlag].Int64())
 	cfg.MessageBusAddress = gethcommon.HexToAddress(flags[MessageBusAddressFlag].String())
 	cfg.TenGenesis = flags[TenGenesisFlag].String()
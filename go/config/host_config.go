@@ -67,8 +67,16 @@ type HostInputConfig struct {
 	L1StartHash gethcommon.Hash
 	// L1BeaconUrl of the beacon chain to fetch blob data
 	L1BeaconUrl string
-	// L1BlobArchiveUrl of the blob archive to fetch expired blob data
+	// L1BlobArchiveUrl of the blob archives to fetch expired blob data, comma separated in order of preference
 	L1BlobArchiveUrl string
+	// BlobRetention is how long the host keeps the blobs it fetched, by L1 block time. Zero keeps them forever
+	BlobRetention time.Duration
+	// DAMode is the data availability layer the sequencer publishes rollups to
+	DAMode common.DAMode
+	// DAStoreURL is the directory or HTTP url of the store backing the external data availability layer
+	DAStoreURL string
+	// RollupArchiveURL is the directory or S3 url (s3://host/bucket) of the archive the published rollups are copied to
+	RollupArchiveURL string
 
 	// MetricsEnabled defines whether the metrics are enabled or not
 	MetricsEnabled bool
@@ -76,6 +84,11 @@ type HostInputConfig struct {
 	// MetricsHTTPPort sets the port where the http server is available
 	MetricsHTTPPort uint
 
+	// TracingExporter is where the spans of the requests are sent: "otlp", "file", or "" to disable tracing
+	TracingExporter string
+	// TracingEndpoint is the address of the OTLP collector, or the path of the file the spans are written to
+	TracingEndpoint string
+
 	// UseInMemoryDB sets whether the host should use in-memory or persistent storage
 	UseInMemoryDB bool
 
@@ -106,6 +119,9 @@ type HostInputConfig struct {
 
 	// MaxRollupSize specifies the threshold size which the sequencer-host publishes a rollup
 	MaxRollupSize uint64
+
+	// HealthThresholds are the limits past which the host services report themselves degraded or unhealthy
+	HealthThresholds HealthThresholds
 }
 
 // ToHostConfig returns a HostConfig given a HostInputConfig
@@ -138,6 +154,8 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		ID:                        gethcommon.Address{},
 		MetricsEnabled:            p.MetricsEnabled,
 		MetricsHTTPPort:           p.MetricsHTTPPort,
+		TracingExporter:           p.TracingExporter,
+		TracingEndpoint:           p.TracingEndpoint,
 		UseInMemoryDB:             p.UseInMemoryDB,
 		PostgresDBHost:            p.PostgresDBHost,
 		DebugNamespaceEnabled:     p.DebugNamespaceEnabled,
@@ -149,6 +167,12 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		IsInboundP2PDisabled:      p.IsInboundP2PDisabled,
 		MaxRollupSize:             p.MaxRollupSize,
 		L1BeaconUrl:               p.L1BeaconUrl,
+		L1BlobArchiveUrl:          p.L1BlobArchiveUrl,
+		BlobRetention:             p.BlobRetention,
+		DAMode:                    p.DAMode,
+		DAStoreURL:                p.DAStoreURL,
+		RollupArchiveURL:          p.RollupArchiveURL,
+		HealthThresholds:          p.HealthThresholds,
 	}
 }
 
@@ -185,8 +209,16 @@ type HostConfig struct {
 	CrossChainInterval time.Duration
 	// L1BeaconUrl of the beacon chain to fetch blob data
 	L1BeaconUrl string
-	// L1BlobArchiveUrl of the blob archive to fetch expired blob data
+	// L1BlobArchiveUrl of the blob archives to fetch expired blob data, comma separated in order of preference
 	L1BlobArchiveUrl string
+	// BlobRetention is how long the host keeps the blobs it fetched, by L1 block time. Zero keeps them forever
+	BlobRetention time.Duration
+	// DAMode is the data availability layer the sequencer publishes rollups to
+	DAMode common.DAMode
+	// DAStoreURL is the directory or HTTP url of the store backing the external data availability layer
+	DAStoreURL string
+	// RollupArchiveURL is the directory or S3 url (s3://host/bucket) of the archive the published rollups are copied to
+	RollupArchiveURL string
 
 	/////
 	// NODE CONFIG
@@ -246,10 +278,57 @@ type HostConfig struct {
 	MetricsEnabled bool
 	// MetricsHTTPPort sets the port where the http server is available
 	MetricsHTTPPort uint
+	// TracingExporter is where the spans of the requests are sent: "otlp", "file", or "" to disable tracing
+	TracingExporter string
+	// TracingEndpoint is the address of the OTLP collector, or the path of the file the spans are written to
+	TracingEndpoint string
 	// DebugNamespaceEnabled enables the debug namespace handler in the host rpc server
 	DebugNamespaceEnabled bool
 	// Whether p2p is enabled or not
 	IsInboundP2PDisabled bool
+	// HealthThresholds are the limits past which the host services report themselves degraded or unhealthy
+	HealthThresholds HealthThresholds
+}
+
+// HealthThresholds are the limits past which a host service reports itself degraded or unhealthy through the health
+// check. A zero limit disables the check.
+type HealthThresholds struct {
+	// L1HeadAge is the age of the latest L1 block received by the host
+	L1HeadAge DurationThreshold
+	// L2HeadAge is the age of the latest batch received or produced by the host
+	L2HeadAge DurationThreshold
+	// EnclaveL2Lag is the number of batches an enclave is behind the L2 head known to the host
+	EnclaveL2Lag CountThreshold
+	// RollupPublicationAge is the time since the sequencer host last published a rollup to the L1
+	RollupPublicationAge DurationThreshold
+	// FailedL1Txs is the number of L1 transactions that failed since the last successful one
+	FailedL1Txs CountThreshold
+	// PeerMessageAge is the time since the least recently heard from peer sent a message
+	PeerMessageAge DurationThreshold
+}
+
+// DurationThreshold is the duration past which a measurement is degraded and the one past which it is unhealthy
+type DurationThreshold struct {
+	Degraded  time.Duration
+	Unhealthy time.Duration
+}
+
+// CountThreshold is the count from which a measurement is degraded and the one from which it is unhealthy
+type CountThreshold struct {
+	Degraded  uint64
+	Unhealthy uint64
+}
+
+// DefaultHealthThresholds returns the thresholds used unless the host is configured with others
+func DefaultHealthThresholds() HealthThresholds {
+	return HealthThresholds{
+		L1HeadAge:            DurationThreshold{Degraded: time.Minute, Unhealthy: 5 * time.Minute},
+		L2HeadAge:            DurationThreshold{Degraded: 2 * time.Minute, Unhealthy: 10 * time.Minute},
+		EnclaveL2Lag:         CountThreshold{Degraded: 20, Unhealthy: 200},
+		RollupPublicationAge: DurationThreshold{Degraded: time.Hour, Unhealthy: 4 * time.Hour},
+		FailedL1Txs:          CountThreshold{Degraded: 3, Unhealthy: 10},
+		PeerMessageAge:       DurationThreshold{Degraded: 2 * time.Minute, Unhealthy: 5 * time.Minute},
+	}
 }
 
 // DefaultHostParsedConfig returns a HostConfig with default values.
@@ -290,6 +369,8 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		MaxRollupSize:        1024 * 128, // the max blob size enforced by the beacon chain is 128kb
 		CrossChainInterval:   6 * time.Second,
 		// L1BeaconUrl:          "127.0.0.1:12600", // default port for the beacon chain if not specified
-		L1BeaconUrl: "eth2network:12600", // local testnet defaults here
+		L1BeaconUrl:      "eth2network:12600", // local testnet defaults here
+		DAMode:           common.BlobDA,
+		HealthThresholds: DefaultHealthThresholds(),
 	}
 }
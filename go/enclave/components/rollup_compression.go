@@ -17,6 +17,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -56,6 +57,9 @@ type RollupCompression struct {
 	storage                storage.Storage
 	chainConfig            *params.ChainConfig
 	logger                 gethlog.Logger
+
+	rollupSize       gethmetrics.Histogram // bytes of the compressed and encrypted rollup payloads
+	compressionRatio gethmetrics.Histogram // serialised size of the rollup payloads per compressed size, in percent
 }
 
 func NewRollupCompression(
@@ -66,6 +70,7 @@ func NewRollupCompression(
 	storage storage.Storage,
 	gethEncodingService gethencoding.EncodingService,
 	chainConfig *params.ChainConfig,
+	metricsRegistry gethmetrics.Registry,
 	logger gethlog.Logger,
 ) *RollupCompression {
 	return &RollupCompression{
@@ -77,6 +82,8 @@ func NewRollupCompression(
 		gethEncodingService:    gethEncodingService,
 		chainConfig:            chainConfig,
 		logger:                 logger,
+		rollupSize:             gethmetrics.GetOrRegisterHistogram("enclave/rollup/size", metricsRegistry, gethmetrics.NewExpDecaySample(1028, 0.015)),
+		compressionRatio:       gethmetrics.GetOrRegisterHistogram("enclave/rollup/compression", metricsRegistry, gethmetrics.NewExpDecaySample(1028, 0.015)),
 	}
 }
 
@@ -101,7 +108,11 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 	if err != nil {
 		return nil, err
 	}
-	encryptedHeader, err := rc.serialiseCompressAndEncrypt(header)
+	encryptionService, err := rc.encryptionServiceFor(ctx, r.Header.CompressionL1Head)
+	if err != nil {
+		return nil, err
+	}
+	encryptedHeader, headerSize, err := rc.serialiseCompressAndEncrypt(encryptionService, header)
 	if err != nil {
 		return nil, err
 	}
@@ -110,11 +121,17 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 	for i, batch := range r.Batches {
 		transactions[i] = batch.Transactions
 	}
-	encryptedTransactions, err := rc.serialiseCompressAndEncrypt(transactions)
+	encryptedTransactions, transactionsSize, err := rc.serialiseCompressAndEncrypt(encryptionService, transactions)
 	if err != nil {
 		return nil, err
 	}
 
+	rollupSize := len(encryptedHeader) + len(encryptedTransactions)
+	rc.rollupSize.Update(int64(rollupSize))
+	if rollupSize > 0 {
+		rc.compressionRatio.Update(int64(100 * (headerSize + transactionsSize) / rollupSize))
+	}
+
 	return &common.ExtRollup{
 		Header:               r.Header,
 		BatchPayloads:        encryptedTransactions,
@@ -124,14 +141,19 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 
 // ProcessExtRollup - given an External rollup, responsible with checking and saving all batches found inside
 func (rc *RollupCompression) ProcessExtRollup(ctx context.Context, rollup *common.ExtRollup) (*common.CalldataRollupHeader, error) {
+	encryptionService, err := rc.encryptionServiceFor(ctx, rollup.Header.CompressionL1Head)
+	if err != nil {
+		return nil, err
+	}
+
 	transactionsPerBatch := make([][]*common.L2Tx, 0)
-	err := rc.decryptDecompressAndDeserialise(rollup.BatchPayloads, &transactionsPerBatch)
+	err = rc.decryptDecompressAndDeserialise(encryptionService, rollup.BatchPayloads, &transactionsPerBatch)
 	if err != nil {
 		return nil, err
 	}
 
 	calldataRollupHeader := new(common.CalldataRollupHeader)
-	err = rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader)
+	err = rc.decryptDecompressAndDeserialise(encryptionService, rollup.CalldataRollupHeader, calldataRollupHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -526,24 +548,49 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(ctx context.Context
 	return nil
 }
 
-func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any) ([]byte, error) {
-	serialised, err := rlp.EncodeToBytes(obj)
+// encryptionServiceFor - returns the service encrypting the rollups compressed against the given L1 block.
+// Once the shared secret was rotated, the key is derived from the generation active at the height of that block.
+func (rc *RollupCompression) encryptionServiceFor(ctx context.Context, compressionL1Head common.L1BlockHash) (crypto.DataEncryptionService, error) {
+	generations, err := rc.storage.FetchSecretGenerations(ctx)
 	if err != nil {
 		return nil, err
 	}
-	compressed, err := rc.dataCompressionService.CompressRollup(serialised)
+	if len(generations) == 1 {
+		return rc.dataEncryptionService, nil
+	}
+	block, err := rc.storage.FetchBlock(ctx, compressionL1Head)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not fetch compression block %s. Cause: %w", compressionL1Head, err)
 	}
-	encrypted, err := rc.dataEncryptionService.Encrypt(compressed)
+	gen, err := rc.storage.FetchSecretForHeight(ctx, block.Number.Uint64())
 	if err != nil {
 		return nil, err
 	}
-	return encrypted, nil
+	if gen.Generation == 0 {
+		return rc.dataEncryptionService, nil
+	}
+	return crypto.NewDataEncryptionServiceWithKey(crypto.RollupEncryptionKey(gen.Secret), rc.logger), nil
+}
+
+// serialiseCompressAndEncrypt returns the encrypted blob and the size of the object before compression
+func (rc *RollupCompression) serialiseCompressAndEncrypt(encryptionService crypto.DataEncryptionService, obj any) ([]byte, int, error) {
+	serialised, err := rlp.EncodeToBytes(obj)
+	if err != nil {
+		return nil, 0, err
+	}
+	compressed, err := rc.dataCompressionService.CompressRollup(serialised)
+	if err != nil {
+		return nil, 0, err
+	}
+	encrypted, err := encryptionService.Encrypt(compressed)
+	if err != nil {
+		return nil, 0, err
+	}
+	return encrypted, len(serialised), nil
 }
 
-func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any) error {
-	plaintextBlob, err := rc.dataEncryptionService.Decrypt(blob)
+func (rc *RollupCompression) decryptDecompressAndDeserialise(encryptionService crypto.DataEncryptionService, blob []byte, obj any) error {
+	plaintextBlob, err := encryptionService.Decrypt(blob)
 	if err != nil {
 		return err
 	}
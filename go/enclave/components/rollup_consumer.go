@@ -4,13 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/ethereum/go-ethereum/crypto/kzg4844"
-
-	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common/measure"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -28,6 +26,7 @@ type rollupConsumerImpl struct {
 
 	storage      storage.Storage
 	sigValidator *SignatureValidator
+	daLayers     da.Layers
 }
 
 func NewRollupConsumer(
@@ -45,6 +44,7 @@ func NewRollupConsumer(
 		logger:            logger,
 		storage:           storage,
 		sigValidator:      verifier,
+		daLayers:          da.NewReadOnlyLayers(),
 	}
 }
 
@@ -118,68 +118,30 @@ func (rc *rollupConsumerImpl) getSignedRollup(rollups []*common.ExtRollup) ([]*c
 
 // todo - when processing the rollup, instead of looking up batches one by one, compare the last sequence number from the db with the ones in the rollup
 // extractAndVerifyRollups returns a list of the rollups published in this block
-// It processes each transaction, attempting to extract and verify rollups
-// If a transaction is not a rollup or fails verification, it's skipped
-// The function only returns an error if there's a critical failure in rollup reconstruction
+// It processes each transaction, attempting to extract and verify rollups against the data availability layer they
+// were published to. If a transaction is not a rollup or fails verification, it's skipped
 func (rc *rollupConsumerImpl) extractAndVerifyRollups(br *common.BlockAndReceipts) ([]*common.ExtRollup, error) {
-	rollups := make([]*common.ExtRollup, 0, len(*br.RelevantTransactions()))
+	rollups := make([]*common.ExtRollup, 0)
 	b := br.BlockHeader
-	blobs, blobHashes, err := rc.extractBlobsAndHashes(br)
-	if err != nil {
-		return nil, err
-	}
 
-	for i, tx := range *br.RelevantTransactions() {
-		t := rc.MgmtContractLib.DecodeTx(tx)
-		if t == nil {
-			continue
-		}
-
-		rollupHashes, ok := t.(*ethadapter.L1RollupHashes)
-		if !ok {
-			continue
+	for i, txWithReceipt := range br.TxsWithReceipts {
+		for _, t := range rc.MgmtContractLib.DecodeReceipt(txWithReceipt.Tx, txWithReceipt.Receipt) {
+			rollupRef, ok := t.(*ethadapter.L1RollupHashes)
+			if !ok {
+				continue
+			}
+
+			payload := &da.Payload{Blobs: txWithReceipt.Blobs, Data: txWithReceipt.RollupData}
+			r, err := rc.daLayers.ReadRollup(rollupRef, payload)
+			if err != nil {
+				rc.logger.Warn(fmt.Sprintf("could not read rollup at index %d from the %s DA layer. Cause: %s", i, rollupRef.DAMode, err))
+				continue
+			}
+
+			rollups = append(rollups, r)
+			rc.logger.Info("Extracted rollup from block", log.RollupHashKey, r.Hash(), log.BlockHashKey, b.Hash(), "da_mode", rollupRef.DAMode)
 		}
-
-		if err := verifyBlobHashes(rollupHashes, blobHashes); err != nil {
-			rc.logger.Warn(fmt.Sprintf("blob hashes in rollup at index %d do not match the rollup blob hashes. Cause: %s", i, err))
-			continue // Blob hashes don't match, skip this rollup
-		}
-
-		r, err := ethadapter.ReconstructRollup(blobs)
-		if err != nil {
-			// This is a critical error because we've already verified the blob hashes
-			// If we can't reconstruct the rollup at this point, something is seriously wrong
-			return nil, fmt.Errorf("could not recreate rollup from blobs. Cause: %w", err)
-		}
-
-		rollups = append(rollups, r)
-		rc.logger.Info("Extracted rollup from block", log.RollupHashKey, r.Hash(), log.BlockHashKey, b.Hash())
 	}
 
 	return rollups, nil
 }
-
-func verifyBlobHashes(rollupHashes *ethadapter.L1RollupHashes, blobHashes []gethcommon.Hash) error {
-	for i, hash := range rollupHashes.BlobHashes {
-		if hash != blobHashes[i] {
-			return fmt.Errorf("hash mismatch at index %d: rollupHash (%s) != blobHash (%s)", i, hash.Hex(), blobHashes[i].Hex())
-		}
-	}
-	return nil
-}
-
-func (rc *rollupConsumerImpl) extractBlobsAndHashes(br *common.BlockAndReceipts) ([]*kzg4844.Blob, []gethcommon.Hash, error) {
-	blobs := make([]*kzg4844.Blob, 0)
-	for _, txWithReceipt := range br.TxsWithReceipts {
-		if txWithReceipt.Blobs != nil {
-			blobs = append(blobs, txWithReceipt.Blobs...)
-		}
-	}
-
-	_, blobHashes, err := ethadapter.MakeSidecar(blobs)
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not create blob sidecar and blob hashes. Cause: %w", err)
-	}
-
-	return blobs, blobHashes, nil
-}
@@ -2,12 +2,15 @@ package components
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
@@ -18,16 +21,18 @@ import (
 type SharedSecretProcessor struct {
 	mgmtContractLib     mgmtcontractlib.MgmtContractLib
 	attestationProvider AttestationProvider // interface for producing attestation reports and verifying them
-	enclaveID           gethcommon.Address
+	enclaveKey          *crypto.EnclaveKey
+	isSequencer         bool // only the sequencer enclave that generated the network secret issues new generations of it
 	storage             storage.Storage
 	logger              gethlog.Logger
 }
 
-func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, enclaveID gethcommon.Address, storage storage.Storage, logger gethlog.Logger) *SharedSecretProcessor {
+func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, enclaveKey *crypto.EnclaveKey, isSequencer bool, storage storage.Storage, logger gethlog.Logger) *SharedSecretProcessor {
 	return &SharedSecretProcessor{
 		mgmtContractLib:     mgmtcontractlib,
 		attestationProvider: attestationProvider,
-		enclaveID:           enclaveID,
+		enclaveKey:          enclaveKey,
+		isSequencer:         isSequencer,
 		storage:             storage,
 		logger:              logger,
 	}
@@ -36,39 +41,113 @@ func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, a
 // ProcessNetworkSecretMsgs we watch for all messages that are requesting or receiving the secret and we store the nodes attested keys
 func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(ctx context.Context, br *common.BlockAndReceipts) []*common.ProducedSecretResponse {
 	var responses []*common.ProducedSecretResponse
-	transactions := br.RelevantTransactions()
 	block := br.BlockHeader
-	for _, tx := range *transactions {
-		t := ssp.mgmtContractLib.DecodeTx(tx)
+	for _, txWithReceipt := range br.TxsWithReceipts {
+		for _, t := range ssp.mgmtContractLib.DecodeReceipt(txWithReceipt.Tx, txWithReceipt.Receipt) {
+			responses = append(responses, ssp.processNetworkSecretMsg(ctx, block, txWithReceipt.Tx, t)...)
+		}
+	}
+	return responses
+}
 
-		// this transaction is for a node that has joined the network and needs to be sent the network secret
-		if scrtReqTx, ok := t.(*ethadapter.L1RequestSecretTx); ok {
-			ssp.logger.Info("Process shared secret request.", log.BlockHeightKey, block.Number, log.BlockHashKey, block.Hash(), log.TxKey, tx.Hash())
-			resp, err := ssp.processSecretRequest(ctx, scrtReqTx)
-			if err != nil {
-				ssp.logger.Error("Failed to process shared secret request.", log.ErrKey, err)
-				continue
-			}
-			responses = append(responses, resp)
+// processNetworkSecretMsg handles one network secret message decoded from the block, returning any secret responses
+// this enclave has to publish
+func (ssp *SharedSecretProcessor) processNetworkSecretMsg(ctx context.Context, block *types.Header, tx *types.Transaction, t ethadapter.L1Transaction) []*common.ProducedSecretResponse {
+	switch typedTx := t.(type) {
+	// this transaction is for a node that has joined the network and needs to be sent the network secret
+	case *ethadapter.L1RequestSecretTx:
+		ssp.logger.Info("Process shared secret request.", log.BlockHeightKey, block.Number, log.BlockHashKey, block.Hash(), log.TxKey, tx.Hash())
+		resp, err := ssp.processSecretRequest(ctx, typedTx)
+		if err != nil {
+			ssp.logger.Error("Failed to process shared secret request.", log.ErrKey, err)
+			return nil
 		}
+		return []*common.ProducedSecretResponse{resp}
 
-		// this transaction was created by the genesis node, we need to store their attested key to decrypt their rollup
-		if initSecretTx, ok := t.(*ethadapter.L1InitializeSecretTx); ok {
-			// todo (#1580) - ensure that we don't accidentally skip over the real `L1InitializeSecretTx` message. Otherwise
-			//  our node will never be able to speak to other nodes.
-			// there must be a way to make sure that this transaction can only be sent once.
-			att, err := common.DecodeAttestation(initSecretTx.Attestation)
-			if err != nil {
-				ssp.logger.Error("Could not decode attestation report", log.ErrKey, err)
-			}
+	// this transaction was created by the genesis node, we need to store their attested key to decrypt their rollup
+	case *ethadapter.L1InitializeSecretTx:
+		// todo (#1580) - ensure that we don't accidentally skip over the real `L1InitializeSecretTx` message. Otherwise
+		//  our node will never be able to speak to other nodes.
+		// there must be a way to make sure that this transaction can only be sent once.
+		att, err := common.DecodeAttestation(typedTx.Attestation)
+		if err != nil {
+			ssp.logger.Error("Could not decode attestation report", log.ErrKey, err)
+		}
 
-			err = ssp.storeAttestation(ctx, att)
+		err = ssp.storeAttestation(ctx, att)
+		if err != nil {
+			ssp.logger.Error("Could not store the attestation report.", log.ErrKey, err)
+		}
+
+		// every enclave records the issuer, as it is the only one allowed to distribute new generations of the secret
+		if typedTx.EnclaveID != nil {
+			err = ssp.storage.StoreSecretIssuer(ctx, *typedTx.EnclaveID)
 			if err != nil {
-				ssp.logger.Error("Could not store the attestation report.", log.ErrKey, err)
+				ssp.logger.Error("Could not store the secret issuer.", log.ErrKey, err)
 			}
 		}
+
+	// a newly attested enclave only received the initial secret, so it is sent all the rotated generations as well
+	case *ethadapter.L1RespondSecretTx:
+		if typedTx.AttesterID != ssp.enclaveKey.EnclaveID() {
+			return nil
+		}
+		resps, err := ssp.distributeSecretGenerations(ctx, typedTx.RequesterID)
+		if err != nil {
+			ssp.logger.Error("Failed to distribute the secret generations.", log.ErrKey, err)
+			return nil
+		}
+		return resps
+
+	// the contract owner requested a new generation of the secret
+	case *ethadapter.L1RequestSecretRotationTx:
+		if !ssp.isSequencer || !ssp.issuedNetworkSecret(ctx) {
+			return nil
+		}
+		ssp.logger.Info("Process secret rotation request.", log.BlockHeightKey, block.Number, "activation_height", typedTx.ActivationHeight)
+		resps, err := ssp.rotateSecret(ctx, block.Number.Uint64(), typedTx.ActivationHeight)
+		if err != nil {
+			ssp.logger.Error("Failed to rotate the shared secret.", log.ErrKey, err)
+			return nil
+		}
+		return resps
+
+	// a new generation of the secret was distributed to an enclave
+	// every enclave verifies the generations published on the L1, so it knows which ones were announced on time
+	// the issuer sends the generation to itself as well, so it only activates it once it is confirmed on the L1
+	case *ethadapter.L1RotateSecretTx:
+		err := ssp.verifySecretGeneration(ctx, block.Number.Uint64(), typedTx)
+		if err != nil {
+			ssp.logger.Error("Ignoring secret generation.", "generation", typedTx.Generation, "activation_height", typedTx.ActivationHeight, log.BlockHeightKey, block.Number, log.ErrKey, err)
+			return nil
+		}
+		if typedTx.RequesterID != ssp.enclaveKey.EnclaveID() {
+			return nil
+		}
+		err = ssp.storeRotatedSecret(ctx, typedTx)
+		if err != nil {
+			ssp.logger.Error("Could not store the rotated secret.", log.ErrKey, err)
+		}
+
+	case *ethadapter.L1RevokeEnclaveTx:
+		ssp.logger.Info("Revoking enclave attestation.", log.EnclaveIDKey, typedTx.EnclaveID)
+		err := ssp.storage.RevokeEnclave(ctx, typedTx.EnclaveID)
+		if err != nil {
+			ssp.logger.Error("Could not revoke the enclave.", log.ErrKey, err)
+		}
 	}
-	return responses
+	return nil
+}
+
+// issuedNetworkSecret returns true if this enclave generated the network secret. A sequencer host can run several
+// sequencer enclaves, but only one of them may issue new generations of the secret.
+func (ssp *SharedSecretProcessor) issuedNetworkSecret(ctx context.Context) bool {
+	issuer, err := ssp.storage.FetchSecretIssuer(ctx)
+	if err != nil {
+		ssp.logger.Warn("Could not determine the secret issuer, not rotating the secret.", log.ErrKey, err)
+		return false
+	}
+	return issuer == ssp.enclaveKey.EnclaveID()
 }
 
 func (ssp *SharedSecretProcessor) processSecretRequest(ctx context.Context, req *ethadapter.L1RequestSecretTx) (*common.ProducedSecretResponse, error) {
@@ -78,6 +157,14 @@ func (ssp *SharedSecretProcessor) processSecretRequest(ctx context.Context, req
 	}
 
 	ssp.logger.Info("received attestation", "attestation", att)
+	revoked, err := ssp.storage.IsEnclaveRevoked(ctx, att.EnclaveID)
+	if err != nil {
+		return nil, fmt.Errorf("could not check the revocation status - %w", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("enclave %s has been revoked, no response will be published", att.EnclaveID)
+	}
+
 	secret, err := ssp.verifyAttestationAndEncryptSecret(ctx, att)
 	if err != nil {
 		return nil, fmt.Errorf("secret request failed, no response will be published - %w", err)
@@ -94,7 +181,7 @@ func (ssp *SharedSecretProcessor) processSecretRequest(ctx context.Context, req
 	return &common.ProducedSecretResponse{
 		Secret:      secret,
 		RequesterID: att.EnclaveID,
-		AttesterID:  ssp.enclaveID,
+		AttesterID:  ssp.enclaveKey.EnclaveID(),
 		HostAddress: att.HostAddress,
 	}, nil
 }
@@ -133,3 +220,178 @@ func (ssp *SharedSecretProcessor) storeAttestation(ctx context.Context, att *com
 	}
 	return nil
 }
+
+// rotateSecret generates a new generation of the secret, and encrypts it for every attested enclave that was not revoked.
+// The new generation is not stored here. Every enclave, including this one, stores it when its NetworkSecretRotated
+// event is observed on the L1, so a generation that never made it to the L1 is never activated.
+func (ssp *SharedSecretProcessor) rotateSecret(ctx context.Context, l1Height uint64, activationHeight uint64) ([]*common.ProducedSecretResponse, error) {
+	if activationHeight <= l1Height {
+		return nil, fmt.Errorf("activation height %d is not after the current height %d", activationHeight, l1Height)
+	}
+	generations, err := ssp.storage.FetchSecretGenerations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the secret generations. Cause: %w", err)
+	}
+	latest := generations[len(generations)-1]
+	if latest.ActivationHeight >= activationHeight {
+		return nil, fmt.Errorf("generation %d is already scheduled at height %d", latest.Generation, latest.ActivationHeight)
+	}
+	// a generation that was not confirmed before its activation height can no longer be, so it is replaced
+	pendingGeneration, pendingActivationHeight, err := ssp.storage.FetchPendingSecretRotation(ctx)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve the pending secret rotation. Cause: %w", err)
+	}
+	if pendingGeneration > latest.Generation && pendingActivationHeight > l1Height {
+		return nil, fmt.Errorf("generation %d was not yet confirmed on the L1", pendingGeneration)
+	}
+
+	newGeneration := &crypto.SecretGeneration{
+		Generation:       latest.Generation + 1,
+		ActivationHeight: activationHeight,
+		Secret:           crypto.GenerateEntropy(ssp.logger),
+	}
+
+	enclaves, err := ssp.storage.FetchAttestedEnclaves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the attested enclaves. Cause: %w", err)
+	}
+	// the response to this enclave comes first, so it is published before the others
+	self, err := ssp.encryptSecretGeneration(ctx, ssp.enclaveKey.EnclaveID(), newGeneration)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt the secret generation for the issuer. Cause: %w", err)
+	}
+	responses := []*common.ProducedSecretResponse{self}
+	for _, enclaveID := range enclaves {
+		if enclaveID == ssp.enclaveKey.EnclaveID() {
+			continue
+		}
+		resp, err := ssp.encryptSecretGeneration(ctx, enclaveID, newGeneration)
+		if err != nil {
+			ssp.logger.Error("Could not encrypt the secret generation.", log.EnclaveIDKey, enclaveID, log.ErrKey, err)
+			continue
+		}
+		responses = append(responses, resp)
+	}
+	err = ssp.storage.StorePendingSecretRotation(ctx, newGeneration.Generation, activationHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not store the pending secret rotation. Cause: %w", err)
+	}
+	ssp.logger.Info("Rotated shared secret.", "generation", newGeneration.Generation, "activation_height", activationHeight, "recipients", len(responses))
+	return responses, nil
+}
+
+// distributeSecretGenerations encrypts all the rotated generations of the secret for a newly attested enclave
+func (ssp *SharedSecretProcessor) distributeSecretGenerations(ctx context.Context, enclaveID gethcommon.Address) ([]*common.ProducedSecretResponse, error) {
+	generations, err := ssp.storage.FetchSecretGenerations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the secret generations. Cause: %w", err)
+	}
+	var responses []*common.ProducedSecretResponse
+	// generation 0 was already sent in response to the secret request
+	for _, gen := range generations[1:] {
+		resp, err := ssp.encryptSecretGeneration(ctx, enclaveID, gen)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+func (ssp *SharedSecretProcessor) encryptSecretGeneration(ctx context.Context, enclaveID gethcommon.Address, gen *crypto.SecretGeneration) (*common.ProducedSecretResponse, error) {
+	revoked, err := ssp.storage.IsEnclaveRevoked(ctx, enclaveID)
+	if err != nil {
+		return nil, fmt.Errorf("could not check the revocation status - %w", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("enclave %s has been revoked", enclaveID)
+	}
+	key, err := ssp.storage.FetchAttestedKey(ctx, enclaveID)
+	if err != nil {
+		return nil, err
+	}
+	encSecret, err := crypto.EncryptSecret(gethcrypto.CompressPubkey(key), gen.Secret, ssp.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	l1tx := (&ethadapter.L1RotateSecretTx{
+		Secret:           encSecret,
+		RequesterID:      enclaveID,
+		AttesterID:       ssp.enclaveKey.EnclaveID(),
+		Generation:       gen.Generation,
+		ActivationHeight: gen.ActivationHeight,
+	}).Sign(ssp.enclaveKey.PrivateKey())
+	if l1tx == nil {
+		return nil, fmt.Errorf("could not sign the secret generation")
+	}
+
+	return &common.ProducedSecretResponse{
+		Secret:           encSecret,
+		RequesterID:      enclaveID,
+		AttesterID:       ssp.enclaveKey.EnclaveID(),
+		Generation:       gen.Generation,
+		ActivationHeight: gen.ActivationHeight,
+		AttesterSig:      l1tx.AttesterSig,
+	}, nil
+}
+
+// verifySecretGeneration checks the generation was signed by the enclave that issued the network secret.
+// The activation deadline only applies the first time a generation is published. The copies sent later to newly
+// attested enclaves are history, and must carry the activation height the generation was announced with.
+func (ssp *SharedSecretProcessor) verifySecretGeneration(ctx context.Context, l1Height uint64, tx *ethadapter.L1RotateSecretTx) error {
+	issuer, err := ssp.storage.FetchSecretIssuer(ctx)
+	if err != nil {
+		return fmt.Errorf("could not determine the secret issuer - %w", err)
+	}
+	if tx.AttesterID != issuer {
+		return fmt.Errorf("attester %s did not issue the network secret", tx.AttesterID)
+	}
+	signer, err := tx.Signer()
+	if err != nil {
+		return err
+	}
+	if signer != tx.AttesterID {
+		return fmt.Errorf("generation was signed by %s instead of the attester", signer)
+	}
+
+	activationHeight, err := ssp.storage.FetchSecretAnnouncement(ctx, tx.Generation)
+	if err == nil {
+		if activationHeight != tx.ActivationHeight {
+			return fmt.Errorf("generation was announced with activation height %d", activationHeight)
+		}
+		return nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not retrieve the secret announcement - %w", err)
+	}
+	if tx.ActivationHeight <= l1Height {
+		return fmt.Errorf("generation was confirmed after its activation height")
+	}
+	return ssp.storage.StoreSecretAnnouncement(ctx, tx.Generation, tx.ActivationHeight)
+}
+
+// storeRotatedSecret decrypts a generation of the secret distributed to this enclave
+func (ssp *SharedSecretProcessor) storeRotatedSecret(ctx context.Context, tx *ethadapter.L1RotateSecretTx) error {
+	generations, err := ssp.storage.FetchSecretGenerations(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve the secret generations. Cause: %w", err)
+	}
+	for _, gen := range generations {
+		if gen.Generation == tx.Generation {
+			ssp.logger.Debug("Secret generation already known.", "generation", tx.Generation)
+			return nil
+		}
+	}
+
+	secret, err := crypto.DecryptSecret(tx.Secret, ssp.enclaveKey.PrivateKey())
+	if err != nil {
+		return fmt.Errorf("could not decrypt the rotated secret. Cause: %w", err)
+	}
+	ssp.logger.Info("Received new secret generation.", "generation", tx.Generation, "activation_height", tx.ActivationHeight, "attester", tx.AttesterID)
+	return ssp.storage.StoreSecretGeneration(ctx, &crypto.SecretGeneration{
+		Generation:       tx.Generation,
+		ActivationHeight: tx.ActivationHeight,
+		Secret:           *secret,
+	})
+}
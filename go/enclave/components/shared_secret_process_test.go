@@ -0,0 +1,294 @@
+package components
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// secretStorage keeps in memory the parts of the enclave storage used by the shared secret processor
+type secretStorage struct {
+	storage.Storage
+	issuer      gethcommon.Address
+	generations []*crypto.SecretGeneration
+	keys        map[gethcommon.Address]*ecdsa.PublicKey
+	revoked     map[gethcommon.Address]bool
+	pending     []uint64
+	announced   map[uint64]uint64
+}
+
+func (s *secretStorage) FetchSecretIssuer(context.Context) (gethcommon.Address, error) {
+	return s.issuer, nil
+}
+
+func (s *secretStorage) FetchSecretGenerations(context.Context) ([]*crypto.SecretGeneration, error) {
+	return s.generations, nil
+}
+
+func (s *secretStorage) StoreSecretGeneration(_ context.Context, secret *crypto.SecretGeneration) error {
+	s.generations = append(s.generations, secret)
+	return nil
+}
+
+func (s *secretStorage) StorePendingSecretRotation(_ context.Context, generation uint64, activationHeight uint64) error {
+	s.pending = []uint64{generation, activationHeight}
+	return nil
+}
+
+func (s *secretStorage) FetchPendingSecretRotation(context.Context) (uint64, uint64, error) {
+	if s.pending == nil {
+		return 0, 0, errutil.ErrNotFound
+	}
+	return s.pending[0], s.pending[1], nil
+}
+
+func (s *secretStorage) StoreSecretAnnouncement(_ context.Context, generation uint64, activationHeight uint64) error {
+	if _, ok := s.announced[generation]; ok {
+		return errors.New("generation already announced")
+	}
+	s.announced[generation] = activationHeight
+	return nil
+}
+
+func (s *secretStorage) FetchSecretAnnouncement(_ context.Context, generation uint64) (uint64, error) {
+	activationHeight, ok := s.announced[generation]
+	if !ok {
+		return 0, errutil.ErrNotFound
+	}
+	return activationHeight, nil
+}
+
+func (s *secretStorage) FetchAttestedKey(_ context.Context, enclaveID gethcommon.Address) (*ecdsa.PublicKey, error) {
+	return s.keys[enclaveID], nil
+}
+
+func (s *secretStorage) FetchAttestedEnclaves(context.Context) ([]gethcommon.Address, error) {
+	var enclaves []gethcommon.Address
+	for enclaveID := range s.keys {
+		if !s.revoked[enclaveID] {
+			enclaves = append(enclaves, enclaveID)
+		}
+	}
+	return enclaves, nil
+}
+
+func (s *secretStorage) RevokeEnclave(_ context.Context, enclaveID gethcommon.Address) error {
+	s.revoked[enclaveID] = true
+	return nil
+}
+
+func (s *secretStorage) IsEnclaveRevoked(_ context.Context, enclaveID gethcommon.Address) (bool, error) {
+	return s.revoked[enclaveID], nil
+}
+
+// newSecretProcessors returns the processor of the sequencer enclave that issued the network secret, and the
+// processor of a validator enclave that was attested by it
+func newSecretProcessors(t *testing.T) (*SharedSecretProcessor, *SharedSecretProcessor) {
+	issuerKey := newEnclaveKey(t)
+	issuer := newSecretProcessor(issuerKey, issuerKey.EnclaveID(), true)
+	validator := attestEnclave(t, issuer)
+	return issuer, validator
+}
+
+func newEnclaveKey(t *testing.T) *crypto.EnclaveKey {
+	key, err := crypto.GenerateEnclaveKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newSecretProcessor(key *crypto.EnclaveKey, issuerID gethcommon.Address, isSequencer bool) *SharedSecretProcessor {
+	secretStorage := &secretStorage{
+		issuer:      issuerID,
+		generations: []*crypto.SecretGeneration{{Generation: 0, Secret: crypto.SharedEnclaveSecret{1}}},
+		keys:        map[gethcommon.Address]*ecdsa.PublicKey{key.EnclaveID(): key.PublicKey()},
+		revoked:     map[gethcommon.Address]bool{},
+		announced:   map[uint64]uint64{},
+	}
+	return NewSharedSecretProcessor(nil, nil, key, isSequencer, secretStorage, gethlog.New())
+}
+
+// attestEnclave returns the processor of a new validator enclave, whose key is known to the issuer
+func attestEnclave(t *testing.T, issuer *SharedSecretProcessor) *SharedSecretProcessor {
+	key := newEnclaveKey(t)
+	issuer.storage.(*secretStorage).keys[key.EnclaveID()] = key.PublicKey()
+	return newSecretProcessor(key, issuer.enclaveKey.EnclaveID(), false)
+}
+
+func processAt(ssp *SharedSecretProcessor, height int64, t ethadapter.L1Transaction) []*common.ProducedSecretResponse {
+	return ssp.processNetworkSecretMsg(context.Background(), &types.Header{Number: big.NewInt(height)}, nil, t)
+}
+
+func rotateSecretTx(resp *common.ProducedSecretResponse) *ethadapter.L1RotateSecretTx {
+	return &ethadapter.L1RotateSecretTx{
+		Secret:           resp.Secret,
+		RequesterID:      resp.RequesterID,
+		AttesterID:       resp.AttesterID,
+		AttesterSig:      resp.AttesterSig,
+		Generation:       resp.Generation,
+		ActivationHeight: resp.ActivationHeight,
+	}
+}
+
+func generations(ssp *SharedSecretProcessor) []*crypto.SecretGeneration {
+	return ssp.storage.(*secretStorage).generations
+}
+
+func TestRotatedSecretIsActivatedWhenObservedOnL1(t *testing.T) {
+	issuer, validator := newSecretProcessors(t)
+
+	resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20})
+	if len(resps) != 2 {
+		t.Fatalf("expected a secret response for the issuer and the validator, got %d", len(resps))
+	}
+	if resps[0].RequesterID != issuer.enclaveKey.EnclaveID() {
+		t.Fatal("expected the first secret response to be for the issuer")
+	}
+	if len(generations(issuer)) != 1 {
+		t.Fatal("the issuer stored the new generation before it was observed on the L1")
+	}
+
+	// a second request is refused while the new generation is not confirmed
+	if resps := processAt(issuer, 11, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 30}); len(resps) != 0 {
+		t.Fatal("expected no secret responses while a generation is pending")
+	}
+
+	for _, resp := range resps {
+		processAt(issuer, 12, rotateSecretTx(resp))
+		processAt(validator, 12, rotateSecretTx(resp))
+	}
+	issuerGens, validatorGens := generations(issuer), generations(validator)
+	if len(issuerGens) != 2 || len(validatorGens) != 2 {
+		t.Fatalf("expected both enclaves to activate the new generation, got %d and %d", len(issuerGens), len(validatorGens))
+	}
+	if issuerGens[1].Generation != 1 || issuerGens[1].ActivationHeight != 20 {
+		t.Fatalf("unexpected generation %d at activation height %d", issuerGens[1].Generation, issuerGens[1].ActivationHeight)
+	}
+	if issuerGens[1].Secret != validatorGens[1].Secret {
+		t.Fatal("the enclaves activated different secrets")
+	}
+}
+
+func TestRotatedSecretIsIgnoredAfterActivationHeight(t *testing.T) {
+	issuer, validator := newSecretProcessors(t)
+
+	resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20})
+	if len(resps) != 2 {
+		t.Fatalf("expected two secret responses, got %d", len(resps))
+	}
+	processAt(validator, 20, rotateSecretTx(resps[1]))
+	if len(generations(validator)) != 1 {
+		t.Fatal("a generation confirmed at its activation height was stored")
+	}
+}
+
+func TestRevokedEnclaveIsExcludedFromRotation(t *testing.T) {
+	issuer, validator := newSecretProcessors(t)
+	validatorID := validator.enclaveKey.EnclaveID()
+
+	processAt(issuer, 5, &ethadapter.L1RevokeEnclaveTx{EnclaveID: validatorID})
+	resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20})
+	if len(resps) != 1 || resps[0].RequesterID != issuer.enclaveKey.EnclaveID() {
+		t.Fatal("expected a secret response for the issuer only")
+	}
+	processAt(issuer, 11, rotateSecretTx(resps[0]))
+
+	// the revoked enclave is not sent the rotated generations when it is attested again
+	resps = processAt(issuer, 12, &ethadapter.L1RespondSecretTx{AttesterID: issuer.enclaveKey.EnclaveID(), RequesterID: validatorID})
+	if len(resps) != 0 {
+		t.Fatal("expected no secret generations for a revoked enclave")
+	}
+}
+
+func TestOnlyTheIssuerRotatesTheSecret(t *testing.T) {
+	_, validator := newSecretProcessors(t)
+	if resps := processAt(validator, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20}); len(resps) != 0 {
+		t.Fatal("expected a validator not to issue a new generation")
+	}
+}
+
+func TestLateJoinerReceivesRotatedGenerations(t *testing.T) {
+	issuer, validator := newSecretProcessors(t)
+	resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20})
+	var rotated []*ethadapter.L1RotateSecretTx
+	for _, resp := range resps {
+		rotated = append(rotated, rotateSecretTx(resp))
+	}
+
+	// the enclave attested after the activation height follows the L1 history, including the original distribution
+	lateJoiner := attestEnclave(t, issuer)
+	for _, ssp := range []*SharedSecretProcessor{issuer, validator, lateJoiner} {
+		for _, tx := range rotated {
+			processAt(ssp, 12, tx)
+		}
+	}
+	replayed := processAt(issuer, 30, &ethadapter.L1RespondSecretTx{AttesterID: issuer.enclaveKey.EnclaveID(), RequesterID: lateJoiner.enclaveKey.EnclaveID()})
+	if len(replayed) != 1 || replayed[0].Generation != 1 || replayed[0].ActivationHeight != 20 {
+		t.Fatal("expected the issuer to replay generation 1 to the late joiner")
+	}
+
+	// a replay that changes the activation height of the generation is refused
+	tampered := rotateSecretTx(replayed[0])
+	tampered.ActivationHeight = 40
+	processAt(lateJoiner, 31, tampered.Sign(issuer.enclaveKey.PrivateKey()))
+	if len(generations(lateJoiner)) != 1 {
+		t.Fatal("the late joiner stored a generation with a different activation height")
+	}
+
+	processAt(lateJoiner, 31, rotateSecretTx(replayed[0]))
+	lateGens := generations(lateJoiner)
+	if len(lateGens) != 2 || lateGens[1].ActivationHeight != 20 || lateGens[1].Secret != generations(validator)[1].Secret {
+		t.Fatal("expected the late joiner to store the replayed generation")
+	}
+}
+
+func TestPendingRotationSurvivesRestart(t *testing.T) {
+	issuer, _ := newSecretProcessors(t)
+	if resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20}); len(resps) != 2 {
+		t.Fatalf("expected two secret responses, got %d", len(resps))
+	}
+
+	restarted := NewSharedSecretProcessor(nil, nil, issuer.enclaveKey, true, issuer.storage, gethlog.New())
+	if resps := processAt(restarted, 11, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 30}); len(resps) != 0 {
+		t.Fatal("expected no secret responses while a generation is pending")
+	}
+
+	// the pending generation was never confirmed, and can no longer be once its activation height is reached
+	resps := processAt(restarted, 20, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 30})
+	if len(resps) != 2 || resps[0].Generation != 1 || resps[0].ActivationHeight != 30 {
+		t.Fatal("expected the issuer to replace the unconfirmed generation")
+	}
+}
+
+func TestGenerationNotSignedByTheIssuerIsIgnored(t *testing.T) {
+	issuer, validator := newSecretProcessors(t)
+	resps := processAt(issuer, 10, &ethadapter.L1RequestSecretRotationTx{ActivationHeight: 20})
+
+	// another attested enclave cannot distribute a generation
+	other := rotateSecretTx(resps[1])
+	other.AttesterID = validator.enclaveKey.EnclaveID()
+	processAt(validator, 12, other.Sign(validator.enclaveKey.PrivateKey()))
+	// nor sign one on behalf of the issuer
+	forged := rotateSecretTx(resps[1])
+	processAt(validator, 12, forged.Sign(validator.enclaveKey.PrivateKey()))
+	if len(generations(validator)) != 1 {
+		t.Fatal("the validator stored a generation that was not signed by the issuer")
+	}
+
+	processAt(validator, 12, rotateSecretTx(resps[1]))
+	if len(generations(validator)) != 2 {
+		t.Fatal("expected the validator to store the generation signed by the issuer")
+	}
+}
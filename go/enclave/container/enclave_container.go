@@ -7,6 +7,7 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/enclave"
 
 	"github.com/ten-protocol/go-ten/go/config"
@@ -23,6 +24,8 @@ type EnclaveContainer struct {
 	Enclave   common.Enclave
 	RPCServer *enclave.RPCServer
 	Logger    gethlog.Logger
+
+	stopTracing func(context.Context) error
 }
 
 func (e *EnclaveContainer) Start() error {
@@ -40,6 +43,9 @@ func (e *EnclaveContainer) Stop() error {
 		e.Logger.Error("Unable to cleanly stop enclave", log.ErrKey, err)
 		return err
 	}
+	if err := e.stopTracing(context.Background()); err != nil {
+		e.Logger.Warn("Could not flush the request traces", log.ErrKey, err)
+	}
 	return nil
 }
 
@@ -70,12 +76,18 @@ func NewEnclaveContainerWithLogger(config *config.EnclaveConfig, logger gethlog.
 		logger.Crit("unable to parse obscuro genesis", log.ErrKey, err)
 	}
 
+	stopTracing, err := tracing.Setup("ten-enclave", config.TracingExporter, config.TracingEndpoint)
+	if err != nil {
+		logger.Crit("could not set up the tracing of the requests", log.ErrKey, err)
+	}
+
 	encl := enclave.NewEnclave(config, genesis, mgmtContractLib, logger)
 	rpcServer := enclave.NewEnclaveRPCServer(config.Address, encl, logger)
 
 	return &EnclaveContainer{
-		Enclave:   encl,
-		RPCServer: rpcServer,
-		Logger:    logger,
+		Enclave:     encl,
+		RPCServer:   rpcServer,
+		Logger:      logger,
+		stopTracing: stopTracing,
 	}
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"golang.org/x/crypto/sha3"
 
-	smt "github.com/FantasyJony/openzeppelin-merkle-tree-go/standard_merkle_tree"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -211,41 +210,7 @@ func (ms MessageStructs) ForMerkleTree() [][]interface{} {
 }
 
 func (ms MessageStructs) HashPacked(index int) gethcommon.Hash {
-	messageStruct := ms[index]
-
-	addrType, _ := abi.NewType("address", "", nil)
-	uint64Type, _ := abi.NewType("uint64", "", nil)
-	uint32Type, _ := abi.NewType("uint32", "", nil)
-	uint8Type, _ := abi.NewType("uint8", "", nil)
-	bytesType, _ := abi.NewType("bytes", "", nil)
-	args := abi.Arguments{
-		{
-			Type: addrType,
-		},
-		{
-			Type: uint64Type,
-		},
-		{
-			Type: uint32Type,
-		},
-		{
-			Type: uint32Type,
-		},
-		{
-			Type: bytesType,
-		},
-		{
-			Type: uint8Type,
-		},
-	}
-
-	// todo @siliev: err
-	packed, err := args.Pack(messageStruct.Sender, messageStruct.Sequence, messageStruct.Nonce, messageStruct.Topic, messageStruct.Payload, messageStruct.ConsistencyLevel)
-	if err != nil {
-		panic(err)
-	}
-	hash := crypto.Keccak256Hash(packed)
-	return hash
+	return common.HashCrossChainMessage(ms[index])
 }
 
 type ValueTransfers []common.ValueTransferEvent
@@ -275,34 +240,7 @@ func (vt ValueTransfers) ForMerkleTree() [][]interface{} {
 }
 
 func (vt ValueTransfers) HashPacked(index int) gethcommon.Hash {
-	valueTransfer := vt[index]
-
-	uint256Type, _ := abi.NewType("uint256", "", nil)
-	uint64Type, _ := abi.NewType("uint64", "", nil)
-	addrType, _ := abi.NewType("address", "", nil)
-
-	args := abi.Arguments{
-		{
-			Type: addrType,
-		},
-		{
-			Type: addrType,
-		},
-		{
-			Type: uint256Type,
-		},
-		{
-			Type: uint64Type,
-		},
-	}
-
-	bytes, err := args.Pack(valueTransfer.Sender, valueTransfer.Receiver, valueTransfer.Amount, valueTransfer.Sequence)
-	if err != nil {
-		panic(err)
-	}
-
-	hash := crypto.Keccak256Hash(bytes)
-	return hash
+	return common.HashValueTransfer(vt[index])
 }
 
-var CrossChainEncodings = []string{smt.SOL_STRING, smt.SOL_BYTES32}
+var CrossChainEncodings = common.CrossChainEncodings
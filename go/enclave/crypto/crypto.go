@@ -30,6 +30,14 @@ const (
 // SharedEnclaveSecret - the entropy
 type SharedEnclaveSecret [sharedSecretLen]byte
 
+// SecretGeneration - a rotated shared secret, together with the L1 height from which it replaces the previous generation.
+// The secret received via InitEnclave is generation 0 and is active from genesis.
+type SecretGeneration struct {
+	Generation       uint64
+	ActivationHeight uint64
+	Secret           SharedEnclaveSecret
+}
+
 func GetObscuroKey(logger gethlog.Logger) *ecdsa.PrivateKey {
 	key, err := crypto.HexToECDSA(obscuroPrivateKeyHex)
 	if err != nil {
@@ -93,6 +101,11 @@ func decryptWithPrivateKey(ciphertext []byte, priv *ecdsa.PrivateKey) ([]byte, e
 	return plaintext, nil
 }
 
+// RollupEncryptionKey - derives the AES key used to encrypt rollups from a rotated shared secret
+func RollupEncryptionKey(secret SharedEnclaveSecret) []byte {
+	return crypto.Keccak256(secret[:], []byte("rollup-encryption"))
+}
+
 // CalculateRootBatchEntropy - calculates entropy per batch
 // In Obscuro, we use a root entropy per batch, which is then used to calculate randomness exposed to individual transactions
 // The RootBatchEntropy is calculated based on the shared secret and the batch height
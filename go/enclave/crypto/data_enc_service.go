@@ -33,7 +33,11 @@ type dataEncryptionServiceImpl struct {
 }
 
 func NewDataEncryptionService(logger gethlog.Logger) DataEncryptionService {
-	key := gethcommon.Hex2Bytes(RollupEncryptionKeyHex)
+	return NewDataEncryptionServiceWithKey(gethcommon.Hex2Bytes(RollupEncryptionKeyHex), logger)
+}
+
+// NewDataEncryptionServiceWithKey - creates a service using the given AES key, for example one derived from a rotated secret
+func NewDataEncryptionServiceWithKey(key []byte, logger gethlog.Logger) DataEncryptionService {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		logger.Crit("could not initialise AES cipher for enclave rollup key.", log.ErrKey, err)
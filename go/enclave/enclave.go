@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/compression"
@@ -34,6 +35,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/metrics"
 	"github.com/ten-protocol/go-ten/go/common/profiler"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
@@ -52,6 +54,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
 
@@ -86,9 +89,11 @@ type enclaveImpl struct {
 	profiler               *profiler.Profiler
 	debugger               *debugger.Debugger
 	logger                 gethlog.Logger
+	metrics                *enclaveMetrics
 
-	stopControl *stopcontrol.StopControl
-	mainMutex   sync.Mutex // serialises all data ingestion or creation to avoid weird races
+	stopControl     *stopcontrol.StopControl
+	mainMutex       sync.Mutex  // serialises all data ingestion or creation to avoid weird races
+	activeSequencer atomic.Bool // set by the host while the enclave is allowed to produce batches
 }
 
 // NewEnclave creates a new enclave.
@@ -105,6 +110,12 @@ func NewEnclave(
 
 	// todo (#1053) - add the delay: N hashes
 
+	// the enclave and the host can share a process (e.g. in tests), the metrics stay enabled if either enables them
+	if config.MetricsEnabled {
+		gethmetrics.Enabled = true
+	}
+	metricsRegistry := gethmetrics.NewRegistry()
+
 	var prof *profiler.Profiler
 	// don't run a profiler on an attested enclave
 	if !config.WillAttest && config.ProfilerEnabled {
@@ -170,7 +181,7 @@ func NewEnclave(
 	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
 
 	systemContractsWallet := system.GetPlaceholderWallet(chainConfig.ChainID, logger)
-	scb := system.NewSystemContractCallbacks(systemContractsWallet, logger)
+	scb := system.NewSystemContractCallbacks(systemContractsWallet, storage, logger)
 
 	gasOracle := gas.NewGasOracle()
 	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, gasOracle, logger)
@@ -181,9 +192,9 @@ func NewEnclave(
 	if err != nil {
 		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
 	}
-	rollupCompression := components.NewRollupCompression(registry, batchExecutor, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, logger)
+	rollupCompression := components.NewRollupCompression(registry, batchExecutor, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, metricsRegistry, logger)
 	rConsumer := components.NewRollupConsumer(mgmtContractLib, registry, rollupCompression, storage, logger, sigVerifier)
-	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, enclaveKey.EnclaveID(), storage, logger)
+	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, enclaveKey, config.NodeType == common.Sequencer, storage, logger)
 
 	blockchain := ethchainadapter.NewEthChainAdapter(big.NewInt(config.ObscuroChainID), registry, storage, gethEncodingService, *config, logger)
 	mempool, err := txpool.NewTxPool(blockchain, config.MinGasPrice, logger)
@@ -216,6 +227,7 @@ func NewEnclave(
 				BaseFee:           config.BaseFee,
 			},
 			blockchain,
+			sigVerifier,
 		)
 	} else {
 		service = nodetype.NewValidator(
@@ -251,6 +263,12 @@ func NewEnclave(
 		logger.Crit("failed to resync L2 chain state DB after restart", log.ErrKey, err)
 	}
 
+	// an enclave restarted after the system contracts were deployed has to load their addresses again
+	err = scb.Load()
+	if err != nil {
+		logger.Crit("failed to load the system contracts after restart", log.ErrKey, err)
+	}
+
 	// TODO ensure debug is allowed/disallowed
 	debug := debugger.New(chain, storage, chainConfig)
 
@@ -275,6 +293,7 @@ func NewEnclave(
 		profiler:               prof,
 		logger:                 logger,
 		debugger:               debug,
+		metrics:                newEnclaveMetrics(metricsRegistry, mempool),
 		stopControl:            stopcontrol.New(),
 		scb:                    scb,
 
@@ -368,7 +387,7 @@ func (e *enclaveImpl) StopClient() common.SystemError {
 	return nil // The enclave is local so there is no client to stop
 }
 
-func (e *enclaveImpl) sendBatch(batch *core.Batch, outChannel chan common.StreamL2UpdatesResponse) {
+func (e *enclaveImpl) sendBatch(ctx context.Context, batch *core.Batch, receipts types.Receipts, outChannel chan common.StreamL2UpdatesResponse) {
 	if batch.SeqNo().Uint64()%10 == 0 {
 		e.logger.Info("Streaming batch to host", log.BatchHashKey, batch.Hash(), log.BatchSeqNoKey, batch.SeqNo())
 	} else {
@@ -382,6 +401,12 @@ func (e *enclaveImpl) sendBatch(batch *core.Batch, outChannel chan common.Stream
 	resp := common.StreamL2UpdatesResponse{
 		Batch: extBatch,
 	}
+	if receipts != nil {
+		resp.ValueTransfers, err = e.crossChainProcessors.Local.ExtractOutboundTransfers(ctx, receipts)
+		if err != nil {
+			e.logger.Error("Could not extract the outbound value transfers", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+		}
+	}
 	outChannel <- resp
 }
 
@@ -409,7 +434,7 @@ func (e *enclaveImpl) StreamL2Updates() (chan common.StreamL2UpdatesResponse, fu
 	}
 
 	e.registry.SubscribeForExecutedBatches(func(batch *core.Batch, receipts types.Receipts) {
-		e.sendBatch(batch, l2UpdatesChannel)
+		e.sendBatch(context.Background(), batch, receipts, l2UpdatesChannel)
 		if receipts != nil {
 			e.streamEventsForNewHeadBatch(context.Background(), batch, receipts, l2UpdatesChannel)
 		}
@@ -452,6 +477,9 @@ func (e *enclaveImpl) SubmitL1Block(ctx context.Context, blockHeader *types.Head
 	}
 
 	bsr := &common.BlockSubmissionResponse{ProducedSecretResponses: e.sharedSecretProcessor.ProcessNetworkSecretMsgs(ctx, br)}
+	if result.IsFork() {
+		bsr.L1Fork = result.ChainFork.ToL1Fork()
+	}
 	return bsr, nil
 }
 
@@ -547,10 +575,12 @@ func (e *enclaveImpl) SubmitBatch(ctx context.Context, extBatch *common.ExtBatch
 		return responses.ToInternalError(fmt.Errorf("could not store batch. Cause: %w", err))
 	}
 
+	executionStart := time.Now()
 	err = e.Validator().ExecuteStoredBatches(ctx)
 	if err != nil {
 		return responses.ToInternalError(fmt.Errorf("could not execute batches. Cause: %w", err))
 	}
+	e.metrics.batchExecution.UpdateSince(executionStart)
 
 	return nil
 }
@@ -564,15 +594,42 @@ func (e *enclaveImpl) CreateBatch(ctx context.Context, skipBatchIfEmpty bool) co
 	e.mainMutex.Lock()
 	defer e.mainMutex.Unlock()
 
+	// the host may have given up on the call and demoted the enclave while it waited for the lock
+	if !e.activeSequencer.Load() {
+		return responses.ToInternalError(fmt.Errorf("requested CreateBatch while not the active sequencer"))
+	}
+	if ctx.Err() != nil {
+		return responses.ToInternalError(fmt.Errorf("requested CreateBatch after the call expired. Cause: %w", ctx.Err()))
+	}
+
+	productionStart := time.Now()
 	err := e.Sequencer().CreateBatch(ctx, skipBatchIfEmpty)
 	if err != nil {
 		return responses.ToInternalError(err)
 	}
+	e.metrics.batchProduction.UpdateSince(productionStart)
 
 	return nil
 }
 
-func (e *enclaveImpl) CreateRollup(ctx context.Context, fromSeqNo uint64) (*common.ExtRollup, common.SystemError) {
+// SetActiveSequencer allows or stops the batch production of a sequencer enclave. It waits for the batch being
+// produced, so once it returns the demoted enclave creates no more batches.
+func (e *enclaveImpl) SetActiveSequencer(_ context.Context, active bool) common.SystemError {
+	if e.stopControl.IsStopping() {
+		return responses.ToInternalError(fmt.Errorf("requested SetActiveSequencer with the enclave stopping"))
+	}
+	if e.config.NodeType != common.Sequencer {
+		return responses.ToInternalError(fmt.Errorf("only a sequencer enclave can produce batches"))
+	}
+
+	e.mainMutex.Lock()
+	defer e.mainMutex.Unlock()
+
+	e.activeSequencer.Store(active)
+	return nil
+}
+
+func (e *enclaveImpl) CreateRollup(ctx context.Context, fromSeqNo uint64, daMode common.DAMode) (*common.ExtRollup, common.SystemError) {
 	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "CreateRollup call ended")
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested GenerateRollup with the enclave stopping"))
@@ -585,7 +642,7 @@ func (e *enclaveImpl) CreateRollup(ctx context.Context, fromSeqNo uint64) (*comm
 		return nil, responses.ToInternalError(fmt.Errorf("not initialised yet"))
 	}
 
-	rollup, err := e.Sequencer().CreateRollup(ctx, fromSeqNo)
+	rollup, err := e.Sequencer().CreateRollup(ctx, fromSeqNo, daMode)
 	// TODO do we need to store the blob hashes here so we can check them against our records?
 	if err != nil {
 		return nil, responses.ToInternalError(err)
@@ -814,6 +871,15 @@ func (e *enclaveImpl) HealthCheck(ctx context.Context) (bool, common.SystemError
 	return storageHealthy && l1blockHealthy && l2batchHealthy, nil
 }
 
+// Metrics returns the metrics of the enclave in the Prometheus text format
+func (e *enclaveImpl) Metrics(context.Context) ([]byte, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested Metrics with the enclave stopping"))
+	}
+	e.metrics.refresh()
+	return metrics.Gather(e.metrics.registry), nil
+}
+
 func (e *enclaveImpl) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
 	// ensure the enclave is running
 	if e.stopControl.IsStopping() {
@@ -869,6 +935,48 @@ func (e *enclaveImpl) GetPersonalTransactions(ctx context.Context, encryptedPara
 	return rpc.WithVKEncryption(ctx, e.rpcEncryptionManager, encryptedParams, rpc.GetPersonalTransactionsValidate, rpc.GetPersonalTransactionsExecute)
 }
 
+func (e *enclaveImpl) GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetPublicContract with the enclave stopping"))
+	}
+
+	contract, err := e.storage.GetPublicContract(ctx, address)
+	if errors.Is(err, errutil.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return contract, nil
+}
+
+func (e *enclaveImpl) GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetPublicContractEvents with the enclave stopping"))
+	}
+
+	events, err := e.storage.GetPublicContractEvents(ctx, address, pagination)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return events, nil
+}
+
+func (e *enclaveImpl) GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetPublicAddressActivity with the enclave stopping"))
+	}
+
+	activity, err := e.storage.GetPublicAddressActivity(ctx, address, pagination)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return activity, nil
+}
+
 func (e *enclaveImpl) EnclavePublicConfig(context.Context) (*common.EnclavePublicConfig, common.SystemError) {
 	address, systemError := e.crossChainProcessors.GetL2MessageBusAddress()
 	if systemError != nil {
@@ -0,0 +1,77 @@
+package enclave
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+)
+
+// slowSequencer produces a batch once it is released, and only if the call has not expired, as the sequencer does
+type slowSequencer struct {
+	nodetype.Sequencer
+	started  chan struct{}
+	release  chan struct{}
+	produced int
+}
+
+func (s *slowSequencer) CreateBatch(ctx context.Context, _ bool) error {
+	s.started <- struct{}{}
+	<-s.release
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	s.produced++
+	return nil
+}
+
+func TestDemotedEnclaveDropsTimedOutBatch(t *testing.T) {
+	sequencer := &slowSequencer{started: make(chan struct{}, 1), release: make(chan struct{})}
+	e := &enclaveImpl{
+		config:      &config.EnclaveConfig{NodeType: common.Sequencer},
+		service:     sequencer,
+		logger:      gethlog.New(),
+		metrics:     newEnclaveMetrics(gethmetrics.NewRegistry(), nil),
+		stopControl: stopcontrol.New(),
+	}
+	if err := e.SetActiveSequencer(context.Background(), true); err != nil {
+		t.Fatal(err)
+	}
+
+	// the host gives up on the batch creation call
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	created := make(chan error, 1)
+	go func() { created <- e.CreateBatch(ctx, false) }()
+	<-sequencer.started
+	<-ctx.Done()
+
+	// and demotes the enclave, which waits for the batch in progress
+	demoted := make(chan error, 1)
+	go func() { demoted <- e.SetActiveSequencer(context.Background(), false) }()
+	select {
+	case <-demoted:
+		t.Fatal("the enclave was demoted while it was producing a batch")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(sequencer.release)
+	if err := <-created; err == nil {
+		t.Fatal("expected the timed out batch creation to fail")
+	}
+	if err := <-demoted; err != nil {
+		t.Fatal(err)
+	}
+	if err := e.CreateBatch(context.Background(), false); err == nil {
+		t.Fatal("expected the demoted enclave to refuse to create a batch")
+	}
+	if sequencer.produced != 0 {
+		t.Fatalf("the demoted enclave produced %d batches", sequencer.produced)
+	}
+}
@@ -8,7 +8,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 
-	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+	"github.com/ten-protocol/go-ten/go/common/vkhandler"
 	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 
 	"github.com/ten-protocol/go-ten/go/enclave/core"
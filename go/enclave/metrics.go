@@ -0,0 +1,40 @@
+package enclave
+
+import (
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ten-protocol/go-ten/go/enclave/txpool"
+)
+
+// enclaveMetrics are collected in the enclave and fetched by the host over the enclave RPC, since the enclave cannot
+// open a port for them to be scraped
+type enclaveMetrics struct {
+	registry gethmetrics.Registry
+
+	batchProduction gethmetrics.Timer // time taken by the sequencer to create a batch, including its execution
+	batchExecution  gethmetrics.Timer // time taken by a validator to execute the batches received from the sequencer
+	txPoolPending   gethmetrics.Gauge
+	txPoolQueued    gethmetrics.Gauge
+
+	mempool *txpool.TxPool
+}
+
+func newEnclaveMetrics(registry gethmetrics.Registry, mempool *txpool.TxPool) *enclaveMetrics {
+	return &enclaveMetrics{
+		registry:        registry,
+		batchProduction: gethmetrics.GetOrRegisterTimer("enclave/batch/production", registry),
+		batchExecution:  gethmetrics.GetOrRegisterTimer("enclave/batch/execution", registry),
+		txPoolPending:   gethmetrics.GetOrRegisterGauge("enclave/txpool/pending", registry),
+		txPoolQueued:    gethmetrics.GetOrRegisterGauge("enclave/txpool/queued", registry),
+		mempool:         mempool,
+	}
+}
+
+// refresh updates the metrics that are sampled rather than recorded as events happen
+func (m *enclaveMetrics) refresh() {
+	if !m.mempool.Running() {
+		return
+	}
+	pending, queued := m.mempool.Stats()
+	m.txPoolPending.Update(int64(pending))
+	m.txPoolQueued.Update(int64(queued))
+}
@@ -35,9 +35,10 @@ type Sequencer interface {
 
 	// CreateRollup - creates a new rollup from the latest recorded rollup in the head l1 chain
 	// and adds as many batches to it as possible.
-	CreateRollup(ctx context.Context, lastBatchNo uint64) (*common.ExtRollup, error)
+	CreateRollup(ctx context.Context, lastBatchNo uint64, daMode common.DAMode) (*common.ExtRollup, error)
 
-	NodeType
+	// ObsValidator - a sequencer enclave on standby validates the batches of the active sequencer enclave
+	ObsValidator
 }
 
 type ObsValidator interface {
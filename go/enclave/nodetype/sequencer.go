@@ -61,6 +61,9 @@ type sequencer struct {
 	dataCompressionService compression.DataCompressionService
 	settings               SequencerSettings
 	blockchain             *ethchainadapter.EthChainAdapter
+
+	// a standby sequencer enclave follows the batches of the active one the same way a validator does
+	standby *obsValidator
 }
 
 func NewSequencer(
@@ -80,6 +83,7 @@ func NewSequencer(
 	dataCompressionService compression.DataCompressionService,
 	settings SequencerSettings,
 	blockchain *ethchainadapter.EthChainAdapter,
+	sigValidator *components.SignatureValidator,
 ) Sequencer {
 	return &sequencer{
 		blockProcessor:         blockProcessor,
@@ -98,6 +102,18 @@ func NewSequencer(
 		dataCompressionService: dataCompressionService,
 		settings:               settings,
 		blockchain:             blockchain,
+		standby: &obsValidator{
+			blockProcessor: blockProcessor,
+			batchExecutor:  batchExecutor,
+			batchRegistry:  registry,
+			rollupConsumer: rollupConsumer,
+			chainConfig:    chainConfig,
+			storage:        storage,
+			sigValidator:   sigValidator,
+			mempool:        mempool,
+			enclaveKey:     enclavePrivateKey,
+			logger:         logger,
+		},
 	}
 }
 
@@ -118,6 +134,13 @@ func (s *sequencer) CreateBatch(ctx context.Context, skipBatchIfEmpty bool) erro
 		return s.createGenesisBatch(ctx, l1HeadBlock)
 	}
 
+	// an enclave promoted from standby must have executed every batch it received from the previous active enclave,
+	// otherwise the new head batch would compete with batches that were already published
+	err = s.ensureFollowedBatchesExecuted(ctx)
+	if err != nil {
+		return err
+	}
+
 	if running := s.mempool.Running(); !running {
 		// the mempool can only be started after at least 1 block (the genesis) is in the blockchain object
 		// if the node restarted the mempool must be started again
@@ -147,6 +170,10 @@ func (s *sequencer) createGenesisBatch(ctx context.Context, block *types.Header)
 		return err
 	}
 
+	if ctx.Err() != nil {
+		return fmt.Errorf("genesis batch production expired. Cause: %w", ctx.Err())
+	}
+
 	if err := s.signBatch(batch); err != nil {
 		return fmt.Errorf("failed signing created batch. Cause: %w", err)
 	}
@@ -320,6 +347,11 @@ func (s *sequencer) produceBatch(
 		return nil, fmt.Errorf("failed committing batch state. Cause: %w", err)
 	}
 
+	// a batch produced for a call the host gave up on is not signed, the host may have promoted another enclave
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("batch production expired. Cause: %w", ctx.Err())
+	}
+
 	if err := s.signBatch(cb.Batch); err != nil {
 		return nil, fmt.Errorf("failed signing created batch. Cause: %w", err)
 	}
@@ -368,7 +400,7 @@ func (s *sequencer) StoreExecutedBatch(ctx context.Context, batch *core.Batch, t
 	return nil
 }
 
-func (s *sequencer) CreateRollup(ctx context.Context, lastBatchNo uint64) (*common.ExtRollup, error) {
+func (s *sequencer) CreateRollup(ctx context.Context, lastBatchNo uint64, daMode common.DAMode) (*common.ExtRollup, error) {
 	rollupLimiter := limiters.NewRollupLimiter(s.settings.MaxRollupSize)
 
 	currentL1Head, err := s.blockProcessor.GetHead(ctx)
@@ -385,6 +417,8 @@ func (s *sequencer) CreateRollup(ctx context.Context, lastBatchNo uint64) (*comm
 	if err != nil {
 		return nil, fmt.Errorf("failed to compress rollup: %w", err)
 	}
+	// the mode is part of the signed header, so the rollup can't be republished to a different layer
+	extRollup.Header.DAMode = daMode
 
 	// todo - double-check that this signing approach is secure, and it properly includes the entire payload
 	if err := s.signRollup(extRollup); err != nil {
@@ -536,7 +570,34 @@ func (s *sequencer) signCrossChainBundle(bundle *common.ExtCrossChainBundle) err
 }
 
 func (s *sequencer) OnL1Block(ctx context.Context, block *types.Header, result *components.BlockIngestionType) error {
-	// nothing to do
+	// batches received while in standby may have been waiting for this block
+	return s.standby.ExecuteStoredBatches(ctx)
+}
+
+func (s *sequencer) ExecuteStoredBatches(ctx context.Context) error {
+	return s.standby.ExecuteStoredBatches(ctx)
+}
+
+func (s *sequencer) VerifySequencerSignature(b *core.Batch) error {
+	return s.standby.VerifySequencerSignature(b)
+}
+
+func (s *sequencer) ensureFollowedBatchesExecuted(ctx context.Context) error {
+	err := s.standby.ExecuteStoredBatches(ctx)
+	if err != nil {
+		return fmt.Errorf("could not execute received batches. Cause: %w", err)
+	}
+	headBatchSeq := s.batchRegistry.HeadBatchSeq()
+	if headBatchSeq == nil {
+		headBatchSeq = big.NewInt(int64(common.L2GenesisSeqNo))
+	}
+	unexecuted, err := s.storage.FetchCanonicalUnexecutedBatches(ctx, headBatchSeq)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not check for unexecuted batches. Cause: %w", err)
+	}
+	if len(unexecuted) > 0 {
+		return fmt.Errorf("cannot produce a batch while %d received batches are not executed, first one seqNo=%d", len(unexecuted), unexecuted[0].SequencerOrderNo)
+	}
 	return nil
 }
 
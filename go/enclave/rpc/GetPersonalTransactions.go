@@ -34,17 +34,22 @@ func GetPersonalTransactionsExecute(builder *CallBuilder[common.ListPrivateTrans
 		return nil //nolint:nilerr
 	}
 	addr := builder.Param.Address
-	internalReceipts, err := rpc.storage.GetTransactionsPerAddress(builder.ctx, &addr, &builder.Param.Pagination)
+	internalReceipts, err := rpc.storage.GetTransactionsPerAddress(builder.ctx, builder.Param)
 	if err != nil {
 		return fmt.Errorf("GetTransactionsPerAddress - %w", err)
 	}
 
-	var receipts types.Receipts
+	receipts := make(types.Receipts, 0, len(internalReceipts))
 	for _, receipt := range internalReceipts {
-		receipts = append(receipts, receipt.ToReceipt())
+		r := receipt.ToReceipt()
+		// the logs are only loaded on request, but the receipt encoding requires them
+		if r.Logs == nil {
+			r.Logs = []*types.Log{}
+		}
+		receipts = append(receipts, r)
 	}
 
-	receiptsCount, err := rpc.storage.CountTransactionsPerAddress(builder.ctx, &addr)
+	receiptsCount, err := rpc.storage.CountTransactionsPerAddress(builder.ctx, &addr, &builder.Param.PersonalTransactionsFilter)
 	if err != nil {
 		return fmt.Errorf("CountTransactionsPerAddress - %w", err)
 	}
@@ -7,10 +7,11 @@ import (
 	"fmt"
 
 	"github.com/ten-protocol/go-ten/go/common/rpc"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
-	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+	"github.com/ten-protocol/go-ten/go/common/vkhandler"
 	"github.com/ten-protocol/go-ten/go/responses"
 )
 
@@ -52,8 +53,11 @@ func WithVKEncryption[P any, R any](
 	validate func([]any, *CallBuilder[P, R], *EncryptionManager) error,
 	execute func(*CallBuilder[P, R], *EncryptionManager) error,
 ) (*responses.EnclaveResponse, common.SystemError) {
+	// the spans time each step, but never hold the decrypted request or its result
 	// 1. Decrypt request
+	_, span := tracing.Start(ctx, "enclave/rpc/decrypt")
 	plaintextRequest, err := encManager.DecryptBytes(encReq)
+	tracing.End(span, err)
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("could not decrypt params - %w", err)), nil
 	}
@@ -68,15 +72,19 @@ func WithVKEncryption[P any, R any](
 	if decodedRequest.VK == nil {
 		return responses.AsPlaintextError(fmt.Errorf("invalid request. viewing key is missing")), nil
 	}
+	_, span = tracing.Start(ctx, "enclave/rpc/verify_vk")
 	vk, err := vkhandler.VerifyViewingKey(decodedRequest.VK, encManager.config.ObscuroChainID)
+	tracing.End(span, err)
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("invalid viewing key - %w", err)), nil
 	}
 
 	// 4. Call the function that knows how to validate the request
-	builder := &CallBuilder[P, R]{Status: NotSet, VK: vk, ctx: ctx}
+	validateCtx, span := tracing.Start(ctx, "enclave/rpc/validate")
+	builder := &CallBuilder[P, R]{Status: NotSet, VK: vk, ctx: validateCtx}
 
 	err = validate(decodedRequest.Params, builder, encManager)
+	tracing.End(span, errors.Join(err, builder.Err))
 	if err != nil {
 		return responses.AsPlaintextError(errInt), responses.ToInternalError(err)
 	}
@@ -86,7 +94,10 @@ func WithVKEncryption[P any, R any](
 
 	// 5. Execute the authorisation and call
 	// Note - it is the responsibility of this function to check that the authenticated address is authorised to view the data
+	executeCtx, span := tracing.Start(ctx, "enclave/rpc/execute")
+	builder.ctx = executeCtx
 	err = execute(builder, encManager)
+	tracing.End(span, errors.Join(err, builder.Err))
 	if err != nil {
 		return responses.AsPlaintextError(errInt), responses.ToInternalError(err)
 	}
@@ -105,6 +116,8 @@ func WithVKEncryption[P any, R any](
 		return responses.AsEncryptedError(errors.New("not authorised"), vk), nil
 	}
 
+	_, span = tracing.Start(ctx, "enclave/rpc/encrypt")
+	defer span.End()
 	return responses.AsEncryptedResponse[R](builder.ReturnValue, vk), nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"google.golang.org/grpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -37,7 +38,8 @@ func NewEnclaveRPCServer(listenAddress string, enclave common.Enclave, logger ge
 	return &RPCServer{
 		enclave: enclave,
 		grpcServer: grpc.NewServer(
-			grpc.MaxRecvMsgSize(1024 * 1024 * 50),
+			grpc.MaxRecvMsgSize(1024*1024*50),
+			grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()),
 		),
 		logger:        logger,
 		listenAddress: listenAddress,
@@ -294,13 +296,21 @@ func (s *RPCServer) HealthCheck(ctx context.Context, _ *generated.EmptyArgs) (*g
 	return &generated.HealthCheckResponse{Status: healthy}, nil
 }
 
+func (s *RPCServer) Metrics(ctx context.Context, _ *generated.MetricsRequest) (*generated.MetricsResponse, error) {
+	metrics, sysError := s.enclave.Metrics(ctx)
+	if sysError != nil {
+		return &generated.MetricsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.MetricsResponse{Metrics: metrics}, nil
+}
+
 func (s *RPCServer) CreateRollup(ctx context.Context, req *generated.CreateRollupRequest) (*generated.CreateRollupResponse, error) {
 	var fromSeqNo uint64 = 1
 	if req.FromSequenceNumber != nil && *req.FromSequenceNumber > common.L2GenesisSeqNo {
 		fromSeqNo = *req.FromSequenceNumber
 	}
 
-	rollup, sysError := s.enclave.CreateRollup(ctx, fromSeqNo)
+	rollup, sysError := s.enclave.CreateRollup(ctx, fromSeqNo, common.DAMode(req.DaMode))
 	if sysError != nil {
 		s.logger.Error("Error creating rollup", log.ErrKey, sysError)
 	}
@@ -321,6 +331,15 @@ func (s *RPCServer) CreateBatch(ctx context.Context, r *generated.CreateBatchReq
 	return &generated.CreateBatchResponse{}, sysError
 }
 
+func (s *RPCServer) SetActiveSequencer(ctx context.Context, r *generated.SetActiveSequencerRequest) (*generated.SetActiveSequencerResponse, error) {
+	sysError := s.enclave.SetActiveSequencer(ctx, r.Active)
+	if sysError != nil {
+		s.logger.Error("Error setting the active sequencer", log.ErrKey, sysError)
+		return &generated.SetActiveSequencerResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.SetActiveSequencerResponse{}, nil
+}
+
 func (s *RPCServer) DebugTraceTransaction(ctx context.Context, req *generated.DebugTraceTransactionRequest) (*generated.DebugTraceTransactionResponse, error) {
 	txHash := gethcommon.BytesToHash(req.TxHash)
 	var config tracers.TraceConfig
@@ -487,6 +506,50 @@ func (s *RPCServer) EnclavePublicConfig(ctx context.Context, _ *generated.Enclav
 	}, nil
 }
 
+func (s *RPCServer) GetPublicContract(ctx context.Context, req *generated.GetPublicContractRequest) (*generated.GetPublicContractResponse, error) {
+	contract, sysError := s.enclave.GetPublicContract(ctx, gethcommon.BytesToAddress(req.Address))
+	if sysError != nil {
+		s.logger.Error("Error getting public contract", log.ErrKey, sysError)
+		return &generated.GetPublicContractResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	if contract == nil {
+		return &generated.GetPublicContractResponse{}, nil
+	}
+	encoded, err := json.Marshal(contract)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode public contract. Cause: %w", err)
+	}
+	return &generated.GetPublicContractResponse{EncodedContract: encoded}, nil
+}
+
+func (s *RPCServer) GetPublicContractEvents(ctx context.Context, req *generated.GetPublicContractEventsRequest) (*generated.GetPublicContractEventsResponse, error) {
+	pagination := &common.QueryPagination{Offset: req.Offset, Size: uint(req.Size)}
+	events, sysError := s.enclave.GetPublicContractEvents(ctx, gethcommon.BytesToAddress(req.Address), pagination)
+	if sysError != nil {
+		s.logger.Error("Error getting public contract events", log.ErrKey, sysError)
+		return &generated.GetPublicContractEventsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	encoded, err := json.Marshal(events)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode public contract events. Cause: %w", err)
+	}
+	return &generated.GetPublicContractEventsResponse{EncodedEvents: encoded}, nil
+}
+
+func (s *RPCServer) GetPublicAddressActivity(ctx context.Context, req *generated.GetPublicAddressActivityRequest) (*generated.GetPublicAddressActivityResponse, error) {
+	pagination := &common.QueryPagination{Offset: req.Offset, Size: uint(req.Size)}
+	activity, sysError := s.enclave.GetPublicAddressActivity(ctx, gethcommon.BytesToAddress(req.Address), pagination)
+	if sysError != nil {
+		s.logger.Error("Error getting public address activity", log.ErrKey, sysError)
+		return &generated.GetPublicAddressActivityResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	encoded, err := json.Marshal(activity)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode public address activity. Cause: %w", err)
+	}
+	return &generated.GetPublicAddressActivityResponse{EncodedActivity: encoded}, nil
+}
+
 func (s *RPCServer) decodeBlock(encodedBlock []byte) (*types.Header, error) {
 	block := types.Header{}
 	err := rlp.DecodeBytes(encodedBlock, &block)
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -366,22 +368,61 @@ func BatchWasExecuted(ctx context.Context, db *sql.DB, hash common.L2BatchHash)
 	return result, nil
 }
 
-func GetTransactionsPerAddress(ctx context.Context, db *sql.DB, address *gethcommon.Address, pagination *common.QueryPagination) ([]*core.InternalReceipt, error) {
-	receipts, err := loadReceiptList(ctx, db, address, " AND tx_sender.address = ? ", []any{address.Bytes()}, " ORDER BY b.height DESC LIMIT ? OFFSET ?", []any{pagination.Size, pagination.Offset})
+// GetTransactionsPerAddress returns the receipts of the transactions sent by the address that match the query, latest
+// first. If requested, each receipt has the logs the address can view.
+func GetTransactionsPerAddress(ctx context.Context, db *sql.DB, query *common.ListPrivateTransactionsQueryParams) ([]*core.InternalReceipt, error) {
+	condition, params, err := personalTransactionsCondition(ctx, db, &query.PersonalTransactionsFilter)
 	if err != nil {
 		return nil, err
 	}
+	if query.Before != nil {
+		condition += " AND (b.height < ? OR (b.height = ? AND curr_tx.idx < ?)) "
+		params = append(params, query.Before.BatchHeight, query.Before.BatchHeight, query.Before.TxIndex)
+	}
+
+	receipts, err := loadReceiptList(ctx, db, &query.Address, condition, params, " ORDER BY b.height DESC, curr_tx.idx DESC LIMIT ? OFFSET ?", []any{query.Pagination.Size, query.Pagination.Offset})
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return []*core.InternalReceipt{}, nil
+		}
+		return nil, err
+	}
+	if !query.IncludeLogs || len(receipts) == 0 {
+		return receipts, nil
+	}
+
+	txHashes := make([]any, len(receipts))
+	for i, r := range receipts {
+		txHashes[i] = r.TxHash.Bytes()
+	}
+	_, logs, err := loadReceiptsAndEventLogs(ctx, db, &query.Address, " AND e.id IS NOT NULL AND curr_tx.hash IN ("+repeat("?", ",", len(txHashes))+") ", txHashes, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not load transaction logs. Cause: %w", err)
+	}
+	logsPerTx := make(map[gethcommon.Hash][]*types.Log)
+	for _, l := range logs {
+		logsPerTx[l.TxHash] = append(logsPerTx[l.TxHash], l)
+	}
+	for _, r := range receipts {
+		r.Logs = logsPerTx[r.TxHash]
+		if r.Logs == nil {
+			r.Logs = []*types.Log{}
+		}
+		sort.Slice(r.Logs, func(i, j int) bool { return r.Logs[i].Index < r.Logs[j].Index })
+	}
 	return receipts, nil
 }
 
-func CountTransactionsPerAddress(ctx context.Context, db *sql.DB, address *gethcommon.Address) (uint64, error) {
-	row := db.QueryRowContext(ctx, "select count(1) from receipt "+
-		"join tx on tx.id=receipt.tx "+
-		"join externally_owned_account eoa on eoa.id = tx.sender_address "+
-		"where eoa.address = ?", address.Bytes())
+// CountTransactionsPerAddress returns the number of canonical transactions sent by the address that match the filter
+func CountTransactionsPerAddress(ctx context.Context, db *sql.DB, address *gethcommon.Address, filter *common.PersonalTransactionsFilter) (uint64, error) {
+	condition, params, err := personalTransactionsCondition(ctx, db, filter)
+	if err != nil {
+		return 0, err
+	}
+	query := "select count(1) " + baseReceiptJoin + " where b.is_canonical=true AND tx_sender.address = ? " + condition
 
 	var count uint64
-	err := row.Scan(&count)
+	err = db.QueryRowContext(ctx, query, append([]any{address.Bytes()}, params...)...).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
@@ -389,6 +430,75 @@ func CountTransactionsPerAddress(ctx context.Context, db *sql.DB, address *gethc
 	return count, nil
 }
 
+// personalTransactionsCondition returns the where condition selecting the transactions that match the filter
+func personalTransactionsCondition(ctx context.Context, db *sql.DB, filter *common.PersonalTransactionsFilter) (string, []any, error) {
+	condition := ""
+	var params []any
+	if filter.Contract != nil {
+		condition += " AND tx_contr.address = ? "
+		params = append(params, filter.Contract.Bytes())
+	}
+	if filter.Status != nil {
+		condition += " AND rec.status = ? "
+		params = append(params, *filter.Status)
+	}
+	if filter.FromBatch != nil {
+		condition += " AND b.height >= ? "
+		params = append(params, *filter.FromBatch)
+	}
+	if filter.ToBatch != nil {
+		condition += " AND b.height <= ? "
+		params = append(params, *filter.ToBatch)
+	}
+	// the batch timestamps are only in the encoded headers, so the time range is converted to a height range
+	if filter.FromTime != nil {
+		height, err := firstCanonicalHeightFrom(ctx, db, *filter.FromTime)
+		if err != nil {
+			return "", nil, err
+		}
+		condition += " AND b.height >= ? "
+		params = append(params, height)
+	}
+	if filter.ToTime != nil && *filter.ToTime < math.MaxUint64 {
+		height, err := firstCanonicalHeightFrom(ctx, db, *filter.ToTime+1)
+		if err != nil {
+			return "", nil, err
+		}
+		condition += " AND b.height < ? "
+		params = append(params, height)
+	}
+	return condition, params, nil
+}
+
+// firstCanonicalHeightFrom returns the height of the first canonical batch with a timestamp at or after the given
+// time, or the height after the head batch if there is none. It relies on the timestamps increasing with the height.
+func firstCanonicalHeightFrom(ctx context.Context, db *sql.DB, time uint64) (uint64, error) {
+	var low, high *uint64
+	err := db.QueryRowContext(ctx, "select min(height), max(height) from batch where is_canonical=true").Scan(&low, &high)
+	if err != nil {
+		return 0, fmt.Errorf("could not read canonical heights. Cause: %w", err)
+	}
+	if low == nil || high == nil {
+		return 0, nil
+	}
+
+	// search for the first height in [lo, hi) whose batch is not before the time
+	lo, hi := *low, *high+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		header, err := ReadCanonicalBatchHeaderByHeight(ctx, db, mid)
+		if err != nil {
+			return 0, fmt.Errorf("could not read canonical batch at height %d. Cause: %w", mid, err)
+		}
+		if header.Time < time {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
 func FetchConvertedBatchHash(ctx context.Context, db *sql.DB, seqNo uint64) (gethcommon.Hash, error) {
 	var hash []byte
 
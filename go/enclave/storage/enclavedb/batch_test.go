@@ -0,0 +1,205 @@
+package enclavedb
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+var (
+	sender      = gethcommon.HexToAddress("0xE1")
+	otherSender = gethcommon.HexToAddress("0xE2")
+	token       = gethcommon.HexToAddress("0xF1")
+	transferSig = gethcommon.HexToHash("0x05")
+	approvalSig = gethcommon.HexToHash("0x06")
+)
+
+func TestPersonalTransactionsAreFiltered(t *testing.T) {
+	db := createPersonalTransactionsDB(t)
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		filter   common.PersonalTransactionsFilter
+		expected []gethcommon.Hash
+	}{
+		"no filter":       {expected: txHashes(0x3, 0x2, 0x1)},
+		"contract":        {filter: common.PersonalTransactionsFilter{Contract: &token}, expected: txHashes(0x3, 0x1)},
+		"status":          {filter: common.PersonalTransactionsFilter{Status: ptr(0)}, expected: txHashes(0x2)},
+		"batch range":     {filter: common.PersonalTransactionsFilter{FromBatch: ptr(2), ToBatch: ptr(2)}, expected: txHashes(0x2)},
+		"time range":      {filter: common.PersonalTransactionsFilter{FromTime: ptr(150), ToTime: ptr(250)}, expected: txHashes(0x2)},
+		"inclusive times": {filter: common.PersonalTransactionsFilter{FromTime: ptr(200), ToTime: ptr(300)}, expected: txHashes(0x3, 0x2)},
+		"before history":  {filter: common.PersonalTransactionsFilter{ToTime: ptr(99)}, expected: txHashes()},
+		"after history":   {filter: common.PersonalTransactionsFilter{FromTime: ptr(301)}, expected: txHashes()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			receipts, err := GetTransactionsPerAddress(ctx, db, &common.ListPrivateTransactionsQueryParams{
+				Address:                    sender,
+				Pagination:                 common.QueryPagination{Size: 10},
+				PersonalTransactionsFilter: test.filter,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, receiptHashes(receipts))
+
+			count, err := CountTransactionsPerAddress(ctx, db, &sender, &test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(len(test.expected)), count)
+		})
+	}
+}
+
+func TestPersonalTransactionsArePagedByCursor(t *testing.T) {
+	db := createPersonalTransactionsDB(t)
+	ctx := context.Background()
+
+	query := &common.ListPrivateTransactionsQueryParams{
+		Address:    sender,
+		Pagination: common.QueryPagination{Size: 2},
+	}
+	receipts, err := GetTransactionsPerAddress(ctx, db, query)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes(0x3, 0x2), receiptHashes(receipts))
+
+	last := receipts[len(receipts)-1]
+	query.Before = &common.PersonalTransactionCursor{BatchHeight: last.BlockNumber.Uint64(), TxIndex: last.TransactionIndex}
+	receipts, err = GetTransactionsPerAddress(ctx, db, query)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes(0x1), receiptHashes(receipts))
+
+	// the transactions at the same height are ordered by their index
+	query.Before = &common.PersonalTransactionCursor{BatchHeight: 3, TxIndex: 1}
+	receipts, err = GetTransactionsPerAddress(ctx, db, query)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes(0x2, 0x1), receiptHashes(receipts))
+}
+
+func TestPersonalTransactionsIncludeVisibleLogs(t *testing.T) {
+	db := createPersonalTransactionsDB(t)
+	ctx := context.Background()
+
+	receipts, err := GetTransactionsPerAddress(ctx, db, &common.ListPrivateTransactionsQueryParams{
+		Address:     sender,
+		Pagination:  common.QueryPagination{Size: 10},
+		IncludeLogs: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+
+	// the approval event of the last transaction is not visible to the sender
+	require.Len(t, receipts[0].Logs, 1)
+	assert.Equal(t, transferSig, receipts[0].Logs[0].Topics[0])
+	assert.Equal(t, token, receipts[0].Logs[0].Address)
+	assert.Equal(t, txHash(0x3), receipts[0].Logs[0].TxHash)
+	assert.NotNil(t, receipts[1].Logs)
+	assert.Empty(t, receipts[1].Logs)
+	assert.Empty(t, receipts[2].Logs)
+
+	receipts, err = GetTransactionsPerAddress(ctx, db, &common.ListPrivateTransactionsQueryParams{
+		Address:     otherSender,
+		Pagination:  common.QueryPagination{Size: 10},
+		IncludeLogs: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, txHashes(0x4), receiptHashes(receipts))
+
+	receipts, err = GetTransactionsPerAddress(ctx, db, &common.ListPrivateTransactionsQueryParams{
+		Address:     token,
+		Pagination:  common.QueryPagination{Size: 10},
+		IncludeLogs: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+}
+
+// createPersonalTransactionsDB creates an enclave database with three canonical batches, at timestamps 100, 200 and
+// 300, and a non-canonical batch at the last height. The sender has a transaction in each batch, the other sender a
+// transaction in the last canonical batch.
+func createPersonalTransactionsDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "enclave.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	schema, err := os.ReadFile(filepath.Join("..", "init", "sqlite", "001_init.sql"))
+	require.NoError(t, err)
+	_, err = db.Exec(string(schema))
+	require.NoError(t, err)
+
+	batches := []struct {
+		seq, height, time uint64
+		canonical         bool
+	}{{1, 1, 100, true}, {2, 2, 200, true}, {3, 3, 300, true}, {4, 3, 300, false}}
+	for _, b := range batches {
+		header, err := rlp.EncodeToBytes(&common.BatchHeader{
+			Number:           big.NewInt(int64(b.height)),
+			SequencerOrderNo: big.NewInt(int64(b.seq)),
+			Time:             b.time,
+		})
+		require.NoError(t, err)
+		hash := gethcommon.BigToHash(big.NewInt(int64(b.seq)))
+		_, err = db.Exec("insert into batch values (?, ?, ?, ?, ?, ?, ?, null, true)",
+			b.seq, hash.Bytes(), hash.Bytes(), b.height, b.canonical, header, gethcommon.Hash{}.Bytes())
+		require.NoError(t, err)
+	}
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{"insert into externally_owned_account (id, address) values (1, ?), (2, ?)", []any{sender.Bytes(), otherSender.Bytes()}},
+		// a successful token call, a failed transfer, a token call after a call of the other sender, and a token call
+		// in the non-canonical batch
+		{"insert into tx (id, hash, content, to_address, type, sender_address, idx, batch_height) values " +
+			"(1, ?, ?, 1, 0, 1, 0, 1), (2, ?, ?, null, 0, 1, 0, 2), (3, ?, ?, 1, 0, 1, 1, 3), (4, ?, ?, 1, 0, 2, 0, 3), (5, ?, ?, 1, 0, 1, 0, 3)",
+			[]any{
+				txHash(0x1).Bytes(), []byte{}, txHash(0x2).Bytes(), []byte{}, txHash(0x3).Bytes(), []byte{},
+				txHash(0x4).Bytes(), []byte{}, txHash(0x5).Bytes(), []byte{},
+			}},
+		{"insert into receipt (id, status, cumulative_gas_used, tx, batch) values (1, 1, 0, 1, 1), (2, 0, 0, 2, 2), (3, 1, 0, 3, 3), (4, 1, 0, 4, 3), (5, 1, 0, 5, 4)", nil},
+		{"insert into contract (id, address, creator, auto_visibility, transparent, tx) values (1, ?, 2, false, false, 4)", []any{token.Bytes()}},
+		{"insert into event_type (id, contract, event_sig, auto_visibility, config_public, topic1_can_view) values " +
+			"(1, 1, ?, false, false, true), (2, 1, ?, false, false, false)",
+			[]any{transferSig.Bytes(), approvalSig.Bytes()}},
+		{"insert into event_topic (id, event_type, topic, rel_address) values (1, 1, ?, 1), (2, 2, ?, 1)",
+			[]any{gethcommon.BytesToHash(sender.Bytes()).Bytes(), gethcommon.BytesToHash(sender.Bytes()).Bytes()}},
+		{"insert into event_log (event_type, topic1, log_idx, receipt) values (1, 1, 0, 3), (2, 2, 1, 3), (1, 1, 0, 5)", nil},
+	}
+	for _, s := range statements {
+		_, err = db.Exec(s.query, s.args...)
+		require.NoError(t, err, s.query)
+	}
+	return db
+}
+
+func txHash(n int64) gethcommon.Hash {
+	return gethcommon.BigToHash(big.NewInt(0x1000 + n))
+}
+
+func txHashes(ns ...int64) []gethcommon.Hash {
+	hashes := make([]gethcommon.Hash, len(ns))
+	for i, n := range ns {
+		hashes[i] = txHash(n)
+	}
+	return hashes
+}
+
+func receiptHashes(receipts []*core.InternalReceipt) []gethcommon.Hash {
+	hashes := make([]gethcommon.Hash, len(receipts))
+	for i, r := range receipts {
+		hashes[i] = r.TxHash
+	}
+	return hashes
+}
+
+func ptr(v uint64) *uint64 {
+	return &v
+}
@@ -16,14 +16,30 @@ const (
 )
 
 const (
-	attInsert = "insert into attestation_key values (?,?)"
-	attSelect = "select ky from attestation_key where party=?"
+	attInsert    = "insert into attestation_key values (?,?)"
+	attSelect    = "select ky from attestation_key where party=?"
+	attSelectAll = "select distinct party from attestation_key where party not in (select party from revoked_enclave)"
+
+	revokedInsert = "insert into revoked_enclave values (?)"
+	revokedSelect = "select count(*) from revoked_enclave where party=?"
+)
+
+const (
+	secretGenInsert    = "insert into secret_generation values (?,?,?)"
+	secretGenSelectAll = "select generation, activation_height, secret from secret_generation order by generation"
+
+	secretAnnouncementInsert = "insert into secret_generation_announcement values (?,?)"
+	secretAnnouncementSelect = "select activation_height from secret_generation_announcement where generation=?"
 )
 
 func WriteConfigToTx(ctx context.Context, dbtx *sql.Tx, key string, value any) (sql.Result, error) {
 	return dbtx.Exec(cfgInsert, key, value)
 }
 
+func UpdateConfigToTx(ctx context.Context, dbtx *sql.Tx, key string, value any) (sql.Result, error) {
+	return dbtx.Exec(cfgUpdate, value, key)
+}
+
 func WriteConfig(ctx context.Context, db *sql.Tx, key string, value []byte) (sql.Result, error) {
 	return db.ExecContext(ctx, cfgInsert, key, value)
 }
@@ -40,6 +56,84 @@ func FetchAttKey(ctx context.Context, db *sql.DB, party common.Address) ([]byte,
 	return readSingleRow(ctx, db, attSelect, party.Bytes())
 }
 
+// FetchAttestedParties - returns the parties with an attested key, excluding the revoked ones
+func FetchAttestedParties(ctx context.Context, db *sql.DB) ([]common.Address, error) {
+	rows, err := db.QueryContext(ctx, attSelectAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	parties := make([]common.Address, 0)
+	for rows.Next() {
+		var party []byte
+		if err := rows.Scan(&party); err != nil {
+			return nil, err
+		}
+		parties = append(parties, common.BytesToAddress(party))
+	}
+	return parties, rows.Err()
+}
+
+func WriteRevokedParty(ctx context.Context, db *sql.Tx, party common.Address) (sql.Result, error) {
+	return db.ExecContext(ctx, revokedInsert, party.Bytes())
+}
+
+func IsRevokedParty(ctx context.Context, db *sql.DB, party common.Address) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx, revokedSelect, party.Bytes()).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func WriteSecretGeneration(ctx context.Context, db *sql.Tx, generation uint64, activationHeight uint64, secret []byte) (sql.Result, error) {
+	return db.ExecContext(ctx, secretGenInsert, generation, activationHeight, secret)
+}
+
+// SecretGenerationRow - the raw content of the secret_generation table
+type SecretGenerationRow struct {
+	Generation       uint64
+	ActivationHeight uint64
+	Secret           []byte
+}
+
+func FetchSecretGenerations(ctx context.Context, db *sql.DB) ([]*SecretGenerationRow, error) {
+	rows, err := db.QueryContext(ctx, secretGenSelectAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*SecretGenerationRow, 0)
+	for rows.Next() {
+		row := &SecretGenerationRow{}
+		if err := rows.Scan(&row.Generation, &row.ActivationHeight, &row.Secret); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func WriteSecretAnnouncement(ctx context.Context, db *sql.Tx, generation uint64, activationHeight uint64) (sql.Result, error) {
+	return db.ExecContext(ctx, secretAnnouncementInsert, generation, activationHeight)
+}
+
+// FetchSecretAnnouncement - returns the activation height with which the generation was first announced on the L1
+func FetchSecretAnnouncement(ctx context.Context, db *sql.DB, generation uint64) (uint64, error) {
+	var activationHeight uint64
+	err := db.QueryRowContext(ctx, secretAnnouncementSelect, generation).Scan(&activationHeight)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errutil.ErrNotFound
+		}
+		return 0, err
+	}
+	return activationHeight, nil
+}
+
 func readSingleRow(ctx context.Context, db *sql.DB, query string, v any) ([]byte, error) {
 	var res []byte
 
@@ -35,6 +35,8 @@ const (
 		"left join event_topic t3 on e.topic3=t3.id and et.id=t1.event_type " +
 		"   left join externally_owned_account eoa3 on t3.rel_address=eoa3.id " +
 		"where b.is_canonical=true "
+
+	logsColumns = " et.event_sig, t1.topic, t2.topic, t3.topic, datablob, log_idx, b.hash, b.height, curr_tx.hash, curr_tx.idx, c.address "
 )
 
 func WriteEventType(ctx context.Context, dbTX *sql.Tx, et *EventType) (uint64, error) {
@@ -240,6 +242,7 @@ func loadReceiptList(ctx context.Context, db *sql.DB, requestingAccount *gethcom
 
 	query := "select b.hash, b.height, curr_tx.hash, curr_tx.idx, rec.post_state, rec.status, rec.cumulative_gas_used, rec.effective_gas_price, rec.created_contract_address, tx_sender.address, tx_contr.address, curr_tx.type "
 	query += baseReceiptJoin
+	query += " where b.is_canonical=true "
 
 	// visibility
 	query += " AND tx_sender.address = ? "
@@ -305,10 +308,9 @@ func onRowWithReceipt(rows *sql.Rows) (*core.InternalReceipt, error) {
 // this complexity is necessary to avoid executing multiple queries.
 // todo always pass in the actual batch hashes because of reorgs, or make sure to clean up log entries from discarded batches
 func loadReceiptsAndEventLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.Address, whereCondition string, whereParams []any, withReceipts bool) ([]*core.InternalReceipt, []*types.Log, error) {
-	logsQuery := " et.event_sig, t1.topic, t2.topic, t3.topic, datablob, log_idx, b.hash, b.height, curr_tx.hash, curr_tx.idx, c.address "
 	receiptQuery := " rec.post_state, rec.status, rec.cumulative_gas_used, rec.effective_gas_price, rec.created_contract_address, tx_sender.address, tx_contr.address, curr_tx.type "
 
-	query := "select " + logsQuery
+	query := "select " + logsColumns
 	if withReceipts {
 		query += "," + receiptQuery
 	}
@@ -0,0 +1,194 @@
+package enclavedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	// the events everyone can view, as in logsVisibilityQuery
+	publicEventCondition = " AND (c.transparent=true OR et.config_public=true OR (et.auto_visibility=true AND et.auto_public=true)) "
+
+	// the transactions of an address with transparent contracts, whose receipts everyone can view
+	publicAddressTxCondition = " where b.is_canonical=true AND tx_contr.transparent=true AND (tx_sender.address=? OR tx_contr.address=?) "
+
+	// the contracts created by an address are listed up to this number
+	maxCreatedContracts = 100
+)
+
+// ReadPublicContract returns what is public about the contract at the address
+func ReadPublicContract(ctx context.Context, db *sql.DB, address gethcommon.Address) (*common.PublicContract, error) {
+	query := "select c.id, c.auto_visibility, c.transparent, eoa.address, creation_tx.hash, " +
+		"(select max(b.height) from receipt rec join batch b on rec.batch=b.sequence where rec.tx=creation_tx.id and b.is_canonical=true) " +
+		"from contract c " +
+		"join externally_owned_account eoa on c.creator=eoa.id " +
+		"join tx creation_tx on c.tx=creation_tx.id " +
+		"where c.address=?"
+
+	contract := common.PublicContract{Address: address}
+	var id uint64
+	var transparent *bool
+	var creator, creationTx []byte
+	var creationHeight *uint64
+	err := db.QueryRowContext(ctx, query, address.Bytes()).Scan(&id, &contract.AutoVisibility, &transparent, &creator, &creationTx, &creationHeight)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not read contract. Cause: %w", err)
+	}
+	contract.Creator = gethcommon.BytesToAddress(creator)
+	contract.CreationTx = gethcommon.BytesToHash(creationTx)
+	contract.Transparent = transparent != nil && *transparent
+	if creationHeight != nil {
+		contract.CreationBatch = new(big.Int).SetUint64(*creationHeight)
+	}
+
+	rows, err := db.QueryContext(ctx, "select event_sig, auto_visibility, auto_public, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view from event_type where contract=? order by id", id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read event types. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	contract.EventTypes = make([]common.PublicEventType, 0)
+	for rows.Next() {
+		var et common.PublicEventType
+		var eventSig []byte
+		var autoPublic *bool
+		var configPublic bool
+		err = rows.Scan(&eventSig, &et.AutoVisibility, &autoPublic, &configPublic, &et.Topic1CanView, &et.Topic2CanView, &et.Topic3CanView, &et.SenderCanView)
+		if err != nil {
+			return nil, fmt.Errorf("could not scan event type. Cause: %w", err)
+		}
+		et.EventSignature = gethcommon.BytesToHash(eventSig)
+		et.Public = contract.Transparent || configPublic || (et.AutoVisibility && autoPublic != nil && *autoPublic)
+		contract.EventTypes = append(contract.EventTypes, et)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read event types. Cause: %w", err)
+	}
+	return &contract, nil
+}
+
+// ReadPublicContractEvents returns the latest events of the contract that everyone can view
+func ReadPublicContractEvents(ctx context.Context, db *sql.DB, address gethcommon.Address, pagination *common.QueryPagination) ([]types.Log, error) {
+	query := "select " + logsColumns + baseReceiptJoin + baseEventJoin +
+		" AND c.address=? " + publicEventCondition +
+		" ORDER BY b.height DESC, curr_tx.idx DESC, e.log_idx DESC LIMIT ? OFFSET ?"
+
+	rows, err := db.QueryContext(ctx, query, address.Bytes(), pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("could not query contract events. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	logs := make([]types.Log, 0)
+	for rows.Next() {
+		_, l, err := onRowWithEventLogAndReceipt(rows, false)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, *l)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read contract events. Cause: %w", err)
+	}
+	return logs, nil
+}
+
+// CountPublicContractEvents returns the number of events of the contract that everyone can view
+func CountPublicContractEvents(ctx context.Context, db *sql.DB, address gethcommon.Address) (uint64, error) {
+	query := "select count(1) from event_log e " +
+		"join event_type et on e.event_type=et.id " +
+		"join contract c on et.contract=c.id " +
+		"join receipt rec on e.receipt=rec.id " +
+		"join batch b on rec.batch=b.sequence " +
+		"where b.is_canonical=true AND c.address=? " + publicEventCondition
+
+	var count uint64
+	if err := db.QueryRowContext(ctx, query, address.Bytes()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("could not count contract events. Cause: %w", err)
+	}
+	return count, nil
+}
+
+// ReadPublicAddressActivity returns the contracts created by the address, and its latest transactions with
+// transparent contracts
+func ReadPublicAddressActivity(ctx context.Context, db *sql.DB, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, error) {
+	activity := common.AddressActivity{Address: address}
+
+	var contracts uint64
+	if err := db.QueryRowContext(ctx, "select count(1) from contract where address=?", address.Bytes()).Scan(&contracts); err != nil {
+		return nil, fmt.Errorf("could not check contract. Cause: %w", err)
+	}
+	activity.IsContract = contracts > 0
+
+	created, err := readCreatedContracts(ctx, db, address)
+	if err != nil {
+		return nil, err
+	}
+	activity.CreatedContracts = created
+
+	query := "select curr_tx.hash, b.height, b.hash, tx_sender.address, tx_contr.address, rec.status " + baseReceiptJoin +
+		publicAddressTxCondition + " ORDER BY b.height DESC, curr_tx.idx DESC LIMIT ? OFFSET ?"
+	rows, err := db.QueryContext(ctx, query, address.Bytes(), address.Bytes(), pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("could not query address transactions. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	activity.TransactionsData = make([]common.AddressTransaction, 0)
+	for rows.Next() {
+		var tx common.AddressTransaction
+		var txHash, batchHash, from, to []byte
+		var height uint64
+		if err = rows.Scan(&txHash, &height, &batchHash, &from, &to, &tx.Status); err != nil {
+			return nil, fmt.Errorf("could not scan address transaction. Cause: %w", err)
+		}
+		tx.TransactionHash = gethcommon.BytesToHash(txHash)
+		tx.BatchHeight = new(big.Int).SetUint64(height)
+		tx.BatchHash = gethcommon.BytesToHash(batchHash)
+		tx.From = gethcommon.BytesToAddress(from)
+		tx.To = gethcommon.BytesToAddress(to)
+		activity.TransactionsData = append(activity.TransactionsData, tx)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read address transactions. Cause: %w", err)
+	}
+
+	countQuery := "select count(1) " + baseReceiptJoin + publicAddressTxCondition
+	if err = db.QueryRowContext(ctx, countQuery, address.Bytes(), address.Bytes()).Scan(&activity.TransactionsTotal); err != nil {
+		return nil, fmt.Errorf("could not count address transactions. Cause: %w", err)
+	}
+	return &activity, nil
+}
+
+func readCreatedContracts(ctx context.Context, db *sql.DB, creator gethcommon.Address) ([]gethcommon.Address, error) {
+	query := "select c.address from contract c join externally_owned_account eoa on c.creator=eoa.id where eoa.address=? order by c.id desc limit ?"
+	rows, err := db.QueryContext(ctx, query, creator.Bytes(), maxCreatedContracts)
+	if err != nil {
+		return nil, fmt.Errorf("could not query created contracts. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	contracts := make([]gethcommon.Address, 0)
+	for rows.Next() {
+		var address []byte
+		if err = rows.Scan(&address); err != nil {
+			return nil, fmt.Errorf("could not scan created contract. Cause: %w", err)
+		}
+		contracts = append(contracts, gethcommon.BytesToAddress(address))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read created contracts. Cause: %w", err)
+	}
+	return contracts, nil
+}
@@ -0,0 +1,140 @@
+package enclavedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+var (
+	deployer            = gethcommon.HexToAddress("0xA1")
+	user                = gethcommon.HexToAddress("0xB2")
+	transparentContract = gethcommon.HexToAddress("0xC3")
+	privateContract     = gethcommon.HexToAddress("0xD4")
+	autoEvent           = gethcommon.HexToHash("0x01")
+	publicEvent         = gethcommon.HexToHash("0x02")
+	privateEvent        = gethcommon.HexToHash("0x03")
+	batchHash           = gethcommon.HexToHash("0xBA")
+)
+
+func TestPublicContractMetadata(t *testing.T) {
+	db := createScanDB(t)
+	ctx := context.Background()
+
+	contract, err := ReadPublicContract(ctx, db, transparentContract)
+	require.NoError(t, err)
+	assert.Equal(t, deployer, contract.Creator)
+	assert.Equal(t, gethcommon.HexToHash("0x1001"), contract.CreationTx)
+	assert.Equal(t, uint64(1), contract.CreationBatch.Uint64())
+	assert.True(t, contract.Transparent)
+	require.Len(t, contract.EventTypes, 1)
+	// every event of a transparent contract is public
+	assert.True(t, contract.EventTypes[0].Public)
+
+	contract, err = ReadPublicContract(ctx, db, privateContract)
+	require.NoError(t, err)
+	assert.False(t, contract.Transparent)
+	require.Len(t, contract.EventTypes, 2)
+	assert.Equal(t, publicEvent, contract.EventTypes[0].EventSignature)
+	assert.True(t, contract.EventTypes[0].Public)
+	assert.False(t, contract.EventTypes[1].Public)
+	assert.True(t, *contract.EventTypes[1].Topic1CanView)
+
+	_, err = ReadPublicContract(ctx, db, user)
+	assert.True(t, errors.Is(err, errutil.ErrNotFound))
+}
+
+func TestOnlyPublicContractEventsAreListed(t *testing.T) {
+	db := createScanDB(t)
+	ctx := context.Background()
+	pagination := &common.QueryPagination{Offset: 0, Size: 10}
+
+	for contract, expected := range map[gethcommon.Address]gethcommon.Hash{transparentContract: autoEvent, privateContract: publicEvent} {
+		events, err := ReadPublicContractEvents(ctx, db, contract, pagination)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, expected, events[0].Topics[0])
+		assert.Equal(t, contract, events[0].Address)
+
+		count, err := CountPublicContractEvents(ctx, db, contract)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), count)
+	}
+}
+
+func TestAddressActivityOnlyHasTransparentContractTransactions(t *testing.T) {
+	db := createScanDB(t)
+	ctx := context.Background()
+	pagination := &common.QueryPagination{Offset: 0, Size: 10}
+
+	activity, err := ReadPublicAddressActivity(ctx, db, user, pagination)
+	require.NoError(t, err)
+	assert.False(t, activity.IsContract)
+	assert.Empty(t, activity.CreatedContracts)
+	require.Len(t, activity.TransactionsData, 1)
+	assert.Equal(t, uint64(1), activity.TransactionsTotal)
+	assert.Equal(t, gethcommon.HexToHash("0x1002"), activity.TransactionsData[0].TransactionHash)
+	assert.Equal(t, transparentContract, activity.TransactionsData[0].To)
+
+	activity, err = ReadPublicAddressActivity(ctx, db, deployer, pagination)
+	require.NoError(t, err)
+	assert.Equal(t, []gethcommon.Address{privateContract, transparentContract}, activity.CreatedContracts)
+	assert.Empty(t, activity.TransactionsData)
+
+	activity, err = ReadPublicAddressActivity(ctx, db, transparentContract, pagination)
+	require.NoError(t, err)
+	assert.True(t, activity.IsContract)
+	require.Len(t, activity.TransactionsData, 1)
+	assert.Equal(t, user, activity.TransactionsData[0].From)
+}
+
+// createScanDB creates an enclave database with a transparent contract and a private contract, each created by the
+// deployer and called by the user
+func createScanDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "enclave.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	for _, file := range []string{"001_init.sql", "003_scan_indexes.sql"} {
+		schema, err := os.ReadFile(filepath.Join("..", "init", "sqlite", file))
+		require.NoError(t, err)
+		_, err = db.Exec(string(schema))
+		require.NoError(t, err)
+	}
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{"insert into externally_owned_account (id, address) values (1, ?), (2, ?)", []any{deployer.Bytes(), user.Bytes()}},
+		{"insert into batch values (1, ?, ?, 1, true, ?, ?, null, true)", []any{batchHash.Bytes(), batchHash.Bytes(), []byte{}, gethcommon.Hash{}.Bytes()}},
+		// the creation and a call of each contract
+		{"insert into tx (id, hash, content, to_address, type, sender_address, idx, batch_height) values " +
+			"(1, ?, ?, null, 0, 1, 0, 1), (2, ?, ?, 1, 0, 2, 1, 1), (3, ?, ?, null, 0, 1, 2, 1), (4, ?, ?, 2, 0, 2, 3, 1)",
+			[]any{
+				gethcommon.HexToHash("0x1001").Bytes(), []byte{}, gethcommon.HexToHash("0x1002").Bytes(), []byte{},
+				gethcommon.HexToHash("0x1003").Bytes(), []byte{}, gethcommon.HexToHash("0x1004").Bytes(), []byte{},
+			}},
+		{"insert into receipt (id, status, cumulative_gas_used, tx, batch) values (1, 1, 0, 1, 1), (2, 1, 0, 2, 1), (3, 1, 0, 3, 1), (4, 1, 0, 4, 1)", nil},
+		{"insert into contract (id, address, creator, auto_visibility, transparent, tx) values (1, ?, 1, false, true, 1), (2, ?, 1, false, false, 3)",
+			[]any{transparentContract.Bytes(), privateContract.Bytes()}},
+		{"insert into event_type (id, contract, event_sig, auto_visibility, auto_public, config_public, topic1_can_view) values " +
+			"(1, 1, ?, true, false, false, null), (2, 2, ?, false, null, true, null), (3, 2, ?, false, null, false, true)",
+			[]any{autoEvent.Bytes(), publicEvent.Bytes(), privateEvent.Bytes()}},
+		{"insert into event_log (event_type, log_idx, receipt) values (1, 0, 2), (2, 0, 4), (3, 1, 4)", nil},
+	}
+	for _, s := range statements {
+		_, err = db.Exec(s.query, s.args...)
+		require.NoError(t, err, s.query)
+	}
+	return db
+}
@@ -0,0 +1,13 @@
+create table if not exists tendb.secret_generation
+(
+    generation        INTEGER,
+    activation_height INTEGER    NOT NULL,
+    secret            binary(32) NOT NULL,
+    primary key (generation)
+);
+
+create table if not exists tendb.revoked_enclave
+(
+    party binary(20),
+    primary key (party)
+);
@@ -0,0 +1,2 @@
+create index IDX_CONTRACT_CREATOR on tendb.contract (creator);
+create index IDX_TX_TO_ADDRESS on tendb.tx (to_address);
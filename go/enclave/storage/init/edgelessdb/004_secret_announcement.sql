@@ -0,0 +1,6 @@
+create table if not exists tendb.secret_generation_announcement
+(
+    generation        INTEGER,
+    activation_height INTEGER NOT NULL,
+    primary key (generation)
+);
@@ -68,10 +68,22 @@ func executeMigration(db *sql.DB, content string, migrationOrder int64) error {
 		return err
 	}
 
-	_, err = enclavedb.WriteConfigToTx(context.Background(), tx, currentMigrationVersionKey, big.NewInt(migrationOrder).Bytes())
+	// record the number of executed migration files, so the next startup resumes with the following file
+	version := big.NewInt(migrationOrder + 1).Bytes()
+	res, err := enclavedb.UpdateConfigToTx(context.Background(), tx, currentMigrationVersionKey, version)
 	if err != nil {
 		return err
 	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		_, err = enclavedb.WriteConfigToTx(context.Background(), tx, currentMigrationVersionKey, version)
+		if err != nil {
+			return err
+		}
+	}
 
 	return tx.Commit()
 }
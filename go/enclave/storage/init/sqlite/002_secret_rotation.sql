@@ -0,0 +1,11 @@
+create table if not exists secret_generation
+(
+    generation        int primary key,
+    activation_height int        NOT NULL,
+    secret            binary(32) NOT NULL
+);
+
+create table if not exists revoked_enclave
+(
+    party binary(20) primary key
+);
@@ -0,0 +1,3 @@
+create index IDX_CONTRACT_CREATOR on contract (creator);
+create index IDX_TX_TO_ADDRESS on tx (to_address);
+create index IDX_EV_TYPE on event_log (event_type);
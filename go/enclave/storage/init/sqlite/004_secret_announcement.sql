@@ -0,0 +1,5 @@
+create table if not exists secret_generation_announcement
+(
+    generation        int primary key,
+    activation_height int NOT NULL
+);
@@ -92,6 +92,24 @@ type SharedSecretStorage interface {
 	FetchSecret(ctx context.Context) (*crypto.SharedEnclaveSecret, error)
 	// StoreSecret stores a secret in the enclave
 	StoreSecret(ctx context.Context, secret crypto.SharedEnclaveSecret) error
+	// StoreSecretGeneration stores a rotated secret, which becomes active at its activation height
+	StoreSecretGeneration(ctx context.Context, secret *crypto.SecretGeneration) error
+	// FetchSecretGenerations returns all the known generations of the secret, ordered by generation, starting with generation 0
+	FetchSecretGenerations(ctx context.Context) ([]*crypto.SecretGeneration, error)
+	// FetchSecretForHeight returns the generation of the secret that is active at the given L1 height
+	FetchSecretForHeight(ctx context.Context, l1Height uint64) (*crypto.SecretGeneration, error)
+	// StoreSecretIssuer records the enclave that generated the network secret, it is the one issuing new generations
+	StoreSecretIssuer(ctx context.Context, enclaveID gethcommon.Address) error
+	// FetchSecretIssuer returns the enclave that generated the network secret
+	FetchSecretIssuer(ctx context.Context) (gethcommon.Address, error)
+	// StorePendingSecretRotation records the generation this enclave issued, until it is observed on the L1
+	StorePendingSecretRotation(ctx context.Context, generation uint64, activationHeight uint64) error
+	// FetchPendingSecretRotation returns the generation and the activation height of the last generation this enclave issued
+	FetchPendingSecretRotation(ctx context.Context) (uint64, uint64, error)
+	// StoreSecretAnnouncement records the activation height with which a generation was first published on the L1
+	StoreSecretAnnouncement(ctx context.Context, generation uint64, activationHeight uint64) error
+	// FetchSecretAnnouncement returns the activation height with which a generation was first published on the L1
+	FetchSecretAnnouncement(ctx context.Context, generation uint64) (uint64, error)
 }
 
 type TransactionStorage interface {
@@ -107,6 +125,12 @@ type AttestationStorage interface {
 	FetchAttestedKey(ctx context.Context, aggregator gethcommon.Address) (*ecdsa.PublicKey, error)
 	// StoreAttestedKey - store the public key of an attested aggregator
 	StoreAttestedKey(ctx context.Context, aggregator gethcommon.Address, key *ecdsa.PublicKey) error
+	// FetchAttestedEnclaves returns the IDs of all the attested enclaves which have not been revoked
+	FetchAttestedEnclaves(ctx context.Context) ([]gethcommon.Address, error)
+	// RevokeEnclave - marks the attestation of an enclave as revoked
+	RevokeEnclave(ctx context.Context, enclaveID gethcommon.Address) error
+	// IsEnclaveRevoked returns true if the attestation of the enclave was revoked
+	IsEnclaveRevoked(ctx context.Context, enclaveID gethcommon.Address) (bool, error)
 }
 
 type CrossChainMessagesStorage interface {
@@ -158,7 +182,15 @@ type Storage interface {
 
 type ScanStorage interface {
 	GetContractCount(ctx context.Context) (*big.Int, error)
-	GetTransactionsPerAddress(ctx context.Context, address *gethcommon.Address, pagination *common.QueryPagination) ([]*core.InternalReceipt, error)
-
-	CountTransactionsPerAddress(ctx context.Context, addr *gethcommon.Address) (uint64, error)
+	// GetTransactionsPerAddress returns the receipts of the transactions sent by the account that match the query, latest first
+	GetTransactionsPerAddress(ctx context.Context, query *common.ListPrivateTransactionsQueryParams) ([]*core.InternalReceipt, error)
+	// CountTransactionsPerAddress returns the number of transactions sent by the account that match the filter
+	CountTransactionsPerAddress(ctx context.Context, addr *gethcommon.Address, filter *common.PersonalTransactionsFilter) (uint64, error)
+
+	// GetPublicContract returns the creation and visibility configuration of the contract
+	GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, error)
+	// GetPublicContractEvents returns the events of the contract that everyone can view
+	GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, error)
+	// GetPublicAddressActivity returns the contracts created by the address and its transactions with transparent contracts
+	GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, error)
 }
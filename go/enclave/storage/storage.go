@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
@@ -42,7 +43,9 @@ import (
 
 // todo - this will require a dedicated table when upgrades are implemented
 const (
-	masterSeedCfg = "MASTER_SEED"
+	masterSeedCfg   = "MASTER_SEED"
+	secretIssuerCfg = "SECRET_ISSUER"
+	pendingRotation = "PENDING_SECRET_ROTATION"
 )
 
 // todo - this file needs splitting up based on concerns
@@ -52,6 +55,9 @@ type storageImpl struct {
 	eventsStorage      *eventsStorage
 	cachedSharedSecret *crypto.SharedEnclaveSecret
 
+	secretGenerationsMutex  sync.RWMutex
+	cachedSecretGenerations []*crypto.SecretGeneration
+
 	stateCache  state.Database
 	chainConfig *params.ChainConfig
 	logger      gethlog.Logger
@@ -360,6 +366,172 @@ func (s *storageImpl) FetchSecret(ctx context.Context) (*crypto.SharedEnclaveSec
 	return s.cachedSharedSecret, nil
 }
 
+func (s *storageImpl) StoreSecretIssuer(ctx context.Context, enclaveID gethcommon.Address) error {
+	defer s.logDuration("StoreSecretIssuer", measure.NewStopwatch())
+	_, err := s.FetchSecretIssuer(ctx)
+	if err == nil {
+		// the network secret is only initialised once, a replayed L1 block does not change the issuer
+		return nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return err
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteConfig(ctx, dbTx, secretIssuerCfg, enclaveID.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not store secret issuer in DB. Cause: %w", err)
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchSecretIssuer(ctx context.Context) (gethcommon.Address, error) {
+	defer s.logDuration("FetchSecretIssuer", measure.NewStopwatch())
+	cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), secretIssuerCfg)
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	return gethcommon.BytesToAddress(cfg), nil
+}
+
+func (s *storageImpl) StorePendingSecretRotation(ctx context.Context, generation uint64, activationHeight uint64) error {
+	defer s.logDuration("StorePendingSecretRotation", measure.NewStopwatch())
+	value, err := rlp.EncodeToBytes([]uint64{generation, activationHeight})
+	if err != nil {
+		return fmt.Errorf("could not encode pending secret rotation. Cause: %w", err)
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	res, err := enclavedb.UpdateConfigToTx(ctx, dbTx, pendingRotation, value)
+	if err != nil {
+		return fmt.Errorf("could not store pending secret rotation in DB. Cause: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		_, err = enclavedb.WriteConfig(ctx, dbTx, pendingRotation, value)
+		if err != nil {
+			return fmt.Errorf("could not store pending secret rotation in DB. Cause: %w", err)
+		}
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchPendingSecretRotation(ctx context.Context) (uint64, uint64, error) {
+	defer s.logDuration("FetchPendingSecretRotation", measure.NewStopwatch())
+	cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), pendingRotation)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pending []uint64
+	if err = rlp.DecodeBytes(cfg, &pending); err != nil {
+		return 0, 0, fmt.Errorf("could not decode pending secret rotation. Cause: %w", err)
+	}
+	if len(pending) != 2 {
+		return 0, 0, fmt.Errorf("invalid pending secret rotation %v", pending)
+	}
+	return pending[0], pending[1], nil
+}
+
+func (s *storageImpl) StoreSecretAnnouncement(ctx context.Context, generation uint64, activationHeight uint64) error {
+	defer s.logDuration("StoreSecretAnnouncement", measure.NewStopwatch())
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteSecretAnnouncement(ctx, dbTx, generation, activationHeight)
+	if err != nil {
+		return fmt.Errorf("could not store secret announcement. Cause: %w", err)
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchSecretAnnouncement(ctx context.Context, generation uint64) (uint64, error) {
+	defer s.logDuration("FetchSecretAnnouncement", measure.NewStopwatch())
+	return enclavedb.FetchSecretAnnouncement(ctx, s.db.GetSQLDB(), generation)
+}
+
+func (s *storageImpl) StoreSecretGeneration(ctx context.Context, secret *crypto.SecretGeneration) error {
+	defer s.logDuration("StoreSecretGeneration", measure.NewStopwatch())
+	if secret.Generation == 0 {
+		return fmt.Errorf("generation 0 is the initial secret and cannot be rotated")
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteSecretGeneration(ctx, dbTx, secret.Generation, secret.ActivationHeight, secret.Secret[:])
+	if err != nil {
+		return fmt.Errorf("could not store secret generation. Cause: %w", err)
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+
+	s.secretGenerationsMutex.Lock()
+	s.cachedSecretGenerations = nil
+	s.secretGenerationsMutex.Unlock()
+	return nil
+}
+
+func (s *storageImpl) FetchSecretGenerations(ctx context.Context) ([]*crypto.SecretGeneration, error) {
+	defer s.logDuration("FetchSecretGenerations", measure.NewStopwatch())
+
+	s.secretGenerationsMutex.RLock()
+	cached := s.cachedSecretGenerations
+	s.secretGenerationsMutex.RUnlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	initialSecret, err := s.FetchSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	generations := []*crypto.SecretGeneration{{Generation: 0, ActivationHeight: 0, Secret: *initialSecret}}
+
+	rows, err := enclavedb.FetchSecretGenerations(ctx, s.db.GetSQLDB())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch secret generations. Cause: %w", err)
+	}
+	for _, row := range rows {
+		gen := &crypto.SecretGeneration{Generation: row.Generation, ActivationHeight: row.ActivationHeight}
+		copy(gen.Secret[:], row.Secret)
+		generations = append(generations, gen)
+	}
+
+	s.secretGenerationsMutex.Lock()
+	s.cachedSecretGenerations = generations
+	s.secretGenerationsMutex.Unlock()
+	return generations, nil
+}
+
+func (s *storageImpl) FetchSecretForHeight(ctx context.Context, l1Height uint64) (*crypto.SecretGeneration, error) {
+	generations, err := s.FetchSecretGenerations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the generations are ordered, so the last one which is already active wins
+	active := generations[0]
+	for _, gen := range generations[1:] {
+		if gen.ActivationHeight <= l1Height {
+			active = gen
+		}
+	}
+	return active, nil
+}
+
 func (s *storageImpl) IsAncestor(ctx context.Context, block *types.Header, maybeAncestor *types.Header) bool {
 	defer s.logDuration("IsAncestor", measure.NewStopwatch())
 	if bytes.Equal(maybeAncestor.Hash().Bytes(), block.Hash().Bytes()) {
@@ -476,6 +648,37 @@ func (s *storageImpl) StoreAttestedKey(ctx context.Context, aggregator gethcommo
 	return nil
 }
 
+func (s *storageImpl) FetchAttestedEnclaves(ctx context.Context) ([]gethcommon.Address, error) {
+	defer s.logDuration("FetchAttestedEnclaves", measure.NewStopwatch())
+	return enclavedb.FetchAttestedParties(ctx, s.db.GetSQLDB())
+}
+
+func (s *storageImpl) RevokeEnclave(ctx context.Context, enclaveID gethcommon.Address) error {
+	defer s.logDuration("RevokeEnclave", measure.NewStopwatch())
+	revoked, err := s.IsEnclaveRevoked(ctx, enclaveID)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return nil
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteRevokedParty(ctx, dbTx, enclaveID)
+	if err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) IsEnclaveRevoked(ctx context.Context, enclaveID gethcommon.Address) (bool, error) {
+	defer s.logDuration("IsEnclaveRevoked", measure.NewStopwatch())
+	return enclavedb.IsRevokedParty(ctx, s.db.GetSQLDB(), enclaveID)
+}
+
 func (s *storageImpl) FetchBatchBySeqNo(ctx context.Context, seqNum uint64) (*core.Batch, error) {
 	defer s.logDuration("FetchBatchBySeqNo", measure.NewStopwatch())
 	h, err := s.FetchBatchHeaderBySeqNo(ctx, seqNum)
@@ -805,14 +1008,37 @@ func (s *storageImpl) BatchWasExecuted(ctx context.Context, hash common.L2BatchH
 	return enclavedb.BatchWasExecuted(ctx, s.db.GetSQLDB(), hash)
 }
 
-func (s *storageImpl) GetTransactionsPerAddress(ctx context.Context, requester *gethcommon.Address, pagination *common.QueryPagination) ([]*core.InternalReceipt, error) {
+func (s *storageImpl) GetTransactionsPerAddress(ctx context.Context, query *common.ListPrivateTransactionsQueryParams) ([]*core.InternalReceipt, error) {
 	defer s.logDuration("GetTransactionsPerAddress", measure.NewStopwatch())
-	return enclavedb.GetTransactionsPerAddress(ctx, s.db.GetSQLDB(), requester, pagination)
+	return enclavedb.GetTransactionsPerAddress(ctx, s.db.GetSQLDB(), query)
 }
 
-func (s *storageImpl) CountTransactionsPerAddress(ctx context.Context, address *gethcommon.Address) (uint64, error) {
+func (s *storageImpl) CountTransactionsPerAddress(ctx context.Context, address *gethcommon.Address, filter *common.PersonalTransactionsFilter) (uint64, error) {
 	defer s.logDuration("CountTransactionsPerAddress", measure.NewStopwatch())
-	return enclavedb.CountTransactionsPerAddress(ctx, s.db.GetSQLDB(), address)
+	return enclavedb.CountTransactionsPerAddress(ctx, s.db.GetSQLDB(), address, filter)
+}
+
+func (s *storageImpl) GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, error) {
+	defer s.logDuration("GetPublicContract", measure.NewStopwatch())
+	return enclavedb.ReadPublicContract(ctx, s.db.GetSQLDB(), address)
+}
+
+func (s *storageImpl) GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, error) {
+	defer s.logDuration("GetPublicContractEvents", measure.NewStopwatch())
+	events, err := enclavedb.ReadPublicContractEvents(ctx, s.db.GetSQLDB(), address, pagination)
+	if err != nil {
+		return nil, err
+	}
+	total, err := enclavedb.CountPublicContractEvents(ctx, s.db.GetSQLDB(), address)
+	if err != nil {
+		return nil, err
+	}
+	return &common.PublicEventListingResponse{EventsData: events, Total: total}, nil
+}
+
+func (s *storageImpl) GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, error) {
+	defer s.logDuration("GetPublicAddressActivity", measure.NewStopwatch())
+	return enclavedb.ReadPublicAddressActivity(ctx, s.db.GetSQLDB(), address, pagination)
 }
 
 func (s *storageImpl) readOrWriteEOA(ctx context.Context, dbTX *sql.Tx, addr gethcommon.Address) (*uint64, error) {
@@ -2,6 +2,7 @@ package system
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/ten-protocol/go-ten/contracts/generated/TransactionPostProcessor"
 	"github.com/ten-protocol/go-ten/contracts/generated/ZenBase"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 	"github.com/ten-protocol/go-ten/go/wallet"
@@ -41,12 +43,12 @@ type systemContractCallbacks struct {
 	logger gethlog.Logger
 }
 
-func NewSystemContractCallbacks(ownerWallet wallet.Wallet, logger gethlog.Logger) SystemContractCallbacks {
+func NewSystemContractCallbacks(ownerWallet wallet.Wallet, storage storage.Storage, logger gethlog.Logger) SystemContractCallbacks {
 	return &systemContractCallbacks{
 		transactionsPostProcessorAddress: nil,
 		ownerWallet:                      ownerWallet,
 		logger:                           logger,
-		storage:                          nil,
+		storage:                          storage,
 	}
 }
 
@@ -58,6 +60,8 @@ func (s *systemContractCallbacks) GetOwner() gethcommon.Address {
 	return s.ownerWallet.Address()
 }
 
+// Load initializes the addresses of the system contracts from the batch that deployed them, when the enclave restarts
+// after storing that batch
 func (s *systemContractCallbacks) Load() error {
 	s.logger.Info("Load: Initializing system contracts")
 
@@ -66,9 +70,14 @@ func (s *systemContractCallbacks) Load() error {
 		return fmt.Errorf("storage is not set")
 	}
 
-	batchSeqNo := uint64(1)
+	// the system contracts are deployed by the batch following the genesis batch
+	batchSeqNo := uint64(2)
 	s.logger.Debug("Load: Fetching batch", "batchSeqNo", batchSeqNo)
 	batch, err := s.storage.FetchBatchBySeqNo(context.Background(), batchSeqNo)
+	if errors.Is(err, errutil.ErrNotFound) {
+		s.logger.Info("Load: System contracts not deployed yet")
+		return nil
+	}
 	if err != nil {
 		s.logger.Error("Load: Failed fetching batch", "batchSeqNo", batchSeqNo, "error", err)
 		return fmt.Errorf("failed fetching batch %w", err)
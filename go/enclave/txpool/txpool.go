@@ -115,6 +115,11 @@ func (t *TxPool) Validate(tx *common.L2Tx) error {
 	return validateTx(t.legacyPool, tx, false)
 }
 
+// Stats returns the number of pending and queued transactions in the pool
+func (t *TxPool) Stats() (int, int) {
+	return t.pool.Stats()
+}
+
 func (t *TxPool) Running() bool {
 	return t.running
 }
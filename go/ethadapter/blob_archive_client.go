@@ -60,6 +60,10 @@ func (ac *ArchivalHTTPClient) BeaconBlobSidecars(ctx context.Context, _ uint64,
 	return resp, nil
 }
 
+func (ac *ArchivalHTTPClient) String() string {
+	return "archive:" + ac.httpClient.Host()
+}
+
 func (ac *ArchivalHTTPClient) request(ctx context.Context, dest any, reqPath string) error {
 	return ac.httpClient.Request(ctx, dest, reqPath, nil)
 }
@@ -39,7 +39,7 @@ type BlobRetrievalService interface {
 
 type L1BeaconClient struct {
 	cl             BeaconClient
-	pool           *ClientPool[BlobRetrievalService]
+	sources        *blobSources
 	initLock       sync.Mutex
 	genesisTime    uint64
 	secondsPerSlot uint64
@@ -60,6 +60,10 @@ func NewBeaconHTTPClient(client *http.Client, baseURL string) *BeaconHTTPClient
 	}
 }
 
+func (bc *BeaconHTTPClient) String() string {
+	return "beacon:" + bc.httpClient.Host()
+}
+
 func (bc *BeaconHTTPClient) request(ctx context.Context, dest any, reqPath string, reqQuery url.Values) error {
 	return bc.httpClient.Request(ctx, dest, reqPath, reqQuery)
 }
@@ -129,16 +133,20 @@ func (p *ClientPool[T]) Next() {
 }
 
 // NewL1BeaconClient returns a client for making requests to an L1 consensus layer node.
-// Fallbacks are optional clients that will be used for fetching blobs. L1BeaconClient will rotate between
-// the `cl` and the fallbacks whenever a client runs into an error while fetching blobs.
+// Fallbacks are optional clients that will be used for fetching blobs. Blobs are requested from `cl` and then from
+// each fallback in order, with sources that keep failing tried last until they recover.
 func NewL1BeaconClient(cl BeaconClient, fallbacks ...BlobRetrievalService) *L1BeaconClient {
-	cs := append([]BlobRetrievalService{cl}, fallbacks...)
 	return &L1BeaconClient{
-		cl:   cl,
-		pool: NewClientPool[BlobRetrievalService](cs...),
+		cl:      cl,
+		sources: newBlobSources(cl, fallbacks...),
 	}
 }
 
+// SourcesHealth returns the health of the blob sources, in their configured order
+func (cl *L1BeaconClient) SourcesHealth() []BlobSourceHealth {
+	return cl.sources.health()
+}
+
 func (cl *L1BeaconClient) Init(ctx context.Context) error {
 	genesis, err := cl.cl.BeaconGenesis(ctx)
 	if err != nil {
@@ -182,17 +190,26 @@ func (cl *L1BeaconClient) GetTimeToSlot(ctx context.Context) (TimeToSlot, error)
 	return cl.timeToSlotFn, nil
 }
 
-func (cl *L1BeaconClient) fetchSidecars(ctx context.Context, slot uint64, hashes []gethcommon.Hash) (APIGetBlobSidecarsResponse, error) {
+// fetchSidecars tries each source in turn until one returns all the requested sidecars. If the slot could not be
+// computed (slotErr is set), only the sources that look blobs up by versioned hash are tried.
+func (cl *L1BeaconClient) fetchSidecars(ctx context.Context, slot uint64, slotErr error, hashes []gethcommon.Hash) (APIGetBlobSidecarsResponse, error) {
 	var errs []error
-	for i := 0; i < cl.pool.Len(); i++ {
-		f := cl.pool.Get()
-		resp, err := f.BeaconBlobSidecars(ctx, slot, hashes)
+	for _, src := range cl.sources.ordered() {
+		if src.needsSlot && slotErr != nil {
+			cl.sources.record(src, slotErr)
+			errs = append(errs, fmt.Errorf("%s: %w", src.name, slotErr))
+			continue
+		}
+		resp, err := src.service.BeaconBlobSidecars(ctx, slot, hashes)
+		if err == nil {
+			_, err = MatchSidecarsWithHashes(resp.Data, hashes)
+		}
+		cl.sources.record(src, err)
 		if err != nil {
-			cl.pool.Next()
-			errs = append(errs, err)
-		} else {
-			return resp, nil
+			errs = append(errs, fmt.Errorf("%s: %w", src.name, err))
+			continue
 		}
+		return resp, nil
 	}
 	return APIGetBlobSidecarsResponse{}, errors.Join(errs...)
 }
@@ -203,16 +220,18 @@ func (cl *L1BeaconClient) GetBlobSidecars(ctx context.Context, b *types.Header,
 	if len(hashes) == 0 {
 		return []*BlobSidecar{}, nil
 	}
-	slotFn, err := cl.GetTimeToSlot(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get time to slot function: %w", err)
-	}
-	slot, err := slotFn(b.Time)
-	if err != nil {
-		return nil, fmt.Errorf("error in converting b.Time to slot: %w", err)
+	var slot uint64
+	slotFn, slotErr := cl.GetTimeToSlot(ctx)
+	if slotErr != nil {
+		slotErr = fmt.Errorf("failed to get time to slot function: %w", slotErr)
+	} else {
+		slot, slotErr = slotFn(b.Time)
+		if slotErr != nil {
+			slotErr = fmt.Errorf("error in converting b.Time to slot: %w", slotErr)
+		}
 	}
 
-	resp, err := cl.fetchSidecars(ctx, slot, hashes)
+	resp, err := cl.fetchSidecars(ctx, slot, slotErr, hashes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch blob sidecars for slot %v block %v: %w", slot, b, err)
 	}
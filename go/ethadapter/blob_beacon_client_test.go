@@ -161,7 +161,7 @@ func TestBeaconClientFallback(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sidecars, resp)
 
-	mockFallback.On("BeaconBlobSidecars", ctx, uint64(2), hashes).Return(APIGetBlobSidecarsResponse{}, errors.New("404 not found"))
+	// a single failure does not demote the primary, so it is still tried first
 	mockPrimary.On("BeaconBlobSidecars", ctx, uint64(2), hashes).Return(APIGetBlobSidecarsResponse{Data: sidecars}, nil)
 
 	header = &types.Header{Time: 14}
@@ -171,6 +171,38 @@ func TestBeaconClientFallback(t *testing.T) {
 
 	mockPrimary.AssertExpectations(t)
 	mockFallback.AssertExpectations(t)
+	mockFallback.AssertNumberOfCalls(t, "BeaconBlobSidecars", 1)
+}
+
+func TestBeaconClientDemotesUnhealthySource(t *testing.T) {
+	hash0, sidecar0 := makeTestBlobSidecar(1)
+	hashes := []gethcommon.Hash{hash0}
+	sidecars := []*BlobSidecar{sidecar0}
+	ctx := context.Background()
+
+	mockPrimary := &MockBeaconClient{}
+	mockFallback := &MockBlobRetrievalService{}
+	client := NewL1BeaconClient(mockPrimary, mockFallback)
+
+	mockPrimary.On("BeaconGenesis", ctx).Return(APIGenesisResponse{Data: ReducedGenesisData{GenesisTime: 10}}, nil)
+	mockPrimary.On("ConfigSpec", ctx).Return(APIConfigResponse{Data: ReducedConfigData{SecondsPerSlot: 2}}, nil)
+	mockPrimary.On("BeaconBlobSidecars", ctx, mock.Anything, hashes).Return(APIGetBlobSidecarsResponse{}, errors.New("503 unavailable"))
+	mockFallback.On("BeaconBlobSidecars", ctx, mock.Anything, hashes).Return(APIGetBlobSidecarsResponse{Data: sidecars}, nil)
+
+	for i := 0; i < blobSourceMaxFailures+2; i++ {
+		_, err := client.GetBlobSidecars(ctx, &types.Header{Time: uint64(12 + 2*i)}, hashes)
+		require.NoError(t, err)
+	}
+
+	// once unhealthy, the primary is no longer tried ahead of the fallback
+	mockPrimary.AssertNumberOfCalls(t, "BeaconBlobSidecars", blobSourceMaxFailures)
+	health := client.SourcesHealth()
+	require.Len(t, health, 2)
+	require.False(t, health[0].Healthy)
+	require.Equal(t, blobSourceMaxFailures, health[0].ConsecutiveFailures)
+	require.Contains(t, health[0].LastError, "503 unavailable")
+	require.True(t, health[1].Healthy)
+	require.False(t, health[1].LastSuccess.IsZero())
 }
 
 // MockBeaconClient is a mock implementation used only in these tests
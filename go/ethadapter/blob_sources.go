@@ -0,0 +1,117 @@
+package ethadapter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// blobSourceMaxFailures is the number of consecutive failures after which a source is considered unhealthy
+	blobSourceMaxFailures = 3
+	// blobSourceCooldown is how long an unhealthy source is demoted behind the healthy ones before it is retried in order
+	blobSourceCooldown = time.Minute
+)
+
+// BlobSourceHealth is a snapshot of the health of one of the sources blobs are fetched from
+type BlobSourceHealth struct {
+	Name                string    `json:"name"`
+	Healthy             bool      `json:"healthy"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastError           string    `json:"lastError,omitempty"`
+	LastSuccess         time.Time `json:"lastSuccess"`
+	LastFailure         time.Time `json:"lastFailure"`
+}
+
+type blobSource struct {
+	name    string
+	service BlobRetrievalService
+	// needsSlot is set for sources which look blobs up by beacon slot rather than by versioned hash
+	needsSlot bool
+
+	consecutiveFailures int
+	lastErr             error
+	lastSuccess         time.Time
+	lastFailure         time.Time
+}
+
+func (s *blobSource) healthy(now time.Time) bool {
+	return s.consecutiveFailures < blobSourceMaxFailures || now.Sub(s.lastFailure) > blobSourceCooldown
+}
+
+// blobSources holds the blob retrieval services in their configured order of preference.
+// Sources which keep failing are moved behind the healthy ones until their cooldown expires, but are never dropped,
+// so a blob can still be fetched when every source is unhealthy.
+type blobSources struct {
+	mu      sync.Mutex
+	sources []*blobSource
+}
+
+func newBlobSources(primary BeaconClient, fallbacks ...BlobRetrievalService) *blobSources {
+	sources := []*blobSource{{name: sourceName(primary, "beacon"), service: primary, needsSlot: true}}
+	for i, f := range fallbacks {
+		sources = append(sources, &blobSource{name: sourceName(f, fmt.Sprintf("fallback-%d", i+1)), service: f})
+	}
+	return &blobSources{sources: sources}
+}
+
+func sourceName(service any, defaultName string) string {
+	if s, ok := service.(fmt.Stringer); ok && s.String() != "" {
+		return s.String()
+	}
+	return defaultName
+}
+
+// ordered returns the healthy sources followed by the unhealthy ones, each group in the configured order
+func (s *blobSources) ordered() []*blobSource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*blobSource, 0, len(s.sources))
+	var unhealthy []*blobSource
+	for _, src := range s.sources {
+		if src.healthy(now) {
+			healthy = append(healthy, src)
+		} else {
+			unhealthy = append(unhealthy, src)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (s *blobSources) record(src *blobSource, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		src.consecutiveFailures++
+		src.lastErr = err
+		src.lastFailure = time.Now()
+		return
+	}
+	src.consecutiveFailures = 0
+	src.lastErr = nil
+	src.lastSuccess = time.Now()
+}
+
+func (s *blobSources) health() []BlobSourceHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	health := make([]BlobSourceHealth, len(s.sources))
+	for i, src := range s.sources {
+		health[i] = BlobSourceHealth{
+			Name:                src.name,
+			Healthy:             src.healthy(now),
+			ConsecutiveFailures: src.consecutiveFailures,
+			LastSuccess:         src.lastSuccess,
+			LastFailure:         src.lastFailure,
+		}
+		if src.lastErr != nil {
+			health[i].LastError = src.lastErr.Error()
+		}
+	}
+	return health
+}
@@ -0,0 +1,83 @@
+package da
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// BlobFetcher retrieves blobs from the consensus layer or a blob archive
+type BlobFetcher interface {
+	FetchBlobs(ctx context.Context, b *types.Header, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error)
+}
+
+// blobLayer publishes rollups in EIP-4844 blobs
+type blobLayer struct {
+	fetcher BlobFetcher
+}
+
+func NewBlobLayer(fetcher BlobFetcher) Layer {
+	return &blobLayer{fetcher: fetcher}
+}
+
+func (b *blobLayer) Mode() common.DAMode {
+	return common.BlobDA
+}
+
+func (b *blobLayer) CreateRollupTx(_ context.Context, to gethcommon.Address, callData []byte, rollup common.EncodedRollup) (types.TxData, error) {
+	blobs, err := ethadapter.EncodeBlobs(rollup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode rollup to blobs: %w", err)
+	}
+
+	sidecar, blobHashes, err := ethadapter.MakeSidecar(blobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make sidecar: %w", err)
+	}
+
+	return &types.BlobTx{
+		To:         to,
+		Data:       callData,
+		BlobHashes: blobHashes,
+		Sidecar:    sidecar,
+	}, nil
+}
+
+func (b *blobLayer) FetchPayload(ctx context.Context, block *types.Header, ref *ethadapter.L1RollupHashes) (*Payload, error) {
+	if b.fetcher == nil {
+		return nil, errors.New("blob layer has no blob fetcher")
+	}
+	blobs, err := b.fetcher.FetchBlobs(ctx, block, ref.BlobHashes)
+	if err != nil {
+		return nil, err
+	}
+	return &Payload{Blobs: blobs}, nil
+}
+
+func (b *blobLayer) ReadRollup(ref *ethadapter.L1RollupHashes, payload *Payload) (common.EncodedRollup, error) {
+	if payload == nil || len(payload.Blobs) != len(ref.BlobHashes) {
+		return nil, fmt.Errorf("expected %d blobs for rollup", len(ref.BlobHashes))
+	}
+	// only the commitments are needed to check the versioned hashes, computing the proofs is unnecessary
+	for i, hash := range ref.BlobHashes {
+		commitment, err := kzg4844.BlobToCommitment(payload.Blobs[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute KZG commitment of blob %d. Cause: %w", i, err)
+		}
+		if blobHash := ethadapter.KZGToVersionedHash(commitment); hash != blobHash {
+			return nil, fmt.Errorf("hash mismatch at index %d: rollupHash (%s) != blobHash (%s)", i, hash.Hex(), blobHash.Hex())
+		}
+	}
+
+	data, err := ethadapter.DecodeBlobs(payload.Blobs)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode rollup blobs. Cause: %w", err)
+	}
+	return data, nil
+}
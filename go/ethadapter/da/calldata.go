@@ -0,0 +1,41 @@
+package da
+
+import (
+	"context"
+	"errors"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// calldataLayer appends the rollup to the calldata of the rollup transaction, for L1s that don't support EIP-4844
+type calldataLayer struct{}
+
+func NewCalldataLayer() Layer {
+	return &calldataLayer{}
+}
+
+func (c *calldataLayer) Mode() common.DAMode {
+	return common.CalldataDA
+}
+
+func (c *calldataLayer) CreateRollupTx(_ context.Context, to gethcommon.Address, callData []byte, rollup common.EncodedRollup) (types.TxData, error) {
+	return &types.LegacyTx{
+		To:   &to,
+		Data: AppendReference(callData, common.CalldataDA, rollup),
+	}, nil
+}
+
+// FetchPayload returns an empty payload, since the rollup is part of the transaction
+func (c *calldataLayer) FetchPayload(context.Context, *types.Header, *ethadapter.L1RollupHashes) (*Payload, error) {
+	return &Payload{}, nil
+}
+
+func (c *calldataLayer) ReadRollup(ref *ethadapter.L1RollupHashes, _ *Payload) (common.EncodedRollup, error) {
+	if len(ref.Calldata) == 0 {
+		return nil, errors.New("rollup transaction does not contain the rollup calldata")
+	}
+	return ref.Calldata, nil
+}
@@ -0,0 +1,105 @@
+package da
+
+import (
+	"context"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// Layer is a data availability backend for rollups. It is shared by the host, which publishes rollups and retrieves
+// their payload, and the enclave, which checks the payload against the reference recorded on the L1.
+type Layer interface {
+	// Mode returns the DA mode implemented by the layer
+	Mode() common.DAMode
+	// CreateRollupTx returns the L1 transaction which calls the management contract with callData and makes the
+	// rollup available on this layer
+	CreateRollupTx(ctx context.Context, to gethcommon.Address, callData []byte, rollup common.EncodedRollup) (types.TxData, error)
+	// FetchPayload retrieves the data referenced by a rollup transaction, which is not part of the transaction itself
+	FetchPayload(ctx context.Context, block *types.Header, ref *ethadapter.L1RollupHashes) (*Payload, error)
+	// ReadRollup checks the payload against the reference and returns the encoded rollup
+	ReadRollup(ref *ethadapter.L1RollupHashes, payload *Payload) (common.EncodedRollup, error)
+}
+
+// Payload is the rollup data retrieved by the host, which is passed to the enclave together with the L1 transaction
+type Payload struct {
+	Blobs []*kzg4844.Blob
+	Data  []byte
+}
+
+// Layers holds a layer for each supported mode, so rollups can be read back whatever mode they were published with
+type Layers map[common.DAMode]Layer
+
+func NewLayers(layers ...Layer) Layers {
+	l := make(Layers, len(layers))
+	for _, layer := range layers {
+		l[layer.Mode()] = layer
+	}
+	return l
+}
+
+// NewReadOnlyLayers returns the layers used to verify rollup payloads. They can't create or fetch any data.
+func NewReadOnlyLayers() Layers {
+	return NewLayers(NewBlobLayer(nil), NewCalldataLayer(), NewExternalLayer(nil))
+}
+
+func (l Layers) Get(mode common.DAMode) (Layer, error) {
+	layer, ok := l[mode]
+	if !ok {
+		return nil, fmt.Errorf("no data availability layer configured for mode %s", mode)
+	}
+	return layer, nil
+}
+
+// ReadRollup checks and decodes the rollup referenced by an L1 transaction. The DA mode recorded in the signed
+// rollup header must match the one it was published with.
+func (l Layers) ReadRollup(ref *ethadapter.L1RollupHashes, payload *Payload) (*common.ExtRollup, error) {
+	layer, err := l.Get(ref.DAMode)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := layer.ReadRollup(ref, payload)
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := common.DecodeRollup(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode rollup. Cause: %w", err)
+	}
+	if rollup.Header.DAMode != ref.DAMode {
+		return nil, fmt.Errorf("rollup %s was published with DA mode %s but its header records %s", rollup.Hash(), ref.DAMode, rollup.Header.DAMode)
+	}
+	return rollup, nil
+}
+
+// AppendReference appends the DA mode and the rollup reference to the management contract call data.
+// The contract ignores the trailing bytes, but they are part of the transaction, so they are available to validators.
+func AppendReference(callData []byte, mode common.DAMode, reference []byte) []byte {
+	data := make([]byte, 0, len(callData)+1+len(reference))
+	data = append(data, callData...)
+	data = append(data, byte(mode))
+	return append(data, reference...)
+}
+
+// DecodeReference parses the bytes appended to the management contract call data by AppendReference
+func DecodeReference(suffix []byte) (*ethadapter.L1RollupHashes, error) {
+	if len(suffix) == 0 {
+		return nil, fmt.Errorf("missing rollup reference")
+	}
+	mode, reference := common.DAMode(suffix[0]), suffix[1:]
+	switch mode {
+	case common.CalldataDA:
+		return &ethadapter.L1RollupHashes{DAMode: mode, Calldata: reference}, nil
+	case common.ExternalDA:
+		if len(reference) != gethcommon.HashLength {
+			return nil, fmt.Errorf("invalid external rollup reference length %d", len(reference))
+		}
+		return &ethadapter.L1RollupHashes{DAMode: mode, DataHash: gethcommon.BytesToHash(reference)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported DA mode %d in rollup reference", mode)
+	}
+}
@@ -0,0 +1,176 @@
+package da
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+var (
+	contractAddr = gethcommon.HexToAddress("0x1234")
+	callData     = []byte{0xde, 0xad, 0xbe, 0xef}
+)
+
+func TestCalldataLayerRoundTrip(t *testing.T) {
+	layer := NewCalldataLayer()
+	rollup, encoded := createRollup(t, common.CalldataDA)
+
+	txData, err := layer.CreateRollupTx(context.Background(), contractAddr, callData, encoded)
+	require.NoError(t, err)
+	ref := decodeReference(t, txData)
+	require.Equal(t, common.CalldataDA, ref.DAMode)
+
+	payload, err := layer.FetchPayload(context.Background(), nil, ref)
+	require.NoError(t, err)
+	read, err := NewReadOnlyLayers().ReadRollup(ref, payload)
+	require.NoError(t, err)
+	require.Equal(t, rollup.Hash(), read.Hash())
+}
+
+func TestExternalLayerRoundTrip(t *testing.T) {
+	layer := NewExternalLayer(NewFileStore(t.TempDir()))
+	rollup, encoded := createRollup(t, common.ExternalDA)
+
+	txData, err := layer.CreateRollupTx(context.Background(), contractAddr, callData, encoded)
+	require.NoError(t, err)
+	ref := decodeReference(t, txData)
+	require.Equal(t, crypto.Keccak256Hash(encoded), ref.DataHash)
+
+	payload, err := layer.FetchPayload(context.Background(), nil, ref)
+	require.NoError(t, err)
+	read, err := NewReadOnlyLayers().ReadRollup(ref, payload)
+	require.NoError(t, err)
+	require.Equal(t, rollup.Hash(), read.Hash())
+
+	// a payload which does not match the published hash is rejected
+	_, err = NewReadOnlyLayers().ReadRollup(ref, &Payload{Data: append(encoded, 0)})
+	require.Error(t, err)
+}
+
+func TestBlobLayerRoundTrip(t *testing.T) {
+	layer := NewBlobLayer(nil)
+	rollup, encoded := createRollup(t, common.BlobDA)
+
+	txData, err := layer.CreateRollupTx(context.Background(), contractAddr, callData, encoded)
+	require.NoError(t, err)
+	blobTx := txData.(*types.BlobTx)
+	ref := &ethadapter.L1RollupHashes{DAMode: common.BlobDA, BlobHashes: blobTx.BlobHashes}
+
+	payload := &Payload{}
+	for i := range blobTx.Sidecar.Blobs {
+		payload.Blobs = append(payload.Blobs, &blobTx.Sidecar.Blobs[i])
+	}
+	read, err := NewReadOnlyLayers().ReadRollup(ref, payload)
+	require.NoError(t, err)
+	require.Equal(t, rollup.Hash(), read.Hash())
+}
+
+func TestRollupHeaderMustMatchDAMode(t *testing.T) {
+	// a rollup signed for the blob layer can't be republished in calldata
+	_, encoded := createRollup(t, common.BlobDA)
+	txData, err := NewCalldataLayer().CreateRollupTx(context.Background(), contractAddr, callData, encoded)
+	require.NoError(t, err)
+
+	_, err = NewReadOnlyLayers().ReadRollup(decodeReference(t, txData), &Payload{})
+	require.Error(t, err)
+}
+
+func TestHTTPStoreServesFileStore(t *testing.T) {
+	server := httptest.NewServer(NewStoreHandler(NewFileStore(t.TempDir())))
+	defer server.Close()
+
+	store, err := NewStore(server.URL, http.DefaultClient)
+	require.NoError(t, err)
+
+	data := []byte("rollup")
+	hash := crypto.Keccak256Hash(data)
+	require.NoError(t, store.Put(context.Background(), hash, data))
+	stored, err := store.Get(context.Background(), hash)
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+
+	_, err = store.Get(context.Background(), crypto.Keccak256Hash([]byte("unknown")))
+	require.True(t, errors.Is(err, ethereum.NotFound))
+
+	// the handler only accepts data stored under its own hash
+	require.Error(t, store.Put(context.Background(), hash, []byte("other")))
+}
+
+func TestS3StoreSignsPathStyleRequests(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		payloadHash := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(payloadHash[:]) ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+			!strings.Contains(r.Header.Get("Authorization"), "/eu-west-1/s3/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		}
+	}))
+	defer server.Close()
+
+	store, err := NewStore("s3://"+strings.TrimPrefix(server.URL, "http://")+"/rollups/testnet?region=eu-west-1&insecure=true", http.DefaultClient)
+	require.NoError(t, err)
+
+	data := []byte("rollup")
+	hash := crypto.Keccak256Hash(data)
+	require.NoError(t, store.Put(context.Background(), hash, data))
+	require.Contains(t, objects, "/rollups/testnet/"+hash.Hex()+rollupFileExtension)
+	stored, err := store.Get(context.Background(), hash)
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+
+	_, err = store.Get(context.Background(), crypto.Keccak256Hash([]byte("unknown")))
+	require.True(t, errors.Is(err, ethereum.NotFound))
+}
+
+func createRollup(t *testing.T, mode common.DAMode) (*common.ExtRollup, common.EncodedRollup) {
+	rollup := &common.ExtRollup{
+		Header: &common.RollupHeader{
+			LastBatchSeqNo: 10,
+			DAMode:         mode,
+		},
+		BatchPayloads: []byte("batches"),
+	}
+	encoded, err := common.EncodeRollup(rollup)
+	require.NoError(t, err)
+	return rollup, encoded
+}
+
+func decodeReference(t *testing.T, txData types.TxData) *ethadapter.L1RollupHashes {
+	tx := types.NewTx(txData)
+	require.Equal(t, contractAddr, *tx.To())
+	require.Equal(t, callData, tx.Data()[:len(callData)])
+	ref, err := DecodeReference(tx.Data()[len(callData):])
+	require.NoError(t, err)
+	return ref
+}
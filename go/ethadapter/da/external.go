@@ -0,0 +1,62 @@
+package da
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// externalLayer stores the rollup in a Store and only publishes its hash on the L1.
+// It is a stand-in for a dedicated DA network, to be used in development.
+type externalLayer struct {
+	store Store
+}
+
+func NewExternalLayer(store Store) Layer {
+	return &externalLayer{store: store}
+}
+
+func (e *externalLayer) Mode() common.DAMode {
+	return common.ExternalDA
+}
+
+func (e *externalLayer) CreateRollupTx(ctx context.Context, to gethcommon.Address, callData []byte, rollup common.EncodedRollup) (types.TxData, error) {
+	if e.store == nil {
+		return nil, errors.New("external DA layer has no store")
+	}
+	hash := crypto.Keccak256Hash(rollup)
+	if err := e.store.Put(ctx, hash, rollup); err != nil {
+		return nil, fmt.Errorf("could not store rollup in external DA layer. Cause: %w", err)
+	}
+	return &types.LegacyTx{
+		To:   &to,
+		Data: AppendReference(callData, common.ExternalDA, hash.Bytes()),
+	}, nil
+}
+
+func (e *externalLayer) FetchPayload(ctx context.Context, _ *types.Header, ref *ethadapter.L1RollupHashes) (*Payload, error) {
+	if e.store == nil {
+		return nil, errors.New("external DA layer has no store")
+	}
+	data, err := e.store.Get(ctx, ref.DataHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch rollup %s from external DA layer. Cause: %w", ref.DataHash, err)
+	}
+	return &Payload{Data: data}, nil
+}
+
+func (e *externalLayer) ReadRollup(ref *ethadapter.L1RollupHashes, payload *Payload) (common.EncodedRollup, error) {
+	if payload == nil || len(payload.Data) == 0 {
+		return nil, fmt.Errorf("missing external payload for rollup %s", ref.DataHash)
+	}
+	if hash := crypto.Keccak256Hash(payload.Data); hash != ref.DataHash {
+		return nil, fmt.Errorf("external payload hash %s does not match the published hash %s", hash, ref.DataHash)
+	}
+	return payload.Data, nil
+}
@@ -0,0 +1,159 @@
+package da
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	s3Scheme        = "s3://"
+	s3DefaultRegion = "us-east-1"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3SignedHeaders = "host;x-amz-content-sha256;x-amz-date"
+)
+
+// s3Store keeps each rollup as an object of a bucket on an S3-compatible endpoint (e.g. AWS S3 or MinIO). The objects
+// are addressed in the path style, and the requests are signed with the AWS signature version 4.
+type s3Store struct {
+	client    *http.Client
+	endpoint  string // scheme://host[:port]
+	bucket    string
+	prefix    string // prepended to the object keys, without the trailing slash
+	region    string
+	accessKey string
+	secretKey string
+}
+
+// NewS3Store parses an url of the form s3://host[:port]/bucket[/prefix][?region=<region>&insecure=true]. The
+// credentials are read from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+func NewS3Store(client *http.Client, storeURL string) (Store, error) {
+	u, err := url.Parse(storeURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 store url. Cause: %w", err)
+	}
+	bucket, prefix, _ := strings.Cut(strings.Trim(u.Path, "/"), "/")
+	if u.Host == "" || bucket == "" {
+		return nil, fmt.Errorf("S3 store url must be of the form %shost/bucket[/prefix]", s3Scheme)
+	}
+	accessKey, secretKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to use an S3 store")
+	}
+
+	scheme := "https"
+	if u.Query().Get("insecure") == "true" {
+		scheme = "http"
+	}
+	region := u.Query().Get("region")
+	if region == "" {
+		region = s3DefaultRegion
+	}
+	return &s3Store{
+		client:    client,
+		endpoint:  scheme + "://" + u.Host,
+		bucket:    bucket,
+		prefix:    strings.Trim(prefix, "/"),
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, hash gethcommon.Hash, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, hash, data)
+	if err != nil {
+		return fmt.Errorf("S3 Put failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		errMsg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed request with status %d: %s", resp.StatusCode, string(errMsg))
+	}
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, hash gethcommon.Hash) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, hash, nil)
+	if err != nil {
+		return nil, fmt.Errorf("S3 Get failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ethereum.NotFound
+	} else if resp.StatusCode != http.StatusOK {
+		errMsg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed request with status %d: %s", resp.StatusCode, string(errMsg))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (s *s3Store) do(ctx context.Context, method string, hash gethcommon.Hash, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+s.objectPath(hash), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	s.sign(req, body, time.Now())
+	return s.client.Do(req)
+}
+
+func (s *s3Store) objectPath(hash gethcommon.Hash) string {
+	key := hash.Hex() + rollupFileExtension
+	if s.prefix != "" {
+		key = s.prefix + "/" + key
+	}
+	return "/" + s.bucket + "/" + key
+}
+
+// sign adds the AWS signature version 4 headers to a request without query parameters
+func (s *s3Store) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method, req.URL.EscapedPath(), "", canonicalHeaders, s3SignedHeaders, payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, scope, s3SignedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,175 @@
+package da
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const rollupFileExtension = ".rollup"
+
+// Store holds the rollups published to the external DA layer, keyed by the hash of their encoding
+type Store interface {
+	Put(ctx context.Context, hash gethcommon.Hash, data []byte) error
+	// Get returns ethereum.NotFound if there is no data stored for the hash
+	Get(ctx context.Context, hash gethcommon.Hash) ([]byte, error)
+}
+
+// NewStore returns an HTTP store for http(s) URLs, an S3 store for s3:// URLs and a file store for anything else
+// (optionally prefixed by file://)
+func NewStore(url string, client *http.Client) (Store, error) {
+	if url == "" {
+		return nil, errors.New("no store url configured")
+	}
+	if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+		return NewHTTPStore(client, url), nil
+	}
+	if strings.HasPrefix(url, s3Scheme) {
+		return NewS3Store(client, url)
+	}
+	return NewFileStore(strings.TrimPrefix(url, "file://")), nil
+}
+
+// fileStore keeps each rollup in its own file
+type fileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+func (f *fileStore) Put(_ context.Context, hash gethcommon.Hash, data []byte) error {
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return fmt.Errorf("could not create store directory. Cause: %w", err)
+	}
+	// write to a temporary file first, so readers never see a partial rollup
+	tmp, err := os.CreateTemp(f.dir, hash.Hex()+"-*")
+	if err != nil {
+		return fmt.Errorf("could not create rollup file. Cause: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write rollup file. Cause: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("could not write rollup file. Cause: %w", err)
+	}
+	return os.Rename(tmp.Name(), f.path(hash))
+}
+
+func (f *fileStore) Get(_ context.Context, hash gethcommon.Hash) ([]byte, error) {
+	data, err := os.ReadFile(f.path(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ethereum.NotFound
+	}
+	return data, err
+}
+
+func (f *fileStore) path(hash gethcommon.Hash) string {
+	return filepath.Join(f.dir, hash.Hex()+rollupFileExtension)
+}
+
+// httpStore reads and writes rollups at <baseURL>/<hash>
+type httpStore struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewHTTPStore(client *http.Client, baseURL string) Store {
+	return &httpStore{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (h *httpStore) Put(ctx context.Context, hash gethcommon.Hash, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, h.url(hash), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http Put failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		errMsg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed request with status %d: %s", resp.StatusCode, string(errMsg))
+	}
+	return nil
+}
+
+func (h *httpStore) Get(ctx context.Context, hash gethcommon.Hash) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url(hash), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http Get failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ethereum.NotFound
+	} else if resp.StatusCode != http.StatusOK {
+		errMsg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed request with status %d: %s", resp.StatusCode, string(errMsg))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (h *httpStore) url(hash gethcommon.Hash) string {
+	return h.baseURL + "/" + hash.Hex()
+}
+
+// NewStoreHandler serves a store over HTTP, in the format expected by the HTTP store
+func NewStoreHandler(store Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hashHex := strings.TrimPrefix(r.URL.Path, "/")
+		if len(hashHex) != 2*gethcommon.HashLength+2 || !strings.HasPrefix(hashHex, "0x") {
+			http.Error(w, "invalid rollup hash", http.StatusBadRequest)
+			return
+		}
+		hash := gethcommon.HexToHash(hashHex)
+
+		switch r.Method {
+		case http.MethodGet:
+			data, err := store.Get(r.Context(), hash)
+			if errors.Is(err, ethereum.NotFound) {
+				http.Error(w, "rollup not found", http.StatusNotFound)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if crypto.Keccak256Hash(data) != hash {
+				http.Error(w, "rollup does not match its hash", http.StatusBadRequest)
+				return
+			}
+			if err = store.Put(r.Context(), hash, data); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
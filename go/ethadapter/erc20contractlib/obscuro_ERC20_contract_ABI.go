@@ -25,6 +25,7 @@ var obscuroERC20ContractABIJSON = abi.ABI{}
 const (
 	TransferFunction  = "transfer"
 	BalanceOfFunction = "balanceOf"
+	DecimalsFunction  = "decimals"
 	AmountField       = "amount"
 	ToField           = "to"
 )
@@ -68,3 +69,11 @@ func CreateBalanceOfData(address gethcommon.Address) []byte {
 	}
 	return balanceData
 }
+
+func CreateDecimalsData() []byte {
+	decimalsData, err := obscuroERC20ContractABIJSON.Pack(DecimalsFunction)
+	if err != nil {
+		panic(err)
+	}
+	return decimalsData
+}
@@ -22,6 +22,19 @@ func NewBaseHTTPClient(client *http.Client, baseURL string) *BaseHTTPClient {
 	return &BaseHTTPClient{client: client, baseURL: baseURL}
 }
 
+// Host returns the host of the base URL, leaving out any path or query which might hold credentials
+func (chc *BaseHTTPClient) Host() string {
+	base := chc.baseURL
+	if !strings.HasPrefix(base, "http://") && !strings.HasPrefix(base, "https://") {
+		base = "http://" + base
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	return baseURL.Host
+}
+
 func (chc *BaseHTTPClient) Request(ctx context.Context, dest any, reqPath string, reqQuery url.Values) error {
 	base := chc.baseURL
 	if !strings.HasPrefix(base, "http://") && !strings.HasPrefix(base, "https://") {
@@ -6,7 +6,9 @@ import (
 	"math/big"
 
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/signature"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -19,8 +21,12 @@ type L1RollupTx struct {
 	Rollup common.EncodedRollup
 }
 
+// L1RollupHashes references the payload of a rollup published on the L1. Which fields are set depends on the DA mode.
 type L1RollupHashes struct {
-	BlobHashes []gethcommon.Hash
+	DAMode     common.DAMode
+	BlobHashes []gethcommon.Hash    // the versioned hashes of the blobs carrying the rollup
+	Calldata   common.EncodedRollup // the rollup appended to the transaction calldata
+	DataHash   gethcommon.Hash      // the hash of the rollup stored on an external DA layer
 }
 
 type L1DepositTx struct {
@@ -67,6 +73,65 @@ func (l *L1RespondSecretTx) Sign(privateKey *ecdsa.PrivateKey) *L1RespondSecretT
 	return l
 }
 
+// L1RotateSecretTx distributes a new generation of the network secret to an attested enclave
+type L1RotateSecretTx struct {
+	Secret           []byte
+	RequesterID      gethcommon.Address
+	AttesterID       gethcommon.Address
+	AttesterSig      []byte
+	Generation       uint64 // the generation of the secret, the initial secret being generation 0
+	ActivationHeight uint64 // the L1 height from which batches and rollups switch to this generation
+}
+
+// Sign signs the payload with a given private key
+func (l *L1RotateSecretTx) Sign(privateKey *ecdsa.PrivateKey) *L1RotateSecretTx {
+	signedHash, err := crypto.Sign(l.payloadHash(), privateKey)
+	if err != nil {
+		return nil
+	}
+
+	// set recovery id to 27; prevent malleable signatures
+	signedHash[64] += 27
+	l.AttesterSig = signedHash
+	return l
+}
+
+// Signer returns the enclave that signed the payload
+func (l *L1RotateSecretTx) Signer() (gethcommon.Address, error) {
+	// the recovery removes the offset of the recovery id in place, so it works on a copy of the signature
+	signer, err := signature.RecoverAddress(l.payloadHash(), gethcommon.CopyBytes(l.AttesterSig))
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("could not recover the attester - %w", err)
+	}
+	return *signer, nil
+}
+
+// payloadHash is the hash signed by the attester, the management contract verifies the signature over the same payload
+func (l *L1RotateSecretTx) payloadHash() []byte {
+	var data []byte
+	data = append(data, l.AttesterID.Bytes()...)
+	data = append(data, l.RequesterID.Bytes()...)
+	data = append(data, gethcommon.BigToHash(new(big.Int).SetUint64(l.Generation)).Bytes()...)
+	data = append(data, gethcommon.BigToHash(new(big.Int).SetUint64(l.ActivationHeight)).Bytes()...)
+	data = append(data, l.Secret...)
+	return accounts.TextHash(data)
+}
+
+// L1CrossChainBundleTx publishes the cross chain roots of a range of batches, making their messages provable on the L1
+type L1CrossChainBundleTx struct {
+	CrossChainRootHashes common.CrossChainRootHashes
+}
+
+// L1RequestSecretRotationTx is issued by the management contract owner to ask for a new generation of the network secret
+type L1RequestSecretRotationTx struct {
+	ActivationHeight uint64
+}
+
+// L1RevokeEnclaveTx is issued by the management contract owner to revoke the attestation of a compromised enclave
+type L1RevokeEnclaveTx struct {
+	EnclaveID gethcommon.Address
+}
+
 type L1RequestSecretTx struct {
 	Attestation common.EncodedAttestationReport
 }
@@ -7,10 +7,24 @@ const (
 	RespondSecretMethod            = "RespondNetworkSecret"
 	RequestSecretMethod            = "RequestNetworkSecret"
 	InitializeSecretMethod         = "InitializeNetworkSecret" //#nosec
+	RotateSecretMethod             = "RotateNetworkSecret"
+	RequestSecretRotationMethod    = "RequestSecretRotation"
+	RevokeEnclaveMethod            = "RevokeEnclave"
+	AddCrossChainRootsMethod       = "addCrossChainMessagesRoot"
 	GetHostAddressesMethod         = "GetHostAddresses"
 	GetImportantContractKeysMethod = "GetImportantContractKeys"
 	SetImportantContractsMethod    = "SetImportantContractAddress"
 	GetImportantAddressMethod      = "importantContractAddresses"
+
+	RollupAddedEvent                 = "RollupAdded"
+	NetworkSecretInitializedEvent    = "NetworkSecretInitialized"
+	NetworkSecretRequestedEvent      = "NetworkSecretRequested"
+	NetworkSecretRespondedEvent      = "NetworkSecretResponded"
+	NetworkSecretRotatedEvent        = "NetworkSecretRotated"
+	SecretRotationRequestedEvent     = "SecretRotationRequested"
+	EnclaveRevokedEvent              = "EnclaveRevoked"
+	CrossChainMessagesRootAddedEvent = "CrossChainMessagesRootAdded"
+	ImportantContractUpdatedEvent    = "ImportantContractAddressUpdated"
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
@@ -0,0 +1,172 @@
+package mgmtcontractlib
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
+	"github.com/ten-protocol/go-ten/go/common/constants"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// deployedContract is the management contract deployed from the generated bytecode on a simulated L1
+type deployedContract struct {
+	backend  *simulated.Backend
+	lib      *contractLibImpl
+	contract *ManagementContract.ManagementContract
+	owner    *ecdsa.PrivateKey
+	chainID  *big.Int
+}
+
+func TestDeployedContractRotatesSecretAndRevokesEnclaves(t *testing.T) {
+	c := deployContract(t)
+	attesterKey, requesterKey := newKey(t), newKey(t)
+	attester, requester := crypto.PubkeyToAddress(attesterKey.PublicKey), crypto.PubkeyToAddress(requesterKey.PublicKey)
+
+	c.send(t, c.owner, c.lib.CreateInitializeSecret(&ethadapter.L1InitializeSecretTx{EnclaveID: &attester, InitialSecret: []byte{1}, Attestation: []byte("attestation")}), true)
+	respond := (&ethadapter.L1RespondSecretTx{Secret: []byte{2}, AttesterID: attester, RequesterID: requester}).Sign(attesterKey)
+	c.send(t, c.owner, c.lib.CreateRespondSecret(respond, true), true)
+
+	// only the owner can request a rotation
+	activationHeight := c.height(t) + 10
+	request, err := c.lib.RequestSecretRotationMsg(activationHeight)
+	require.NoError(t, err)
+	c.send(t, newKey(t), callTx(request), false)
+	decoded := c.send(t, c.owner, callTx(request), true)
+	require.Equal(t, []ethadapter.L1Transaction{&ethadapter.L1RequestSecretRotationTx{ActivationHeight: activationHeight}}, decoded)
+
+	// the generation must be signed by the rotating sequencer enclave
+	rotate := &ethadapter.L1RotateSecretTx{Secret: []byte{3, 4}, AttesterID: attester, RequesterID: requester, Generation: 1, ActivationHeight: activationHeight}
+	forged := *rotate
+	c.send(t, c.owner, c.lib.CreateRotateSecret(forged.Sign(requesterKey)), false)
+	decoded = c.send(t, c.owner, c.lib.CreateRotateSecret(rotate.Sign(attesterKey)), true)
+	require.Equal(t, []ethadapter.L1Transaction{rotate}, decoded)
+	generation, err := c.contract.SecretGeneration(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, generation.Uint64())
+
+	// the generation is replayed with its activation height to an enclave attested after that height
+	for c.height(t) <= activationHeight {
+		c.backend.Commit()
+	}
+	lateJoinerKey := newKey(t)
+	lateJoiner := crypto.PubkeyToAddress(lateJoinerKey.PublicKey)
+	lateRespond := (&ethadapter.L1RespondSecretTx{Secret: []byte{5}, AttesterID: attester, RequesterID: lateJoiner}).Sign(attesterKey)
+	c.send(t, c.owner, c.lib.CreateRespondSecret(lateRespond, true), true)
+	moved := &ethadapter.L1RotateSecretTx{Secret: []byte{6}, AttesterID: attester, RequesterID: lateJoiner, Generation: 1, ActivationHeight: activationHeight + 100}
+	c.send(t, c.owner, c.lib.CreateRotateSecret(moved.Sign(attesterKey)), false)
+	expired := &ethadapter.L1RotateSecretTx{Secret: []byte{6}, AttesterID: attester, RequesterID: lateJoiner, Generation: 2, ActivationHeight: activationHeight}
+	c.send(t, c.owner, c.lib.CreateRotateSecret(expired.Sign(attesterKey)), false)
+	replay := &ethadapter.L1RotateSecretTx{Secret: []byte{6}, AttesterID: attester, RequesterID: lateJoiner, Generation: 1, ActivationHeight: activationHeight}
+	decoded = c.send(t, c.owner, c.lib.CreateRotateSecret(replay.Sign(attesterKey)), true)
+	require.Equal(t, []ethadapter.L1Transaction{replay}, decoded)
+
+	revoke, err := c.lib.RevokeEnclaveMsg(requester)
+	require.NoError(t, err)
+	decoded = c.send(t, c.owner, callTx(revoke), true)
+	require.Equal(t, []ethadapter.L1Transaction{&ethadapter.L1RevokeEnclaveTx{EnclaveID: requester}}, decoded)
+	revoked, err := c.contract.IsRevoked(&bind.CallOpts{}, requester)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	attested, err := c.contract.Attested(&bind.CallOpts{}, requester)
+	require.NoError(t, err)
+	require.False(t, attested)
+
+	// a revoked enclave cannot be attested again
+	c.send(t, c.owner, c.lib.CreateRespondSecret(respond, true), false)
+}
+
+func deployContract(t *testing.T) *deployedContract {
+	owner := newKey(t)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(owner.PublicKey): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+
+	bytecode, err := constants.Bytecode()
+	require.NoError(t, err)
+	c := &deployedContract{backend: backend, owner: owner, chainID: chainID}
+	receipt := c.sendRaw(t, owner, nil, nil, bytecode)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	c.lib = NewMgmtContractLib(&receipt.ContractAddress, gethlog.New()).(*contractLibImpl)
+	c.contract, err = ManagementContract.NewManagementContract(receipt.ContractAddress, backend.Client())
+	require.NoError(t, err)
+	initialize, err := c.lib.contractABI.Pack("initialize")
+	require.NoError(t, err)
+	c.send(t, owner, &types.LegacyTx{To: c.lib.addr, Data: initialize}, true)
+	return c
+}
+
+// send sends the call to the management contract and returns the calls decoded from the receipt
+func (c *deployedContract) send(t *testing.T, key *ecdsa.PrivateKey, call types.TxData, succeeds bool) []ethadapter.L1Transaction {
+	msg := types.NewTx(call)
+	receipt := c.sendRaw(t, key, msg.To(), nil, msg.Data())
+	if !succeeds {
+		require.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+		return nil
+	}
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	tx, _, err := c.backend.Client().TransactionByHash(context.Background(), receipt.TxHash)
+	require.NoError(t, err)
+	return c.lib.DecodeReceipt(tx, receipt)
+}
+
+func (c *deployedContract) sendRaw(t *testing.T, key *ecdsa.PrivateKey, to *gethcommon.Address, value *big.Int, data []byte) *types.Receipt {
+	ctx := context.Background()
+	client := c.backend.Client()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	// the accounts other than the owner are funded on first use
+	balance, err := client.BalanceAt(ctx, from, nil)
+	require.NoError(t, err)
+	if balance.Sign() == 0 {
+		c.sendRaw(t, c.owner, &from, big.NewInt(1e18), nil)
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	require.NoError(t, err)
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(c.chainID), &types.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		Gas:      10_000_000,
+		GasPrice: gasPrice,
+		Data:     data,
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, tx))
+	c.backend.Commit()
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	return receipt
+}
+
+func (c *deployedContract) height(t *testing.T) uint64 {
+	height, err := c.backend.Client().BlockNumber(context.Background())
+	require.NoError(t, err)
+	return height
+}
+
+func callTx(msg ethereum.CallMsg) types.TxData {
+	return &types.LegacyTx{To: msg.To, Data: msg.Data}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key
+}
@@ -0,0 +1,180 @@
+package mgmtcontractlib
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+// DecodeReceipt converts the management contract events in the receipt into L1Transactions. The events carry the
+// payload of the calls, so they are decoded whichever account or contract called the management contract. A direct
+// call that emitted none of the events is decoded from its calldata instead.
+func (c *contractLibImpl) DecodeReceipt(tx *types.Transaction, receipt *types.Receipt) []ethadapter.L1Transaction {
+	decoded := make([]ethadapter.L1Transaction, 0)
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return decoded
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != *c.addr || len(l.Topics) == 0 {
+			continue
+		}
+		event, err := c.contractABI.EventByID(l.Topics[0])
+		if err != nil {
+			continue // an event that is not part of the ABI, e.g. from an upgraded implementation
+		}
+		t, err := c.decodeEvent(tx, event.Name, l.Data)
+		if err != nil {
+			c.logger.Warn("could not decode management contract event", "event", event.Name, log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+		if t != nil {
+			decoded = append(decoded, t)
+		}
+	}
+	// a direct call to a management contract deployed without the events is decoded from its calldata
+	if len(decoded) == 0 {
+		if t := c.DecodeTx(tx); t != nil {
+			decoded = append(decoded, t)
+		}
+	}
+	return decoded
+}
+
+func (c *contractLibImpl) decodeEvent(tx *types.Transaction, eventName string, data []byte) (ethadapter.L1Transaction, error) {
+	if eventName == RollupAddedEvent {
+		return c.rollupReference(tx)
+	}
+
+	fields := map[string]interface{}{}
+	if err := c.contractABI.UnpackIntoMap(fields, eventName, data); err != nil {
+		return nil, fmt.Errorf("could not unpack event. Cause: %w", err)
+	}
+	e := &eventFields{fields: fields}
+
+	var t ethadapter.L1Transaction
+	switch eventName {
+	case NetworkSecretInitializedEvent:
+		enclaveID := e.address("enclaveID")
+		t = &ethadapter.L1InitializeSecretTx{
+			EnclaveID:     &enclaveID,
+			InitialSecret: e.bytes("initSecret"),
+			Attestation:   e.base64("genesisAttestation"),
+		}
+	case NetworkSecretRequestedEvent:
+		t = &ethadapter.L1RequestSecretTx{
+			Attestation: e.base64("requestReport"),
+		}
+	case NetworkSecretRespondedEvent:
+		t = &ethadapter.L1RespondSecretTx{
+			AttesterID:  e.address("attesterID"),
+			RequesterID: e.address("requesterID"),
+			AttesterSig: e.bytes("attesterSig"),
+			Secret:      e.bytes("responseSecret"),
+		}
+	case NetworkSecretRotatedEvent:
+		t = &ethadapter.L1RotateSecretTx{
+			AttesterID:       e.address("attesterID"),
+			RequesterID:      e.address("requesterID"),
+			AttesterSig:      e.bytes("attesterSig"),
+			Secret:           e.bytes("responseSecret"),
+			Generation:       e.uint64("generation"),
+			ActivationHeight: e.uint64("activationHeight"),
+		}
+	case SecretRotationRequestedEvent:
+		t = &ethadapter.L1RequestSecretRotationTx{
+			ActivationHeight: e.uint64("activationHeight"),
+		}
+	case EnclaveRevokedEvent:
+		t = &ethadapter.L1RevokeEnclaveTx{
+			EnclaveID: e.address("enclaveID"),
+		}
+	case CrossChainMessagesRootAddedEvent:
+		t = &ethadapter.L1CrossChainBundleTx{
+			CrossChainRootHashes: e.bytesList("crossChainHashes"),
+		}
+	case ImportantContractUpdatedEvent:
+		t = &ethadapter.L1SetImportantContractsTx{
+			Key:        e.text("key"),
+			NewAddress: e.address("newAddress"),
+		}
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	return t, nil
+}
+
+// rollupReference locates the payload of the rollup announced by a RollupAdded event. Blobs are attached to the
+// transaction whoever sent it, while the calldata and external DA references are appended to a direct AddRollup call.
+func (c *contractLibImpl) rollupReference(tx *types.Transaction) (*ethadapter.L1RollupHashes, error) {
+	if tx.Type() == types.BlobTxType {
+		return &ethadapter.L1RollupHashes{
+			DAMode:     common.BlobDA,
+			BlobHashes: tx.BlobHashes(),
+		}, nil
+	}
+	if ref, ok := c.DecodeTx(tx).(*ethadapter.L1RollupHashes); ok {
+		return ref, nil
+	}
+	return nil, errors.New("rollups published without blobs must be sent to the management contract directly")
+}
+
+// eventFields reads typed fields from an unpacked event, keeping the first error
+type eventFields struct {
+	fields map[string]interface{}
+	err    error
+}
+
+func (e *eventFields) address(name string) gethcommon.Address {
+	v, ok := e.fields[name].(gethcommon.Address)
+	e.check(ok, name)
+	return v
+}
+
+func (e *eventFields) bytes(name string) []byte {
+	v, ok := e.fields[name].([]byte)
+	e.check(ok, name)
+	return v
+}
+
+func (e *eventFields) bytesList(name string) [][]byte {
+	v, ok := e.fields[name].([][]byte)
+	e.check(ok, name)
+	return v
+}
+
+func (e *eventFields) text(name string) string {
+	v, ok := e.fields[name].(string)
+	e.check(ok, name)
+	return v
+}
+
+func (e *eventFields) uint64(name string) uint64 {
+	v, ok := e.fields[name].(*big.Int)
+	e.check(ok, name)
+	if !ok {
+		return 0
+	}
+	return v.Uint64()
+}
+
+// base64 reads a string field holding a base64 encoded attestation. Anyone can emit the secret request event, so
+// unlike the transaction decoding a malformed attestation is an error rather than a panic.
+func (e *eventFields) base64(name string) []byte {
+	decoded, err := base64.StdEncoding.DecodeString(e.text(name))
+	e.check(err == nil, name)
+	return decoded
+}
+
+func (e *eventFields) check(ok bool, name string) {
+	if !ok && e.err == nil {
+		e.err = fmt.Errorf("could not decode %s data", name)
+	}
+}
@@ -0,0 +1,98 @@
+package mgmtcontractlib
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+var (
+	testMgmtAddr = gethcommon.HexToAddress("0x1000")
+	multisigAddr = gethcommon.HexToAddress("0x2000")
+)
+
+func TestDecodeReceiptOfRelayedCall(t *testing.T) {
+	lib := NewMgmtContractLib(&testMgmtAddr, gethlog.New()).(*contractLibImpl)
+	attestation := []byte("attestation")
+
+	// the secret request reached the management contract through a multisig
+	tx := types.NewTx(&types.LegacyTx{To: &multisigAddr, Data: []byte{1, 2, 3}})
+	receipt := successfulReceipt(
+		lib.eventLog(t, testMgmtAddr, NetworkSecretRequestedEvent, []gethcommon.Hash{gethcommon.BytesToHash(multisigAddr.Bytes())}, base64EncodeToString(attestation)),
+		// events of other contracts are ignored
+		lib.eventLog(t, multisigAddr, EnclaveRevokedEvent, nil, multisigAddr),
+	)
+
+	decoded := lib.DecodeReceipt(tx, receipt)
+	require.Len(t, decoded, 1)
+	require.Equal(t, &ethadapter.L1RequestSecretTx{Attestation: attestation}, decoded[0])
+
+	receipt.Status = types.ReceiptStatusFailed
+	require.Empty(t, lib.DecodeReceipt(tx, receipt))
+}
+
+func TestDecodeReceiptOfRelayedBlobRollup(t *testing.T) {
+	lib := NewMgmtContractLib(&testMgmtAddr, gethlog.New()).(*contractLibImpl)
+	blobHashes := []gethcommon.Hash{{0x01, 1}, {0x01, 2}}
+	tx := types.NewTx(&types.BlobTx{To: multisigAddr, BlobHashes: blobHashes, BlobFeeCap: uint256.NewInt(1)})
+	receipt := successfulReceipt(
+		lib.eventLog(t, testMgmtAddr, RollupAddedEvent, nil, [32]byte{1}),
+		lib.eventLog(t, testMgmtAddr, CrossChainMessagesRootAddedEvent, nil, [32]byte{2}, [][]byte{{3}}),
+	)
+
+	decoded := lib.DecodeReceipt(tx, receipt)
+	require.Len(t, decoded, 2)
+	require.Equal(t, &ethadapter.L1RollupHashes{DAMode: common.BlobDA, BlobHashes: blobHashes}, decoded[0])
+	require.Equal(t, common.CrossChainRootHashes{{3}}, decoded[1].(*ethadapter.L1CrossChainBundleTx).CrossChainRootHashes)
+}
+
+func TestDecodeReceiptSkipsMalformedEvents(t *testing.T) {
+	lib := NewMgmtContractLib(&testMgmtAddr, gethlog.New()).(*contractLibImpl)
+	tx := types.NewTx(&types.LegacyTx{To: &multisigAddr})
+	receipt := successfulReceipt(
+		lib.eventLog(t, testMgmtAddr, NetworkSecretRequestedEvent, []gethcommon.Hash{{}}, "not base64!"),
+		lib.eventLog(t, testMgmtAddr, SecretRotationRequestedEvent, nil, big.NewInt(100)),
+	)
+
+	decoded := lib.DecodeReceipt(tx, receipt)
+	require.Equal(t, []ethadapter.L1Transaction{&ethadapter.L1RequestSecretRotationTx{ActivationHeight: 100}}, decoded)
+}
+
+func TestDecodeReceiptFallsBackToCalldata(t *testing.T) {
+	lib := NewMgmtContractLib(&testMgmtAddr, gethlog.New()).(*contractLibImpl)
+	respond := &ethadapter.L1RespondSecretTx{
+		Secret:      []byte{1},
+		AttesterID:  gethcommon.HexToAddress("0xa"),
+		RequesterID: gethcommon.HexToAddress("0xb"),
+		AttesterSig: []byte{2},
+	}
+	// a management contract deployed without the events only emits the ones it already had, if any
+	tx := types.NewTx(lib.CreateRespondSecret(respond, false))
+	receipt := successfulReceipt()
+
+	decoded := lib.DecodeReceipt(tx, receipt)
+	require.Len(t, decoded, 1)
+	require.Equal(t, respond.RequesterID, decoded[0].(*ethadapter.L1RespondSecretTx).RequesterID)
+
+	// relayed calls without events are not decoded
+	relayed := types.NewTx(&types.LegacyTx{To: &multisigAddr, Data: tx.Data()})
+	require.Empty(t, lib.DecodeReceipt(relayed, receipt))
+}
+
+func (c *contractLibImpl) eventLog(t *testing.T, addr gethcommon.Address, eventName string, indexed []gethcommon.Hash, args ...interface{}) *types.Log {
+	event := c.contractABI.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+	return &types.Log{Address: addr, Topics: append([]gethcommon.Hash{event.ID}, indexed...), Data: data}
+}
+
+func successfulReceipt(logs ...*types.Log) *types.Receipt {
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1), Logs: logs}
+}
@@ -1,6 +1,7 @@
 package mgmtcontractlib
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -25,13 +27,18 @@ const methodBytesLen = 4
 // messages for call requests, and converting ethereum transactions into L1Transactions.
 type MgmtContractLib interface {
 	IsMock() bool
-	CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxData, error)
+	// CreateRollup returns the transaction publishing the rollup on the given data availability layer
+	CreateRollup(ctx context.Context, t *ethadapter.L1RollupTx, layer da.Layer) (types.TxData, error)
 	CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData
 	CreateRespondSecret(tx *ethadapter.L1RespondSecretTx, verifyAttester bool) types.TxData
 	CreateInitializeSecret(tx *ethadapter.L1InitializeSecretTx) types.TxData
+	CreateRotateSecret(tx *ethadapter.L1RotateSecretTx) types.TxData
 
 	// DecodeTx receives a *types.Transaction and converts it to a common.L1Transaction
 	DecodeTx(tx *types.Transaction) ethadapter.L1Transaction
+	// DecodeReceipt converts the management contract events of a successful transaction into L1Transactions, so
+	// calls relayed through another contract (e.g. a multisig) are recognised as well as direct calls
+	DecodeReceipt(tx *types.Transaction, receipt *types.Receipt) []ethadapter.L1Transaction
 	GetContractAddr() *gethcommon.Address
 
 	// The methods below are used to create call messages for mgmt contract data and unpack the responses
@@ -46,6 +53,10 @@ type MgmtContractLib interface {
 
 	GetImportantAddressCallMsg(key string) (ethereum.CallMsg, error)
 	DecodeImportantAddressResponse(callResponse []byte) (gethcommon.Address, error)
+
+	// RequestSecretRotationMsg and RevokeEnclaveMsg are restricted to the contract owner
+	RequestSecretRotationMsg(activationHeight uint64) (ethereum.CallMsg, error)
+	RevokeEnclaveMsg(enclaveID gethcommon.Address) (ethereum.CallMsg, error)
 }
 
 type contractLibImpl struct {
@@ -89,11 +100,16 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 	case AddRollupMethod:
 		if tx.Type() == types.BlobTxType {
 			return &ethadapter.L1RollupHashes{
+				DAMode:     common.BlobDA,
 				BlobHashes: tx.BlobHashes(),
 			}
-		} else {
+		}
+		ref, err := c.unpackRollupReference(method, tx.Data())
+		if err != nil {
+			c.logger.Warn("could not unpack rollup reference", log.ErrKey, err)
 			return nil
 		}
+		return ref
 	case RespondSecretMethod:
 		return c.unpackRespondSecretTx(tx, method, contractCallData)
 
@@ -103,6 +119,38 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 	case InitializeSecretMethod:
 		return c.unpackInitSecretTx(tx, method, contractCallData)
 
+	case RotateSecretMethod:
+		tx, err := c.unpackRotateSecretTx(tx, method, contractCallData)
+		if err != nil {
+			c.logger.Warn("could not unpack rotate secret tx", log.ErrKey, err)
+			return nil
+		}
+		return tx
+
+	case RequestSecretRotationMethod:
+		tx, err := c.unpackRequestSecretRotationTx(tx, method, contractCallData)
+		if err != nil {
+			c.logger.Warn("could not unpack request secret rotation tx", log.ErrKey, err)
+			return nil
+		}
+		return tx
+
+	case RevokeEnclaveMethod:
+		tx, err := c.unpackRevokeEnclaveTx(tx, method, contractCallData)
+		if err != nil {
+			c.logger.Warn("could not unpack revoke enclave tx", log.ErrKey, err)
+			return nil
+		}
+		return tx
+
+	case AddCrossChainRootsMethod:
+		tx, err := c.unpackCrossChainBundleTx(tx, method, contractCallData)
+		if err != nil {
+			c.logger.Warn("could not unpack cross chain bundle tx", log.ErrKey, err)
+			return nil
+		}
+		return tx
+
 	case SetImportantContractsMethod:
 		tx, err := c.unpackSetImportantContractsTx(tx, method, contractCallData)
 		if err != nil {
@@ -115,8 +163,8 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 	return nil
 }
 
-// CreateBlobRollup creates a BlobTx, encoding the rollup data into blobs.
-func (c *contractLibImpl) CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxData, error) {
+// CreateRollup creates the AddRollup transaction, making the rollup data available on the given layer.
+func (c *contractLibImpl) CreateRollup(ctx context.Context, t *ethadapter.L1RollupTx, layer da.Layer) (types.TxData, error) {
 	decodedRollup, err := common.DecodeRollup(t.Rollup)
 	if err != nil {
 		panic(err)
@@ -141,24 +189,21 @@ func (c *contractLibImpl) CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxDa
 		panic(err)
 	}
 
-	blobs, err := ethadapter.EncodeBlobs(t.Rollup)
+	return layer.CreateRollupTx(ctx, *c.addr, data, t.Rollup)
+}
+
+// unpackRollupReference reads the rollup reference appended to the AddRollup arguments of a non-blob transaction
+func (c *contractLibImpl) unpackRollupReference(method *abi.Method, txData []byte) (*ethadapter.L1RollupHashes, error) {
+	args, err := method.Inputs.Unpack(txData[methodBytesLen:])
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode rollup to blobs: %w", err)
+		return nil, fmt.Errorf("could not unpack rollup arguments. Cause: %w", err)
 	}
-
-	var blobHashes []gethcommon.Hash
-	var sidecar *types.BlobTxSidecar
-
-	if sidecar, blobHashes, err = ethadapter.MakeSidecar(blobs); err != nil {
-		return nil, fmt.Errorf("failed to make sidecar: %w", err)
+	// re-encoding the arguments gives the length of the call data the reference was appended to
+	encodedArgs, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack rollup arguments. Cause: %w", err)
 	}
-
-	return &types.BlobTx{
-		To:         *c.addr,
-		Data:       data,
-		BlobHashes: blobHashes,
-		Sidecar:    sidecar,
-	}, nil
+	return da.DecodeReference(txData[methodBytesLen+len(encodedArgs):])
 }
 
 func (c *contractLibImpl) CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData {
@@ -207,6 +252,25 @@ func (c *contractLibImpl) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	}
 }
 
+func (c *contractLibImpl) CreateRotateSecret(tx *ethadapter.L1RotateSecretTx) types.TxData {
+	data, err := c.contractABI.Pack(
+		RotateSecretMethod,
+		tx.AttesterID,
+		tx.RequesterID,
+		tx.AttesterSig,
+		tx.Secret,
+		new(big.Int).SetUint64(tx.Generation),
+		new(big.Int).SetUint64(tx.ActivationHeight),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}
+}
+
 func (c *contractLibImpl) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	data, err := c.contractABI.Pack(GetHostAddressesMethod)
 	if err != nil {
@@ -319,6 +383,22 @@ func (c *contractLibImpl) DecodeImportantAddressResponse(callResponse []byte) (g
 	return address, nil
 }
 
+func (c *contractLibImpl) RequestSecretRotationMsg(activationHeight uint64) (ethereum.CallMsg, error) {
+	data, err := c.contractABI.Pack(RequestSecretRotationMethod, new(big.Int).SetUint64(activationHeight))
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: c.addr, Data: data}, nil
+}
+
+func (c *contractLibImpl) RevokeEnclaveMsg(enclaveID gethcommon.Address) (ethereum.CallMsg, error) {
+	data, err := c.contractABI.Pack(RevokeEnclaveMethod, enclaveID)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: c.addr, Data: data}, nil
+}
+
 func (c *contractLibImpl) unpackInitSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1InitializeSecretTx {
 	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
 	if err != nil {
@@ -429,6 +509,92 @@ func (c *contractLibImpl) unpackSetImportantContractsTx(tx *types.Transaction, m
 	}, nil
 }
 
+func (c *contractLibImpl) unpackRotateSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) (*ethadapter.L1RotateSecretTx, error) {
+	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack transaction. Cause: %w", err)
+	}
+
+	requesterAddr, ok := contractCallData["requesterID"].(gethcommon.Address)
+	if !ok {
+		return nil, fmt.Errorf("could not decode requesterID data")
+	}
+	attesterAddr, ok := contractCallData["attesterID"].(gethcommon.Address)
+	if !ok {
+		return nil, fmt.Errorf("could not decode attesterID data")
+	}
+	attesterSig, ok := contractCallData["attesterSig"].([]uint8)
+	if !ok {
+		return nil, fmt.Errorf("could not decode attesterSig data")
+	}
+	responseSecret, ok := contractCallData["responseSecret"].([]uint8)
+	if !ok {
+		return nil, fmt.Errorf("could not decode responseSecret data")
+	}
+	generation, ok := contractCallData["generation"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("could not decode generation data")
+	}
+	activationHeight, ok := contractCallData["activationHeight"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("could not decode activationHeight data")
+	}
+
+	return &ethadapter.L1RotateSecretTx{
+		Secret:           responseSecret,
+		RequesterID:      requesterAddr,
+		AttesterID:       attesterAddr,
+		AttesterSig:      attesterSig,
+		Generation:       generation.Uint64(),
+		ActivationHeight: activationHeight.Uint64(),
+	}, nil
+}
+
+func (c *contractLibImpl) unpackRequestSecretRotationTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) (*ethadapter.L1RequestSecretRotationTx, error) {
+	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack transaction. Cause: %w", err)
+	}
+
+	activationHeight, ok := contractCallData["activationHeight"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("could not decode activationHeight data")
+	}
+	return &ethadapter.L1RequestSecretRotationTx{
+		ActivationHeight: activationHeight.Uint64(),
+	}, nil
+}
+
+func (c *contractLibImpl) unpackRevokeEnclaveTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) (*ethadapter.L1RevokeEnclaveTx, error) {
+	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack transaction. Cause: %w", err)
+	}
+
+	enclaveID, ok := contractCallData["_addr"].(gethcommon.Address)
+	if !ok {
+		return nil, fmt.Errorf("could not decode enclaveID data")
+	}
+	return &ethadapter.L1RevokeEnclaveTx{
+		EnclaveID: enclaveID,
+	}, nil
+}
+
+func (c *contractLibImpl) unpackCrossChainBundleTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) (*ethadapter.L1CrossChainBundleTx, error) {
+	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack transaction. Cause: %w", err)
+	}
+
+	roots, ok := contractCallData["crossChainHashes"].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("could not decode cross chain hashes data")
+	}
+	return &ethadapter.L1CrossChainBundleTx{
+		CrossChainRootHashes: roots,
+	}, nil
+}
+
 // base64EncodeToString encodes a byte array to a string
 func base64EncodeToString(bytes []byte) string {
 	return base64.StdEncoding.EncodeToString(bytes)
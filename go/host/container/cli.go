@@ -44,6 +44,8 @@ type HostConfigToml struct {
 	SequencerP2PAddress       string
 	MetricsEnabled            bool
 	MetricsHTTPPort           uint
+	TracingExporter           string
+	TracingEndpoint           string
 	UseInMemoryDB             bool
 	PostgresDBHost            string
 	DebugNamespaceEnabled     bool
@@ -56,6 +58,33 @@ type HostConfigToml struct {
 	MaxRollupSize             int
 	L1BeaconUrl               string
 	L1BlobArchiveUrl          string
+	BlobRetention             string
+	DAMode                    string
+	DAStoreURL                string
+	RollupArchiveURL          string
+	HealthThresholds          HealthThresholdsToml
+}
+
+// HealthThresholdsToml is the [HealthThresholds] table of the .toml config, the thresholds not set keep their default
+type HealthThresholdsToml struct {
+	L1HeadAge            DurationThresholdToml
+	L2HeadAge            DurationThresholdToml
+	EnclaveL2Lag         CountThresholdToml
+	RollupPublicationAge DurationThresholdToml
+	FailedL1Txs          CountThresholdToml
+	PeerMessageAge       DurationThresholdToml
+}
+
+// DurationThresholdToml holds durations such as "5m", "0s" disables the check
+type DurationThresholdToml struct {
+	Degraded  string
+	Unhealthy string
+}
+
+// CountThresholdToml holds counts, 0 disables the check
+type CountThresholdToml struct {
+	Degraded  *uint64
+	Unhealthy *uint64
 }
 
 // ParseConfig returns a config.HostInputConfig based on either the file identified by the `config` flag, or the flags with
@@ -89,6 +118,8 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	sequencerP2PAddress := flag.String(sequencerP2PAddrName, cfg.SequencerP2PAddress, flagUsageMap[sequencerP2PAddrName])
 	metricsEnabled := flag.Bool(metricsEnabledName, cfg.MetricsEnabled, flagUsageMap[metricsEnabledName])
 	metricsHTPPPort := flag.Uint(metricsHTTPPortName, cfg.MetricsHTTPPort, flagUsageMap[metricsHTTPPortName])
+	tracingExporter := flag.String(tracingExporterName, cfg.TracingExporter, flagUsageMap[tracingExporterName])
+	tracingEndpoint := flag.String(tracingEndpointName, cfg.TracingEndpoint, flagUsageMap[tracingEndpointName])
 	useInMemoryDB := flag.Bool(useInMemoryDBName, cfg.UseInMemoryDB, flagUsageMap[useInMemoryDBName])
 	postgresDBHost := flag.String(postgresDBHostName, cfg.PostgresDBHost, flagUsageMap[postgresDBHostName])
 	debugNamespaceEnabled := flag.Bool(debugNamespaceEnabledName, cfg.DebugNamespaceEnabled, flagUsageMap[debugNamespaceEnabledName])
@@ -100,6 +131,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	maxRollupSize := flag.Uint64(maxRollupSizeFlagName, cfg.MaxRollupSize, flagUsageMap[maxRollupSizeFlagName])
 	l1BeaconUrl := flag.String(l1BeaconUrlName, cfg.L1BeaconUrl, flagUsageMap[l1BeaconUrlName])
 	l1BlobArchiveUrl := flag.String(l1BlobArchiveUrlName, cfg.L1BlobArchiveUrl, flagUsageMap[l1BlobArchiveUrlName])
+	blobRetention := flag.String(blobRetentionName, cfg.BlobRetention.String(), flagUsageMap[blobRetentionName])
+	daMode := flag.String(daModeName, cfg.DAMode.String(), flagUsageMap[daModeName])
+	daStoreURL := flag.String(daStoreURLName, cfg.DAStoreURL, flagUsageMap[daStoreURLName])
+	rollupArchiveURL := flag.String(rollupArchiveURLName, cfg.RollupArchiveURL, flagUsageMap[rollupArchiveURLName])
 
 	flag.Parse()
 
@@ -138,6 +173,8 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.SequencerP2PAddress = *sequencerP2PAddress
 	cfg.MetricsEnabled = *metricsEnabled
 	cfg.MetricsHTTPPort = *metricsHTPPPort
+	cfg.TracingExporter = *tracingExporter
+	cfg.TracingEndpoint = *tracingEndpoint
 	cfg.UseInMemoryDB = *useInMemoryDB
 	cfg.PostgresDBHost = *postgresDBHost
 	cfg.DebugNamespaceEnabled = *debugNamespaceEnabled
@@ -161,6 +198,16 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.MaxRollupSize = *maxRollupSize
 	cfg.L1BeaconUrl = *l1BeaconUrl
 	cfg.L1BlobArchiveUrl = *l1BlobArchiveUrl
+	cfg.BlobRetention, err = time.ParseDuration(*blobRetention)
+	if err != nil {
+		return nil, err
+	}
+	cfg.DAMode, err = common.ToDAMode(*daMode)
+	if err != nil {
+		return nil, err
+	}
+	cfg.DAStoreURL = *daStoreURL
+	cfg.RollupArchiveURL = *rollupArchiveURL
 
 	return cfg, nil
 }
@@ -198,6 +245,24 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		crossChainInterval = interval
 	}
 
+	var blobRetention time.Duration
+	if retention, err := time.ParseDuration(tomlConfig.BlobRetention); err == nil {
+		blobRetention = retention
+	}
+
+	healthThresholds, err := tomlConfig.HealthThresholds.toHealthThresholds()
+	if err != nil {
+		return &config.HostInputConfig{}, err
+	}
+
+	daMode := common.BlobDA
+	if tomlConfig.DAMode != "" {
+		daMode, err = common.ToDAMode(tomlConfig.DAMode)
+		if err != nil {
+			return &config.HostInputConfig{}, err
+		}
+	}
+
 	return &config.HostInputConfig{
 		IsGenesis:                 tomlConfig.IsGenesis,
 		NodeType:                  nodeType,
@@ -225,6 +290,8 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		SequencerP2PAddress:       tomlConfig.SequencerP2PAddress,
 		MetricsEnabled:            tomlConfig.MetricsEnabled,
 		MetricsHTTPPort:           tomlConfig.MetricsHTTPPort,
+		TracingExporter:           tomlConfig.TracingExporter,
+		TracingEndpoint:           tomlConfig.TracingEndpoint,
 		UseInMemoryDB:             tomlConfig.UseInMemoryDB,
 		PostgresDBHost:            tomlConfig.PostgresDBHost,
 		BatchInterval:             batchInterval,
@@ -233,5 +300,58 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		IsInboundP2PDisabled:      tomlConfig.IsInboundP2PDisabled,
 		L1BlockTime:               time.Duration(tomlConfig.L1BlockTime) * time.Second,
 		CrossChainInterval:        crossChainInterval,
+		L1BeaconUrl:               tomlConfig.L1BeaconUrl,
+		L1BlobArchiveUrl:          tomlConfig.L1BlobArchiveUrl,
+		BlobRetention:             blobRetention,
+		DAMode:                    daMode,
+		DAStoreURL:                tomlConfig.DAStoreURL,
+		RollupArchiveURL:          tomlConfig.RollupArchiveURL,
+		HealthThresholds:          healthThresholds,
 	}, nil
 }
+
+func (t HealthThresholdsToml) toHealthThresholds() (config.HealthThresholds, error) {
+	thresholds := config.DefaultHealthThresholds()
+	durations := []struct {
+		name      string
+		toml      DurationThresholdToml
+		threshold *config.DurationThreshold
+	}{
+		{"L1HeadAge", t.L1HeadAge, &thresholds.L1HeadAge},
+		{"L2HeadAge", t.L2HeadAge, &thresholds.L2HeadAge},
+		{"RollupPublicationAge", t.RollupPublicationAge, &thresholds.RollupPublicationAge},
+		{"PeerMessageAge", t.PeerMessageAge, &thresholds.PeerMessageAge},
+	}
+	for _, d := range durations {
+		if d.toml.Degraded != "" {
+			degraded, err := time.ParseDuration(d.toml.Degraded)
+			if err != nil {
+				return thresholds, fmt.Errorf("invalid HealthThresholds.%s.Degraded - %w", d.name, err)
+			}
+			d.threshold.Degraded = degraded
+		}
+		if d.toml.Unhealthy != "" {
+			unhealthy, err := time.ParseDuration(d.toml.Unhealthy)
+			if err != nil {
+				return thresholds, fmt.Errorf("invalid HealthThresholds.%s.Unhealthy - %w", d.name, err)
+			}
+			d.threshold.Unhealthy = unhealthy
+		}
+	}
+	counts := []struct {
+		toml      CountThresholdToml
+		threshold *config.CountThreshold
+	}{
+		{t.EnclaveL2Lag, &thresholds.EnclaveL2Lag},
+		{t.FailedL1Txs, &thresholds.FailedL1Txs},
+	}
+	for _, c := range counts {
+		if c.toml.Degraded != nil {
+			c.threshold.Degraded = *c.toml.Degraded
+		}
+		if c.toml.Unhealthy != nil {
+			c.threshold.Unhealthy = *c.toml.Unhealthy
+		}
+	}
+	return thresholds, nil
+}
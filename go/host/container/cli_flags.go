@@ -28,6 +28,8 @@ const (
 	sequencerP2PAddrName         = "sequencerP2PAddress"
 	metricsEnabledName           = "metricsEnabled"
 	metricsHTTPPortName          = "metricsHTTPPort"
+	tracingExporterName          = "tracingExporter"
+	tracingEndpointName          = "tracingEndpoint"
 	useInMemoryDBName            = "useInMemoryDB"
 	postgresDBHostName           = "postgresDBHost"
 	debugNamespaceEnabledName    = "debugNamespaceEnabled"
@@ -39,6 +41,10 @@ const (
 	maxRollupSizeFlagName        = "maxRollupSize"
 	l1BeaconUrlName              = "l1BeaconUrl"
 	l1BlobArchiveUrlName         = "l1BlobArchiveUrl"
+	blobRetentionName            = "blobRetention"
+	daModeName                   = "daMode"
+	daStoreURLName               = "daStoreUrl"
+	rollupArchiveURLName         = "rollupArchiveUrl"
 )
 
 // Returns a map of the flag usages.
@@ -71,6 +77,8 @@ func getFlagUsageMap() map[string]string {
 		sequencerP2PAddrName:         "The P2P address of the sequencer",
 		metricsEnabledName:           "Whether the metrics are enabled (Defaults to true)",
 		metricsHTTPPortName:          "The port on which the metrics are served (Defaults to 0.0.0.0:14000)",
+		tracingExporterName:          "Where the request traces are sent: otlp, file, or empty to disable tracing (Defaults to empty)",
+		tracingEndpointName:          "The address of the OTLP collector, or the path of the file the traces are written to",
 		useInMemoryDBName:            "Whether the host will use an in-memory DB rather than persist data",
 		postgresDBHostName:           "The host for the Postgres DB instance",
 		debugNamespaceEnabledName:    "Whether the debug names is enabled",
@@ -81,6 +89,10 @@ func getFlagUsageMap() map[string]string {
 		maxRollupSizeFlagName:        "Max size of a rollup",
 		crossChainIntervalName:       "Duration between each cross chain bundle. Can be put down as 1.0s",
 		l1BeaconUrlName:              "Gateway endpoint url for the beacon chain",
-		l1BlobArchiveUrlName:         "Url for the blob archive endpoint",
+		l1BlobArchiveUrlName:         "Comma-separated urls of the blob archive endpoints, tried in order after the beacon chain",
+		blobRetentionName:            "How long fetched blobs are kept in the host DB, by L1 block time. Can be put down as 480h (Defaults to 0s, keep forever)",
+		daModeName:                   "The data availability layer rollups are published to: blob, calldata or external (Defaults to blob)",
+		daStoreURLName:               "The directory or HTTP url of the store used by the external data availability layer",
+		rollupArchiveURLName:         "The directory or S3 url (s3://host/bucket[/prefix]) the published rollups are archived to. Empty disables the archive",
 	}
 }
@@ -29,6 +29,10 @@ func TestConfigIsParsedFromTomlFileIfConfigFlagIsPresent(t *testing.T) {
 	if cfg.P2PConnectionTimeout != p2pConnectionTimeout {
 		t.Fatalf("config file was not parsed from TOML. Expected P2PConnectionTimeout of %d, got %d", p2pConnectionTimeout, cfg.P2PConnectionTimeout)
 	}
+	thresholds := cfg.HealthThresholds
+	if thresholds.L1HeadAge.Degraded != 2*time.Minute || thresholds.FailedL1Txs.Unhealthy != 0 || thresholds.L2HeadAge != config.DefaultHealthThresholds().L2HeadAge {
+		t.Fatalf("health thresholds were not parsed from TOML, got %+v", thresholds)
+	}
 }
 
 func TestConfigIsParsedFromCmdLineFlagsIfConfigFlagIsNotPresent(t *testing.T) {
@@ -1,8 +1,11 @@
 package container
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ten-protocol/go-ten/lib/gethfork/node"
@@ -13,6 +16,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/metrics"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
@@ -30,6 +34,7 @@ import (
 const (
 	APIVersion1         = "1.0"
 	APINamespaceObscuro = "obscuro"
+	APINamespaceTen     = "ten"
 	APINamespaceEth     = "eth"
 	APINamespaceScan    = "scan"
 	APINamespaceNetwork = "net"
@@ -42,6 +47,7 @@ type HostContainer struct {
 	logger         gethlog.Logger
 	metricsService *metrics.Service
 	rpcServer      node.Server
+	stopTracing    func(context.Context) error
 }
 
 func (h *HostContainer) Start() error {
@@ -77,6 +83,10 @@ func (h *HostContainer) Stop() error {
 
 	h.metricsService.Stop()
 
+	if err := h.stopTracing(context.Background()); err != nil {
+		h.logger.Warn("Could not flush the request traces", log.ErrKey, err)
+	}
+
 	if h.rpcServer != nil {
 		// rpc server cannot be stopped synchronously as it will kill current request
 		go func() {
@@ -151,17 +161,26 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger get
 
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&cfg.ManagementContractAddress, logger)
 	obscuroRelevantContracts := []gethcommon.Address{cfg.ManagementContractAddress, cfg.MessageBusAddress}
-	l1Repo := l1.NewL1Repository(l1Client, obscuroRelevantContracts, logger)
+	l1Repo := l1.NewL1Repository(l1Client, obscuroRelevantContracts, cfg.HealthThresholds.L1HeadAge, logger)
 	beaconClient := ethadapter.NewBeaconHTTPClient(new(http.Client), cfg.L1BeaconUrl)
-	// we can add more fallback clients as they become available
-	fallback := ethadapter.NewArchivalHTTPClient(new(http.Client), cfg.L1BlobArchiveUrl)
-	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallback))
+	// the archives are tried in the configured order once the beacon chain has pruned the blobs
+	var fallbacks []ethadapter.BlobRetrievalService
+	for _, archiveURL := range strings.Split(cfg.L1BlobArchiveUrl, ",") {
+		if archiveURL = strings.TrimSpace(archiveURL); archiveURL != "" {
+			fallbacks = append(fallbacks, ethadapter.NewArchivalHTTPClient(new(http.Client), archiveURL))
+		}
+	}
+	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallbacks...))
 	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Repo, enclaveClients, mgmtContractLib, ethWallet, rpcServer, logger, metricsService, blobResolver)
 }
 
 // NewHostContainer builds a host container with dependency injection rather than from config.
 // Useful for testing etc. (want to be able to pass in logger, and also have option to mock out dependencies)
 func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p2p hostcommon.P2PHostService, l1Client ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, contractLib mgmtcontractlib.MgmtContractLib, hostWallet wallet.Wallet, rpcServer node.Server, logger gethlog.Logger, metricsService *metrics.Service, blobResolver l1.BlobResolver) *HostContainer {
+	stopTracing, err := tracing.Setup("ten-host", cfg.TracingExporter, cfg.TracingEndpoint)
+	if err != nil {
+		logger.Crit("could not set up the tracing of the requests.", log.ErrKey, err)
+	}
 	h := host.NewHost(cfg, services, p2p, l1Client, l1Repo, enclaveClients, hostWallet, contractLib, logger, metricsService.Registry(), blobResolver)
 
 	hostContainer := &HostContainer{
@@ -169,15 +188,37 @@ func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p
 		logger:         logger,
 		rpcServer:      rpcServer,
 		metricsService: metricsService,
+		stopTracing:    stopTracing,
+	}
+
+	// the enclaves cannot open ports, so their metrics are fetched over the enclave RPC and served with the host's
+	for i, enclaveClient := range enclaveClients {
+		client := enclaveClient
+		metricsService.AddSource("enclave", strconv.Itoa(i), func(ctx context.Context) ([]byte, error) {
+			enclaveMetrics, sysErr := client.Metrics(ctx)
+			if sysErr != nil {
+				return nil, sysErr
+			}
+			return enclaveMetrics, nil
+		})
 	}
 
 	if cfg.HasClientRPCHTTP || cfg.HasClientRPCWebsockets {
 		filterAPI := clientapi.NewFilterAPI(h, logger)
+		// the Ten namespace is registered once, with the operator operations only when the debug namespace is enabled
+		var tenAPI any = clientapi.NewTenAPI(h)
+		if cfg.DebugNamespaceEnabled {
+			tenAPI = clientapi.NewTenAdminAPI(h)
+		}
 		rpcServer.RegisterAPIs([]rpc.API{
 			{
 				Namespace: APINamespaceObscuro,
 				Service:   clientapi.NewTenAPI(h),
 			},
+			{
+				Namespace: APINamespaceTen,
+				Service:   tenAPI,
+			},
 			{
 				Namespace: APINamespaceEth,
 				Service:   clientapi.NewEthereumAPI(h, logger),
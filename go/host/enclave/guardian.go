@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/ethadapter"
@@ -39,6 +40,12 @@ const (
 
 	// when we have submitted request to L1 for the secret, how long do we wait for an answer before we retry
 	_maxWaitForSecretResponse = 2 * time.Minute
+
+	// number of L1 blocks after which a bundled (inbound) or published (outbound) cross chain message is considered final
+	_crossChainFinalityDepth = 64
+
+	// upper bound on a batch or rollup production call, so that a hung active sequencer enclave can still be demoted
+	_maxProductionTime = 1 * time.Minute
 )
 
 // This private interface enforces the services that the guardian depends on
@@ -54,6 +61,7 @@ type guardianServiceLocator interface {
 // Guardian is a host service which monitors an enclave, it's responsibilities include:
 // - monitor the enclave state and feed it the data it needs
 // - if it is an active sequencer then the guardian will trigger batch/rollup creation
+// - if it is a standby sequencer then the guardian feeds it the batches of the active sequencer enclave
 // - guardian provides access to the enclave data and reports the enclave status for other services - acting as a gatekeeper
 type Guardian struct {
 	hostData      host.Identity
@@ -70,7 +78,9 @@ type Guardian struct {
 	blockTime          time.Duration
 	crossChainInterval time.Duration
 	l1StartHash        gethcommon.Hash
+	messageBusAddress  gethcommon.Address
 	maxRollupSize      uint64
+	l2LagThreshold     config.CountThreshold
 
 	hostInterrupter *stopcontrol.StopControl // host hostInterrupter so we can stop quickly
 
@@ -78,6 +88,14 @@ type Guardian struct {
 	maxBatchInterval time.Duration
 	lastBatchCreated time.Time
 	enclaveID        *common.EnclaveID
+
+	// sequencer enclaves are elected active or standby by the enclave service, only the active one produces batches.
+	// Changes to the flag are made holding both locks, so that once an enclave is demoted it has no production call in
+	// progress and none of its batches are published.
+	activeSequencer atomic.Bool
+	productionLock  sync.Mutex // held while the active enclave produces a batch or rollup
+	enclaveEnabled  bool       // whether the enclave was told it is the active sequencer, guarded by the production lock
+	publishLock     sync.Mutex // held while a batch streamed from the active enclave is published
 }
 
 func NewGuardian(cfg *config.HostConfig, hostData host.Identity, serviceLocator guardianServiceLocator, enclaveClient common.Enclave, storage storage.Storage, interrupter *stopcontrol.StopControl, logger gethlog.Logger) *Guardian {
@@ -90,9 +108,11 @@ func NewGuardian(cfg *config.HostConfig, hostData host.Identity, serviceLocator
 		maxBatchInterval:   cfg.MaxBatchInterval,
 		rollupInterval:     cfg.RollupInterval,
 		l1StartHash:        cfg.L1StartHash,
+		messageBusAddress:  cfg.MessageBusAddress,
 		maxRollupSize:      cfg.MaxRollupSize,
 		blockTime:          cfg.L1BlockTime,
 		crossChainInterval: cfg.CrossChainInterval,
+		l2LagThreshold:     cfg.HealthThresholds.EnclaveL2Lag,
 		storage:            storage,
 		hostInterrupter:    interrupter,
 		logger:             logger,
@@ -123,7 +143,7 @@ func (g *Guardian) Start() error {
 	go g.mainLoop()
 	if g.hostData.IsSequencer {
 		// if we are a sequencer then we need to start the periodic batch/rollup production
-		// Note: after HA work this will need additional check that we are the **active** sequencer enclave
+		// (the production only happens while the enclave is the active sequencer enclave)
 		go g.periodicBatchProduction()
 		go g.periodicRollupProduction()
 		go g.periodicBundleSubmission()
@@ -156,13 +176,26 @@ func (g *Guardian) Stop() error {
 	return nil
 }
 
-func (g *Guardian) HealthStatus(context.Context) host.HealthStatus {
-	// todo (@matt) do proper health status based on enclave state
-	errMsg := ""
-	if !g.hostInterrupter.IsStopping() {
-		errMsg = "not running"
+// HealthStatus checks the enclave health, which in turn checks the DB health, and grades how far the enclave is behind
+// the L2 head known to the host
+func (g *Guardian) HealthStatus(ctx context.Context) host.HealthStatus {
+	status := &host.MeasuredHealthStatus{}
+	if g.hostInterrupter.IsStopping() {
+		status.Errors = append(status.Errors, errors.New("not running"))
+		return status
 	}
-	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
+	enclaveHealthy, err := g.enclaveClient.HealthCheck(ctx)
+	if err != nil {
+		status.Errors = append(status.Errors, fmt.Errorf("unable to HealthCheck enclave - %w", err))
+	} else if !enclaveHealthy {
+		status.Errors = append(status.Errors, errors.New("enclave reported itself not healthy"))
+	}
+	if !g.state.InSyncWithL1() {
+		status.Errors = append(status.Errors, errors.New("enclave not in sync with L1"))
+	}
+	status.Measure("status", g.state.GetStatus().String())
+	status.CheckCount("l2Lag", g.state.L2Lag(), g.l2LagThreshold)
+	return status
 }
 
 func (g *Guardian) GetEnclaveState() *StateTracker {
@@ -194,13 +227,13 @@ func (g *Guardian) HandleBlock(block *types.Block) {
 }
 
 // HandleBatch is called by the L2 repository when a new batch arrives
-// Note: this should only be called for validators, sequencers produce their own batches
+// Note: the active sequencer enclave produces its own batches, validators and standby sequencer enclaves follow them
 func (g *Guardian) HandleBatch(batch *common.ExtBatch) {
 	g.logger.Debug("Host received L2 batch", log.BatchHashKey, batch.Hash(), log.BatchSeqNoKey, batch.Header.SequencerOrderNo)
 	// record the newest batch we've seen
 	g.state.OnReceivedBatch(batch.Header.SequencerOrderNo)
-	// Sequencer enclaves produce batches, they cannot receive them. Also, enclave will reject new batches if it is not up-to-date
-	if g.hostData.IsSequencer || !g.state.IsUpToDate() {
+	// The active sequencer enclave produced the batch, it cannot receive it. Also, enclave will reject new batches if it is not up-to-date
+	if g.IsActiveSequencer() || !g.state.IsUpToDate() {
 		return // ignore batches until we're up-to-date
 	}
 	// todo - @matt - does it make sense to use a timeout context?
@@ -311,7 +344,11 @@ func (g *Guardian) provideSecret() error {
 		if err != nil {
 			return fmt.Errorf("next block after block=%s not found - %w", awaitFromBlock, err)
 		}
-		secretRespTxs := g.sl.L1Publisher().FindSecretResponseTx(nextBlock)
+		receipts, err := g.sl.L1Repo().FetchObscuroReceipts(nextBlock)
+		if err != nil {
+			return fmt.Errorf("could not fetch receipts for block=%s - %w", nextBlock.Hash(), err)
+		}
+		secretRespTxs := g.sl.L1Publisher().FindSecretResponseTx(nextBlock, receipts)
 		for _, scrt := range secretRespTxs {
 			if scrt.RequesterID.Hex() == g.enclaveID.Hex() {
 				err = g.enclaveClient.InitEnclave(context.Background(), scrt.Secret)
@@ -392,8 +429,8 @@ func (g *Guardian) catchupWithL1() error {
 func (g *Guardian) catchupWithL2() error {
 	// while we are behind the L2 head and still running:
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L2Catchup {
-		if g.hostData.IsSequencer {
-			return errors.New("l2 catchup is not supported for sequencer")
+		if g.IsActiveSequencer() {
+			return errors.New("l2 catchup is not supported for the active sequencer")
 		}
 		// request the next batch by sequence number (based on what the enclave has been fed so far)
 		prevHead := g.state.GetEnclaveL2Head()
@@ -426,7 +463,7 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 		g.submitDataLock.Unlock() // lock must be released before returning
 		return false, fmt.Errorf("could not fetch obscuro receipts for block=%s - %w", block.Hash(), err)
 	}
-	txsReceiptsAndBlobs, rollupTxs, contractAddressTxs := g.sl.L1Publisher().ExtractRelevantTenTransactions(block, receipts)
+	txsReceiptsAndBlobs, rollupTxs, contractAddressTxs, bundleTxs := g.sl.L1Publisher().ExtractRelevantTenTransactions(block, receipts)
 
 	resp, err := g.enclaveClient.SubmitL1Block(context.Background(), block.Header(), txsReceiptsAndBlobs)
 	g.submitDataLock.Unlock() // lock is only guarding the enclave call, so we can release it now
@@ -449,6 +486,10 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 	// successfully processed block, update the state
 	g.state.OnProcessedBlock(block.Hash())
 	g.processL1BlockTransactions(block, rollupTxs, contractAddressTxs)
+	g.indexCrossChainMessages(block, receipts, bundleTxs)
+	if resp.L1Fork != nil {
+		g.recordL1Fork(resp.L1Fork)
+	}
 
 	if err != nil {
 		return false, fmt.Errorf("submitted block to enclave but could not store the block processing result. Cause: %w", err)
@@ -500,6 +541,39 @@ func (g *Guardian) processL1BlockTransactions(block *common.L1Block, rollupTxs [
 	}
 }
 
+// recordL1Fork keeps the L1 reorgs seen by the enclave, so operators and users can see which batches they affected.
+// Like the other indexes it is informational, so failures are only logged.
+func (g *Guardian) recordL1Fork(fork *common.L1Fork) {
+	g.logger.Info("Enclave processed an L1 fork", "new_head", fork.NewHead, "common_ancestor", fork.CommonAncestor,
+		"abandoned_blocks", len(fork.NonCanonicalPath))
+	if err := g.storage.AddL1Fork(fork); err != nil {
+		g.logger.Error("Could not store L1 fork.", log.BlockHashKey, fork.NewHead, log.ErrKey, err)
+	}
+}
+
+// indexCrossChainMessages records the inbound messages of the block and moves the indexed messages along their lifecycle.
+// The index is informational, so failures are logged rather than interrupting block processing.
+func (g *Guardian) indexCrossChainMessages(block *common.L1Block, receipts types.Receipts, bundleTxs []*ethadapter.L1CrossChainBundleTx) {
+	records, err := l1.ExtractInboundCrossChainMessages(block.Header(), receipts, g.messageBusAddress)
+	if err != nil {
+		g.logger.Error("Could not extract inbound cross chain messages.", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+	} else if err = g.storage.AddInboundCrossChainMessages(records); err != nil {
+		g.logger.Error("Could not store inbound cross chain messages.", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+	}
+
+	for _, bundle := range bundleTxs {
+		if err = g.storage.PublishCrossChainBundle(bundle.CrossChainRootHashes, block.NumberU64()); err != nil {
+			g.logger.Error("Could not mark cross chain bundle as published.", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+		}
+	}
+
+	if block.NumberU64() > _crossChainFinalityDepth {
+		if err = g.storage.FinaliseCrossChainMessages(block.NumberU64() - _crossChainFinalityDepth); err != nil {
+			g.logger.Error("Could not finalise cross chain messages.", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+		}
+	}
+}
+
 func (g *Guardian) publishSharedSecretResponses(scrtResponses []*common.ProducedSecretResponse) error {
 	for _, scrtResponse := range scrtResponses {
 		// todo (#1624) - implement proper protocol so only one host responds to this secret requests initially
@@ -552,14 +626,7 @@ func (g *Guardian) periodicBatchProduction() {
 				g.logger.Debug("Skipping batch production because L1 is not up to date")
 				continue
 			}
-			g.logger.Debug("Create batch")
-			// if maxBatchInterval is set higher than batchInterval then we are happy to skip creating batches when there is no data
-			// (up to a maximum time of maxBatchInterval)
-			skipBatchIfEmpty := g.maxBatchInterval > g.batchInterval && time.Since(g.lastBatchCreated) < g.maxBatchInterval
-			err := g.enclaveClient.CreateBatch(context.Background(), skipBatchIfEmpty)
-			if err != nil {
-				g.logger.Error("Unable to produce batch", log.ErrKey, err)
-			}
+			g.produceBatch()
 		case <-g.hostInterrupter.Done():
 			// interrupted - end periodic process
 			batchProdTicker.Stop()
@@ -568,6 +635,33 @@ func (g *Guardian) periodicBatchProduction() {
 	}
 }
 
+func (g *Guardian) produceBatch() {
+	g.productionLock.Lock()
+	defer g.productionLock.Unlock()
+	if !g.IsActiveSequencer() {
+		return
+	}
+	g.logger.Debug("Create batch")
+	// if maxBatchInterval is set higher than batchInterval then we are happy to skip creating batches when there is no data
+	// (up to a maximum time of maxBatchInterval)
+	skipBatchIfEmpty := g.maxBatchInterval > g.batchInterval && time.Since(g.lastBatchCreated) < g.maxBatchInterval
+	ctx, cancel := context.WithTimeout(context.Background(), _maxProductionTime)
+	defer cancel()
+	if !g.enclaveEnabled {
+		if err := g.enclaveClient.SetActiveSequencer(ctx, true); err != nil {
+			g.logger.Error("Unable to enable batch production of the enclave", log.ErrKey, err)
+			return
+		}
+		g.enclaveEnabled = true
+	}
+	err := g.enclaveClient.CreateBatch(ctx, skipBatchIfEmpty)
+	if err != nil {
+		g.logger.Error("Unable to produce batch", log.ErrKey, err)
+		// the enclave forgets it is the active sequencer when it restarts, so it is enabled again before the next batch
+		g.enclaveEnabled = false
+	}
+}
+
 const batchCompressionFactor = 0.85
 
 func (g *Guardian) periodicRollupProduction() {
@@ -580,6 +674,9 @@ func (g *Guardian) periodicRollupProduction() {
 	for {
 		select {
 		case <-rollupCheckTicker.C:
+			if !g.IsActiveSequencer() {
+				continue
+			}
 			if !g.state.IsUpToDate() {
 				// if we're behind the L1, we don't want to produce rollups
 				g.logger.Debug("Skipping rollup production because L1 is not up to date", "state", g.state)
@@ -610,7 +707,7 @@ func (g *Guardian) periodicRollupProduction() {
 			sizeExceeded := estimatedRunningRollupSize >= g.maxRollupSize
 			if timeExpired || sizeExceeded {
 				g.logger.Info("Trigger rollup production.", "timeExpired", timeExpired, "sizeExceeded", sizeExceeded)
-				producedRollup, err := g.enclaveClient.CreateRollup(context.Background(), fromBatch)
+				producedRollup, err := g.produceRollup(fromBatch)
 				if err != nil {
 					g.logger.Error("Unable to create rollup", log.BatchSeqNoKey, fromBatch, log.ErrKey, err)
 					continue
@@ -628,6 +725,17 @@ func (g *Guardian) periodicRollupProduction() {
 	}
 }
 
+func (g *Guardian) produceRollup(fromBatch uint64) (*common.ExtRollup, error) {
+	g.productionLock.Lock()
+	defer g.productionLock.Unlock()
+	if !g.IsActiveSequencer() {
+		return nil, errors.New("enclave was demoted from active sequencer")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), _maxProductionTime)
+	defer cancel()
+	return g.enclaveClient.CreateRollup(ctx, fromBatch, g.sl.L1Publisher().DAMode())
+}
+
 func (g *Guardian) periodicBundleSubmission() {
 	defer g.logger.Info("Stopping bundle submission")
 
@@ -639,6 +747,9 @@ func (g *Guardian) periodicBundleSubmission() {
 	for {
 		select {
 		case <-bundleSubmissionTicker.C:
+			if !g.IsActiveSequencer() {
+				continue
+			}
 			err := g.sl.CrossChainMachine().Synchronize()
 			if err != nil {
 				g.logger.Error("Failed to synchronize cross chain state machine", log.ErrKey, err)
@@ -679,29 +790,17 @@ func (g *Guardian) streamEnclaveData() {
 				continue
 			}
 
-			if resp.Batch != nil { //nolint:nestif
+			if resp.Batch != nil {
+				g.handleMissedBatches(lastBatch, resp.Batch)
 				lastBatch = resp.Batch
 				g.logger.Trace("Received batch from stream", log.BatchHashKey, lastBatch.Hash())
-				err := g.sl.L2Repo().AddBatch(resp.Batch)
-				if err != nil && !errors.Is(err, errutil.ErrAlreadyExists) {
-					// todo (@matt) this is a catastrophic scenario, the host may never get that batch - handle this
-					g.logger.Crit("failed to add batch to L2 repo", log.BatchHashKey, resp.Batch.Hash(), log.ErrKey, err)
-				}
-
-				if g.hostData.IsSequencer { // if we are the sequencer we need to broadcast this new batch to the network
-					g.lastBatchCreated = time.Now()
-					g.logger.Info("Batch produced. Sending to peers..", log.BatchHeightKey, resp.Batch.Header.Number, log.BatchHashKey, resp.Batch.Hash())
+				g.handleStreamedBatch(resp.Batch)
+			}
 
-					err = g.sl.P2P().BroadcastBatches([]*common.ExtBatch{resp.Batch})
-					if err != nil {
-						g.logger.Error("Failed to broadcast batch", log.BatchHashKey, resp.Batch.Hash(), log.ErrKey, err)
-					}
-				} else {
-					g.logger.Debug("Received validated batch from enclave", log.BatchSeqNoKey, resp.Batch.Header.SequencerOrderNo, log.BatchHashKey, resp.Batch.Hash())
+			if len(resp.ValueTransfers) > 0 {
+				if err := g.storage.AddOutboundValueTransfers(resp.ValueTransfers); err != nil {
+					g.logger.Error("Could not store the outbound value transfers", log.ErrKey, err)
 				}
-				// Notify the L2 repo that an enclave has validated a batch, so it can update its validated head and notify subscribers
-				g.sl.L2Repo().NotifyNewValidatedHead(resp.Batch)
-				g.state.OnProcessedBatch(resp.Batch.Header.SequencerOrderNo)
 			}
 
 			if resp.Logs != nil {
@@ -715,6 +814,121 @@ func (g *Guardian) streamEnclaveData() {
 	}
 }
 
+func (g *Guardian) handleStreamedBatch(batch *common.ExtBatch) {
+	if g.hostData.IsSequencer {
+		g.handleSequencerBatch(batch)
+	} else {
+		g.addValidatedBatch(batch)
+	}
+	g.state.OnProcessedBatch(batch.Header.SequencerOrderNo)
+}
+
+// handleMissedBatches fetches from the enclave the batches produced between the last batch streamed and the new one.
+// They are missing when the stream broke in between, e.g. because the enclave restarted, and the host would never
+// get them otherwise.
+func (g *Guardian) handleMissedBatches(lastBatch *common.ExtBatch, batch *common.ExtBatch) {
+	if lastBatch == nil {
+		return
+	}
+	for seqNo := lastBatch.Header.SequencerOrderNo.Uint64() + 1; seqNo < batch.Header.SequencerOrderNo.Uint64(); seqNo++ {
+		missed, err := g.enclaveClient.GetBatchBySeqNo(context.Background(), seqNo)
+		if err != nil {
+			g.logger.Error("Could not fetch the batch missed by the stream", log.BatchSeqNoKey, seqNo, log.ErrKey, err)
+			return
+		}
+		g.logger.Info("Recovered batch missed by the stream", log.BatchSeqNoKey, seqNo, log.BatchHashKey, missed.Hash())
+		g.handleStreamedBatch(missed)
+	}
+}
+
+// handleSequencerBatch publishes the batches of the active sequencer enclave. The batches streamed by a standby enclave
+// are the ones it received from the active enclave, so they are already known to the host.
+func (g *Guardian) handleSequencerBatch(batch *common.ExtBatch) {
+	g.publishLock.Lock()
+	defer g.publishLock.Unlock()
+	if !g.IsActiveSequencer() {
+		g.checkFollowedBatch(batch)
+		return
+	}
+
+	g.addValidatedBatch(batch)
+	// we are the active sequencer, so we need to broadcast this new batch to the network
+	g.lastBatchCreated = time.Now()
+	g.logger.Info("Batch produced. Sending to peers..", log.BatchHeightKey, batch.Header.Number, log.BatchHashKey, batch.Hash())
+	err := g.sl.P2P().BroadcastBatches([]*common.ExtBatch{batch})
+	if err != nil {
+		g.logger.Error("Failed to broadcast batch", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+	}
+}
+
+func (g *Guardian) addValidatedBatch(batch *common.ExtBatch) {
+	err := g.sl.L2Repo().AddBatch(batch)
+	if err != nil && !errors.Is(err, errutil.ErrAlreadyExists) {
+		// todo (@matt) this is a catastrophic scenario, the host may never get that batch - handle this
+		g.logger.Crit("failed to add batch to L2 repo", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+	}
+	if !g.IsActiveSequencer() {
+		g.logger.Debug("Received validated batch from enclave", log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.BatchHashKey, batch.Hash())
+	}
+	// Notify the L2 repo that an enclave has validated a batch, so it can update its validated head and notify subscribers
+	g.sl.L2Repo().NotifyNewValidatedHead(batch)
+}
+
+// checkFollowedBatch reports a batch streamed by a standby enclave that does not match the published one. This is only
+// expected from an enclave that was demoted while it was producing, the fencing guarantees the batch is never published.
+func (g *Guardian) checkFollowedBatch(batch *common.ExtBatch) {
+	published, err := g.storage.FetchBatchBySeqNo(batch.Header.SequencerOrderNo.Uint64())
+	if err != nil {
+		if !errors.Is(err, errutil.ErrNotFound) {
+			g.logger.Warn("Could not fetch published batch", log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.ErrKey, err)
+		}
+		g.logger.Warn("Standby sequencer enclave produced a batch that was not published", log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.BatchHashKey, batch.Hash())
+		return
+	}
+	if published.Hash() != batch.Hash() {
+		g.logger.Error("Standby sequencer enclave has a batch competing with the published one",
+			log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.BatchHashKey, batch.Hash(), "published", published.Hash())
+	}
+}
+
+// IsActiveSequencer returns true if the enclave is the one producing the batches of the sequencer host
+func (g *Guardian) IsActiveSequencer() bool {
+	return g.activeSequencer.Load()
+}
+
+// promoteSequencer makes the enclave the active sequencer. The enclave service only promotes an enclave that has
+// executed the head batch published by the host, so its batches continue the published chain.
+func (g *Guardian) promoteSequencer(ctx context.Context) error {
+	g.productionLock.Lock()
+	defer g.productionLock.Unlock()
+	g.publishLock.Lock()
+	defer g.publishLock.Unlock()
+	g.logger.Info("Promoting enclave to active sequencer")
+	if err := g.enclaveClient.SetActiveSequencer(ctx, true); err != nil {
+		return fmt.Errorf("could not enable batch production of the enclave. Cause: %w", err)
+	}
+	g.enclaveEnabled = true
+	g.activeSequencer.Store(true)
+	return nil
+}
+
+// demoteSequencer fences the enclave off batch production. When it returns the enclave has no production call in
+// progress and the batches it streams are no longer published. The enclave is fenced too, so a batch creation call the
+// host gave up on cannot produce a batch once another enclave is promoted.
+func (g *Guardian) demoteSequencer(ctx context.Context) {
+	g.productionLock.Lock()
+	defer g.productionLock.Unlock()
+	g.publishLock.Lock()
+	defer g.publishLock.Unlock()
+	g.logger.Warn("Demoting enclave from active sequencer")
+	g.activeSequencer.Store(false)
+	g.enclaveEnabled = false
+	// an unreachable enclave still drops the batch of an expired call, it only signs batches for calls in progress
+	if err := g.enclaveClient.SetActiveSequencer(ctx, false); err != nil {
+		g.logger.Warn("Unable to disable batch production of the demoted enclave", log.ErrKey, err)
+	}
+}
+
 func (g *Guardian) calculateNonRolledupBatchesSize(seqNo uint64) (uint64, error) {
 	var size uint64
 
@@ -0,0 +1,41 @@
+package enclave
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+func TestL1ForkIsRecordedOnceWhenSeenByEveryEnclave(t *testing.T) {
+	logger := gethlog.New()
+	hostStorage := storage.NewHostStorageFromConfig(&config.HostConfig{UseInMemoryDB: true}, logger)
+	t.Cleanup(func() { hostStorage.Close() })
+	// the guardians of a host with two enclaves share its storage, and both enclaves report the fork
+	guardians := []*Guardian{
+		{storage: hostStorage, logger: logger},
+		{storage: hostStorage, logger: logger},
+	}
+	fork := &common.L1Fork{
+		NewHead:              gethcommon.Hash{1},
+		NewHeight:            12,
+		OldHead:              gethcommon.Hash{2},
+		CommonAncestor:       gethcommon.Hash{3},
+		CommonAncestorHeight: 10,
+		CanonicalPath:        []gethcommon.Hash{{4}, {1}},
+		NonCanonicalPath:     []gethcommon.Hash{{5}, {2}},
+	}
+	for _, g := range guardians {
+		g.recordL1Fork(fork)
+	}
+
+	listing, err := hostStorage.FetchL1ForkListing(&common.QueryPagination{Offset: 0, Size: 10})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), listing.Total)
+	require.Len(t, listing.ForksData, 1)
+	require.Equal(t, fork.NewHead, listing.ForksData[0].NewHead)
+}
@@ -0,0 +1,240 @@
+package enclave
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+const (
+	// number of consecutive failed requests after which an enclave stops receiving reads
+	_breakerFailureThreshold = 5
+	// time an enclave stops receiving reads before a single request is let through to probe it
+	_breakerCoolDown = 10 * time.Second
+)
+
+// circuitBreaker stops routing reads to an enclave that keeps failing them. After the cool-down it lets one request
+// through (half-open), which closes the breaker if it succeeds or opens it again if it fails.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	failures    int
+	openedAt    time.Time
+	open        bool
+	probing     bool
+	now         func() time.Time
+	openGauge   gethmetrics.Gauge
+	tripCounter gethmetrics.Counter
+}
+
+func newCircuitBreaker(idx int, registry gethmetrics.Registry) *circuitBreaker {
+	return &circuitBreaker{
+		now:         time.Now,
+		openGauge:   gethmetrics.GetOrRegisterGauge(fmt.Sprintf("host/enclave/%d/breaker/open", idx), registry),
+		tripCounter: gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/enclave/%d/breaker/trips", idx), registry),
+	}
+}
+
+// allow returns true if a request can be routed to the enclave, it reserves the probe when the breaker is half-open
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < _breakerCoolDown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) onResult(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		if b.open {
+			b.open = false
+			b.openGauge.Update(0)
+		}
+		return
+	}
+	b.failures++
+	if b.open || b.failures >= _breakerFailureThreshold {
+		if !b.open {
+			b.tripCounter.Inc(1)
+		}
+		b.open = true
+		b.openedAt = b.now()
+		b.openGauge.Update(1)
+	}
+}
+
+// routingMetrics count the enclave each request was routed to
+type routingMetrics struct {
+	reads        []gethmetrics.Counter
+	writes       []gethmetrics.Counter
+	readFallback gethmetrics.Counter
+}
+
+func newRoutingMetrics(numEnclaves int, registry gethmetrics.Registry) *routingMetrics {
+	m := &routingMetrics{
+		reads:        make([]gethmetrics.Counter, numEnclaves),
+		writes:       make([]gethmetrics.Counter, numEnclaves),
+		readFallback: gethmetrics.GetOrRegisterCounter("host/enclave/reads/fallback", registry),
+	}
+	for i := 0; i < numEnclaves; i++ {
+		m.reads[i] = gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/enclave/%d/reads", i), registry)
+		m.writes[i] = gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/enclave/%d/writes", i), registry)
+	}
+	return m
+}
+
+// readableGuardian picks the next enclave, in round-robin order, that is caught up with the L2 head and whose circuit
+// breaker lets the request through. It falls back to the primary enclave when no enclave qualifies.
+func (e *Service) readableGuardian() (*Guardian, *circuitBreaker) {
+	upToDate := make([]int, 0, len(e.enclaveGuardians))
+	for idx, guardian := range e.enclaveGuardians {
+		if guardian.GetEnclaveState().IsUpToDate() {
+			upToDate = append(upToDate, idx)
+		}
+	}
+	// rotate over the up-to-date enclaves only, so a lagging enclave does not shift its share of reads onto its neighbour
+	start := e.nextRead.Add(1)
+	for i := range upToDate {
+		idx := upToDate[(start+uint64(i))%uint64(len(upToDate))]
+		if !e.breakers[idx].allow() {
+			continue
+		}
+		e.metrics.reads[idx].Inc(1)
+		return e.enclaveGuardians[idx], e.breakers[idx]
+	}
+	e.metrics.readFallback.Inc(1)
+	return e.primaryGuardian(), nil
+}
+
+// routedEnclave is the client of the enclave a read request was routed to. It records the outcome of the read
+// requests with the circuit breaker of the enclave, the other calls go to the enclave client directly.
+type routedEnclave struct {
+	common.Enclave
+	breaker *circuitBreaker
+}
+
+// record feeds the breaker with system errors, which are failures of the enclave rather than of the request
+func (r *routedEnclave) record(sysErr common.SystemError) {
+	if r.breaker == nil {
+		return
+	}
+	if sysErr != nil {
+		r.breaker.onResult(sysErr)
+	} else {
+		r.breaker.onResult(nil)
+	}
+}
+
+func (r *routedEnclave) ObsCall(ctx context.Context, encryptedParams common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
+	resp, sysErr := r.Enclave.ObsCall(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetTransactionCount(ctx context.Context, encryptedParams common.EncryptedParamsGetTxCount) (*responses.TxCount, common.SystemError) {
+	resp, sysErr := r.Enclave.GetTransactionCount(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetTransaction(ctx context.Context, encryptedParams common.EncryptedParamsGetTxByHash) (*responses.TxByHash, common.SystemError) {
+	resp, sysErr := r.Enclave.GetTransaction(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetTransactionReceipt(ctx context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
+	resp, sysErr := r.Enclave.GetTransactionReceipt(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetBalance(ctx context.Context, encryptedParams common.EncryptedParamsGetBalance) (*responses.Balance, common.SystemError) {
+	resp, sysErr := r.Enclave.GetBalance(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetCode(ctx context.Context, address gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, common.SystemError) {
+	resp, sysErr := r.Enclave.GetCode(ctx, address, blockNrOrHash)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetStorageSlot(ctx context.Context, encryptedParams common.EncryptedParamsGetStorageSlot) (*responses.EnclaveResponse, common.SystemError) {
+	resp, sysErr := r.Enclave.GetStorageSlot(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) EstimateGas(ctx context.Context, encryptedParams common.EncryptedParamsEstimateGas) (*responses.Gas, common.SystemError) {
+	resp, sysErr := r.Enclave.EstimateGas(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetLogs(ctx context.Context, encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
+	resp, sysErr := r.Enclave.GetLogs(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) DebugTraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+	resp, sysErr := r.Enclave.DebugTraceTransaction(ctx, hash, config)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) DebugEventLogRelevancy(ctx context.Context, encryptedParams common.EncryptedParamsDebugLogRelevancy) (*responses.DebugLogs, common.SystemError) {
+	resp, sysErr := r.Enclave.DebugEventLogRelevancy(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetTotalContractCount(ctx context.Context) (*big.Int, common.SystemError) {
+	resp, sysErr := r.Enclave.GetTotalContractCount(ctx)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, common.SystemError) {
+	resp, sysErr := r.Enclave.GetPublicContract(ctx, address)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, common.SystemError) {
+	resp, sysErr := r.Enclave.GetPublicContractEvents(ctx, address, pagination)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, common.SystemError) {
+	resp, sysErr := r.Enclave.GetPublicAddressActivity(ctx, address, pagination)
+	r.record(sysErr)
+	return resp, sysErr
+}
+
+func (r *routedEnclave) GetPersonalTransactions(ctx context.Context, encryptedParams common.EncryptedParamsGetPersonalTransactions) (*responses.PersonalTransactionsResponse, common.SystemError) {
+	resp, sysErr := r.Enclave.GetPersonalTransactions(ctx, encryptedParams)
+	r.record(sysErr)
+	return resp, sysErr
+}
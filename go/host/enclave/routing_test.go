@@ -0,0 +1,93 @@
+package enclave
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+func TestReadsSpreadAcrossUpToDateEnclaves(t *testing.T) {
+	head := testBatch(5, 1)
+	first := newLiveGuardian(head)
+	second := newLiveGuardian(head)
+	lagging := newTestGuardian(testBatch(4, 1))
+	lagging.state.OnReceivedBatch(head.Header.SequencerOrderNo)
+	lagging.state.OnEnclaveStatus(common.Status{StatusCode: common.Running, L1Head: _l1Block123, L2Head: big.NewInt(4)})
+	svc := newTestService(t, head, first, lagging, second)
+
+	routed := map[common.Enclave]int{}
+	for i := 0; i < 10; i++ {
+		routed[svc.GetReadEnclaveClient().(*routedEnclave).Enclave]++
+	}
+	require.Equal(t, 5, routed[first.GetEnclaveClient()])
+	require.Equal(t, 5, routed[second.GetEnclaveClient()])
+	require.Zero(t, routed[lagging.GetEnclaveClient()])
+}
+
+func TestReadsSkipEnclaveWithOpenBreaker(t *testing.T) {
+	head := testBatch(5, 1)
+	failing := newLiveGuardian(head)
+	failing.enclaveClient.(*fakeEnclave).callErr = errors.New("enclave unreachable")
+	healthy := newLiveGuardian(head)
+	svc := newTestService(t, head, failing, healthy)
+	clock := time.Now()
+	for _, b := range svc.breakers {
+		b.now = func() time.Time { return clock }
+	}
+
+	for i := 0; i < 2*_breakerFailureThreshold; i++ {
+		_, _ = svc.GetReadEnclaveClient().ObsCall(context.Background(), nil)
+	}
+	require.True(t, svc.breakers[0].open)
+	for i := 0; i < 4; i++ {
+		require.Equal(t, healthy.GetEnclaveClient(), svc.GetReadEnclaveClient().(*routedEnclave).Enclave)
+	}
+
+	// after the cool-down a single probe reaches the recovered enclave and closes the breaker
+	failing.enclaveClient.(*fakeEnclave).callErr = nil
+	clock = clock.Add(_breakerCoolDown)
+	for i := 0; i < 2; i++ {
+		_, err := svc.GetReadEnclaveClient().ObsCall(context.Background(), nil)
+		require.NoError(t, err)
+	}
+	require.False(t, svc.breakers[0].open)
+}
+
+func TestWritesGoToActiveSequencerEnclave(t *testing.T) {
+	head := testBatch(5, 1)
+	standby := newLiveGuardian(head)
+	active := newLiveGuardian(head)
+	svc := newTestService(t, head, standby, active)
+	svc.setActiveGuardian(active)
+
+	_, err := svc.SubmitAndBroadcastTx(context.Background(), nil)
+	require.NoError(t, err)
+	require.Zero(t, standby.enclaveClient.(*fakeEnclave).submitted)
+	require.Equal(t, 1, active.enclaveClient.(*fakeEnclave).submitted)
+}
+
+func newLiveGuardian(head *common.ExtBatch) *Guardian {
+	g := newTestGuardian(head)
+	g.state.OnReceivedBatch(head.Header.SequencerOrderNo)
+	// the status is only recalculated when the enclave reports it
+	g.state.OnEnclaveStatus(common.Status{StatusCode: common.Running, L1Head: _l1Block123, L2Head: head.Header.SequencerOrderNo})
+	return g
+}
+
+func (f *fakeEnclave) ObsCall(context.Context, common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
+	if f.callErr != nil {
+		return nil, f.callErr
+	}
+	return &responses.Call{}, nil
+}
+
+func (f *fakeEnclave) SubmitTx(context.Context, common.EncryptedTx) (*responses.RawTx, common.SystemError) {
+	f.submitted++
+	return &responses.RawTx{}, nil
+}
@@ -2,19 +2,27 @@ package enclave
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 	"github.com/ten-protocol/go-ten/go/responses"
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
 
+// number of consecutive failed health checks before the active sequencer enclave is demoted
+const _failedChecksBeforeFailover = 3
+
 // This private interface enforces the services that the enclaves service depends on
 type enclaveServiceLocator interface {
 	P2P() host.P2P
@@ -27,16 +35,35 @@ type Service struct {
 
 	// The service goes via the Guardians to talk to the enclave (because guardian knows if the enclave is healthy etc.)
 	enclaveGuardians []*Guardian
+	// the host DB holds the batches published by the sequencer host, which a standby has to execute before it is promoted
+	storage storage.Storage
+
+	// the sequencer enclave currently producing batches (nil while none is elected) and its consecutive failed health checks
+	activeSequencer *Guardian
+	activeLock      sync.RWMutex
+	failedChecks    int
+
+	// reads are spread round-robin over the enclaves that are caught up, skipping the ones whose breaker is open
+	nextRead atomic.Uint64
+	breakers []*circuitBreaker
+	metrics  *routingMetrics
 
 	running atomic.Bool
 	logger  gethlog.Logger
 }
 
-func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardians []*Guardian, logger gethlog.Logger) *Service {
+func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardians []*Guardian, storage storage.Storage, registry gethmetrics.Registry, logger gethlog.Logger) *Service {
+	breakers := make([]*circuitBreaker, len(enclaveGuardians))
+	for i := range enclaveGuardians {
+		breakers[i] = newCircuitBreaker(i, registry)
+	}
 	return &Service{
 		hostData:         hostData,
 		sl:               serviceLocator,
 		enclaveGuardians: enclaveGuardians,
+		storage:          storage,
+		breakers:         breakers,
+		metrics:          newRoutingMetrics(len(enclaveGuardians), registry),
 		logger:           logger,
 	}
 }
@@ -49,6 +76,9 @@ func (e *Service) Start() error {
 			return err
 		}
 	}
+	if e.hostData.IsSequencer {
+		go e.monitorSequencerEnclaves()
+	}
 	return nil
 }
 
@@ -67,35 +97,32 @@ func (e *Service) Stop() error {
 }
 
 func (e *Service) HealthStatus(ctx context.Context) host.HealthStatus {
+	status := &host.MeasuredHealthStatus{}
 	if !e.running.Load() {
-		return &host.BasicErrHealthStatus{ErrMsg: "not running"}
+		status.Errors = append(status.Errors, errors.New("not running"))
+		return status
 	}
 
-	errors := make([]error, 0, len(e.enclaveGuardians))
-
 	for i, guardian := range e.enclaveGuardians {
-		// check the enclave health, which in turn checks the DB health
-		enclaveHealthy, err := guardian.enclaveClient.HealthCheck(ctx)
-		if err != nil {
-			errors = append(errors, fmt.Errorf("unable to HealthCheck enclave[%d] - %w", i, err))
-		} else if !enclaveHealthy {
-			errors = append(errors, fmt.Errorf("enclave[%d] reported itself not healthy", i))
-		}
-
-		if !guardian.GetEnclaveState().InSyncWithL1() {
-			errors = append(errors, fmt.Errorf("enclave[%d] not in sync with L1", i))
+		enclave := fmt.Sprintf("enclave[%d]", i)
+		if e.hostData.IsSequencer && guardian != e.activeGuardian() {
+			// a standby sequencer enclave being unavailable does not affect the host
+			status.Measure(enclave+".status", guardian.GetEnclaveState().GetStatus().String())
+			continue
 		}
+		status.Include(enclave, guardian.HealthStatus(ctx))
 	}
 
-	// empty error msg means healthy
-	return &host.GroupErrsHealthStatus{Errors: errors}
+	if e.hostData.IsSequencer && e.activeGuardian() == nil {
+		status.Errors = append(status.Errors, fmt.Errorf("no active sequencer enclave"))
+	}
+	return status
 }
 
 // LookupBatchBySeqNo is used to fetch batch data from the enclave - it is only used as a fallback for the sequencer
 // host if it's missing a batch (other host services should use L2Repo to fetch batch data)
 func (e *Service) LookupBatchBySeqNo(ctx context.Context, seqNo *big.Int) (*common.ExtBatch, error) {
-	// todo (@matt) revisit this flow to make sure it handles HA scenarios properly
-	hg := e.enclaveGuardians[0]
+	hg := e.primaryGuardian()
 	state := hg.GetEnclaveState()
 	if state.GetEnclaveL2Head().Cmp(seqNo) < 0 {
 		return nil, errutil.ErrNotFound
@@ -104,16 +131,137 @@ func (e *Service) LookupBatchBySeqNo(ctx context.Context, seqNo *big.Int) (*comm
 	return client.GetBatchBySeqNo(ctx, seqNo.Uint64())
 }
 
+// GetEnclaveClient returns the client of the primary enclave, which handles the writes and the subscriptions (so that
+// unsubscribing reaches the enclave holding the subscription)
 func (e *Service) GetEnclaveClient() common.Enclave {
-	// for now we always return first guardian's enclave client
-	// in future be good to load balance and failover but need to improve subscribe/unsubscribe (unsubscribe from same enclave)
-	return e.enclaveGuardians[0].GetEnclaveClient()
+	return e.primaryGuardian().GetEnclaveClient()
+}
+
+// GetReadEnclaveClient returns the client of an enclave caught up with the L2 head, load balancing read requests across
+// the enclaves of the host
+func (e *Service) GetReadEnclaveClient() common.Enclave {
+	guardian, breaker := e.readableGuardian()
+	return &routedEnclave{Enclave: guardian.GetEnclaveClient(), breaker: breaker}
+}
+
+// writeGuardian is the enclave the transactions are submitted to, the active sequencer enclave on a sequencer host
+func (e *Service) writeGuardian() *Guardian {
+	guardian := e.primaryGuardian()
+	for i, g := range e.enclaveGuardians {
+		if g == guardian {
+			e.metrics.writes[i].Inc(1)
+		}
+	}
+	return guardian
+}
+
+// primaryGuardian is the active sequencer enclave on a sequencer host, falling back to the first enclave while none is
+// elected or when the host is a validator
+func (e *Service) primaryGuardian() *Guardian {
+	if active := e.activeGuardian(); active != nil {
+		return active
+	}
+	return e.enclaveGuardians[0]
+}
+
+func (e *Service) activeGuardian() *Guardian {
+	e.activeLock.RLock()
+	defer e.activeLock.RUnlock()
+	return e.activeSequencer
+}
+
+// monitorSequencerEnclaves elects the active sequencer enclave and fails over to a standby enclave when the active one
+// fails its health checks
+func (e *Service) monitorSequencerEnclaves() {
+	for e.running.Load() {
+		e.checkActiveSequencer(context.Background())
+		time.Sleep(_monitoringInterval)
+	}
+}
+
+// checkActiveSequencer is only called from the monitoring loop, so the active enclave can only change here. The lock
+// is only held to swap the active enclave, readers are not blocked while the enclaves are checked.
+func (e *Service) checkActiveSequencer(ctx context.Context) {
+	active := e.activeGuardian()
+	if active != nil {
+		if e.sequencerEnclaveHealthy(ctx, active) {
+			e.failedChecks = 0
+			return
+		}
+		e.failedChecks++
+		if e.failedChecks < _failedChecksBeforeFailover {
+			return
+		}
+		e.logger.Warn("Active sequencer enclave failed its health checks", log.EnclaveIDKey, active.enclaveID)
+		// the demotion fences the enclave, after it returns no more of its batches are published
+		active.demoteSequencer(ctx)
+		e.setActiveGuardian(nil)
+		e.failedChecks = 0
+	}
+
+	candidate, err := e.promotionCandidate(ctx)
+	if err != nil {
+		e.logger.Warn("No sequencer enclave can be promoted to active", log.ErrKey, err)
+		return
+	}
+	if err := candidate.promoteSequencer(ctx); err != nil {
+		e.logger.Warn("Sequencer enclave could not be promoted to active", log.EnclaveIDKey, candidate.enclaveID, log.ErrKey, err)
+		return
+	}
+	e.setActiveGuardian(candidate)
+}
+
+func (e *Service) setActiveGuardian(guardian *Guardian) {
+	e.activeLock.Lock()
+	defer e.activeLock.Unlock()
+	e.activeSequencer = guardian
+}
+
+// promotionCandidate returns the first healthy sequencer enclave that has executed the head batch published by the
+// host. Promoting an enclave that is behind would make it sign batches competing with the published ones.
+func (e *Service) promotionCandidate(ctx context.Context) (*Guardian, error) {
+	publishedHead, err := e.storage.FetchLatestBatch()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not fetch head batch published by the host. Cause: %w", err)
+	}
+	for _, guardian := range e.enclaveGuardians {
+		if !e.sequencerEnclaveHealthy(ctx, guardian) {
+			continue
+		}
+		if publishedHead != nil && !hasExecutedBatch(ctx, guardian, publishedHead) {
+			continue
+		}
+		return guardian, nil
+	}
+	return nil, errors.New("no healthy enclave has executed the published head batch")
+}
+
+func (e *Service) sequencerEnclaveHealthy(ctx context.Context, guardian *Guardian) bool {
+	if !guardian.GetEnclaveState().InSyncWithL1() {
+		return false
+	}
+	// the enclaves only pass their health checks once they store batches, so until the genesis batch is published an
+	// enclave in sync with the L1 is healthy enough to produce it
+	if _, err := e.storage.FetchLatestBatch(); errors.Is(err, errutil.ErrNotFound) {
+		return true
+	}
+	healthy, err := guardian.GetEnclaveClient().HealthCheck(ctx)
+	return err == nil && healthy
+}
+
+func hasExecutedBatch(ctx context.Context, guardian *Guardian, batch *common.BatchHeader) bool {
+	l2Head := guardian.GetEnclaveState().GetEnclaveL2Head()
+	if l2Head == nil || l2Head.Cmp(batch.SequencerOrderNo) < 0 {
+		return false
+	}
+	executed, err := guardian.GetEnclaveClient().GetBatchBySeqNo(ctx, batch.SequencerOrderNo.Uint64())
+	return err == nil && executed.Hash() == batch.Hash()
 }
 
 func (e *Service) SubmitAndBroadcastTx(ctx context.Context, encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error) {
 	encryptedTx := common.EncryptedTx(encryptedParams)
 
-	enclaveResponse, sysError := e.GetEnclaveClient().SubmitTx(ctx, encryptedTx)
+	enclaveResponse, sysError := e.writeGuardian().GetEnclaveClient().SubmitTx(ctx, encryptedTx)
 	if sysError != nil {
 		e.logger.Warn("Could not submit transaction due to sysError.", log.ErrKey, sysError)
 		return nil, sysError
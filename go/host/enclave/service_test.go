@@ -0,0 +1,156 @@
+package enclave
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+func TestServiceFailsOverToStandbyWithPublishedHead(t *testing.T) {
+	published := testBatch(5, 1)
+	active := newTestGuardian(published)
+	// a previously demoted enclave that signed a batch which was never published
+	fenced := newTestGuardian(testBatch(5, 2))
+	standby := newTestGuardian(published)
+	svc := newTestService(t, published, active, fenced, standby)
+
+	svc.checkActiveSequencer(context.Background())
+	require.True(t, active.IsActiveSequencer())
+	require.Equal(t, active.GetEnclaveClient(), svc.GetEnclaveClient())
+
+	active.enclaveClient.(*fakeEnclave).healthy = false
+	for i := 0; i < _failedChecksBeforeFailover-1; i++ {
+		svc.checkActiveSequencer(context.Background())
+		require.True(t, active.IsActiveSequencer(), "demoted before the failure threshold")
+	}
+
+	svc.checkActiveSequencer(context.Background())
+	require.False(t, active.IsActiveSequencer())
+	require.False(t, fenced.IsActiveSequencer())
+	require.True(t, standby.IsActiveSequencer())
+	require.Equal(t, standby.GetEnclaveClient(), svc.GetEnclaveClient())
+	// the enclaves themselves are fenced, not only the host
+	require.False(t, active.enclaveClient.(*fakeEnclave).active)
+	require.True(t, standby.enclaveClient.(*fakeEnclave).active)
+}
+
+func TestServiceDoesNotPromoteEnclaveThatCannotBeEnabled(t *testing.T) {
+	published := testBatch(5, 1)
+	sequencer := newTestGuardian(published)
+	sequencer.enclaveClient.(*fakeEnclave).callErr = errors.New("enclave unreachable")
+	svc := newTestService(t, published, sequencer)
+
+	svc.checkActiveSequencer(context.Background())
+	require.False(t, sequencer.IsActiveSequencer())
+	require.Nil(t, svc.activeGuardian())
+}
+
+func TestServiceOnlyPromotesStandbyOnceCaughtUp(t *testing.T) {
+	previous := testBatch(4, 1)
+	published := testBatch(5, 1)
+	standby := newTestGuardian(previous)
+	svc := newTestService(t, published, standby)
+
+	svc.checkActiveSequencer(context.Background())
+	require.False(t, standby.IsActiveSequencer())
+	require.Nil(t, svc.activeGuardian())
+
+	// the standby executes the published head
+	standby.enclaveClient.(*fakeEnclave).batches[5] = published
+	standby.state.OnProcessedBatch(published.Header.SequencerOrderNo)
+
+	svc.checkActiveSequencer(context.Background())
+	require.True(t, standby.IsActiveSequencer())
+}
+
+func TestServicePromotesSequencerBeforeGenesis(t *testing.T) {
+	// the enclave does not pass its health check because it has no batches yet
+	sequencer := newTestGuardian(testBatch(1, 1))
+	sequencer.enclaveClient.(*fakeEnclave).healthy = false
+	svc := newTestService(t, nil, sequencer)
+
+	svc.checkActiveSequencer(context.Background())
+	require.True(t, sequencer.IsActiveSequencer())
+
+	// once the genesis batch is published, the enclave must pass its health checks to remain active
+	require.NoError(t, svc.storage.AddBatch(testBatch(1, 1)))
+	for i := 0; i < _failedChecksBeforeFailover; i++ {
+		svc.checkActiveSequencer(context.Background())
+	}
+	require.False(t, sequencer.IsActiveSequencer())
+}
+
+func newTestService(t *testing.T, published *common.ExtBatch, guardians ...*Guardian) *Service {
+	logger := gethlog.New()
+	hostStorage := storage.NewHostStorageFromConfig(&config.HostConfig{UseInMemoryDB: true}, logger)
+	t.Cleanup(func() { hostStorage.Close() })
+	if published != nil {
+		require.NoError(t, hostStorage.AddBatch(published))
+	}
+	return NewService(host.Identity{IsSequencer: true}, nil, guardians, hostStorage, gethmetrics.NewRegistry(), logger)
+}
+
+func newTestGuardian(head *common.ExtBatch) *Guardian {
+	logger := gethlog.New()
+	g := &Guardian{
+		hostData: host.Identity{IsSequencer: true},
+		state:    NewStateTracker(logger),
+		enclaveClient: &fakeEnclave{
+			healthy: true,
+			batches: map[uint64]*common.ExtBatch{head.Header.SequencerOrderNo.Uint64(): head},
+		},
+		logger: logger,
+	}
+	g.state.OnReceivedBlock(_l1Block123)
+	g.state.OnEnclaveStatus(common.Status{StatusCode: common.Running, L1Head: _l1Block123, L2Head: head.Header.SequencerOrderNo})
+	return g
+}
+
+func testBatch(seqNo int64, salt byte) *common.ExtBatch {
+	return &common.ExtBatch{Header: &common.BatchHeader{
+		SequencerOrderNo: big.NewInt(seqNo),
+		Number:           big.NewInt(seqNo),
+		ParentHash:       gethcommon.Hash{salt},
+	}}
+}
+
+// fakeEnclave implements the enclave calls made by the enclave service
+type fakeEnclave struct {
+	common.Enclave
+	healthy   bool
+	batches   map[uint64]*common.ExtBatch
+	callErr   error
+	submitted int
+	active    bool
+}
+
+func (f *fakeEnclave) SetActiveSequencer(_ context.Context, active bool) common.SystemError {
+	if f.callErr != nil {
+		return f.callErr
+	}
+	f.active = active
+	return nil
+}
+
+func (f *fakeEnclave) HealthCheck(context.Context) (bool, common.SystemError) {
+	return f.healthy, nil
+}
+
+func (f *fakeEnclave) GetBatchBySeqNo(_ context.Context, seqNo uint64) (*common.ExtBatch, common.SystemError) {
+	batch, ok := f.batches[seqNo]
+	if !ok {
+		return nil, errutil.ErrNotFound
+	}
+	return batch, nil
+}
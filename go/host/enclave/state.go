@@ -166,9 +166,28 @@ func (s *StateTracker) InSyncWithL1() bool {
 }
 
 func (s *StateTracker) IsUpToDate() bool {
+	s.m.RLock()
+	defer s.m.RUnlock()
 	return s.status == Live
 }
 
+// L2Lag returns the number of batches the enclave is behind the L2 head known to the host
+func (s *StateTracker) L2Lag() uint64 {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.hostL2Head == nil {
+		return 0
+	}
+	enclaveL2Head := s.enclaveL2Head
+	if enclaveL2Head == nil || enclaveL2Head.Cmp(_noBatch) == 0 {
+		enclaveL2Head = big.NewInt(0)
+	}
+	if s.hostL2Head.Cmp(enclaveL2Head) <= 0 {
+		return 0
+	}
+	return new(big.Int).Sub(s.hostL2Head, enclaveL2Head).Uint64()
+}
+
 func (s *StateTracker) GetEnclaveL1Head() gethcommon.Hash {
 	s.m.RLock()
 	defer s.m.RUnlock()
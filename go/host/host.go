@@ -3,7 +3,9 @@ package host
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/host/l2"
@@ -13,11 +15,13 @@ import (
 
 	"github.com/naoina/toml"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/profiler"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 	"github.com/ten-protocol/go-ten/go/host/events"
 	"github.com/ten-protocol/go-ten/go/host/storage"
@@ -60,6 +64,7 @@ func (bl batchListener) HandleBatch(batch *common.ExtBatch) {
 
 func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry, blobResolver l1.BlobResolver) hostcommon.Host {
 	hostStorage := storage.NewHostStorageFromConfig(config, logger)
+	blobResolver = l1.NewStoringBlobResolver(blobResolver, hostStorage, config.BlobRetention, logger)
 	hostIdentity := hostcommon.NewIdentity(config)
 	host := &host{
 		// config
@@ -84,15 +89,15 @@ func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p host
 		// clone the hostIdentity data for each enclave
 		enclHostID := hostIdentity
 		if i > 0 {
-			// only the first enclave can be the sequencer for now, others behave as read-only validators
-			enclHostID.IsSequencer = false
+			// only the first enclave generates the network secret, the sequencer enclaves are elected active or
+			// standby by the enclave service
 			enclHostID.IsGenesis = false
 		}
 		enclGuardian := enclave.NewGuardian(config, enclHostID, hostServices, enclClient, hostStorage, host.stopControl, logger)
 		enclGuardians = append(enclGuardians, enclGuardian)
 	}
 
-	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardians, logger)
+	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardians, hostStorage, regMetrics, logger)
 	l2Repo := l2.NewBatchRepository(config, hostServices, hostStorage, logger)
 	subsService := events.NewLogEventManager(hostServices, logger)
 	l2Repo.SubscribeValidatedBatches(batchListener{newHeads: host.newHeads})
@@ -106,12 +111,16 @@ func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p host
 		ethClient,
 		mgmtContractLib,
 		l1Repo,
-		blobResolver,
+		newDALayers(config, blobResolver, logger),
+		config.DAMode,
+		newRollupArchive(config, logger),
 		host.stopControl,
 		logger,
 		maxWaitForL1Receipt,
 		retryIntervalForL1Receipt,
 		hostStorage,
+		config.HealthThresholds,
+		regMetrics,
 	)
 
 	hostServices.RegisterService(hostcommon.L1PublisherName, l1Publisher)
@@ -166,7 +175,7 @@ func (h *host) Config() *config.HostConfig {
 }
 
 func (h *host) EnclaveClient() common.Enclave {
-	return h.services.Enclaves().GetEnclaveClient()
+	return h.services.Enclaves().GetReadEnclaveClient()
 }
 
 func (h *host) SubmitAndBroadcastTx(ctx context.Context, encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error) {
@@ -218,6 +227,7 @@ func (h *host) HealthCheck(ctx context.Context) (*hostcommon.HealthCheck, error)
 	}
 
 	healthErrors := make([]string, 0)
+	servicesHealth := make(map[string]*hostcommon.ServiceHealth)
 
 	// loop through all registered services and collect their health statuses
 	for name, service := range h.services.All() {
@@ -225,11 +235,18 @@ func (h *host) HealthCheck(ctx context.Context) (*hostcommon.HealthCheck, error)
 		if !status.OK() {
 			healthErrors = append(healthErrors, fmt.Sprintf("[%s] not healthy - %s", name, status.Message()))
 		}
+		servicesHealth[name] = &hostcommon.ServiceHealth{
+			Level:        status.Level(),
+			Message:      status.Message(),
+			Measurements: status.Measurements(),
+		}
 	}
 
+	// degraded services are reported but don't make the host unhealthy
 	return &hostcommon.HealthCheck{
 		OverallHealth: len(healthErrors) == 0,
 		Errors:        healthErrors,
+		Services:      servicesHealth,
 	}, nil
 }
 
@@ -255,6 +272,71 @@ func (h *host) TenConfig() (*common.TenNetworkInfo, error) {
 	}, nil
 }
 
+// CrossChainProof locates the batch containing the message, builds the merkle proof against the batch cross chain root
+// and reports whether the bundle containing that root has been published on the L1
+func (h *host) CrossChainProof(messageHash gethcommon.Hash) (*common.CrossChainProof, error) {
+	batch, err := h.storage.FetchBatchByCrossChainMessage(messageHash)
+	if err != nil {
+		return nil, err
+	}
+
+	root, leafType, proof, err := common.CrossChainTreeProof(batch.Header.CrossChainTree, messageHash)
+	if err != nil {
+		return nil, err
+	}
+	if root != batch.Header.CrossChainRoot {
+		return nil, fmt.Errorf("cross chain root mismatch for batch %s. expected %s, got %s", batch.Hash(), batch.Header.CrossChainRoot, root)
+	}
+
+	status, err := h.crossChainBundleStatus(batch.SeqNo().Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.CrossChainProof{
+		MessageHash: messageHash,
+		LeafType:    leafType,
+		BatchHash:   batch.Hash(),
+		BatchSeqNo:  batch.SeqNo().Uint64(),
+		Root:        root,
+		Proof:       proof,
+		Status:      status,
+	}, nil
+}
+
+// crossChainBundleStatus rebuilds the bundle containing the batch, the same way the enclave exports it, and checks its
+// availability on the management contract. The bundle can span several rollups.
+func (h *host) crossChainBundleStatus(seqNo uint64) (common.CrossChainBundleStatus, error) {
+	from, to, err := h.storage.FetchCrossChainBundleRange(seqNo)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return common.CrossChainBundlePending, nil
+		}
+		return "", fmt.Errorf("could not fetch bundle range for batch %d - %w", seqNo, err)
+	}
+
+	roots := make(common.CrossChainRootHashes, 0)
+	for i := from; i <= to; i++ {
+		batch, err := h.storage.FetchBatchBySeqNo(i)
+		if err != nil {
+			return "", fmt.Errorf("could not fetch batch %d - %w", i, err)
+		}
+		if batch.Header.CrossChainRoot != gethcommon.BigToHash(gethcommon.Big0) {
+			roots = append(roots, batch.Header.CrossChainRoot.Bytes())
+		}
+	}
+
+	published, err := h.services.L1Publisher().IsBundlePublished(roots)
+	if err != nil {
+		h.logger.Warn("Unable to check cross chain bundle availability", "from", from, "to", to, log.ErrKey, err)
+		return common.CrossChainBundleRolledUp, nil
+	}
+	if published {
+		return common.CrossChainBundlePublished, nil
+	}
+	return common.CrossChainBundleRolledUp, nil
+}
+
 func (h *host) Storage() storage.Storage {
 	return h.storage
 }
@@ -280,3 +362,29 @@ func (h *host) validateConfig() {
 		h.logger.Crit("the host must specify an L1 block time")
 	}
 }
+
+// newRollupArchive returns the archive the published rollups are copied to, or nil if none is configured
+func newRollupArchive(config *config.HostConfig, logger gethlog.Logger) *l1.RollupArchive {
+	if config.RollupArchiveURL == "" {
+		return nil
+	}
+	store, err := da.NewStore(config.RollupArchiveURL, new(http.Client))
+	if err != nil {
+		logger.Crit("Could not create rollup archive.", log.ErrKey, err)
+	}
+	return l1.NewRollupArchive(store)
+}
+
+// newDALayers returns the data availability layers the host can publish rollups to and read them from.
+// The external layer is only available when a store is configured.
+func newDALayers(config *config.HostConfig, blobResolver l1.BlobResolver, logger gethlog.Logger) da.Layers {
+	layers := []da.Layer{da.NewBlobLayer(blobResolver), da.NewCalldataLayer()}
+	if config.DAStoreURL != "" {
+		store, err := da.NewStore(config.DAStoreURL, new(http.Client))
+		if err != nil {
+			logger.Crit("Could not create external DA store.", log.ErrKey, err)
+		}
+		layers = append(layers, da.NewExternalLayer(store))
+	}
+	return da.NewLayers(layers...)
+}
@@ -0,0 +1,94 @@
+package l1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
+)
+
+// RollupArchive keeps a copy of every rollup published by the host, keyed by the rollup hash, so the rollups remain
+// recoverable after the L1 blobs carrying them expire
+type RollupArchive struct {
+	store da.Store
+}
+
+func NewRollupArchive(store da.Store) *RollupArchive {
+	return &RollupArchive{store: store}
+}
+
+// Archive stores the rollup, unless it was already archived
+func (a *RollupArchive) Archive(ctx context.Context, rollup *common.ExtRollup) error {
+	hash := rollup.Hash()
+	_, err := a.store.Get(ctx, hash)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return fmt.Errorf("could not check if rollup %s is archived. Cause: %w", hash, err)
+	}
+	encoded, err := common.EncodeRollup(rollup)
+	if err != nil {
+		return fmt.Errorf("could not encode rollup %s. Cause: %w", hash, err)
+	}
+	if err = a.store.Put(ctx, hash, encoded); err != nil {
+		return fmt.Errorf("could not archive rollup %s. Cause: %w", hash, err)
+	}
+	return nil
+}
+
+// FetchRollup returns the archived rollup with the given hash, or ethereum.NotFound if it was not archived
+func (a *RollupArchive) FetchRollup(ctx context.Context, hash gethcommon.Hash) (common.EncodedRollup, error) {
+	encoded, err := a.store.Get(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := common.DecodeRollup(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode archived rollup %s. Cause: %w", hash, err)
+	}
+	if rollup.Hash() != hash {
+		return nil, fmt.Errorf("archived rollup has hash %s instead of %s", rollup.Hash(), hash)
+	}
+	return encoded, nil
+}
+
+// VerifyRollup checks the archived rollup against the reference published on the L1, in the same way the enclave
+// checks the rollups it ingests. It returns the payload the DA layer would have served for the reference, which
+// holds the blobs for a rollup published in blobs.
+func (a *RollupArchive) VerifyRollup(ctx context.Context, hash gethcommon.Hash, ref *ethadapter.L1RollupHashes) (*da.Payload, error) {
+	encoded, err := a.FetchRollup(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &da.Payload{}
+	switch ref.DAMode {
+	case common.BlobDA:
+		payload.Blobs, err = ethadapter.EncodeBlobs(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode rollup %s to blobs. Cause: %w", hash, err)
+		}
+	case common.ExternalDA:
+		payload.Data = encoded
+	case common.CalldataDA:
+		if !bytes.Equal(ref.Calldata, encoded) {
+			return nil, fmt.Errorf("archived rollup %s does not match the rollup in the L1 calldata", hash)
+		}
+	}
+
+	rollup, err := da.NewReadOnlyLayers().ReadRollup(ref, payload)
+	if err != nil {
+		return nil, fmt.Errorf("archived rollup %s does not match the L1. Cause: %w", hash, err)
+	}
+	if rollup.Hash() != hash {
+		return nil, fmt.Errorf("archived rollup %s does not match the L1 rollup %s", hash, rollup.Hash())
+	}
+	return payload, nil
+}
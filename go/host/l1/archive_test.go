@@ -0,0 +1,63 @@
+package l1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
+)
+
+func TestArchivedRollupIsVerifiedAgainstBlobHashes(t *testing.T) {
+	archive := NewRollupArchive(da.NewFileStore(t.TempDir()))
+	rollup := &common.ExtRollup{
+		Header:        &common.RollupHeader{LastBatchSeqNo: 10, DAMode: common.BlobDA},
+		BatchPayloads: []byte("batches"),
+	}
+	encoded, err := common.EncodeRollup(rollup)
+	require.NoError(t, err)
+	txData, err := da.NewBlobLayer(nil).CreateRollupTx(context.Background(), gethcommon.Address{}, nil, encoded)
+	require.NoError(t, err)
+	ref := &ethadapter.L1RollupHashes{DAMode: common.BlobDA, BlobHashes: txData.(*types.BlobTx).BlobHashes}
+
+	_, err = archive.VerifyRollup(context.Background(), rollup.Hash(), ref)
+	require.True(t, errors.Is(err, ethereum.NotFound))
+
+	require.NoError(t, archive.Archive(context.Background(), rollup))
+	// archiving is idempotent
+	require.NoError(t, archive.Archive(context.Background(), rollup))
+
+	payload, err := archive.VerifyRollup(context.Background(), rollup.Hash(), ref)
+	require.NoError(t, err)
+	require.Equal(t, txData.(*types.BlobTx).Sidecar.Blobs[0], *payload.Blobs[0])
+
+	otherRef := &ethadapter.L1RollupHashes{DAMode: common.BlobDA, BlobHashes: []gethcommon.Hash{gethcommon.HexToHash("0x01")}}
+	_, err = archive.VerifyRollup(context.Background(), rollup.Hash(), otherRef)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ethereum.NotFound))
+}
+
+func TestArchivedRollupMustMatchItsHash(t *testing.T) {
+	store := da.NewFileStore(t.TempDir())
+	archive := NewRollupArchive(store)
+	rollup := &common.ExtRollup{Header: &common.RollupHeader{LastBatchSeqNo: 1, DAMode: common.CalldataDA}}
+	encoded, err := common.EncodeRollup(rollup)
+	require.NoError(t, err)
+
+	// a rollup stored under the hash of another rollup
+	wrongHash := gethcommon.HexToHash("0x02")
+	require.NoError(t, store.Put(context.Background(), wrongHash, encoded))
+	_, err = archive.FetchRollup(context.Background(), wrongHash)
+	require.Error(t, err)
+
+	require.NoError(t, archive.Archive(context.Background(), rollup))
+	ref := &ethadapter.L1RollupHashes{DAMode: common.CalldataDA, Calldata: encoded}
+	_, err = archive.VerifyRollup(context.Background(), rollup.Hash(), ref)
+	require.NoError(t, err)
+}
@@ -2,14 +2,20 @@ package l1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 )
 
 // BlobResolver is an interface for fetching blobs
@@ -39,3 +45,51 @@ func (r *beaconBlobResolver) FetchBlobs(ctx context.Context, b *types.Header, ha
 func (r *beaconBlobResolver) StoreBlobs(_ uint64, _ []*kzg4844.Blob) error {
 	panic("provided by the ethereum consensus layer")
 }
+
+// storingBlobResolver serves blobs from the host DB, and stores the blobs fetched from the wrapped resolver so they
+// remain available after the beacon nodes prune them
+type storingBlobResolver struct {
+	resolver  BlobResolver
+	storage   storage.BlobResolver
+	retention time.Duration // blobs of L1 blocks older than this are pruned. Zero keeps them forever
+	logger    gethlog.Logger
+}
+
+func NewStoringBlobResolver(resolver BlobResolver, storage storage.BlobResolver, retention time.Duration, logger gethlog.Logger) BlobResolver {
+	return &storingBlobResolver{
+		resolver:  resolver,
+		storage:   storage,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+func (r *storingBlobResolver) FetchBlobs(ctx context.Context, b *types.Header, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	blobs, err := r.storage.FetchBlobs(hashes)
+	if err == nil {
+		return blobs, nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		r.logger.Warn("Could not read blobs from the host DB.", log.BlockHashKey, b.Hash(), log.ErrKey, err)
+	}
+
+	blobs, err = r.resolver.FetchBlobs(ctx, b, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	// failing to cache the blobs only means they will be fetched again
+	if err := r.storage.AddBlobs(b, hashes, blobs); err != nil {
+		r.logger.Warn("Could not store blobs in the host DB.", log.BlockHashKey, b.Hash(), log.ErrKey, err)
+	}
+	if r.retention > 0 && b.Time > uint64(r.retention.Seconds()) {
+		if err := r.storage.PruneBlobs(b.Time - uint64(r.retention.Seconds())); err != nil {
+			r.logger.Warn("Could not prune blobs from the host DB.", log.ErrKey, err)
+		}
+	}
+	return blobs, nil
+}
+
+func (r *storingBlobResolver) StoreBlobs(slot uint64, blobs []*kzg4844.Blob) error {
+	return r.resolver.StoreBlobs(slot, blobs)
+}
@@ -2,13 +2,21 @@ package l1
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"net/http"
 	"testing"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 )
 
 const (
@@ -30,3 +38,58 @@ func TestBlobResolver(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, blobs, 2)
 }
+
+func TestStoringBlobResolver(t *testing.T) {
+	hostStorage := storage.NewHostStorageFromConfig(&config.HostConfig{UseInMemoryDB: true}, gethlog.New())
+	defer hostStorage.Close()
+	source := &countingBlobResolver{blobs: make(map[gethcommon.Hash]*kzg4844.Blob)}
+	resolver := NewStoringBlobResolver(source, hostStorage, time.Hour, gethlog.New())
+
+	oldBlock := &types.Header{Number: big.NewInt(1), Time: 1000}
+	oldHash := source.add(1)
+	blobs, err := resolver.FetchBlobs(context.Background(), oldBlock, []gethcommon.Hash{oldHash})
+	require.NoError(t, err)
+	require.Equal(t, byte(1), blobs[0][0])
+
+	// the second fetch is served from the host DB
+	_, err = resolver.FetchBlobs(context.Background(), oldBlock, []gethcommon.Hash{oldHash})
+	require.NoError(t, err)
+	require.Equal(t, 1, source.fetches)
+	stored, err := hostStorage.FetchBlob(oldHash)
+	require.NoError(t, err)
+	require.Equal(t, oldBlock.Hash(), stored.L1Hash)
+
+	// fetching blobs for a block past the retention period prunes the old ones
+	newBlock := &types.Header{Number: big.NewInt(2), Time: 1000 + uint64(2*time.Hour.Seconds())}
+	_, err = resolver.FetchBlobs(context.Background(), newBlock, []gethcommon.Hash{source.add(2)})
+	require.NoError(t, err)
+	_, err = hostStorage.FetchBlob(oldHash)
+	require.True(t, errors.Is(err, errutil.ErrNotFound))
+}
+
+// countingBlobResolver serves blobs from memory and counts the fetches that reach it
+type countingBlobResolver struct {
+	blobs   map[gethcommon.Hash]*kzg4844.Blob
+	fetches int
+}
+
+func (r *countingBlobResolver) add(content byte) gethcommon.Hash {
+	var blob kzg4844.Blob
+	blob[0] = content
+	hash := gethcommon.BytesToHash([]byte{0x01, content})
+	r.blobs[hash] = &blob
+	return hash
+}
+
+func (r *countingBlobResolver) FetchBlobs(_ context.Context, _ *types.Header, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	r.fetches++
+	blobs := make([]*kzg4844.Blob, len(hashes))
+	for i, h := range hashes {
+		blobs[i] = r.blobs[h]
+	}
+	return blobs, nil
+}
+
+func (r *countingBlobResolver) StoreBlobs(_ uint64, _ []*kzg4844.Blob) error {
+	return nil
+}
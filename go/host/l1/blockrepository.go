@@ -19,6 +19,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 )
 
@@ -38,14 +39,17 @@ type Repository struct {
 
 	running                  atomic.Bool
 	head                     gethcommon.Hash
+	headTime                 atomic.Int64 // unix time of the latest block received (or of the start while none was received)
+	headAgeThreshold         config.DurationThreshold
 	obscuroRelevantContracts []gethcommon.Address
 }
 
-func NewL1Repository(ethClient ethadapter.EthClient, obscuroRelevantContracts []gethcommon.Address, logger gethlog.Logger) *Repository {
+func NewL1Repository(ethClient ethadapter.EthClient, obscuroRelevantContracts []gethcommon.Address, headAgeThreshold config.DurationThreshold, logger gethlog.Logger) *Repository {
 	return &Repository{
 		blockSubscribers:         subscription.NewManager[host.L1BlockHandler](),
 		ethClient:                ethClient,
 		obscuroRelevantContracts: obscuroRelevantContracts,
+		headAgeThreshold:         headAgeThreshold,
 		running:                  atomic.Bool{},
 		logger:                   logger,
 	}
@@ -53,6 +57,7 @@ func NewL1Repository(ethClient ethadapter.EthClient, obscuroRelevantContracts []
 
 func (r *Repository) Start() error {
 	r.running.Store(true)
+	r.headTime.Store(time.Now().Unix())
 
 	// Repository constantly streams new blocks and forwards them to subscribers
 	go r.streamLiveBlocks()
@@ -65,12 +70,13 @@ func (r *Repository) Stop() error {
 }
 
 func (r *Repository) HealthStatus(context.Context) host.HealthStatus {
-	// todo (@matt) do proper health status based on last received block or something
-	errMsg := ""
+	status := &host.MeasuredHealthStatus{}
 	if !r.running.Load() {
-		errMsg = "not running"
+		status.Errors = append(status.Errors, errors.New("not running"))
+		return status
 	}
-	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
+	status.CheckDuration("l1HeadAge", time.Since(time.Unix(r.headTime.Load(), 0)), r.headAgeThreshold)
+	return status
 }
 
 // Subscribe will register a new block handler to receive new blocks as they arrive, returns unsubscribe func
@@ -128,30 +134,31 @@ func (r *Repository) latestCanonAncestor(blkHash gethcommon.Hash) (*types.Block,
 	return blk, nil
 }
 
-// FetchObscuroReceipts returns all obscuro-relevant receipts for an L1 block
+// FetchObscuroReceipts returns the receipts of the obscuro-relevant transactions of an L1 block, in block order.
+// A transaction is relevant if it produced log events from one of the obscuro contracts, so calls that went through
+// another contract (e.g. a multisig or a relayer) are picked up, or if it was sent to one of them directly.
 func (r *Repository) FetchObscuroReceipts(block *common.L1Block) (types.Receipts, error) {
-	receipts := make([]*types.Receipt, len(block.Transactions()))
+	receipts := make([]*types.Receipt, 0)
 	if len(block.Transactions()) == 0 {
 		return receipts, nil
 	}
 
 	blkHash := block.Hash()
-	// we want to send receipts for any transactions that produced obscuro-relevant log events
-	logs, err := r.ethClient.GetLogs(ethereum.FilterQuery{BlockHash: &blkHash, Addresses: r.obscuroRelevantContracts})
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch logs for L1 block - %w", err)
-	}
-	// make a lookup map of the relevant tx hashes which need receipts
 	relevantTx := make(map[gethcommon.Hash]bool)
-	for _, l := range logs {
-		relevantTx[l.TxHash] = true
+	// the bloom filter of the header rules out most blocks without having to fetch their logs
+	if r.mayContainObscuroLogs(block.Header()) {
+		logs, err := r.ethClient.GetLogs(ethereum.FilterQuery{BlockHash: &blkHash, Addresses: r.obscuroRelevantContracts})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch logs for L1 block - %w", err)
+		}
+		// make a lookup map of the relevant tx hashes which need receipts
+		for _, l := range logs {
+			relevantTx[l.TxHash] = true
+		}
 	}
 
-	for idx, transaction := range block.Transactions() {
+	for _, transaction := range block.Transactions() {
 		if !relevantTx[transaction.Hash()] && !r.isObscuroTransaction(transaction) {
-			// put in a dummy receipt so that the index matches the transaction index
-			// (the receipts list maintains the indexes of the transactions, it is a sparse list)
-			receipts[idx] = &types.Receipt{Status: types.ReceiptStatusFailed}
 			continue
 		}
 		receipt, err := r.ethClient.TransactionReceipt(transaction.Hash())
@@ -164,12 +171,22 @@ func (r *Repository) FetchObscuroReceipts(block *common.L1Block) (types.Receipts
 		r.logger.Trace("Adding receipt", "status", receipt.Status, log.TxKey, transaction.Hash(),
 			log.BlockHashKey, blkHash, log.CmpKey, log.CrossChainCmp)
 
-		receipts[idx] = receipt
+		receipts = append(receipts, receipt)
 	}
 
 	return receipts, nil
 }
 
+// mayContainObscuroLogs checks the bloom filter of the header for logs emitted by the obscuro contracts
+func (r *Repository) mayContainObscuroLogs(header *types.Header) bool {
+	for _, address := range r.obscuroRelevantContracts {
+		if types.BloomLookup(header.Bloom, address) {
+			return true
+		}
+	}
+	return false
+}
+
 // stream blocks from L1 as they arrive and forward them to subscribers, no guarantee of perfect ordering or that there won't be gaps.
 // If streaming is interrupted it will carry on from latest, it won't try to replay missed blocks.
 func (r *Repository) streamLiveBlocks() {
@@ -178,6 +195,7 @@ func (r *Repository) streamLiveBlocks() {
 		select {
 		case header := <-liveStream:
 			r.head = header.Hash()
+			r.headTime.Store(int64(header.Time))
 			block, err := r.ethClient.BlockByHash(header.Hash())
 			if err != nil {
 				r.logger.Error("Error fetching new block", log.BlockHashKey, header.Hash(),
@@ -224,7 +242,8 @@ func (r *Repository) FetchBlockByHeight(height *big.Int) (*types.Block, error) {
 	return r.ethClient.BlockByNumber(height)
 }
 
-// isObscuroTransaction will look at the 'to' address of the transaction, we are only interested in management contract and bridge transactions
+// isObscuroTransaction will look at the 'to' address of the transaction, we are only interested in management contract and bridge transactions.
+// It catches the direct calls to contracts that do not emit the events yet.
 func (r *Repository) isObscuroTransaction(transaction *types.Transaction) bool {
 	for _, address := range r.obscuroRelevantContracts {
 		if transaction.To() != nil && *transaction.To() == address {
@@ -0,0 +1,84 @@
+package l1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+var messageBusABI, _ = abi.JSON(strings.NewReader(MessageBus.MessageBusMetaData.ABI))
+
+// ExtractInboundCrossChainMessages returns the index records of the messages and value transfers published on the L1
+// message bus in the given block. The hashes match the ones used by the enclave for the same messages.
+func ExtractInboundCrossChainMessages(block *types.Header, receipts types.Receipts, busAddress gethcommon.Address) ([]common.CrossChainMessageRecord, error) {
+	records := make([]common.CrossChainMessageRecord, 0)
+	if busAddress == (gethcommon.Address{}) {
+		return records, nil
+	}
+
+	messageEventID := messageBusABI.Events["LogMessagePublished"].ID
+	valueTransferEventID := messageBusABI.Events["ValueTransfer"].ID
+
+	for _, receipt := range receipts {
+		if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if l.Address != busAddress || len(l.Topics) == 0 {
+				continue
+			}
+
+			switch l.Topics[0] {
+			case messageEventID:
+				var event MessageBus.MessageBusLogMessagePublished
+				if err := messageBusABI.UnpackIntoInterface(&event, "LogMessagePublished", l.Data); err != nil {
+					return nil, fmt.Errorf("could not unpack cross chain message. Cause: %w", err)
+				}
+				sender := event.Sender
+				records = append(records, common.CrossChainMessageRecord{
+					MessageHash: common.HashCrossChainMessage(common.CrossChainMessage{
+						Sender:           event.Sender,
+						Sequence:         event.Sequence,
+						Nonce:            event.Nonce,
+						Topic:            event.Topic,
+						Payload:          event.Payload,
+						ConsistencyLevel: event.ConsistencyLevel,
+					}),
+					Direction: common.CrossChainInbound,
+					LeafType:  "m",
+					Sender:    &sender,
+					L1Height:  block.Number.Uint64(),
+				})
+
+			case valueTransferEventID:
+				if len(l.Topics) != 3 {
+					return nil, fmt.Errorf("invalid number of topics in value transfer log: %d", len(l.Topics))
+				}
+				var event MessageBus.MessageBusValueTransfer
+				if err := messageBusABI.UnpackIntoInterface(&event, "ValueTransfer", l.Data); err != nil {
+					return nil, fmt.Errorf("could not unpack value transfer. Cause: %w", err)
+				}
+				transfer := common.ValueTransferEvent{
+					Sender:   gethcommon.BytesToAddress(l.Topics[1].Bytes()),
+					Receiver: gethcommon.BytesToAddress(l.Topics[2].Bytes()),
+					Amount:   event.Amount,
+					Sequence: event.Sequence,
+				}
+				records = append(records, common.CrossChainMessageRecord{
+					MessageHash: common.HashValueTransfer(transfer),
+					Direction:   common.CrossChainInbound,
+					LeafType:    "v",
+					Sender:      &transfer.Sender,
+					Receiver:    &transfer.Receiver,
+					L1Height:    block.Number.Uint64(),
+				})
+			}
+		}
+	}
+	return records, nil
+}
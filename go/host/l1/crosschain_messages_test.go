@@ -0,0 +1,42 @@
+package l1
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestInboundMessageHashMatchesEnclave(t *testing.T) {
+	busAddress := gethcommon.HexToAddress("0xb05")
+	msg := common.CrossChainMessage{
+		Sender:           gethcommon.HexToAddress("0x5e4d"),
+		Sequence:         3,
+		Nonce:            7,
+		Topic:            1,
+		Payload:          []byte("payload"),
+		ConsistencyLevel: 2,
+	}
+	event := messageBusABI.Events["LogMessagePublished"]
+	data, err := event.Inputs.Pack(msg.Sender, msg.Sequence, msg.Nonce, msg.Topic, msg.Payload, msg.ConsistencyLevel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{{Address: busAddress, Topics: []gethcommon.Hash{event.ID}, Data: data}},
+	}
+
+	records, err := ExtractInboundCrossChainMessages(&types.Header{Number: big.NewInt(1)}, types.Receipts{receipt}, busAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected one record, got %d", len(records))
+	}
+	if records[0].MessageHash != common.HashCrossChainMessage(msg) {
+		t.Fatal("the indexed hash does not match the hash of the message")
+	}
+}
@@ -5,11 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/ethereum/go-ethereum/crypto/kzg4844"
-
 	"github.com/ethereum/go-ethereum"
 
 	"github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
@@ -21,23 +21,30 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
-	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 	"github.com/ten-protocol/go-ten/go/wallet"
 )
 
+const _archiveTimeout = 30 * time.Second
+
 type Publisher struct {
 	hostData        host.Identity
 	hostWallet      wallet.Wallet // Wallet used to issue ethereum transactions
 	ethClient       ethadapter.EthClient
 	mgmtContractLib mgmtcontractlib.MgmtContractLib // Library to handle Management Contract lib operations
 	storage         storage.Storage
-	blobResolver    BlobResolver
+	daLayers        da.Layers      // used to read rollups back whatever DA mode they were published with
+	daLayer         da.Layer       // the layer new rollups are published to
+	rollupArchive   *RollupArchive // keeps a copy of the published rollups, nil if not configured
 
 	// cached map of important contract addresses (updated when we see a SetImportantContractsTx)
 	importantContractAddresses map[string]gethcommon.Address
@@ -49,14 +56,22 @@ type Publisher struct {
 
 	hostStopper *stopcontrol.StopControl
 
-	maxWaitForL1Receipt       time.Duration
-	retryIntervalForL1Receipt time.Duration
-
-	// we only allow one transaction in-flight at a time to avoid nonce conflicts
-	// We also have a context to cancel the tx if host stops
-	sendingLock      sync.Mutex
+	// assigns the nonces and tracks the receipts of the L1 transactions, several can be in flight at once
+	txManager *TxManager
+	// context to stop waiting for txs if host stops
 	sendingContext   context.Context
 	sendingCtxCancel context.CancelFunc
+
+	// reported by the health check: the L1 txs that failed since the last successful one and the unix time of the last
+	// rollup published (or of the start while none was published)
+	failedL1Txs      atomic.Uint64
+	lastRollupTime   atomic.Int64
+	healthThresholds config.HealthThresholds
+
+	gasUsed       gethmetrics.Counter   // gas used by the L1 txs the host published, blob gas included
+	gasSpendGwei  gethmetrics.Counter   // fees paid for the L1 txs the host published
+	rollupSize    gethmetrics.Histogram // bytes of the encoded rollups published
+	rollupsIssued gethmetrics.Counter
 }
 
 func NewL1Publisher(
@@ -65,37 +80,56 @@ func NewL1Publisher(
 	client ethadapter.EthClient,
 	mgmtContract mgmtcontractlib.MgmtContractLib,
 	repository host.L1BlockRepository,
-	blobResolver BlobResolver,
+	daLayers da.Layers,
+	daMode common.DAMode,
+	rollupArchive *RollupArchive,
 	hostStopper *stopcontrol.StopControl,
 	logger gethlog.Logger,
 	maxWaitForL1Receipt time.Duration,
 	retryIntervalForL1Receipt time.Duration,
 	storage storage.Storage,
+	healthThresholds config.HealthThresholds,
+	registry gethmetrics.Registry,
 ) *Publisher {
+	daLayer, err := daLayers.Get(daMode)
+	if err != nil {
+		logger.Crit("Could not configure rollup data availability layer.", log.ErrKey, err)
+	}
 	sendingCtx, cancelSendingCtx := context.WithCancel(context.Background())
 	return &Publisher{
-		hostData:                  hostData,
-		hostWallet:                hostWallet,
-		ethClient:                 client,
-		mgmtContractLib:           mgmtContract,
-		repository:                repository,
-		blobResolver:              blobResolver,
-		hostStopper:               hostStopper,
-		logger:                    logger,
-		maxWaitForL1Receipt:       maxWaitForL1Receipt,
-		retryIntervalForL1Receipt: retryIntervalForL1Receipt,
-		storage:                   storage,
+		hostData:        hostData,
+		hostWallet:      hostWallet,
+		ethClient:       client,
+		mgmtContractLib: mgmtContract,
+		repository:      repository,
+		daLayers:        daLayers,
+		daLayer:         daLayer,
+		rollupArchive:   rollupArchive,
+		hostStopper:     hostStopper,
+		logger:          logger,
+		txManager:       NewTxManager(client, hostWallet, storage, maxWaitForL1Receipt, retryIntervalForL1Receipt, logger),
+		storage:         storage,
 
 		importantContractAddresses: map[string]gethcommon.Address{},
 		importantAddressesMutex:    sync.RWMutex{},
 
-		sendingLock:      sync.Mutex{},
 		sendingContext:   sendingCtx,
 		sendingCtxCancel: cancelSendingCtx,
+		healthThresholds: healthThresholds,
+
+		gasUsed:       gethmetrics.GetOrRegisterCounter("host/l1/publication/gas", registry),
+		gasSpendGwei:  gethmetrics.GetOrRegisterCounter("host/l1/publication/spend_gwei", registry),
+		rollupSize:    gethmetrics.GetOrRegisterHistogram("host/l1/rollup/size", registry, gethmetrics.NewExpDecaySample(1028, 0.015)),
+		rollupsIssued: gethmetrics.GetOrRegisterCounter("host/l1/rollup/published", registry),
 	}
 }
 
 func (p *Publisher) Start() error {
+	p.lastRollupTime.Store(time.Now().Unix())
+	// resume the txs that were in flight when the host last stopped before anything new is issued
+	if err := p.txManager.Start(); err != nil {
+		return err
+	}
 	go func() {
 		// Do an initial read of important contract addresses when service starts up
 		err := p.ResyncImportantContracts()
@@ -148,18 +182,51 @@ func (p *Publisher) GetBundleRangeFromManagementContract(lastRollupNumber *big.I
 	return &nextRollupUID, fromSeqNo, nextRollup.LastSequenceNumber, nil
 }
 
+func (p *Publisher) IsBundlePublished(crossChainRoots common.CrossChainRootHashes) (bool, error) {
+	if p.mgmtContractLib.IsMock() {
+		return false, fmt.Errorf("bundle publishing unavailable for mocked environments")
+	}
+
+	managementCtr, err := ManagementContract.NewManagementContract(*p.mgmtContractLib.GetContractAddr(), p.ethClient.EthClient())
+	if err != nil {
+		p.logger.Error("Unable to instantiate management contract client")
+		return false, err
+	}
+
+	return managementCtr.IsBundleAvailable(&bind.CallOpts{}, crossChainRoots)
+}
+
+func (p *Publisher) IsSequencerEnclave(enclaveID gethcommon.Address) (bool, error) {
+	if p.mgmtContractLib.IsMock() {
+		return false, fmt.Errorf("sequencer enclaves unavailable for mocked environments")
+	}
+
+	managementCtr, err := ManagementContract.NewManagementContract(*p.mgmtContractLib.GetContractAddr(), p.ethClient.EthClient())
+	if err != nil {
+		p.logger.Error("Unable to instantiate management contract client")
+		return false, err
+	}
+
+	return managementCtr.IsSequencerEnclave(&bind.CallOpts{}, enclaveID)
+}
+
 func (p *Publisher) Stop() error {
 	p.sendingCtxCancel()
+	p.txManager.Stop()
 	return nil
 }
 
 func (p *Publisher) HealthStatus(context.Context) host.HealthStatus {
-	// todo (@matt) do proper health status based on failed transactions or something
-	errMsg := ""
+	status := &host.MeasuredHealthStatus{}
 	if p.hostStopper.IsStopping() {
-		errMsg = "not running"
+		status.Errors = append(status.Errors, errors.New("not running"))
+		return status
+	}
+	status.CheckCount("failedL1Txs", p.failedL1Txs.Load(), p.healthThresholds.FailedL1Txs)
+	if p.hostData.IsSequencer {
+		status.CheckDuration("rollupPublicationAge", time.Since(time.Unix(p.lastRollupTime.Load(), 0)), p.healthThresholds.RollupPublicationAge)
 	}
-	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
+	return status
 }
 
 func (p *Publisher) InitializeSecret(attestation *common.AttestationReport, encSecret common.EncryptedSharedEnclaveSecret) error {
@@ -174,7 +241,7 @@ func (p *Publisher) InitializeSecret(attestation *common.AttestationReport, encS
 	}
 	initialiseSecretTx := p.mgmtContractLib.CreateInitializeSecret(l1tx)
 	// we block here until we confirm a successful receipt. It is important this is published before the initial rollup.
-	return p.publishTransaction(initialiseSecretTx)
+	return p.publishTransaction("", initialiseSecretTx)
 }
 
 func (p *Publisher) RequestSecret(attestation *common.AttestationReport) (gethcommon.Hash, error) {
@@ -199,7 +266,7 @@ func (p *Publisher) RequestSecret(attestation *common.AttestationReport) (gethco
 	}
 	requestSecretTx := p.mgmtContractLib.CreateRequestSecret(l1tx)
 	// we wait until the secret req transaction has succeeded before we start polling for the secret
-	err = p.publishTransaction(requestSecretTx)
+	err = p.publishTransaction("", requestSecretTx)
 	if err != nil {
 		return gethcommon.Hash{}, err
 	}
@@ -208,6 +275,10 @@ func (p *Publisher) RequestSecret(attestation *common.AttestationReport) (gethco
 }
 
 func (p *Publisher) PublishSecretResponse(secretResponse *common.ProducedSecretResponse) error {
+	if secretResponse.Generation > 0 {
+		return p.publishSecretRotation(secretResponse)
+	}
+
 	l1tx := &ethadapter.L1RespondSecretTx{
 		Secret:      secretResponse.Secret,
 		RequesterID: secretResponse.RequesterID,
@@ -219,7 +290,7 @@ func (p *Publisher) PublishSecretResponse(secretResponse *common.ProducedSecretR
 
 	// fire-and-forget (track the receipt asynchronously)
 	go func() {
-		err := p.publishTransaction(respondSecretTx)
+		err := p.publishTransaction("", respondSecretTx)
 		if err != nil {
 			p.logger.Error("Could not broadcast secret response L1 tx", log.ErrKey, err)
 		}
@@ -228,78 +299,119 @@ func (p *Publisher) PublishSecretResponse(secretResponse *common.ProducedSecretR
 	return nil
 }
 
-// ExtractRelevantTenTransactions will extract any transactions from the block that are relevant to TEN
-// todo (#2495) we should monitor for relevant L1 events instead of scanning every transaction in the block
-func (p *Publisher) ExtractRelevantTenTransactions(block *types.Block, receipts types.Receipts) ([]*common.TxAndReceiptAndBlobs, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx) {
+// publishSecretRotation distributes a rotated generation of the secret, signed by the attester enclave
+func (p *Publisher) publishSecretRotation(secretResponse *common.ProducedSecretResponse) error {
+	l1tx := &ethadapter.L1RotateSecretTx{
+		Secret:           secretResponse.Secret,
+		RequesterID:      secretResponse.RequesterID,
+		AttesterID:       secretResponse.AttesterID,
+		AttesterSig:      secretResponse.AttesterSig,
+		Generation:       secretResponse.Generation,
+		ActivationHeight: secretResponse.ActivationHeight,
+	}
+	rotateSecretTx := p.mgmtContractLib.CreateRotateSecret(l1tx)
+	p.logger.Info("Broadcasting secret rotation L1 tx.", "requester", secretResponse.RequesterID, "generation", secretResponse.Generation)
+
+	// the enclaves only activate the generation once this tx is observed on the L1. It is keyed, so a restarted host
+	// resumes it rather than leaving the recipient without the generation
+	key := fmt.Sprintf("rotation:%d:%s", secretResponse.Generation, secretResponse.RequesterID.Hex())
+	go func() {
+		err := p.publishTransaction(key, rotateSecretTx)
+		if err != nil {
+			p.logger.Error("Could not broadcast secret rotation L1 tx", log.ErrKey, err)
+		}
+	}()
+
+	return nil
+}
+
+// ExtractRelevantTenTransactions will extract any transactions from the block that are relevant to TEN. The receipts
+// are the ones of the transactions that emitted logs from the TEN contracts, see Repository.FetchObscuroReceipts
+func (p *Publisher) ExtractRelevantTenTransactions(block *types.Block, receipts types.Receipts) ([]*common.TxAndReceiptAndBlobs, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx, []*ethadapter.L1CrossChainBundleTx) {
 	txWithReceiptsAndBlobs := make([]*common.TxAndReceiptAndBlobs, 0)
 	rollupTxs := make([]*ethadapter.L1RollupTx, 0)
 	contractAddressTxs := make([]*ethadapter.L1SetImportantContractsTx, 0)
+	bundleTxs := make([]*ethadapter.L1CrossChainBundleTx, 0)
 
-	txs := block.Transactions()
-	for i, rec := range receipts {
-		if rec.BlockNumber == nil {
-			continue // Skip non-relevant transactions
+	for _, rec := range receipts {
+		tx := block.Transaction(rec.TxHash)
+		if tx == nil {
+			p.logger.Warn("Receipt does not belong to the block", log.TxKey, rec.TxHash, log.BlockHashKey, block.Hash())
+			continue
 		}
 
-		decodedTx := p.mgmtContractLib.DecodeTx(txs[i])
-		var blobs []*kzg4844.Blob
-		var err error
-
-		switch typedTx := decodedTx.(type) {
-		case *ethadapter.L1SetImportantContractsTx:
-			contractAddressTxs = append(contractAddressTxs, typedTx)
-		case *ethadapter.L1RollupHashes:
-			blobs, err = p.blobResolver.FetchBlobs(p.sendingContext, block.Header(), typedTx.BlobHashes)
-			if err != nil {
-				if errors.Is(err, ethereum.NotFound) {
-					p.logger.Crit("Blobs were not found on beacon chain or archive service", "block", block.Hash(), "error", err)
-				} else {
-					p.logger.Crit("could not fetch blobs", log.ErrKey, err)
+		payload := &da.Payload{}
+		// the management contract events tell what the transaction did, whoever sent it
+		for _, decodedTx := range p.mgmtContractLib.DecodeReceipt(tx, rec) {
+			switch typedTx := decodedTx.(type) {
+			case *ethadapter.L1SetImportantContractsTx:
+				contractAddressTxs = append(contractAddressTxs, typedTx)
+			case *ethadapter.L1CrossChainBundleTx:
+				bundleTxs = append(bundleTxs, typedTx)
+			case *ethadapter.L1RollupHashes:
+				layer, err := p.daLayers.Get(typedTx.DAMode)
+				if err != nil {
+					p.logger.Crit("could not read rollup", log.ErrKey, err)
+					continue
+				}
+				payload, err = layer.FetchPayload(p.sendingContext, block.Header(), typedTx)
+				if err != nil {
+					if errors.Is(err, ethereum.NotFound) {
+						p.logger.Crit("Rollup data was not found on the data availability layer", "block", block.Hash(), "da_mode", typedTx.DAMode, "error", err)
+					} else {
+						p.logger.Crit("could not fetch rollup data", "da_mode", typedTx.DAMode, log.ErrKey, err)
+					}
+					continue
 				}
-				continue
-			}
 
-			encodedRlp, err := ethadapter.DecodeBlobs(blobs)
-			if err != nil {
-				p.logger.Crit("could not decode blobs.", log.ErrKey, err)
-				continue
-			}
+				encodedRlp, err := layer.ReadRollup(typedTx, payload)
+				if err != nil {
+					p.logger.Crit("could not read rollup data.", log.ErrKey, err)
+					continue
+				}
 
-			rlp := &ethadapter.L1RollupTx{
-				Rollup: encodedRlp,
+				rlp := &ethadapter.L1RollupTx{
+					Rollup: encodedRlp,
+				}
+				rollupTxs = append(rollupTxs, rlp)
 			}
-			rollupTxs = append(rollupTxs, rlp)
 		}
 
 		// compile the tx, receipt and blobs into a single struct for submission to the enclave
 		txWithReceiptsAndBlobs = append(txWithReceiptsAndBlobs, &common.TxAndReceiptAndBlobs{
-			Tx:      txs[i],
-			Receipt: rec,
-			Blobs:   blobs,
+			Tx:         tx,
+			Receipt:    rec,
+			Blobs:      payload.Blobs,
+			RollupData: payload.Data,
 		})
 	}
 
-	return txWithReceiptsAndBlobs, rollupTxs, contractAddressTxs
+	return txWithReceiptsAndBlobs, rollupTxs, contractAddressTxs, bundleTxs
 }
 
-// FindSecretResponseTx will scan the block for any secret response transactions. This is separate from the above method
-// as we do not require the receipts for these transactions.
-func (p *Publisher) FindSecretResponseTx(block *types.Block) []*ethadapter.L1RespondSecretTx {
+// FindSecretResponseTx will scan the receipts of the block for any secret response events. This is separate from the
+// above method as we do not need the rollup data for these transactions.
+func (p *Publisher) FindSecretResponseTx(block *types.Block, receipts types.Receipts) []*ethadapter.L1RespondSecretTx {
 	secretRespTxs := make([]*ethadapter.L1RespondSecretTx, 0)
 
-	for _, tx := range block.Transactions() {
-		t := p.mgmtContractLib.DecodeTx(tx)
-		if t == nil {
+	for _, rec := range receipts {
+		tx := block.Transaction(rec.TxHash)
+		if tx == nil {
 			continue
 		}
-		if scrtTx, ok := t.(*ethadapter.L1RespondSecretTx); ok {
-			secretRespTxs = append(secretRespTxs, scrtTx)
-			continue
+		for _, t := range p.mgmtContractLib.DecodeReceipt(tx, rec) {
+			if scrtTx, ok := t.(*ethadapter.L1RespondSecretTx); ok {
+				secretRespTxs = append(secretRespTxs, scrtTx)
+			}
 		}
 	}
 	return secretRespTxs
 }
 
+func (p *Publisher) DAMode() common.DAMode {
+	return p.daLayer.Mode()
+}
+
 func (p *Publisher) FetchLatestSeqNo() (*big.Int, error) {
 	return p.ethClient.FetchLastBatchSeqNo(*p.mgmtContractLib.GetContractAddr())
 }
@@ -312,7 +424,7 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 	tx := &ethadapter.L1RollupTx{
 		Rollup: encRollup,
 	}
-	p.logger.Info("Publishing rollup", "size", len(encRollup)/1024, log.RollupHashKey, producedRollup.Hash())
+	p.logger.Info("Publishing rollup", "size", len(encRollup)/1024, "da_mode", p.daLayer.Mode(), log.RollupHashKey, producedRollup.Hash())
 
 	if p.logger.Enabled(context.Background(), gethlog.LevelTrace) {
 		var headerLog string
@@ -326,18 +438,35 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 		p.logger.Trace("Sending transaction to publish rollup", "rollup_header", headerLog, log.RollupHashKey, producedRollup.Header.Hash(), "batches_len", len(producedRollup.BatchPayloads))
 	}
 
-	rollupBlobTx, err := p.mgmtContractLib.CreateBlobRollup(tx)
+	rollupTx, err := p.mgmtContractLib.CreateRollup(p.sendingContext, tx, p.daLayer)
 	if err != nil {
-		p.logger.Error("Could not create rollup blobs", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
+		p.logger.Error("Could not create rollup transaction", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
+		return
 	}
 
-	err = p.publishTransaction(rollupBlobTx)
+	err = p.publishTransaction("rollup:"+producedRollup.Hash().Hex(), rollupTx)
 	if err != nil {
 		p.logger.Error("Could not issue rollup tx", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
 	} else {
+		p.lastRollupTime.Store(time.Now().Unix())
+		p.rollupsIssued.Inc(1)
+		p.rollupSize.Update(int64(len(encRollup)))
 		p.logger.Info("Rollup included in L1", log.RollupHashKey, producedRollup.Hash())
+		p.archiveRollup(producedRollup)
+	}
+}
+
+// archiveRollup keeps a copy of the published rollup. A failure does not affect the publication, the rollup can be
+// archived later by another host or from the L1 while its blobs are available.
+func (p *Publisher) archiveRollup(rollup *common.ExtRollup) {
+	if p.rollupArchive == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(p.sendingContext, _archiveTimeout)
+	defer cancel()
+	if err := p.rollupArchive.Archive(ctx, rollup); err != nil {
+		p.logger.Error("Could not archive rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
 	}
-	// TODO publish rollup to archive service if not already done
 }
 
 func (p *Publisher) PublishCrossChainBundle(bundle *common.ExtCrossChainBundle, rollupNum *big.Int, forkID gethcommon.Hash) error {
@@ -345,46 +474,30 @@ func (p *Publisher) PublishCrossChainBundle(bundle *common.ExtCrossChainBundle,
 		return nil
 	}
 
-	managementCtr, err := ManagementContract.NewManagementContract(*p.mgmtContractLib.GetContractAddr(), p.ethClient.EthClient())
+	mgmtABI, err := ManagementContract.ManagementContractMetaData.GetAbi()
 	if err != nil {
-		p.logger.Error("Unable to instantiate management contract client")
-		return fmt.Errorf("unable to init")
+		return fmt.Errorf("unable to load management contract ABI. Cause: %w", err)
 	}
-
-	transactor, err := bind.NewKeyedTransactorWithChainID(p.hostWallet.PrivateKey(), p.hostWallet.ChainID())
+	data, err := mgmtABI.Pack("addCrossChainMessagesRoot", [32]byte(bundle.LastBatchHash.Bytes()), bundle.L1BlockHash, bundle.L1BlockNum, bundle.CrossChainRootHashes, bundle.Signature, rollupNum, forkID)
 	if err != nil {
-		p.logger.Error("Unable to create transactor for management contract")
-		return fmt.Errorf("unable to init")
+		return fmt.Errorf("unable to pack cross chain bundle transaction. Cause: %w", err)
 	}
-
-	p.logger.Info("Host preparing to send cross chain bundle transaction")
-	p.sendingLock.Lock()
-	defer p.sendingLock.Unlock()
-
-	nonce, err := p.ethClient.EthClient().PendingNonceAt(context.Background(), p.hostWallet.Address())
-	if err != nil {
-		p.logger.Error("Unable to get nonce for management contract", log.ErrKey, err)
-		return fmt.Errorf("unable to get nonce for management contract. Cause: %w", err)
+	bundleTx := &types.LegacyTx{
+		To:   p.mgmtContractLib.GetContractAddr(),
+		Data: data,
 	}
 
-	transactor.Nonce = big.NewInt(0).SetUint64(nonce)
-
-	tx, err := managementCtr.AddCrossChainMessagesRoot(transactor, [32]byte(bundle.LastBatchHash.Bytes()), bundle.L1BlockHash, bundle.L1BlockNum, bundle.CrossChainRootHashes, bundle.Signature, rollupNum, forkID)
+	p.logger.Info("Host preparing to send cross chain bundle transaction")
+	err = p.publishTransaction("bundle:"+bundle.LastBatchHash.Hex(), bundleTx)
 	if err != nil {
-		if !errors.Is(err, errutil.ErrCrossChainBundleRepublished) {
+		// the gas estimation reverts if another host published the bundle first
+		if strings.Contains(err.Error(), errutil.ErrCrossChainBundleRepublished.Error()) {
 			p.logger.Info("Cross chain bundle already published. Proceeding without publishing", log.ErrKey, err, log.BundleHashKey, bundle.LastBatchHash)
 			return nil
 		}
-		p.hostWallet.SetNonce(p.hostWallet.GetNonce() - 1)
 		return fmt.Errorf("unable to submit cross chain bundle transaction. Cause: %w", err)
 	}
 
-	err = p.awaitTransaction(tx)
-	if err != nil {
-		p.logger.Error("Error with receipt of cross chain publish transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
-		return fmt.Errorf("unable to get receipt for cross chain bundle transaction. Cause: %w", err)
-	}
-
 	p.logger.Info("Successfully submitted bundle", log.BundleHashKey, bundle.LastBatchHash, "bundleRoots", bundle.CrossChainRootHashes.ToHexString(), "managementContract", *p.mgmtContractLib.GetContractAddr())
 	return nil
 }
@@ -438,96 +551,31 @@ func (p *Publisher) ResyncImportantContracts() error {
 	return nil
 }
 
-// publishTransaction will keep trying unless the L1 seems to be unavailable or the tx is otherwise rejected
-// this method is guarded by a lock to ensure that only one transaction is attempted at a time to avoid nonce conflicts
-// todo (@matt) this method should take a context so we can try to cancel if the tx is no longer required
-func (p *Publisher) publishTransaction(tx types.TxData) error {
-	// this log message seems superfluous but is useful to debug deadlock issues, we expect 'Host issuing l1 tx' soon
-	// after unless we're stuck blocking.
-	p.logger.Info("Host preparing to issue L1 tx")
-
-	p.sendingLock.Lock()
-	defer p.sendingLock.Unlock()
-
-	retries := -1
-
-	nonce, err := p.ethClient.Nonce(p.hostWallet.Address())
+// publishTransaction blocks until the tx is included in the L1, or the host stops.
+// Txs with the same non-empty key are only issued once, even across host restarts.
+func (p *Publisher) publishTransaction(key string, tx types.TxData) error {
+	receipt, err := p.txManager.Send(p.sendingContext, key, tx)
 	if err != nil {
-		return fmt.Errorf("could not get nonce for L1 tx: %w", err)
-	}
-
-	// while the publisher service is still alive we keep trying to get the transaction into the L1
-	for !p.hostStopper.IsStopping() {
-		retries++ // count each attempt so we can increase gas price
-
-		// update the tx gas price before each attempt
-		tx, err := p.ethClient.PrepareTransactionToRetry(p.sendingContext, tx, p.hostWallet.Address(), nonce, retries)
-		if err != nil {
-			return errors.Wrap(err, "could not estimate gas/gas price for L1 tx")
-		}
-
-		signedTx, err := p.hostWallet.SignTransaction(tx)
-		if err != nil {
-			return errors.Wrap(err, "could not sign L1 tx")
-		}
-		p.logger.Info("Host issuing L1 tx", log.TxKey, signedTx.Hash(), "size", signedTx.Size()/1024, "retries", retries)
-		err = p.ethClient.SendTransaction(signedTx)
-		if err != nil {
-			return errors.Wrap(err, "could not broadcast L1 tx")
-		}
-		p.logger.Info("Successfully submitted tx to L1", "txHash", signedTx.Hash())
-
-		var receipt *types.Receipt
-		// retry until receipt is found or context is canceled
-		err = retry.Do(
-			func() error {
-				if p.hostStopper.IsStopping() {
-					return retry.FailFast(errors.New("host is stopping or context canceled"))
-				}
-				receipt, err = p.ethClient.TransactionReceipt(signedTx.Hash())
-				if err != nil {
-					return fmt.Errorf("could not get receipt publishing tx for L1 tx=%s: %w", signedTx.Hash(), err)
-				}
-				return err
-			},
-			retry.NewTimeoutStrategy(p.maxWaitForL1Receipt, p.retryIntervalForL1Receipt),
-		)
-		if err != nil {
-			p.logger.Info("Receipt not found for transaction, we will re-attempt", log.ErrKey, err)
-			continue // try again with updated gas price
-		}
-
-		if receipt.Status != types.ReceiptStatusSuccessful {
-			return fmt.Errorf("unsuccessful receipt found for published L1 transaction, status=%d", receipt.Status)
-		}
-
-		p.logger.Debug("L1 transaction successful receipt found.", log.TxKey, signedTx.Hash(),
-			log.BlockHeightKey, receipt.BlockNumber, log.BlockHashKey, receipt.BlockHash)
-		break
+		p.failedL1Txs.Add(1)
+		return err
 	}
+	p.failedL1Txs.Store(0)
+	p.recordGasSpend(receipt)
 	return nil
 }
 
-func (p *Publisher) awaitTransaction(tx *types.Transaction) error {
-	var receipt *types.Receipt
-	var err error
-	err = retry.Do(
-		func() error {
-			receipt, err = p.ethClient.TransactionReceipt(tx.Hash())
-			if err != nil {
-				return fmt.Errorf("could not get receipt for xchain L1 tx=%s: %w", tx.Hash(), err)
-			}
-			return err
-		},
-		retry.NewTimeoutStrategy(p.maxWaitForL1Receipt, p.retryIntervalForL1Receipt),
-	)
-	if err != nil {
-		p.logger.Info("Receipt not found for transaction, we will re-attempt", log.ErrKey, err)
-		return err
+// recordGasSpend adds the gas used by the L1 tx, and the fees paid for it, to the publication metrics
+func (p *Publisher) recordGasSpend(receipt *types.Receipt) {
+	if receipt == nil {
+		return
 	}
-
-	if err == nil && receipt.Status != types.ReceiptStatusSuccessful {
-		return fmt.Errorf("unsuccessful receipt found for published L1 transaction, status=%d", receipt.Status)
+	p.gasUsed.Inc(int64(receipt.GasUsed + receipt.BlobGasUsed))
+	spend := new(big.Int)
+	if receipt.EffectiveGasPrice != nil {
+		spend.Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
 	}
-	return nil
+	if receipt.BlobGasPrice != nil {
+		spend.Add(spend, new(big.Int).Mul(new(big.Int).SetUint64(receipt.BlobGasUsed), receipt.BlobGasPrice))
+	}
+	p.gasSpendGwei.Inc(spend.Div(spend, big.NewInt(params.GWei)).Int64())
 }
@@ -153,12 +153,7 @@ func (c *crossChainStateMachine) PublishNextBundle() error {
 }
 
 func (c *crossChainStateMachine) IsBundleAlreadyPublished(bundle *common.ExtCrossChainBundle) (bool, error) {
-	managementContract, err := ManagementContract.NewManagementContract(*c.mgmtContractLib.GetContractAddr(), c.ethClient.EthClient())
-	if err != nil {
-		return false, err
-	}
-
-	return managementContract.IsBundleAvailable(&bind.CallOpts{}, bundle.CrossChainRootHashes)
+	return c.publisher.IsBundlePublished(bundle.CrossChainRootHashes)
 }
 
 // Synchronize - checks if there are any new rollups or forks and moves the tracking needle to the latest common ancestor.
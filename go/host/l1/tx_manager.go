@@ -0,0 +1,446 @@
+package l1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+// keptCompletedL1Txs is how many nonces of completed transactions are kept in the DB, so a restarted host recognises
+// the keyed transactions it already issued
+const keptCompletedL1Txs = 1000
+
+var (
+	ErrL1TxCancelled     = errors.New("L1 tx was replaced by a no-op after it was cancelled")
+	ErrTxManagerStopping = errors.New("L1 tx manager is stopping")
+)
+
+// trackedTx is an L1 transaction that holds a nonce and is waiting for a receipt
+type trackedTx struct {
+	record *common.L1TxRecord
+	txData types.TxData // the unsigned transaction, re-priced for every attempt
+	// noopHashes are the attempts that replaced the transaction with a no-op after it was cancelled
+	noopHashes map[gethcommon.Hash]bool
+
+	waiters   int           // guarded by the manager lock
+	cancelled chan struct{} // closed once nobody waits for the transaction any more
+	done      chan struct{} // closed once the outcome below is known
+	receipt   *types.Receipt
+	err       error
+}
+
+func newTrackedTx(record *common.L1TxRecord, txData types.TxData) *trackedTx {
+	return &trackedTx{
+		record:     record,
+		txData:     txData,
+		noopHashes: make(map[gethcommon.Hash]bool),
+		cancelled:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+func (t *trackedTx) isCancelled() bool {
+	select {
+	case <-t.cancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// TxManager issues the host L1 transactions. Nonces are assigned locally, so any number of transactions can be in
+// flight at once. Each transaction is tracked in its own routine, which re-prices it with PrepareTransactionToRetry
+// until one of its attempts is mined. The state of the in-flight transactions is persisted, so a restarted host
+// resumes them rather than issuing them again.
+type TxManager struct {
+	ethClient ethadapter.EthClient
+	wallet    wallet.Wallet
+	storage   storage.L1TxResolver
+	logger    gethlog.Logger
+
+	maxWaitForReceipt time.Duration // how long to wait for a receipt before re-pricing the transaction
+	retryInterval     time.Duration // how often to poll for a receipt
+
+	mu          sync.Mutex
+	nonceSynced bool
+	nextNonce   uint64
+	freeNonces  []uint64              // nonces that were assigned but never broadcast, reused before nextNonce
+	inFlight    map[string]*trackedTx // keyed transactions waiting for a receipt
+
+	stopCtx    context.Context
+	stopCancel context.CancelFunc
+}
+
+func NewTxManager(ethClient ethadapter.EthClient, wallet wallet.Wallet, storage storage.L1TxResolver, maxWaitForReceipt time.Duration, retryInterval time.Duration, logger gethlog.Logger) *TxManager {
+	stopCtx, stopCancel := context.WithCancel(context.Background())
+	return &TxManager{
+		ethClient:         ethClient,
+		wallet:            wallet,
+		storage:           storage,
+		logger:            logger,
+		maxWaitForReceipt: maxWaitForReceipt,
+		retryInterval:     retryInterval,
+		inFlight:          make(map[string]*trackedTx),
+		stopCtx:           stopCtx,
+		stopCancel:        stopCancel,
+	}
+}
+
+// Start resumes tracking the transactions that were still pending when the host stopped
+func (m *TxManager) Start() error {
+	records, err := m.storage.FetchPendingL1Txs()
+	if err != nil {
+		return fmt.Errorf("could not load pending L1 txs: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, record := range records {
+		signedTx := new(types.Transaction)
+		if err := signedTx.UnmarshalBinary(record.SignedTx); err != nil {
+			m.logger.Error("Could not decode pending L1 tx, it will not be resumed.", "nonce", record.Nonce, log.ErrKey, err)
+			continue
+		}
+		t := newTrackedTx(record, unsignedTxData(signedTx))
+		if record.Key != "" {
+			m.inFlight[record.Key] = t
+		}
+		if record.Nonce >= m.nextNonce {
+			m.nextNonce = record.Nonce + 1
+		}
+		// the L1 node may have dropped the transaction while the host was down
+		if err := m.ethClient.SendTransaction(signedTx); err != nil {
+			m.logger.Debug("Could not rebroadcast pending L1 tx.", log.TxKey, signedTx.Hash(), log.ErrKey, err)
+		}
+		m.logger.Info("Resuming pending L1 tx.", "nonce", record.Nonce, "key", record.Key, log.TxKey, signedTx.Hash())
+		go m.track(t)
+	}
+	return nil
+}
+
+func (m *TxManager) Stop() {
+	m.stopCancel()
+}
+
+// Send issues the transaction and blocks until one of its attempts has a receipt.
+// Transactions with a non-empty key are only issued once: if a transaction with the same key is in flight, Send waits
+// for it, and if one was already confirmed Send returns its receipt. A confirmed transaction whose receipt the L1 node
+// no longer serves, e.g. because it was reorged out, is issued again.
+// Cancelling the context stops the wait. Once nobody waits for a transaction any more, it is replaced by a no-op, so
+// its nonce does not hold up the following transactions.
+func (m *TxManager) Send(ctx context.Context, key string, txData types.TxData) (*types.Receipt, error) {
+	if key != "" {
+		if receipt, confirmed := m.confirmedReceipt(key); confirmed {
+			m.logger.Info("L1 tx was already confirmed, it will not be issued again.", "key", key)
+			return receipt, nil
+		}
+	}
+
+	m.mu.Lock()
+	if existing, ok := m.inFlight[key]; ok && key != "" {
+		existing.waiters++
+		m.mu.Unlock()
+		m.logger.Info("L1 tx already in flight, waiting for its receipt.", "key", key, "nonce", existing.record.Nonce)
+		return m.wait(ctx, existing)
+	}
+	nonce, err := m.assignNonce()
+	if err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	t := newTrackedTx(&common.L1TxRecord{Nonce: nonce, Key: key, Status: common.L1TxPending}, txData)
+	t.waiters = 1
+	if key != "" {
+		m.inFlight[key] = t
+	}
+	m.mu.Unlock()
+
+	if err := m.attempt(t, 0); err != nil {
+		m.abandon(t, err)
+		return nil, err
+	}
+	m.persist(t)
+	go m.track(t)
+	return m.wait(ctx, t)
+}
+
+// assignNonce must be called holding the lock
+func (m *TxManager) assignNonce() (uint64, error) {
+	if !m.nonceSynced {
+		if err := m.syncNonce(); err != nil {
+			return 0, err
+		}
+	}
+	if len(m.freeNonces) > 0 {
+		nonce := m.freeNonces[0]
+		m.freeNonces = m.freeNonces[1:]
+		return nonce, nil
+	}
+	nonce := m.nextNonce
+	m.nextNonce++
+	return nonce, nil
+}
+
+// syncNonce reads the next nonce from the L1 node, dropping the free nonces it has already seen used.
+// It must be called holding the lock.
+func (m *TxManager) syncNonce() error {
+	nonce, err := m.ethClient.Nonce(m.wallet.Address())
+	if err != nil {
+		m.nonceSynced = false
+		return fmt.Errorf("could not get nonce for L1 tx: %w", err)
+	}
+	if nonce > m.nextNonce {
+		m.nextNonce = nonce
+	}
+	free := m.freeNonces[:0]
+	for _, n := range m.freeNonces {
+		if n >= nonce {
+			free = append(free, n)
+		}
+	}
+	m.freeNonces = free
+	m.nonceSynced = true
+	return nil
+}
+
+// abandon releases the nonce of a transaction that was never broadcast. The broadcast may have failed because the
+// nonce was already used (e.g. by another process sharing the wallet), so the nonce is read from the L1 node again.
+func (m *TxManager) abandon(t *trackedTx, err error) {
+	m.mu.Lock()
+	nonce := t.record.Nonce
+	if nonce+1 == m.nextNonce {
+		m.nextNonce--
+	} else {
+		m.freeNonces = append(m.freeNonces, nonce)
+		sort.Slice(m.freeNonces, func(i, j int) bool { return m.freeNonces[i] < m.freeNonces[j] })
+	}
+	if syncErr := m.syncNonce(); syncErr != nil {
+		m.logger.Warn("Could not resync L1 nonce, it will be read again with the next tx.", log.ErrKey, syncErr)
+	}
+	// a free nonce that the L1 node has not seen used holds up the later transactions
+	gap := slices.Contains(m.freeNonces, nonce)
+	m.mu.Unlock()
+	m.finish(t, nil, err)
+
+	// later transactions can't be mined until the nonce is used, so it is filled straight away
+	if gap {
+		go func() {
+			if _, err := m.Send(m.stopCtx, "", m.noopTx()); err != nil {
+				m.logger.Warn("Could not fill L1 nonce gap.", "nonce", nonce, log.ErrKey, err)
+			}
+		}()
+	}
+}
+
+func (m *TxManager) wait(ctx context.Context, t *trackedTx) (*types.Receipt, error) {
+	select {
+	case <-t.done:
+		return t.receipt, t.err
+	case <-ctx.Done():
+		m.mu.Lock()
+		t.waiters--
+		if t.waiters == 0 && !t.isCancelled() {
+			close(t.cancelled)
+		}
+		m.mu.Unlock()
+		return nil, ctx.Err()
+	case <-m.stopCtx.Done():
+		return nil, ErrTxManagerStopping
+	}
+}
+
+// attempt prices, signs and broadcasts the transaction (or its no-op replacement) with the tracked nonce
+func (m *TxManager) attempt(t *trackedTx, retries int) error {
+	txData := t.txData
+	noop := false
+	// blob transactions can only be replaced by other blob transactions, so they are left to be mined
+	if _, isBlobTx := txData.(*types.BlobTx); t.isCancelled() && !isBlobTx {
+		txData = m.noopTx()
+		noop = true
+	}
+
+	prepared, err := m.ethClient.PrepareTransactionToRetry(m.stopCtx, txData, m.wallet.Address(), t.record.Nonce, retries)
+	if err != nil {
+		return fmt.Errorf("could not estimate gas/gas price for L1 tx: %w", err)
+	}
+	signedTx, err := m.wallet.SignTransaction(prepared)
+	if err != nil {
+		return fmt.Errorf("could not sign L1 tx: %w", err)
+	}
+	m.logger.Info("Host issuing L1 tx", log.TxKey, signedTx.Hash(), "nonce", t.record.Nonce, "size", signedTx.Size()/1024, "retries", retries, "noop", noop)
+	if err = m.ethClient.SendTransaction(signedTx); err != nil {
+		return fmt.Errorf("could not broadcast L1 tx: %w", err)
+	}
+
+	encoded, err := signedTx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode L1 tx: %w", err)
+	}
+	t.record.Retries = retries
+	t.record.SignedTx = encoded
+	if !containsHash(t.record.TxHashes, signedTx.Hash()) {
+		t.record.TxHashes = append(t.record.TxHashes, signedTx.Hash())
+	}
+	if noop {
+		t.noopHashes[signedTx.Hash()] = true
+	}
+	return nil
+}
+
+// track re-prices the transaction until one of its attempts is mined, or the manager stops
+func (m *TxManager) track(t *trackedTx) {
+	retries := t.record.Retries
+	for {
+		receipt, minedHash, err := m.awaitReceipt(t)
+		if err == nil {
+			m.complete(t, receipt, minedHash)
+			return
+		}
+		if m.stopCtx.Err() != nil {
+			// the transaction stays pending in the DB and is resumed on restart
+			return
+		}
+
+		m.logger.Info("Receipt not found for L1 tx, re-pricing it.", "nonce", t.record.Nonce, log.ErrKey, err)
+		retries++
+		if err := m.attempt(t, retries); err != nil {
+			// a previous attempt may still be mined, e.g. when the replacement is underpriced
+			m.logger.Warn("Could not resubmit L1 tx.", "nonce", t.record.Nonce, log.ErrKey, err)
+			continue
+		}
+		m.persist(t)
+	}
+}
+
+// awaitReceipt polls for the receipt of any of the attempts, until the receipt timeout
+func (m *TxManager) awaitReceipt(t *trackedTx) (*types.Receipt, gethcommon.Hash, error) {
+	var receipt *types.Receipt
+	var minedHash gethcommon.Hash
+	err := retry.Do(
+		func() error {
+			if m.stopCtx.Err() != nil {
+				return retry.FailFast(ErrTxManagerStopping)
+			}
+			for _, h := range t.record.TxHashes {
+				r, err := m.ethClient.TransactionReceipt(h)
+				if err == nil && r != nil {
+					receipt, minedHash = r, h
+					return nil
+				}
+			}
+			return fmt.Errorf("no receipt for L1 tx with nonce %d", t.record.Nonce)
+		},
+		retry.NewTimeoutStrategy(m.maxWaitForReceipt, m.retryInterval),
+	)
+	return receipt, minedHash, err
+}
+
+func (m *TxManager) complete(t *trackedTx, receipt *types.Receipt, minedHash gethcommon.Hash) {
+	var err error
+	switch {
+	case t.noopHashes[minedHash]:
+		t.record.Status = common.L1TxCancelled
+		err = ErrL1TxCancelled
+	case receipt.Status != types.ReceiptStatusSuccessful:
+		t.record.Status = common.L1TxFailed
+		err = fmt.Errorf("unsuccessful receipt found for published L1 transaction, status=%d", receipt.Status)
+	default:
+		t.record.Status = common.L1TxConfirmed
+	}
+	m.logger.Debug("L1 transaction receipt found.", log.TxKey, minedHash, "status", t.record.Status,
+		log.BlockHeightKey, receipt.BlockNumber, log.BlockHashKey, receipt.BlockHash)
+
+	m.persist(t)
+	if t.record.Nonce > keptCompletedL1Txs {
+		if err := m.storage.PruneL1Txs(t.record.Nonce - keptCompletedL1Txs); err != nil {
+			m.logger.Warn("Could not prune completed L1 txs.", log.ErrKey, err)
+		}
+	}
+	m.finish(t, receipt, err)
+}
+
+func (m *TxManager) finish(t *trackedTx, receipt *types.Receipt, err error) {
+	m.mu.Lock()
+	if m.inFlight[t.record.Key] == t {
+		delete(m.inFlight, t.record.Key)
+	}
+	m.mu.Unlock()
+	t.receipt, t.err = receipt, err
+	close(t.done)
+}
+
+func (m *TxManager) persist(t *trackedTx) {
+	// the transaction is on its way regardless, losing its state only risks issuing it again after a restart
+	if err := m.storage.StoreL1Tx(t.record); err != nil {
+		m.logger.Error("Could not persist L1 tx state.", "nonce", t.record.Nonce, log.ErrKey, err)
+	}
+}
+
+// confirmedReceipt returns whether the transaction with the key was already confirmed, and its receipt if available
+func (m *TxManager) confirmedReceipt(key string) (*types.Receipt, bool) {
+	record, err := m.storage.FetchL1TxByKey(key)
+	if err != nil {
+		if !errors.Is(err, errutil.ErrNotFound) {
+			m.logger.Warn("Could not look up previous L1 tx.", "key", key, log.ErrKey, err)
+		}
+		return nil, false
+	}
+	if record.Status != common.L1TxConfirmed {
+		return nil, false
+	}
+	for _, h := range record.TxHashes {
+		if receipt, err := m.ethClient.TransactionReceipt(h); err == nil && receipt != nil {
+			return receipt, true
+		}
+	}
+	m.logger.Warn("Receipt of confirmed L1 tx not found, it may have been reorged out.", "key", key, "nonce", record.Nonce)
+	return nil, false
+}
+
+// noopTx is a zero value transfer to the host itself, used to take over the nonce of a transaction no longer needed
+func (m *TxManager) noopTx() types.TxData {
+	to := m.wallet.Address()
+	return &types.LegacyTx{To: &to, Value: big.NewInt(0)}
+}
+
+// unsignedTxData extracts the fields that PrepareTransactionToRetry does not override from a signed transaction
+func unsignedTxData(tx *types.Transaction) types.TxData {
+	if tx.Type() == types.BlobTxType {
+		return &types.BlobTx{
+			To:         *tx.To(),
+			Data:       tx.Data(),
+			BlobHashes: tx.BlobHashes(),
+			Sidecar:    tx.BlobTxSidecar(),
+		}
+	}
+	return &types.LegacyTx{
+		To:    tx.To(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+}
+
+func containsHash(hashes []gethcommon.Hash, h gethcommon.Hash) bool {
+	for _, existing := range hashes {
+		if existing == h {
+			return true
+		}
+	}
+	return false
+}
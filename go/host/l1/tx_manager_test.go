@@ -0,0 +1,295 @@
+package l1
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+const (
+	testMaxWaitForReceipt = 100 * time.Millisecond
+	testReceiptInterval   = 5 * time.Millisecond
+)
+
+var testTarget = gethcommon.HexToAddress("0x1234")
+
+func TestTxManagerIssuesConcurrentTxs(t *testing.T) {
+	client := newFakeL1Client(true)
+	manager := newTestTxManager(t, client, newTestStorage(t))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := manager.Send(context.Background(), string(rune('a'+i)), testTxData(byte(i)))
+			require.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	nonces := map[uint64]bool{}
+	for _, tx := range client.sentTxs() {
+		nonces[tx.Nonce()] = true
+	}
+	require.Equal(t, map[uint64]bool{0: true, 1: true, 2: true}, nonces)
+}
+
+func TestTxManagerRepricesUntilMined(t *testing.T) {
+	client := newFakeL1Client(false)
+	manager := newTestTxManager(t, client, newTestStorage(t))
+
+	go func() {
+		// wait for the tx to be re-priced twice before mining the latest attempt
+		for len(client.sentTxs()) < 3 {
+			time.Sleep(testReceiptInterval)
+		}
+		client.mine(client.sentTxs()[2].Hash(), types.ReceiptStatusSuccessful)
+	}()
+	receipt, err := manager.Send(context.Background(), "rollup", testTxData(1))
+	require.NoError(t, err)
+
+	sent := client.sentTxs()
+	require.Equal(t, sent[2].Hash(), receipt.TxHash)
+	for i, tx := range sent[:3] {
+		require.Equal(t, uint64(0), tx.Nonce())
+		require.Equal(t, int64(i+1), tx.GasPrice().Int64())
+	}
+}
+
+func TestTxManagerResumesAfterRestart(t *testing.T) {
+	client := newFakeL1Client(false)
+	hostStorage := newTestStorage(t)
+	manager := newTestTxManager(t, client, hostStorage)
+
+	go func() {
+		for len(client.sentTxs()) == 0 {
+			time.Sleep(testReceiptInterval)
+		}
+		manager.Stop()
+	}()
+	_, err := manager.Send(context.Background(), "rollup", testTxData(1))
+	require.ErrorIs(t, err, ErrTxManagerStopping)
+
+	// the restarted manager picks up the pending tx, so sending it again waits for it rather than taking a new nonce
+	restarted := newTestTxManager(t, client, hostStorage)
+	go func() {
+		for len(client.sentTxs()) < 2 {
+			time.Sleep(testReceiptInterval)
+		}
+		client.mine(client.sentTxs()[0].Hash(), types.ReceiptStatusSuccessful)
+	}()
+	_, err = restarted.Send(context.Background(), "rollup", testTxData(1))
+	require.NoError(t, err)
+	for _, tx := range client.sentTxs() {
+		require.Equal(t, uint64(0), tx.Nonce())
+	}
+
+	// once confirmed, the tx is not issued again
+	sentCount := len(client.sentTxs())
+	receipt, err := restarted.Send(context.Background(), "rollup", testTxData(1))
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.Len(t, client.sentTxs(), sentCount)
+
+	record, err := hostStorage.FetchL1TxByKey("rollup")
+	require.NoError(t, err)
+	require.Equal(t, common.L1TxConfirmed, record.Status)
+}
+
+func TestTxManagerReplacesCancelledTx(t *testing.T) {
+	client := newFakeL1Client(false)
+	manager := newTestTxManager(t, client, newTestStorage(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for len(client.sentTxs()) == 0 {
+			time.Sleep(testReceiptInterval)
+		}
+		cancel()
+	}()
+	_, err := manager.Send(ctx, "secret", testTxData(1))
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the next attempt for the nonce is a no-op transfer to the host itself
+	for len(client.sentTxs()) < 2 {
+		time.Sleep(testReceiptInterval)
+	}
+	noop := client.sentTxs()[1]
+	require.Equal(t, uint64(0), noop.Nonce())
+	require.Equal(t, manager.wallet.Address(), *noop.To())
+	require.Empty(t, noop.Data())
+	client.mine(noop.Hash(), types.ReceiptStatusSuccessful)
+
+	client.setAutoMine(true)
+	_, err = manager.Send(context.Background(), "next", testTxData(2))
+	require.NoError(t, err)
+	sent := client.sentTxs()
+	require.Equal(t, uint64(1), sent[len(sent)-1].Nonce())
+}
+
+func TestTxManagerReissuesReorgedTx(t *testing.T) {
+	client := newFakeL1Client(true)
+	manager := newTestTxManager(t, client, newTestStorage(t))
+
+	_, err := manager.Send(context.Background(), "bundle", testTxData(1))
+	require.NoError(t, err)
+
+	// the block with the tx is reorged out, so its receipt is gone and the tx is issued again
+	client.reorg()
+	receipt, err := manager.Send(context.Background(), "bundle", testTxData(1))
+	require.NoError(t, err)
+	sent := client.sentTxs()
+	require.Len(t, sent, 2)
+	require.Equal(t, sent[1].Hash(), receipt.TxHash)
+}
+
+func TestTxManagerResyncsNonceAfterSendError(t *testing.T) {
+	client := newFakeL1Client(true)
+	manager := newTestTxManager(t, client, newTestStorage(t))
+
+	_, err := manager.Send(context.Background(), "a", testTxData(1))
+	require.NoError(t, err)
+
+	// another process uses the wallet, so the next local nonce is rejected
+	client.useNonces(5)
+	_, err = manager.Send(context.Background(), "b", testTxData(2))
+	require.Error(t, err)
+
+	_, err = manager.Send(context.Background(), "b", testTxData(2))
+	require.NoError(t, err)
+	sent := client.sentTxs()
+	require.Len(t, sent, 2)
+	require.Equal(t, uint64(5), sent[1].Nonce())
+}
+
+func newTestStorage(t *testing.T) storage.Storage {
+	hostStorage := storage.NewHostStorageFromConfig(&config.HostConfig{UseInMemoryDB: true}, gethlog.New())
+	t.Cleanup(func() { hostStorage.Close() })
+	return hostStorage
+}
+
+func newTestTxManager(t *testing.T, client *fakeL1Client, hostStorage storage.Storage) *TxManager {
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	w := wallet.NewInMemoryWalletFromPK(big.NewInt(1337), pk, gethlog.New())
+	if client.wallet == nil {
+		client.wallet = w
+	} else {
+		// a restarted host uses the same key
+		w = client.wallet
+	}
+	manager := NewTxManager(client, w, hostStorage, testMaxWaitForReceipt, testReceiptInterval, gethlog.New())
+	require.NoError(t, manager.Start())
+	t.Cleanup(manager.Stop)
+	return manager
+}
+
+func testTxData(b byte) types.TxData {
+	return &types.LegacyTx{To: &testTarget, Value: big.NewInt(0), Data: []byte{b}}
+}
+
+// ethClient is embedded under another name, as the interface has a method called EthClient
+type ethClient = ethadapter.EthClient
+
+// fakeL1Client records the broadcast txs and serves receipts for the ones that were mined
+type fakeL1Client struct {
+	ethClient
+	wallet wallet.Wallet
+
+	mu        sync.Mutex
+	autoMine  bool
+	usedNonce uint64 // the nonces below it were used outside the tx manager
+	sent      []*types.Transaction
+	receipts  map[gethcommon.Hash]*types.Receipt
+}
+
+func newFakeL1Client(autoMine bool) *fakeL1Client {
+	return &fakeL1Client{autoMine: autoMine, receipts: make(map[gethcommon.Hash]*types.Receipt)}
+}
+
+func (c *fakeL1Client) Nonce(gethcommon.Address) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.usedNonce, nil
+}
+
+// PrepareTransactionToRetry bumps the gas price by one for each retry, so every attempt has a distinct hash
+func (c *fakeL1Client) PrepareTransactionToRetry(_ context.Context, txData types.TxData, _ gethcommon.Address, nonce uint64, retries int) (types.TxData, error) {
+	tx := types.NewTx(txData)
+	return &types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: big.NewInt(int64(retries + 1)),
+		Gas:      21_000,
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+	}, nil
+}
+
+func (c *fakeL1Client) SendTransaction(tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tx.Nonce() < c.usedNonce {
+		return errors.New("nonce too low")
+	}
+	c.sent = append(c.sent, tx)
+	if c.autoMine {
+		c.receipts[tx.Hash()] = &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1)}
+	}
+	return nil
+}
+
+func (c *fakeL1Client) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	receipt, ok := c.receipts[hash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return receipt, nil
+}
+
+func (c *fakeL1Client) mine(hash gethcommon.Hash, status uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receipts[hash] = &types.Receipt{TxHash: hash, Status: status, BlockNumber: big.NewInt(1)}
+}
+
+func (c *fakeL1Client) reorg() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receipts = make(map[gethcommon.Hash]*types.Receipt)
+}
+
+func (c *fakeL1Client) useNonces(n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.usedNonce = n
+}
+
+func (c *fakeL1Client) setAutoMine(autoMine bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.autoMine = autoMine
+}
+
+func (c *fakeL1Client) sentTxs() []*types.Transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*types.Transaction(nil), c.sent...)
+}
@@ -23,7 +23,9 @@ const (
 	// if request asks for batches from seq no. X we don't want to return potentially thousands of batches, so we limit
 	// the number of batches we return with this cap
 	// (recipient will request the next ones as required, and they should be catching up from roll-ups first)
-	_maxBatchesInP2PResponse      = 50
+	_maxBatchesInP2PResponse = 50
+	// a peer that is further behind requests a snapshot, which is served as a bulk transfer of up to this many batches
+	_maxBatchesInSnapshot         = 1000
 	_timeoutWaitingForP2PResponse = 30 * time.Second
 )
 
@@ -45,7 +47,10 @@ type Repository struct {
 
 	// high watermark for batch sequence numbers seen so far. If we can't find batch for seq no < this, then we should ask peers for missing batches
 	latestBatchSeqNo *big.Int
+	// time of the latest batch seen (or of the start while none was seen), reported by the health check
+	latestBatchTime  time.Time
 	latestSeqNoMutex sync.Mutex
+	headAgeThreshold config.DurationThreshold
 
 	// high watermark for batch sequence numbers validated by our enclave so far.
 	latestValidatedSeqNo *big.Int
@@ -71,6 +76,7 @@ func NewBatchRepository(cfg *config.HostConfig, hostService batchRepoServiceLoca
 		isSequencer:               cfg.NodeType == common.Sequencer,
 		latestBatchSeqNo:          big.NewInt(0),
 		latestValidatedSeqNo:      big.NewInt(0),
+		headAgeThreshold:          cfg.HealthThresholds.L2HeadAge,
 		running:                   atomic.Bool{},
 		logger:                    logger,
 	}
@@ -78,6 +84,9 @@ func NewBatchRepository(cfg *config.HostConfig, hostService batchRepoServiceLoca
 
 func (r *Repository) Start() error {
 	r.running.Store(true)
+	r.latestSeqNoMutex.Lock()
+	r.latestBatchTime = time.Now()
+	r.latestSeqNoMutex.Unlock()
 
 	// register ourselves for new batches from p2p
 	r.sl.P2P().SubscribeForBatches(r)
@@ -92,12 +101,18 @@ func (r *Repository) Stop() error {
 }
 
 func (r *Repository) HealthStatus(context.Context) host.HealthStatus {
-	// todo (@matt) do proper health status based on last received batch or something
-	errMsg := ""
+	status := &host.MeasuredHealthStatus{}
 	if !r.running.Load() {
-		errMsg = "not running"
+		status.Errors = append(status.Errors, errors.New("not running"))
+		return status
 	}
-	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
+	r.latestSeqNoMutex.Lock()
+	headAge := time.Since(r.latestBatchTime)
+	status.Measure("l2Head", r.latestBatchSeqNo.String())
+	r.latestSeqNoMutex.Unlock()
+	status.CheckDuration("l2HeadAge", headAge, r.headAgeThreshold)
+	status.Measure("l2ValidatedHead", r.LatestValidatedSeqNo().String())
+	return status
 }
 
 // HandleBatches receives new batches from the p2p network, it also handles batches that are requested from peers
@@ -125,30 +140,51 @@ func (r *Repository) HandleBatches(batches []*common.ExtBatch, isLive bool) {
 	}
 }
 
-// HandleBatchRequest handles a request for a batch from a peer, sending batches to the requester asynchronously
-// todo (#1625) - only allow requests for batches since last rollup, to avoid DoS attacks.
-func (r *Repository) HandleBatchRequest(requesterID string, fromSeqNo *big.Int) {
-	batches := make([]*common.ExtBatch, 0)
-	nextSeqNum := fromSeqNo
-	for len(batches) <= _maxBatchesInP2PResponse {
-		batch, err := r.storage.FetchBatchBySeqNo(nextSeqNum.Uint64())
+// HandleBatchRequest handles a request for a range of batches from a peer, sending batches to the requester asynchronously.
+// The range served is capped (at a larger cap for snapshot requests) and a snapshot is sent in chunks, so a request
+// cannot make the host load or send an unbounded number of batches.
+func (r *Repository) HandleBatchRequest(requesterID string, request *common.BatchRequest) {
+	if request.FromSeqNo == nil {
+		return
+	}
+	limit := int64(_maxBatchesInP2PResponse)
+	if request.Snapshot {
+		limit = _maxBatchesInSnapshot
+	}
+	toSeqNo := new(big.Int).Add(request.FromSeqNo, big.NewInt(limit-1))
+	if request.ToSeqNo != nil && request.ToSeqNo.Cmp(request.FromSeqNo) >= 0 && request.ToSeqNo.Cmp(toSeqNo) < 0 {
+		toSeqNo = request.ToSeqNo
+	}
+
+	batches := make([]*common.ExtBatch, 0, _maxBatchesInP2PResponse)
+	for seqNo := new(big.Int).Set(request.FromSeqNo); seqNo.Cmp(toSeqNo) <= 0; seqNo.Add(seqNo, big.NewInt(1)) {
+		batch, err := r.storage.FetchBatchBySeqNo(seqNo.Uint64())
 		if err != nil {
 			if !errors.Is(err, errutil.ErrNotFound) {
-				r.logger.Warn("unexpected error fetching batches for peer req", log.BatchSeqNoKey, nextSeqNum, log.ErrKey, err)
+				r.logger.Warn("unexpected error fetching batches for peer req", log.BatchSeqNoKey, seqNo, log.ErrKey, err)
 			}
 			break // once one batch lookup fails we don't expect to find any of them
 		}
 		batches = append(batches, batch)
-		nextSeqNum = nextSeqNum.Add(nextSeqNum, big.NewInt(1))
+		if len(batches) == _maxBatchesInP2PResponse {
+			if !r.respondToBatchRequest(requesterID, batches) {
+				return
+			}
+			batches = make([]*common.ExtBatch, 0, _maxBatchesInP2PResponse)
+		}
 	}
-	if len(batches) == 0 {
-		return // nothing to send
+	if len(batches) > 0 {
+		r.respondToBatchRequest(requesterID, batches)
 	}
+}
 
+func (r *Repository) respondToBatchRequest(requesterID string, batches []*common.ExtBatch) bool {
 	err := r.sl.P2P().RespondToBatchRequest(requesterID, batches)
 	if err != nil {
 		r.logger.Warn("unable to send batches to peer", "peer", requesterID, log.ErrKey, err)
+		return false
 	}
+	return true
 }
 
 // SubscribeNewBatches registers a handler to be notified of new head batches as they arrive, returns unsubscribe func
@@ -193,6 +229,7 @@ func (r *Repository) AddBatch(batch *common.ExtBatch) error {
 	defer r.latestSeqNoMutex.Unlock()
 	if batch.Header.SequencerOrderNo.Cmp(r.latestBatchSeqNo) > 0 {
 		r.latestBatchSeqNo = batch.Header.SequencerOrderNo
+		r.latestBatchTime = time.Unix(int64(batch.Header.Time), 0)
 		// notify subscribers, a new batch has been successfully added to the db
 		for _, subscriber := range r.batchSubscribers.Subscribers() {
 			go subscriber.HandleBatch(batch)
@@ -214,6 +251,12 @@ func (r *Repository) NotifyNewValidatedHead(batch *common.ExtBatch) {
 	}
 }
 
+func (r *Repository) LatestValidatedSeqNo() *big.Int {
+	r.latestValidatedMutex.Lock()
+	defer r.latestValidatedMutex.Unlock()
+	return new(big.Int).Set(r.latestValidatedSeqNo)
+}
+
 func (r *Repository) fetchBatchFallbackToEnclave(ctx context.Context, seqNo *big.Int) (*common.ExtBatch, error) {
 	b, err := r.sl.Enclaves().LookupBatchBySeqNo(ctx, seqNo)
 	if err != nil {
@@ -231,23 +274,34 @@ func (r *Repository) fetchBatchFallbackToEnclave(ctx context.Context, seqNo *big
 	return b, nil
 }
 
-// RequestMissingBatches requests batches from peers from the specified sequence number.
+// RequestMissingBatches requests batches from peers from the specified sequence number up to the latest batch seen.
 // It is an asynchronous request and the repository does not expect to be notified of the result.
 func (r *Repository) requestMissingBatchesFromPeers(fromSeqNo *big.Int) {
 	r.p2pReqMutex.Lock()
 	defer r.p2pReqMutex.Unlock()
 	if r.p2pInFlightReqTime != nil && time.Since(*r.p2pInFlightReqTime) < _timeoutWaitingForP2PResponse {
 		// don't send request if we have sent one too recently
-		r.logger.Trace("not requesting missing batches from sequencer - too soon since last request", "fromSeqNo", fromSeqNo, "lastReq", r.p2pInFlightReqTime)
+		r.logger.Trace("not requesting missing batches from peers - too soon since last request", "fromSeqNo", fromSeqNo, "lastReq", r.p2pInFlightReqTime)
 		return
 	}
 
-	r.logger.Debug("requesting missing batches from sequencer", "fromSeqNo", fromSeqNo)
-	err := r.sl.P2P().RequestBatchesFromSequencer(fromSeqNo)
+	r.latestSeqNoMutex.Lock()
+	toSeqNo := new(big.Int).Set(r.latestBatchSeqNo)
+	r.latestSeqNoMutex.Unlock()
+	// a gap larger than a single response is requested as a snapshot, the rest is requested once it has been received
+	gap := new(big.Int).Sub(toSeqNo, fromSeqNo)
+	snapshot := gap.Cmp(big.NewInt(_maxBatchesInP2PResponse)) >= 0
+	if gap.Cmp(big.NewInt(_maxBatchesInSnapshot)) >= 0 {
+		toSeqNo = new(big.Int).Add(fromSeqNo, big.NewInt(_maxBatchesInSnapshot-1))
+	}
+
+	r.logger.Debug("requesting missing batches from peers", "fromSeqNo", fromSeqNo, "toSeqNo", toSeqNo, "snapshot", snapshot)
+	err := r.sl.P2P().RequestBatches(fromSeqNo, toSeqNo, snapshot)
 	if err != nil {
-		r.logger.Warn("unable to request missing batches from sequencer", "fromSeqNo", fromSeqNo, log.ErrKey, err)
+		r.logger.Warn("unable to request missing batches from peers", "fromSeqNo", fromSeqNo, log.ErrKey, err)
 		return
 	}
 	now := time.Now()
+	r.p2pInFlightRequested = fromSeqNo
 	r.p2pInFlightReqTime = &now
 }
@@ -0,0 +1,98 @@
+package l2
+
+import (
+	"math/big"
+	"testing"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+type batchStorage struct {
+	storage.Storage
+	head uint64
+}
+
+func (s *batchStorage) FetchBatchBySeqNo(seqNo uint64) (*common.ExtBatch, error) {
+	if seqNo > s.head {
+		return nil, errutil.ErrNotFound
+	}
+	return &common.ExtBatch{Header: &common.BatchHeader{SequencerOrderNo: new(big.Int).SetUint64(seqNo)}}, nil
+}
+
+// respondingP2P records the responses sent to batch requests
+type respondingP2P struct {
+	host.P2P
+	responses [][]*common.ExtBatch
+}
+
+func (p *respondingP2P) RespondToBatchRequest(_ string, batches []*common.ExtBatch) error {
+	p.responses = append(p.responses, batches)
+	return nil
+}
+
+type serviceLocator struct {
+	host.EnclaveService
+	p2p *respondingP2P
+}
+
+func (s *serviceLocator) P2P() host.P2P {
+	return s.p2p
+}
+
+func (s *serviceLocator) Enclaves() host.EnclaveService {
+	return s.EnclaveService
+}
+
+func newTestRepository(head uint64) (*Repository, *respondingP2P) {
+	p2p := &respondingP2P{}
+	repo := NewBatchRepository(&config.HostConfig{}, &serviceLocator{p2p: p2p}, &batchStorage{head: head}, gethlog.New())
+	return repo, p2p
+}
+
+func TestSnapshotIsSentInChunks(t *testing.T) {
+	repo, p2p := newTestRepository(5000)
+	repo.HandleBatchRequest("peer", &common.BatchRequest{FromSeqNo: big.NewInt(1), ToSeqNo: big.NewInt(120), Snapshot: true})
+
+	if len(p2p.responses) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(p2p.responses))
+	}
+	for i, size := range []int{_maxBatchesInP2PResponse, _maxBatchesInP2PResponse, 20} {
+		if len(p2p.responses[i]) != size {
+			t.Fatalf("expected chunk %d to hold %d batches, got %d", i, size, len(p2p.responses[i]))
+		}
+	}
+	if last := p2p.responses[2][19].Header.SequencerOrderNo.Uint64(); last != 120 {
+		t.Fatalf("expected the last batch sent to be 120, got %d", last)
+	}
+}
+
+func TestBatchRequestRangeIsCapped(t *testing.T) {
+	repo, p2p := newTestRepository(5000)
+	repo.HandleBatchRequest("peer", &common.BatchRequest{FromSeqNo: big.NewInt(1), ToSeqNo: big.NewInt(4000)})
+	if len(p2p.responses) != 1 || len(p2p.responses[0]) != _maxBatchesInP2PResponse {
+		t.Fatal("expected a request that is not a snapshot to be served a single response")
+	}
+
+	repo, p2p = newTestRepository(5000)
+	repo.HandleBatchRequest("peer", &common.BatchRequest{FromSeqNo: big.NewInt(1), ToSeqNo: big.NewInt(4000), Snapshot: true})
+	sent := 0
+	for _, response := range p2p.responses {
+		sent += len(response)
+	}
+	if sent != _maxBatchesInSnapshot {
+		t.Fatalf("expected the snapshot to be capped at %d batches, got %d", _maxBatchesInSnapshot, sent)
+	}
+}
+
+func TestBatchRequestStopsAtMissingBatch(t *testing.T) {
+	repo, p2p := newTestRepository(10)
+	repo.HandleBatchRequest("peer", &common.BatchRequest{FromSeqNo: big.NewInt(5), ToSeqNo: big.NewInt(40)})
+	if len(p2p.responses) != 1 || len(p2p.responses[0]) != 6 {
+		t.Fatal("expected the batches held by the host to be sent")
+	}
+}
@@ -0,0 +1,71 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	_minBatchRequestInterval    = time.Second      // a requester can ask for one range of batches per interval
+	_minSnapshotRequestInterval = 10 * time.Second // and for one snapshot per interval
+	_maxConcurrentBatchRequests = 4                // batch requests served at the same time, the others are dropped
+)
+
+// batchRequestLimiter bounds the batch requests a host serves, per requester and in total, so peers requesting batches
+// cannot overload it
+type batchRequestLimiter struct {
+	lock          sync.Mutex
+	lastRequest   map[string]time.Time
+	lastSnapshot  map[string]time.Time
+	inFlightSlots chan struct{}
+}
+
+func newBatchRequestLimiter() *batchRequestLimiter {
+	return &batchRequestLimiter{
+		lastRequest:   map[string]time.Time{},
+		lastSnapshot:  map[string]time.Time{},
+		inFlightSlots: make(chan struct{}, _maxConcurrentBatchRequests),
+	}
+}
+
+// acquire returns false if the request must be dropped, otherwise release must be called once it has been served
+func (l *batchRequestLimiter) acquire(requester string, snapshot bool) bool {
+	l.lock.Lock()
+	now := time.Now()
+	// forget the requesters that are no longer limited, so the maps don't grow with the number of peers ever seen
+	for peer, last := range l.lastRequest {
+		if now.Sub(last) >= _minBatchRequestInterval {
+			delete(l.lastRequest, peer)
+		}
+	}
+	for peer, last := range l.lastSnapshot {
+		if now.Sub(last) >= _minSnapshotRequestInterval {
+			delete(l.lastSnapshot, peer)
+		}
+	}
+	_, limited := l.lastRequest[requester]
+	if snapshot {
+		_, snapshotLimited := l.lastSnapshot[requester]
+		limited = limited || snapshotLimited
+	}
+	if limited {
+		l.lock.Unlock()
+		return false
+	}
+	l.lastRequest[requester] = now
+	if snapshot {
+		l.lastSnapshot[requester] = now
+	}
+	l.lock.Unlock()
+
+	select {
+	case l.inFlightSlots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *batchRequestLimiter) release() {
+	<-l.inFlightSlots
+}
@@ -0,0 +1,85 @@
+package p2p
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+)
+
+// _sequencerEnclaveCacheTTL is how long the management contract answer for an enclave is reused, so a revoked sequencer
+// enclave stops being accepted after at most this long
+var _sequencerEnclaveCacheTTL = time.Minute
+
+// sequencerSigners verifies the batches received from peers. The sender of a message is not authenticated, so a batch is
+// only accepted when it is signed by an enclave the management contract lists as a sequencer enclave. A peer can only
+// relay batches signed by one of those enclaves, it cannot forge or alter them.
+type sequencerSigners struct {
+	isSequencerEnclave func(enclaveID gethcommon.Address) (bool, error)
+
+	lock    sync.Mutex
+	checked map[gethcommon.Address]signerCheck
+}
+
+type signerCheck struct {
+	granted bool
+	at      time.Time
+}
+
+func newSequencerSigners(isSequencerEnclave func(enclaveID gethcommon.Address) (bool, error)) *sequencerSigners {
+	return &sequencerSigners{isSequencerEnclave: isSequencerEnclave, checked: map[gethcommon.Address]signerCheck{}}
+}
+
+// verify returns an error unless every batch is signed by a sequencer enclave
+func (s *sequencerSigners) verify(batches []*common.ExtBatch) error {
+	for _, batch := range batches {
+		signer, err := batchSigner(batch)
+		if err != nil {
+			return err
+		}
+		granted, err := s.isGranted(signer)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return fmt.Errorf("batch %s signed by enclave %s, which is not a sequencer enclave", batch.Hash(), signer)
+		}
+	}
+	return nil
+}
+
+func (s *sequencerSigners) isGranted(enclaveID gethcommon.Address) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	// forget the expired answers, so the enclaves signing forged batches don't make the cache grow
+	for id, check := range s.checked {
+		if now.Sub(check.at) >= _sequencerEnclaveCacheTTL {
+			delete(s.checked, id)
+		}
+	}
+	if check, ok := s.checked[enclaveID]; ok {
+		return check.granted, nil
+	}
+	granted, err := s.isSequencerEnclave(enclaveID)
+	if err != nil {
+		return false, fmt.Errorf("could not check the sequencer enclaves on the management contract. Cause: %w", err)
+	}
+	s.checked[enclaveID] = signerCheck{granted: granted, at: now}
+	return granted, nil
+}
+
+func batchSigner(batch *common.ExtBatch) (gethcommon.Address, error) {
+	if batch == nil || batch.Header == nil || len(batch.Header.Signature) == 0 {
+		return gethcommon.Address{}, fmt.Errorf("missing signature on batch")
+	}
+	// the header is hashed rather than using the cached batch hash, so the signature covers the header as received
+	signer, err := signature.RecoverAddress(batch.Header.Hash().Bytes(), batch.Header.Signature)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("invalid signature on batch %s. Cause: %w", batch.Hash(), err)
+	}
+	return *signer, nil
+}
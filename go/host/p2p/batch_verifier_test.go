@@ -0,0 +1,163 @@
+package p2p
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+	"github.com/ten-protocol/go-ten/go/common/subscription"
+)
+
+func TestPeerBatchesMustBeSignedBySequencerEnclave(t *testing.T) {
+	sequencerBatch, sequencerID := signedBatch(t, 1)
+	granted := map[gethcommon.Address]bool{sequencerID: true}
+	lookups := 0
+	signers := newSequencerSigners(func(enclaveID gethcommon.Address) (bool, error) {
+		lookups++
+		return granted[enclaveID], nil
+	})
+
+	// a batch relayed by a peer is accepted when signed by a sequencer enclave of the management contract
+	require.NoError(t, signers.verify([]*common.ExtBatch{sequencerBatch}))
+	require.NoError(t, signers.verify([]*common.ExtBatch{sequencerBatch}))
+	require.Equal(t, 1, lookups, "the management contract answer is cached")
+
+	// batches that are forged, altered or unsigned are rejected
+	forged, _ := signedBatch(t, 2)
+	require.Error(t, signers.verify([]*common.ExtBatch{sequencerBatch, forged}))
+	alteredHeader := *sequencerBatch.Header
+	alteredHeader.Number = big.NewInt(99)
+	require.Error(t, signers.verify([]*common.ExtBatch{{Header: &alteredHeader}}))
+	unsigned := &common.ExtBatch{Header: &common.BatchHeader{Number: big.NewInt(1)}}
+	require.Error(t, signers.verify([]*common.ExtBatch{unsigned}))
+}
+
+func TestRevokedSequencerEnclaveIsRejectedOnceCacheExpires(t *testing.T) {
+	defer func(ttl time.Duration) { _sequencerEnclaveCacheTTL = ttl }(_sequencerEnclaveCacheTTL)
+	_sequencerEnclaveCacheTTL = 10 * time.Millisecond
+
+	batch, sequencerID := signedBatch(t, 1)
+	granted := map[gethcommon.Address]bool{sequencerID: true}
+	signers := newSequencerSigners(func(enclaveID gethcommon.Address) (bool, error) {
+		return granted[enclaveID], nil
+	})
+	require.NoError(t, signers.verify([]*common.ExtBatch{batch}))
+
+	granted[sequencerID] = false
+	time.Sleep(_sequencerEnclaveCacheTTL)
+	require.Error(t, signers.verify([]*common.ExtBatch{batch}))
+}
+
+func TestBatchRequestsAreRateLimited(t *testing.T) {
+	limiter := newBatchRequestLimiter()
+	require.True(t, limiter.acquire("10.0.0.1", false))
+	limiter.release()
+	require.False(t, limiter.acquire("10.0.0.1", false), "second request within the interval")
+
+	for i := 0; i < _maxConcurrentBatchRequests; i++ {
+		require.True(t, limiter.acquire(string(rune('a'+i)), true))
+	}
+	require.False(t, limiter.acquire("10.0.0.2", false), "no request slot available")
+}
+
+type countingBatchRequestHandler struct {
+	requests int
+}
+
+func (h *countingBatchRequestHandler) HandleBatchRequest(string, *common.BatchRequest) {
+	h.requests++
+}
+
+func TestBatchRequestsAreLimitedPerRemoteHost(t *testing.T) {
+	handler := &countingBatchRequestHandler{}
+	p := &Service{
+		batchReqHandlers: subscription.NewManager[host.P2PBatchRequestHandler](),
+		batchReqLimiter:  newBatchRequestLimiter(),
+		logger:           gethlog.New(),
+	}
+	p.batchReqHandlers.Subscribe(handler)
+
+	request := func(requester string) common.EncodedBatchRequest {
+		encoded, err := rlp.EncodeToBytes(&common.BatchRequest{Requester: requester, FromSeqNo: big.NewInt(1)})
+		require.NoError(t, err)
+		return encoded
+	}
+	// a peer declaring a different requester on every request is still limited
+	p.handleBatchRequest(request("peer1:10000"), "10.0.0.1")
+	p.handleBatchRequest(request("peer2:10000"), "10.0.0.1")
+	require.Equal(t, 1, handler.requests)
+
+	p.handleBatchRequest(request("peer3:10000"), "10.0.0.2")
+	require.Equal(t, 2, handler.requests)
+}
+
+func TestRemoteHostIgnoresPort(t *testing.T) {
+	first, second := &fakeConn{remote: "10.0.0.1:50001"}, &fakeConn{remote: "10.0.0.1:50002"}
+	require.Equal(t, "10.0.0.1", remoteHost(first))
+	require.Equal(t, remoteHost(first), remoteHost(second))
+}
+
+func TestPeerBatchHeadsAreOnlyAcceptedFromTheSequencerHost(t *testing.T) {
+	p := &Service{
+		sequencerAddress: "127.0.0.1:10000",
+		ourPublicAddress: "127.0.0.2:10000",
+		batchHeads:       newPeerBatchHeads(),
+		peerTracker:      newPeerTracker(),
+		metrics:          newP2PMetrics(gethmetrics.NewRegistry()),
+		logger:           gethlog.New(),
+	}
+	peerHeads := func(remote string, address string) *fakeConn {
+		contents, err := rlp.EncodeToBytes([]peerBatchHead{{Address: address, Head: big.NewInt(10)}})
+		require.NoError(t, err)
+		// the message claims to come from the sequencer whichever host sends it
+		encoded, err := rlp.EncodeToBytes(message{Sender: p.sequencerAddress, Type: msgTypePeerBatchHeads, Contents: contents})
+		require.NoError(t, err)
+		return &fakeConn{remote: remote, data: bytes.NewReader(encoded)}
+	}
+
+	p.handle(peerHeads("10.0.0.1:50001", "10.0.0.1:10000"))
+	require.Empty(t, p.batchHeads.all())
+
+	p.handle(peerHeads("127.0.0.1:50001", "10.0.0.3:10000"))
+	require.Equal(t, []string{"10.0.0.3:10000"}, p.batchHeads.holding(big.NewInt(10)))
+}
+
+type fakeConn struct {
+	net.Conn
+	remote string
+	data   io.Reader
+}
+
+func (c *fakeConn) Read(b []byte) (int, error) {
+	return c.data.Read(b)
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) RemoteAddr() net.Addr {
+	addr, _ := net.ResolveTCPAddr(tcp, c.remote)
+	return addr
+}
+
+func signedBatch(t *testing.T, seqNo int64) (*common.ExtBatch, gethcommon.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	batch := &common.ExtBatch{Header: &common.BatchHeader{Number: big.NewInt(seqNo), SequencerOrderNo: big.NewInt(seqNo)}}
+	batch.Header.Signature, err = signature.Sign(batch.Hash().Bytes(), key)
+	require.NoError(t, err)
+	return batch, crypto.PubkeyToAddress(key.PublicKey)
+}
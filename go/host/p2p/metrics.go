@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"fmt"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+var _msgTypeNames = map[msgType]string{
+	msgTypeTx:                    "tx",
+	msgTypeBatches:               "batches",
+	msgTypeBatchRequest:          "batchrequest",
+	msgTypeRegisterForBroadcasts: "register",
+	msgTypePeerBatchHeads:        "batchheads",
+}
+
+// p2pMetrics count the messages exchanged with the peers, per message type
+type p2pMetrics struct {
+	received     map[msgType]gethmetrics.Counter
+	sent         map[msgType]gethmetrics.Counter
+	sendFailures gethmetrics.Counter
+	peers        gethmetrics.Gauge // peers the sequencer broadcasts to
+}
+
+func newP2PMetrics(registry gethmetrics.Registry) *p2pMetrics {
+	m := &p2pMetrics{
+		received:     map[msgType]gethmetrics.Counter{},
+		sent:         map[msgType]gethmetrics.Counter{},
+		sendFailures: gethmetrics.GetOrRegisterCounter("host/p2p/send/failures", registry),
+		peers:        gethmetrics.GetOrRegisterGauge("host/p2p/peers", registry),
+	}
+	for t, name := range _msgTypeNames {
+		m.received[t] = gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/p2p/received/%s", name), registry)
+		m.sent[t] = gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/p2p/sent/%s", name), registry)
+	}
+	return m
+}
+
+func (m *p2pMetrics) onReceived(t msgType) {
+	if c, ok := m.received[t]; ok {
+		c.Inc(1)
+	}
+}
+
+func (m *p2pMetrics) onSent(t msgType, err error) {
+	if err != nil {
+		m.sendFailures.Inc(1)
+		return
+	}
+	if c, ok := m.sent[t]; ok {
+		c.Inc(1)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"math/rand"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/config"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 )
@@ -34,20 +37,27 @@ const (
 	msgTypeBatches
 	msgTypeBatchRequest
 	msgTypeRegisterForBroadcasts
+	msgTypePeerBatchHeads
 	// bounds for msgType validation (must update if adding new type)
 	_minMsgType = msgTypeTx
-	_maxMsgType = msgTypeRegisterForBroadcasts
+	_maxMsgType = msgTypePeerBatchHeads
 )
 
 var (
-	_alertPeriod             = 5 * time.Minute
 	_maxPeerFailures         = 3               // peer removed from broadcast pool after this many failures
 	_maxWaitWithoutBroadcast = 2 * time.Minute // validators will re-register for broadcasts after this period of silence
+	_batchHeadsShareInterval = time.Minute     // sequencer shares the batch heads advertised by the validators at this interval
 )
 
 // A P2P message's type.
 type msgType uint8
 
+// peerBatchHead is advertised by a host when it registers for broadcasts, it can serve peers the batches up to Head
+type peerBatchHead struct {
+	Address string
+	Head    *big.Int
+}
+
 // Associates an encoded message to its type.
 type message struct {
 	Sender   string // todo (#1619) - this needs to be authed in the future
@@ -62,6 +72,10 @@ type p2pServiceLocator interface {
 
 // NewSocketP2PLayer - returns the Socket implementation of the P2P
 func NewSocketP2PLayer(config *config.HostConfig, serviceLocator p2pServiceLocator, logger gethlog.Logger, metricReg gethmetrics.Registry) *Service {
+	// the publisher is looked up on every call, the service locator is not fully wired when the p2p layer is created
+	isSequencerEnclave := func(enclaveID gethcommon.Address) (bool, error) {
+		return serviceLocator.L1Publisher().IsSequencerEnclave(enclaveID)
+	}
 	return &Service{
 		batchSubscribers: subscription.NewManager[host.P2PBatchHandler](),
 		txSubscribers:    subscription.NewManager[host.P2PTxHandler](),
@@ -79,10 +93,15 @@ func NewSocketP2PLayer(config *config.HostConfig, serviceLocator p2pServiceLocat
 
 		peerAddressesMutex: sync.RWMutex{},
 
+		batchHeads:       newPeerBatchHeads(),
+		sequencerSigners: newSequencerSigners(isSequencerEnclave),
+		batchReqLimiter:  newBatchRequestLimiter(),
+
 		// monitoring
-		peerTracker:     newPeerTracker(),
-		metricsRegistry: metricReg,
-		logger:          logger,
+		peerTracker:      newPeerTracker(),
+		peerAgeThreshold: config.HealthThresholds.PeerMessageAge,
+		metrics:          newP2PMetrics(metricReg),
+		logger:           logger,
 
 		isIncomingP2PDisabled: config.IsInboundP2PDisabled,
 	}
@@ -104,8 +123,13 @@ type Service struct {
 	peerAddresses    map[string]int // map of peer addresses to the number of times they have failed to send a message
 	p2pTimeout       time.Duration
 
+	batchHeads       *peerBatchHeads      // batches the peers advertised they can serve
+	sequencerSigners *sequencerSigners    // enclaves whose signature is accepted on batches served by peers
+	batchReqLimiter  *batchRequestLimiter // limits the batch requests served to peers
+
 	peerTracker           *peerTracker
-	metricsRegistry       gethmetrics.Registry
+	peerAgeThreshold      config.DurationThreshold
+	metrics               *p2pMetrics
 	logger                gethlog.Logger
 	peerAddressesMutex    sync.RWMutex
 	isIncomingP2PDisabled bool
@@ -134,6 +158,8 @@ func (p *Service) Start() error {
 			p.logger.Error("Failed to register for broadcasts", log.ErrKey, err)
 		}
 		go p.EnsureSubscribedToSequencer()
+	} else {
+		go p.shareBatchHeads()
 	}
 
 	return nil
@@ -151,13 +177,25 @@ func (p *Service) Stop() error {
 }
 
 func (p *Service) HealthStatus(context.Context) host.HealthStatus {
-	msg := ""
-	if err := p.verifyHealth(); err != nil {
-		msg = err.Error()
+	status := &host.MeasuredHealthStatus{}
+	if p.isIncomingP2PDisabled {
+		return status
+	}
+	// the health of the p2p is graded on the peer we haven't heard from for the longest
+	var stalestPeer string
+	var stalestAge time.Duration
+	peers := p.peerTracker.receivedMessagesByPeer()
+	for peer, lastMsgTimestamp := range peers {
+		if age := time.Since(lastMsgTimestamp); age > stalestAge {
+			stalestPeer, stalestAge = peer, age
+		}
 	}
-	return &host.BasicErrHealthStatus{
-		ErrMsg: msg,
+	status.Measure("peers", strconv.Itoa(len(peers)))
+	if stalestPeer != "" {
+		status.Measure("stalestPeer", stalestPeer)
+		status.CheckDuration("peerMessageAge", stalestAge, p.peerAgeThreshold)
 	}
+	return status
 }
 
 func (p *Service) SubscribeForBatches(handler host.P2PBatchHandler) func() {
@@ -207,18 +245,23 @@ func (p *Service) BroadcastBatches(batches []*common.ExtBatch) error {
 	return p.broadcast(msg)
 }
 
-func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+// RequestBatches sends the batch request to a random peer among the ones that advertised the start of the range, so
+// catching up doesn't depend on the sequencer. The sequencer is asked when no peer holds the batches or the peer is
+// unreachable.
+func (p *Service) RequestBatches(fromSeqNo *big.Int, toSeqNo *big.Int, snapshot bool) error {
 	if p.isIncomingP2PDisabled {
 		return nil
 	}
 	if p.isSequencer {
-		return errors.New("sequencer cannot request batches from itself")
+		return errors.New("sequencer cannot request batches from peers")
 	}
 	batchRequest := &common.BatchRequest{
 		Requester: p.ourPublicAddress,
 		FromSeqNo: fromSeqNo,
+		ToSeqNo:   toSeqNo,
+		Snapshot:  snapshot,
 	}
-	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from sequencer", "fromSeqNo", batchRequest.FromSeqNo)
+	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from peer", "fromSeqNo", batchRequest.FromSeqNo, "toSeqNo", batchRequest.ToSeqNo)
 
 	encodedBatchRequest, err := rlp.EncodeToBytes(batchRequest)
 	if err != nil {
@@ -226,7 +269,15 @@ func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
 	}
 
 	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatchRequest, Contents: encodedBatchRequest}
-	// todo (#718) - allow missing batches to be requested from peers other than sequencer?
+	if peers := p.batchHeads.holding(fromSeqNo); len(peers) > 0 {
+		peer := peers[rand.Intn(len(peers))] //nolint:gosec
+		err = p.send(msg, peer)
+		if err == nil {
+			return nil
+		}
+		p.logger.Debug("Could not request batches from peer, requesting them from the sequencer", "peer", peer, log.ErrKey, err)
+		p.batchHeads.remove(peer)
+	}
 	return p.send(msg, p.getSequencer())
 }
 
@@ -234,9 +285,6 @@ func (p *Service) RespondToBatchRequest(requestID string, batches []*common.ExtB
 	if p.isIncomingP2PDisabled {
 		return nil
 	}
-	if !p.isSequencer {
-		return errors.New("only sequencer can respond to batch requests")
-	}
 	batchMsg := &host.BatchMsg{
 		Batches: batches,
 		IsLive:  false,
@@ -261,35 +309,14 @@ func (p *Service) RegisterForBroadcasts() error {
 	if p.isSequencer {
 		return errors.New("sequencer cannot register for broadcasts")
 	}
-	// note: contents are not read, but p2p server expects message contents to be non-empty
-	msg := message{Sender: p.ourPublicAddress, Type: msgTypeRegisterForBroadcasts, Contents: []byte{1}}
-	return p.send(msg, p.getSequencer())
-}
-
-// HealthCheck returns whether the p2p is considered healthy
-// Currently it considers itself unhealthy
-// if there's more than 100 failures on a given fail type
-// if there's a known peer for which a message hasn't been received
-func (p *Service) verifyHealth() error {
-	if p.isIncomingP2PDisabled {
-		return nil
-	}
-	var noMsgReceivedPeers []string
-	for peer, lastMsgTimestamp := range p.peerTracker.receivedMessagesByPeer() {
-		if time.Now().After(lastMsgTimestamp.Add(_alertPeriod)) {
-			noMsgReceivedPeers = append(noMsgReceivedPeers, peer)
-			p.logger.Warn("no message from peer in the alert period",
-				"ourAddress", p.ourPublicAddress,
-				"peer", peer,
-				"alertPeriod", _alertPeriod,
-			)
-		}
-	}
-	if len(noMsgReceivedPeers) > 0 {
-		return errors.New("no message received from peers")
+	// the registration advertises the batches this host can serve to its peers
+	advert := peerBatchHead{Address: p.ourPublicAddress, Head: p.sl.L2Repo().LatestValidatedSeqNo()}
+	encodedAdvert, err := rlp.EncodeToBytes(advert)
+	if err != nil {
+		return fmt.Errorf("could not encode batch head using RLP. Cause: %w", err)
 	}
-
-	return nil
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeRegisterForBroadcasts, Contents: encodedAdvert}
+	return p.send(msg, p.getSequencer())
 }
 
 // Listens for connections and handles them in a separate goroutine.
@@ -325,6 +352,7 @@ func (p *Service) handle(conn net.Conn) {
 		p.logger.Debug("Failed to decode message received from peer: ", log.ErrKey, err)
 		return
 	}
+	p.metrics.onReceived(msg.Type)
 
 	switch msg.Type {
 	case msgTypeTx:
@@ -348,18 +376,21 @@ func (p *Service) handle(conn net.Conn) {
 			// nothing to send to subscribers
 			break
 		}
-		// todo - check the batch signature
-		p.lastReceivedBroadcast = time.Now()
+		if err := p.sequencerSigners.verify(batchMsg.Batches); err != nil {
+			p.logger.Warn("dropping batches from peer that are not signed by the sequencer", "peer", msg.Sender, log.ErrKey, err)
+			// stop requesting batches from that peer
+			p.batchHeads.remove(msg.Sender)
+			break
+		}
+		if msg.Sender == p.getSequencer() && batchMsg.IsLive {
+			p.lastReceivedBroadcast = time.Now()
+		}
 		for _, batchSubs := range p.batchSubscribers.Subscribers() {
 			go batchSubs.HandleBatches(batchMsg.Batches, batchMsg.IsLive)
 		}
 	case msgTypeBatchRequest:
-		if !p.isSequencer {
-			p.logger.Error("received batch request from peer, but not a sequencer node")
-			return
-		}
 		// this is an incoming request, p2p service is responsible for finding the response and returning it
-		go p.handleBatchRequest(msg.Contents)
+		go p.handleBatchRequest(msg.Contents, remoteHost(conn))
 	case msgTypeRegisterForBroadcasts:
 		if !p.isSequencer {
 			p.logger.Error("received register for broadcasts from peer, but not a sequencer node")
@@ -368,7 +399,30 @@ func (p *Service) handle(conn net.Conn) {
 		// add the peer to the list of peers
 		p.peerAddressesMutex.Lock()
 		p.peerAddresses[msg.Sender] = 0
+		p.metrics.peers.Update(int64(len(p.peerAddresses)))
 		p.peerAddressesMutex.Unlock()
+		var advert peerBatchHead
+		if err := rlp.DecodeBytes(msg.Contents, &advert); err == nil && advert.Address == msg.Sender {
+			p.batchHeads.update([]peerBatchHead{advert})
+		}
+	case msgTypePeerBatchHeads:
+		// the sender declared in the message is not authenticated, so the connection must come from the sequencer host
+		if p.isSequencer || !p.isSequencerHost(remoteHost(conn)) {
+			p.logger.Warn("received peer batch heads, but not from the sequencer", "remote", remoteHost(conn))
+			return
+		}
+		var heads []peerBatchHead
+		if err := rlp.DecodeBytes(msg.Contents, &heads); err != nil {
+			p.logger.Warn("unable to decode peer batch heads received from sequencer", log.ErrKey, err)
+			break
+		}
+		peers := make([]peerBatchHead, 0, len(heads))
+		for _, head := range heads {
+			if head.Address != p.ourPublicAddress {
+				peers = append(peers, head)
+			}
+		}
+		p.batchHeads.update(peers)
 	}
 	p.peerTracker.receivedPeerMsg(msg.Sender)
 }
@@ -392,6 +446,7 @@ func (p *Service) broadcast(msg message) error {
 		closureAddr := address
 		go func() {
 			err := p.sendBytesWithRetry(closureAddr, msgEncoded)
+			p.metrics.onSent(msg.Type, err)
 			if err != nil {
 				p.logger.Debug("Could not send message to peer", "peer", closureAddr, log.ErrKey, err)
 
@@ -403,6 +458,9 @@ func (p *Service) broadcast(msg message) error {
 					// if address has failed too many times, remove it
 					if p.peerAddresses[closureAddr] > _maxPeerFailures {
 						delete(p.peerAddresses, closureAddr)
+						p.batchHeads.remove(closureAddr)
+						p.peerTracker.removePeer(closureAddr)
+						p.metrics.peers.Update(int64(len(p.peerAddresses)))
 					}
 				}
 				p.peerAddressesMutex.Unlock()
@@ -436,6 +494,7 @@ func (p *Service) send(msg message, to string) error {
 		return fmt.Errorf("could not encode message to send to sequencer. Cause: %w", err)
 	}
 	err = p.sendBytesWithRetry(to, msgEncoded)
+	p.metrics.onSent(msg.Type, err)
 	if err != nil {
 		return err
 	}
@@ -471,11 +530,48 @@ func (p *Service) sendBytes(address string, tx []byte) error {
 	return nil
 }
 
+// remoteHost returns the host of the peer at the other end of the connection, without the port which changes with
+// every connection
+func remoteHost(conn net.Conn) string {
+	if conn == nil || conn.RemoteAddr() == nil {
+		return ""
+	}
+	addr := conn.RemoteAddr().String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
 func (p *Service) getSequencer() string {
 	return p.sequencerAddress
 }
 
-func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequest) {
+// isSequencerHost returns whether the remote host is the host of the configured sequencer address, resolving it when it
+// is a host name
+func (p *Service) isSequencerHost(remote string) bool {
+	remoteIP := net.ParseIP(remote)
+	if remoteIP == nil {
+		return false
+	}
+	sequencerHost, _, err := net.SplitHostPort(p.getSequencer())
+	if err != nil {
+		sequencerHost = p.getSequencer()
+	}
+	sequencerIPs, err := net.LookupHost(sequencerHost)
+	if err != nil {
+		p.logger.Debug("Could not resolve the sequencer host", "host", sequencerHost, log.ErrKey, err)
+		return false
+	}
+	for _, ip := range sequencerIPs {
+		if remoteIP.Equal(net.ParseIP(ip)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequest, remote string) {
 	var batchRequest *common.BatchRequest
 	err := rlp.DecodeBytes(encodedBatchRequest, &batchRequest)
 	if err != nil {
@@ -483,14 +579,45 @@ func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequ
 		return
 	}
 
-	// todo (@matt) should this response be synchronous?
+	// the requester is declared by the peer, so the requests are limited per connecting host
+	if !p.batchReqLimiter.acquire(remote, batchRequest.Snapshot) {
+		p.logger.Debug("dropping batch request from peer, rate limit exceeded", "peer", batchRequest.Requester, "remote", remote)
+		return
+	}
+	defer p.batchReqLimiter.release()
 	for _, requestHandler := range p.batchReqHandlers.Subscribers() {
-		go requestHandler.HandleBatchRequest(batchRequest.Requester, batchRequest.FromSeqNo)
+		requestHandler.HandleBatchRequest(batchRequest.Requester, batchRequest)
+	}
+}
+
+// shareBatchHeads - the sequencer shares the batch heads advertised by the validators with all of them, so they can
+// request missing batches from each other
+func (p *Service) shareBatchHeads() {
+	for {
+		select {
+		case <-p.stopControl.Done():
+			return // host is stopping
+		case <-time.After(_batchHeadsShareInterval):
+			heads := p.batchHeads.all()
+			if len(heads) == 0 {
+				continue
+			}
+			encodedHeads, err := rlp.EncodeToBytes(heads)
+			if err != nil {
+				p.logger.Error("Could not encode peer batch heads", log.ErrKey, err)
+				continue
+			}
+			err = p.broadcast(message{Sender: p.ourPublicAddress, Type: msgTypePeerBatchHeads, Contents: encodedHeads})
+			if err != nil {
+				p.logger.Warn("Could not share peer batch heads", log.ErrKey, err)
+			}
+		}
 	}
 }
 
 // EnsureSubscribedToSequencer - validators need to register with the sequencer for broadcasts
-// Note: this should be run **once** in a goroutine, it will re-request registration if we stop receiving broadcasts
+// Note: this should be run **once** in a goroutine, it re-registers periodically to keep its advertised batch head
+// current and to recover if we stop receiving broadcasts
 func (p *Service) EnsureSubscribedToSequencer() {
 	// this continues to run until the host is stopped
 	for {
@@ -500,11 +627,12 @@ func (p *Service) EnsureSubscribedToSequencer() {
 		case <-time.After(_maxWaitWithoutBroadcast / 2):
 			if time.Since(p.lastReceivedBroadcast) > _maxWaitWithoutBroadcast {
 				p.logger.Info("No broadcast received from sequencer, re-registering.")
-				err := p.RegisterForBroadcasts()
-				if err != nil {
-					// just log the error, we'll retry on the next iteration
-					p.logger.Error("Failed to re-register for broadcasts", log.ErrKey, err)
-				}
+			}
+			// registering again also advertises the latest batch head of the host to its peers
+			err := p.RegisterForBroadcasts()
+			if err != nil {
+				// just log the error, we'll retry on the next iteration
+				p.logger.Error("Failed to re-register for broadcasts", log.ErrKey, err)
 			}
 		}
 	}
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"math/big"
+	"sort"
 	"sync"
 	"time"
 )
@@ -24,6 +26,12 @@ func (s *peerTracker) receivedPeerMsg(peer string) {
 	s.lastReceivedMessageByPeer[peer] = time.Now()
 }
 
+func (s *peerTracker) removePeer(peer string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.lastReceivedMessageByPeer, peer)
+}
+
 func (s *peerTracker) receivedMessagesByPeer() map[string]time.Time {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -34,3 +42,54 @@ func (s *peerTracker) receivedMessagesByPeer() map[string]time.Time {
 	}
 	return newMap
 }
+
+// peerBatchHeads tracks the latest batch each peer advertised it can serve, so missing batches can be requested from
+// any peer holding them rather than only from the sequencer
+type peerBatchHeads struct {
+	lock  sync.RWMutex
+	heads map[string]*big.Int
+}
+
+func newPeerBatchHeads() *peerBatchHeads {
+	return &peerBatchHeads{heads: map[string]*big.Int{}}
+}
+
+func (h *peerBatchHeads) update(peers []peerBatchHead) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for _, peer := range peers {
+		if peer.Head == nil {
+			continue
+		}
+		h.heads[peer.Address] = peer.Head
+	}
+}
+
+func (h *peerBatchHeads) remove(peer string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	delete(h.heads, peer)
+}
+
+// all returns the advertised heads in a stable order
+func (h *peerBatchHeads) all() []peerBatchHead {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	peers := make([]peerBatchHead, 0, len(h.heads))
+	for address, head := range h.heads {
+		peers = append(peers, peerBatchHead{Address: address, Head: head})
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].Address < peers[j].Address })
+	return peers
+}
+
+// holding returns the peers that advertised a head at or after the given seq no
+func (h *peerBatchHeads) holding(seqNo *big.Int) []string {
+	var peers []string
+	for _, peer := range h.all() {
+		if peer.Head.Cmp(seqNo) >= 0 {
+			peers = append(peers, peer.Address)
+		}
+	}
+	return peers
+}
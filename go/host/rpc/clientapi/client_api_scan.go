@@ -2,13 +2,18 @@ package clientapi
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"math/big"
+	"strconv"
+	"strings"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/host"
 )
 
@@ -120,6 +125,128 @@ func (s *ScanAPI) GetBatchTransactions(batchHash gethcommon.Hash) (*common.Trans
 	return s.host.Storage().FetchBatchTransactions(batchHash)
 }
 
+// GetCrossChainMessage returns the lifecycle status of the cross chain message or value transfer with the given hash
+func (s *ScanAPI) GetCrossChainMessage(messageHash gethcommon.Hash) (*common.PublicCrossChainMessage, error) {
+	return s.host.Storage().FetchCrossChainMessage(messageHash)
+}
+
+// GetCrossChainMessageListing returns a paginated list of cross chain messages, without their participants
+func (s *ScanAPI) GetCrossChainMessageListing(pagination *common.QueryPagination) (*common.CrossChainMessageListingResponse, error) {
+	return s.host.Storage().FetchCrossChainMessageListing(pagination)
+}
+
+// GetL1ForkListing returns a paginated list of the L1 reorgs processed by the enclave, latest first
+func (s *ScanAPI) GetL1ForkListing(pagination *common.QueryPagination) (*common.L1ForkListingResponse, error) {
+	return s.host.Storage().FetchL1ForkListing(pagination)
+}
+
+// GetReorgedBatchListing returns a paginated list of the batches made non-canonical by L1 forks, with their replacements
+func (s *ScanAPI) GetReorgedBatchListing(pagination *common.QueryPagination) (*common.ReorgedBatchListingResponse, error) {
+	return s.host.Storage().FetchReorgedBatchListing(pagination)
+}
+
+// GetMovedTransactionListing returns a paginated list of the transactions of non-canonical batches, with the batch
+// that included each of them again
+func (s *ScanAPI) GetMovedTransactionListing(pagination *common.QueryPagination) (*common.MovedTransactionListingResponse, error) {
+	return s.host.Storage().FetchMovedTransactionListing(pagination)
+}
+
+// GetContract returns the creation and visibility configuration of the contract at the address
+func (s *ScanAPI) GetContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, error) {
+	contract, err := s.host.EnclaveClient().GetPublicContract(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if contract == nil {
+		return nil, errutil.ErrNotFound
+	}
+	return contract, nil
+}
+
+// GetContractEvents returns a paginated list of the events of the contract that everyone can view, latest first
+func (s *ScanAPI) GetContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, error) {
+	return s.host.EnclaveClient().GetPublicContractEvents(ctx, address, pagination)
+}
+
+// GetAddressActivity returns the contracts created by the address, and a paginated list of its transactions with
+// transparent contracts, latest first. The other transactions of the address are private.
+func (s *ScanAPI) GetAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, error) {
+	return s.host.EnclaveClient().GetPublicAddressActivity(ctx, address, pagination)
+}
+
+// Search resolves the query to the transactions, batches, rollups and L1 blocks with that hash, the batches with that
+// height or sequence number and the rollup containing it, or the contract at that address. Hashes and addresses are
+// hex, numbers are decimal or 0x-prefixed hex.
+func (s *ScanAPI) Search(ctx context.Context, query string, pagination *common.QueryPagination) (*common.SearchResponse, error) {
+	var results []common.SearchResult
+	var err error
+	query = strings.TrimSpace(query)
+	switch {
+	case isHexOfLength(query, gethcommon.HashLength):
+		results, err = s.host.Storage().SearchByHash(gethcommon.HexToHash(query))
+	case isHexOfLength(query, gethcommon.AddressLength):
+		results, err = s.searchContract(ctx, gethcommon.HexToAddress(query))
+	default:
+		number, parseErr := parseSearchNumber(query)
+		if parseErr != nil {
+			return nil, errors.New("search query must be a hash, an address or a number")
+		}
+		results, err = s.host.Storage().SearchByNumber(number)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint64(len(results))
+	start := min(pagination.Offset, total)
+	// the size is bounded before it is added, so a huge page size cannot overflow the end of the page
+	end := start + min(uint64(pagination.Size), total-start)
+	return &common.SearchResponse{
+		ResultsData: results[start:end],
+		Total:       total,
+	}, nil
+}
+
+func (s *ScanAPI) searchContract(ctx context.Context, address gethcommon.Address) ([]common.SearchResult, error) {
+	contract, err := s.host.EnclaveClient().GetPublicContract(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if contract == nil {
+		return []common.SearchResult{}, nil
+	}
+	return []common.SearchResult{{
+		Type:      common.SearchResultContract,
+		MatchedBy: common.MatchedByAddress,
+		Hash:      &contract.CreationTx,
+		Address:   &contract.Address,
+		Height:    contract.CreationBatch,
+	}}, nil
+}
+
+// isHexOfLength returns whether the string is the hex encoding of the given number of bytes, with or without 0x
+func isHexOfLength(s string, length int) bool {
+	if has0xPrefix(s) {
+		s = s[2:]
+	}
+	if len(s) != 2*length {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+func parseSearchNumber(s string) (uint64, error) {
+	if has0xPrefix(s) {
+		return strconv.ParseUint(s[2:], 16, 64)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
 // These methods are for private user data, they will need to be requested with VK (e.g. via the gateway)
 
 // GetPersonalTransactions gets the private transactions data for a given user
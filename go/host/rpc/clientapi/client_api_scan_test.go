@@ -0,0 +1,59 @@
+package clientapi
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+type searchStorage struct {
+	storage.Storage
+	results []common.SearchResult
+}
+
+func (s *searchStorage) SearchByNumber(uint64) ([]common.SearchResult, error) {
+	return s.results, nil
+}
+
+type searchHost struct {
+	host.Host
+	storage *searchStorage
+}
+
+func (h *searchHost) Storage() storage.Storage {
+	return h.storage
+}
+
+func TestSearchPagination(t *testing.T) {
+	results := make([]common.SearchResult, 5)
+	for i := range results {
+		results[i] = common.SearchResult{Type: common.SearchResultBatch}
+	}
+	api := NewScanAPI(&searchHost{storage: &searchStorage{results: results}}, gethlog.New())
+
+	for _, tc := range []struct {
+		name     string
+		offset   uint64
+		size     uint
+		expected int
+	}{
+		{"first page", 0, 2, 2},
+		{"last page", 4, 2, 1},
+		{"past the results", 10, 2, 0},
+		{"huge page size", 1, math.MaxUint, 4},
+		{"huge offset and page size", math.MaxUint64, math.MaxUint, 0},
+	} {
+		resp, err := api.Search(context.Background(), "12", &common.QueryPagination{Offset: tc.offset, Size: tc.size})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+		if len(resp.ResultsData) != tc.expected || resp.Total != uint64(len(results)) {
+			t.Fatalf("%s: expected %d of %d results, got %d of %d", tc.name, tc.expected, len(results), len(resp.ResultsData), resp.Total)
+		}
+	}
+}
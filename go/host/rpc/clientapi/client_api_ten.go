@@ -2,10 +2,14 @@ package clientapi
 
 import (
 	"context"
+	"fmt"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/common/vkhandler"
+	"github.com/ten-protocol/go-ten/go/responses"
 )
 
 // TenAPI implements Ten-specific JSON RPC operations.
@@ -33,6 +37,36 @@ func (api *TenAPI) Config() (*ChecksumFormattedTenNetworkConfig, error) {
 	return checksumFormatted(config), nil
 }
 
+// GetCrossChainProof returns the merkle proof for an outbound cross chain message or value transfer, identified by the
+// hash of its packed encoding, together with the root it proves against and the L1 publication status of its bundle
+func (api *TenAPI) GetCrossChainProof(messageHash gethcommon.Hash) (*common.CrossChainProof, error) {
+	return api.host.CrossChainProof(messageHash)
+}
+
+// GetCrossChainMessages returns the cross chain messages and value transfers sent or received by the address.
+// The caller must sign in with a viewing key of the address, and the response is encrypted with it.
+func (api *TenAPI) GetCrossChainMessages(address gethcommon.Address, pagination *common.QueryPagination, vk *viewingkey.RPCSignedViewingKey) (*responses.EnclaveResponse, error) {
+	if vk == nil {
+		return nil, fmt.Errorf("a viewing key is required")
+	}
+	authenticatedVK, err := vkhandler.VerifyViewingKey(vk, api.host.Config().ObscuroChainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate the viewing key - %w", err)
+	}
+	if *authenticatedVK.AccountAddress != address {
+		return nil, fmt.Errorf("the viewing key was not signed by %s", address.Hex())
+	}
+	if pagination == nil {
+		return responses.AsEncryptedError(fmt.Errorf("pagination is required"), authenticatedVK), nil
+	}
+
+	messages, err := api.host.Storage().FetchCrossChainMessagesByAddress(address, pagination)
+	if err != nil {
+		return responses.AsEncryptedError(err, authenticatedVK), nil
+	}
+	return responses.AsEncryptedResponse(messages, authenticatedVK), nil
+}
+
 // ChecksumFormattedTenNetworkConfig serialises the addresses as EIP55 checksum addresses.
 type ChecksumFormattedTenNetworkConfig struct {
 	ManagementContractAddress       gethcommon.AddressEIP55
@@ -0,0 +1,27 @@
+package clientapi
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+)
+
+// TenAdminAPI extends the Ten-specific JSON RPC operations with the ones meant for node operators. It replaces the
+// TenAPI in the Ten namespace when the debug namespace is enabled.
+type TenAdminAPI struct {
+	*TenAPI
+	host host.Host
+}
+
+func NewTenAdminAPI(host host.Host) *TenAdminAPI {
+	return &TenAdminAPI{
+		TenAPI: NewTenAPI(host),
+		host:   host,
+	}
+}
+
+// GetBlob returns the blob with the given versioned hash from the host DB, together with the L1 block it was fetched
+// for, to help debug rollup ingestion
+func (api *TenAdminAPI) GetBlob(versionedHash gethcommon.Hash) (*common.StoredBlob, error) {
+	return api.host.Storage().FetchBlob(versionedHash)
+}
@@ -0,0 +1,93 @@
+package clientapi
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+const testChainID = 443
+
+type crossChainStorage struct {
+	storage.Storage
+	messages map[gethcommon.Address]*common.PersonalCrossChainMessageListingResponse
+}
+
+func (s *crossChainStorage) FetchCrossChainMessagesByAddress(address gethcommon.Address, _ *common.QueryPagination) (*common.PersonalCrossChainMessageListingResponse, error) {
+	return s.messages[address], nil
+}
+
+type crossChainHost struct {
+	host.Host
+	storage *crossChainStorage
+}
+
+func (h *crossChainHost) Config() *config.HostConfig {
+	return &config.HostConfig{ObscuroChainID: testChainID}
+}
+
+func (h *crossChainHost) Storage() storage.Storage {
+	return h.storage
+}
+
+func newViewingKey(t *testing.T) *viewingkey.ViewingKey {
+	pk, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vk, err := viewingkey.GenerateViewingKeyForWallet(wallet.NewInMemoryWalletFromPK(big.NewInt(testChainID), pk, gethlog.New()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vk
+}
+
+func signedViewingKey(vk *viewingkey.ViewingKey) *viewingkey.RPCSignedViewingKey {
+	return &viewingkey.RPCSignedViewingKey{
+		PublicKey:               vk.PublicKey,
+		SignatureWithAccountKey: vk.SignatureWithAccountKey,
+		SignatureType:           vk.SignatureType,
+	}
+}
+
+func TestCrossChainMessagesRequireViewingKeyOfAddress(t *testing.T) {
+	owner, other := newViewingKey(t), newViewingKey(t)
+	listing := &common.PersonalCrossChainMessageListingResponse{Total: 1}
+	api := NewTenAPI(&crossChainHost{storage: &crossChainStorage{
+		messages: map[gethcommon.Address]*common.PersonalCrossChainMessageListingResponse{*owner.Account: listing},
+	}})
+	pagination := &common.QueryPagination{Offset: 0, Size: 10}
+
+	if _, err := api.GetCrossChainMessages(*owner.Account, pagination, nil); err == nil {
+		t.Fatal("expected a request without a viewing key to be rejected")
+	}
+	if _, err := api.GetCrossChainMessages(*owner.Account, pagination, signedViewingKey(other)); err == nil {
+		t.Fatal("expected a viewing key of another account to be rejected")
+	}
+
+	resp, err := api.GetCrossChainMessages(*owner.Account, pagination, signedViewingKey(owner))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := owner.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatalf("could not decrypt the response with the viewing key - %s", err)
+	}
+	result, err := responses.DecodeResponse[common.PersonalCrossChainMessageListingResponse](decrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != listing.Total {
+		t.Fatalf("unexpected listing total %d", result.Total)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	"google.golang.org/grpc"
@@ -42,7 +43,10 @@ type Client struct {
 }
 
 func NewClient(enclaveRPCAddress string, enclaveRPCTimeout time.Duration, logger gethlog.Logger) common.Enclave {
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+	}
 	connection, err := grpc.NewClient(enclaveRPCAddress, opts...)
 	if err != nil {
 		logger.Crit("Failed to connect to enclave RPC service.", log.ErrKey, err)
@@ -446,6 +450,20 @@ func (c *Client) HealthCheck(ctx context.Context) (bool, common.SystemError) {
 	return response.Status, nil
 }
 
+func (c *Client) Metrics(ctx context.Context) ([]byte, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.Metrics(timeoutCtx, &generated.MetricsRequest{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return response.Metrics, nil
+}
+
 func (c *Client) CreateBatch(ctx context.Context, skipIfEmpty bool) common.SystemError {
 	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "CreateBatch rpc call")
 
@@ -459,11 +477,26 @@ func (c *Client) CreateBatch(ctx context.Context, skipIfEmpty bool) common.Syste
 	return err
 }
 
-func (c *Client) CreateRollup(ctx context.Context, fromSeqNo uint64) (*common.ExtRollup, common.SystemError) {
+func (c *Client) SetActiveSequencer(ctx context.Context, active bool) common.SystemError {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SetActiveSequencer(timeoutCtx, &generated.SetActiveSequencerRequest{Active: active})
+	if err != nil {
+		return syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return nil
+}
+
+func (c *Client) CreateRollup(ctx context.Context, fromSeqNo uint64, daMode common.DAMode) (*common.ExtRollup, common.SystemError) {
 	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "CreateRollup rpc call")
 
 	response, err := c.protoClient.CreateRollup(ctx, &generated.CreateRollupRequest{
 		FromSequenceNumber: &fromSeqNo,
+		DaMode:             uint32(daMode),
 	})
 	if err != nil {
 		return nil, syserr.NewRPCError(err)
@@ -610,6 +643,71 @@ func (c *Client) GetTotalContractCount(ctx context.Context) (*big.Int, common.Sy
 	return big.NewInt(response.Count), nil
 }
 
+func (c *Client) GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetPublicContract(timeoutCtx, &generated.GetPublicContractRequest{Address: address.Bytes()})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if len(response.EncodedContract) == 0 {
+		return nil, nil
+	}
+	var contract common.PublicContract
+	if err = json.Unmarshal(response.EncodedContract, &contract); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not decode public contract. Cause: %w", err))
+	}
+	return &contract, nil
+}
+
+func (c *Client) GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetPublicContractEvents(timeoutCtx, &generated.GetPublicContractEventsRequest{
+		Address: address.Bytes(),
+		Offset:  pagination.Offset,
+		Size:    uint32(pagination.Size),
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	var events common.PublicEventListingResponse
+	if err = json.Unmarshal(response.EncodedEvents, &events); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not decode public contract events. Cause: %w", err))
+	}
+	return &events, nil
+}
+
+func (c *Client) GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetPublicAddressActivity(timeoutCtx, &generated.GetPublicAddressActivityRequest{
+		Address: address.Bytes(),
+		Offset:  pagination.Offset,
+		Size:    uint32(pagination.Size),
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	var activity common.AddressActivity
+	if err = json.Unmarshal(response.EncodedActivity, &activity); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not decode public address activity. Cause: %w", err))
+	}
+	return &activity, nil
+}
+
 func (c *Client) GetPersonalTransactions(ctx context.Context, encryptedParams common.EncryptedParamsGetPersonalTransactions) (*responses.PersonalTransactionsResponse, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
 	defer cancel()
@@ -18,9 +18,13 @@ const (
 	selectExtBatch     = "SELECT ext_batch FROM batch_host"
 	selectLatestBatch  = "SELECT sequence, hash, height, ext_batch FROM batch_host ORDER BY sequence DESC LIMIT 1"
 	selectTxsAndBatch  = "SELECT t.hash FROM transaction_host t JOIN batch_host b ON t.b_sequence = b.sequence WHERE b.hash = "
-	selectBatchSeqByTx = "SELECT b_sequence FROM transaction_host WHERE hash = "
+	selectBatchSeqByTx = "SELECT b_sequence FROM transaction_host WHERE b_sequence NOT IN (SELECT sequence FROM reorged_batch_host) AND hash = "
 	selectTxBySeq      = "SELECT hash FROM transaction_host WHERE b_sequence = "
 	selectBatchTxs     = "SELECT t.hash, b.sequence, b.height, b.ext_batch FROM transaction_host t JOIN batch_host b ON t.b_sequence = b.sequence"
+	// a height can hold batches made non-canonical by an L1 fork, which are replaced by the latest batch at that height
+	canonicalAtHeight = " ORDER BY sequence DESC LIMIT 1"
+	// a transaction of a batch made non-canonical is usually included again in a later batch
+	canonicalTxBatch = " ORDER BY b_sequence DESC LIMIT 1"
 )
 
 // AddBatch adds a batch and its header to the DB
@@ -43,6 +47,10 @@ func AddBatch(dbtx *dbTransaction, statements *SQLStatements, batch *common.ExtB
 		return fmt.Errorf("host failed to insert batch: %w", err)
 	}
 
+	if err := markReorgedBatches(dbtx, statements, batch); err != nil {
+		return err
+	}
+
 	if len(batch.TxHashes) > 0 {
 		insert := statements.InsertTransactions
 		args := make([]any, 0)
@@ -57,6 +65,13 @@ func AddBatch(dbtx *dbTransaction, statements *SQLStatements, batch *common.ExtB
 		}
 	}
 
+	if err := addOutboundCrossChainMessages(dbtx, statements, batch); err != nil {
+		return err
+	}
+	if err := executeInboundCrossChainMessages(dbtx, statements, batch); err != nil {
+		return err
+	}
+
 	var currentTotal int
 	err = dbtx.tx.QueryRow(selectTxCount).Scan(&currentTotal)
 	if err != nil {
@@ -179,7 +194,7 @@ func GetBatchHeader(db HostDB, hash gethcommon.Hash) (*common.BatchHeader, error
 
 // GetBatchHashByNumber returns the hash of a batch given its number.
 func GetBatchHashByNumber(db HostDB, number *big.Int) (*gethcommon.Hash, error) {
-	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder
+	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder + canonicalAtHeight
 	batch, err := fetchBatchHeader(db.GetSQLDB(), whereQuery, number.Uint64())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch batch header - %w", err)
@@ -241,7 +256,7 @@ func GetPublicBatch(db HostDB, hash common.L2BatchHash) (*common.PublicBatch, er
 // GetBatchByTx returns the batch with the given hash.
 func GetBatchByTx(db HostDB, txHash gethcommon.Hash) (*common.ExtBatch, error) {
 	var seqNo uint64
-	query := selectBatchSeqByTx + db.GetSQLStatement().Placeholder
+	query := selectBatchSeqByTx + db.GetSQLStatement().Placeholder + canonicalTxBatch
 	err := db.GetSQLDB().QueryRow(query, txHash.Bytes()).Scan(&seqNo)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -269,13 +284,13 @@ func GetLatestBatch(db HostDB) (*common.BatchHeader, error) {
 
 // GetBatchHeaderByHeight returns the batch header given the height
 func GetBatchHeaderByHeight(db HostDB, height *big.Int) (*common.BatchHeader, error) {
-	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder
+	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder + canonicalAtHeight
 	return fetchBatchHeader(db.GetSQLDB(), whereQuery, height.Uint64())
 }
 
 // GetBatchByHeight returns the batch header given the height
 func GetBatchByHeight(db HostDB, height *big.Int) (*common.PublicBatch, error) {
-	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder
+	whereQuery := " WHERE height=" + db.GetSQLStatement().Placeholder + canonicalAtHeight
 	return fetchPublicBatch(db.GetSQLDB(), whereQuery, height.Uint64())
 }
 
@@ -311,7 +326,7 @@ func fetchBatchHeader(db *sql.DB, whereQuery string, args ...any) (*common.Batch
 
 func fetchBatchNumber(db HostDB, args ...any) (*big.Int, error) {
 	var seqNo uint64
-	query := selectBatchSeqByTx + db.GetSQLStatement().Placeholder
+	query := selectBatchSeqByTx + db.GetSQLStatement().Placeholder + canonicalTxBatch
 	var err error
 	if len(args) > 0 {
 		err = db.GetSQLDB().QueryRow(query, args...).Scan(&seqNo)
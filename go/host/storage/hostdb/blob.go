@@ -0,0 +1,83 @@
+package hostdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectBlob      = "SELECT versioned_hash, blob, l1_hash, l1_height, l1_time FROM blob_host WHERE versioned_hash="
+	deleteBlobsUpTo = "DELETE FROM blob_host WHERE l1_time < "
+)
+
+// AddBlobs stores the blobs fetched for an L1 block, keyed by their versioned hashes
+func AddBlobs(dbtx *dbTransaction, statements *SQLStatements, b *types.Header, hashes []gethcommon.Hash, blobs []*kzg4844.Blob) error {
+	if len(hashes) != len(blobs) {
+		return fmt.Errorf("number of hashes and blobs mismatch, %d != %d", len(hashes), len(blobs))
+	}
+	for i, blob := range blobs {
+		_, err := dbtx.tx.Exec(statements.InsertBlob,
+			hashes[i].Bytes(), // versioned hash
+			blob[:],           // blob
+			b.Hash().Bytes(),  // l1 block hash
+			b.Number.Uint64(), // l1 block height
+			b.Time,            // l1 block time, used for retention
+		)
+		if err != nil {
+			return fmt.Errorf("could not insert blob %s. Cause: %w", hashes[i].Hex(), err)
+		}
+	}
+	return nil
+}
+
+// DeleteBlobsBefore removes the blobs fetched for L1 blocks older than the given time
+func DeleteBlobsBefore(dbtx *dbTransaction, statements *SQLStatements, l1Time uint64) error {
+	_, err := dbtx.tx.Exec(deleteBlobsUpTo+statements.GetPlaceHolder(1), l1Time)
+	if err != nil {
+		return fmt.Errorf("could not delete blobs. Cause: %w", err)
+	}
+	return nil
+}
+
+// GetBlob returns the stored blob with the given versioned hash
+func GetBlob(db HostDB, versionedHash gethcommon.Hash) (*common.StoredBlob, error) {
+	var hash, blob, l1Hash []byte
+	stored := &common.StoredBlob{}
+	err := db.GetSQLDB().QueryRow(selectBlob+db.GetSQLStatement().Placeholder, versionedHash.Bytes()).
+		Scan(&hash, &blob, &l1Hash, &stored.L1Height, &stored.L1Time)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch blob %s. Cause: %w", versionedHash.Hex(), err)
+	}
+	stored.VersionedHash = gethcommon.BytesToHash(hash)
+	stored.L1Hash = gethcommon.BytesToHash(l1Hash)
+	stored.Blob = blob
+	return stored, nil
+}
+
+// GetBlobs returns the stored blobs in the order of the hashes, or errutil.ErrNotFound if any of them is missing
+func GetBlobs(db HostDB, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	blobs := make([]*kzg4844.Blob, len(hashes))
+	for i, h := range hashes {
+		stored, err := GetBlob(db, h)
+		if err != nil {
+			return nil, err
+		}
+		if len(stored.Blob) != len(kzg4844.Blob{}) {
+			return nil, fmt.Errorf("stored blob %s has invalid length %d", h.Hex(), len(stored.Blob))
+		}
+		var blob kzg4844.Blob
+		copy(blob[:], stored.Blob)
+		blobs[i] = &blob
+	}
+	return blobs, nil
+}
@@ -0,0 +1,85 @@
+package hostdb
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanStoreAndRetrieveBlobs(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	block := &types.Header{Number: big.NewInt(10), Time: 1000}
+	hashes, blobs := createBlobs(2)
+
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddBlobs(dbtx, db.GetSQLStatement(), block, hashes, blobs); err != nil {
+		t.Fatalf("could not store blobs. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	// the blobs are returned in the order they were requested
+	fetched, err := GetBlobs(db, []gethcommon.Hash{hashes[1], hashes[0]})
+	if err != nil {
+		t.Fatalf("stored blobs but could not retrieve them. Cause: %s", err)
+	}
+	if *fetched[0] != *blobs[1] || *fetched[1] != *blobs[0] {
+		t.Errorf("blobs were not stored correctly")
+	}
+
+	stored, err := GetBlob(db, hashes[0])
+	if err != nil {
+		t.Fatalf("stored blob but could not retrieve it. Cause: %s", err)
+	}
+	if stored.L1Hash != block.Hash() || stored.L1Height != 10 || stored.L1Time != 1000 {
+		t.Errorf("blob L1 block was not stored correctly")
+	}
+
+	_, err = GetBlobs(db, []gethcommon.Hash{hashes[0], gethcommon.BytesToHash([]byte("unknown"))})
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store blob but was able to retrieve it")
+	}
+}
+
+func TestCanPruneBlobs(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	hashes, blobs := createBlobs(2)
+
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddBlobs(dbtx, db.GetSQLStatement(), &types.Header{Number: big.NewInt(1), Time: 100}, hashes[:1], blobs[:1]); err != nil {
+		t.Fatalf("could not store blobs. Cause: %s", err)
+	}
+	if err := AddBlobs(dbtx, db.GetSQLStatement(), &types.Header{Number: big.NewInt(2), Time: 200}, hashes[1:], blobs[1:]); err != nil {
+		t.Fatalf("could not store blobs. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	dbtx, _ = db.NewDBTransaction()
+	if err := DeleteBlobsBefore(dbtx, db.GetSQLStatement(), 200); err != nil {
+		t.Fatalf("could not prune blobs. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	if _, err := GetBlob(db, hashes[0]); !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("blob older than the retention period was not pruned")
+	}
+	if _, err := GetBlob(db, hashes[1]); err != nil {
+		t.Errorf("blob within the retention period was pruned. Cause: %s", err)
+	}
+}
+
+func createBlobs(count int) ([]gethcommon.Hash, []*kzg4844.Blob) {
+	hashes := make([]gethcommon.Hash, count)
+	blobs := make([]*kzg4844.Blob, count)
+	for i := range blobs {
+		var blob kzg4844.Blob
+		blob[0] = byte(i + 1)
+		blobs[i] = &blob
+		hashes[i] = gethcommon.BytesToHash([]byte{0x01, byte(i + 1)})
+	}
+	return hashes, blobs
+}
@@ -0,0 +1,332 @@
+package hostdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectCrossChainMessages      = "SELECT message_hash, direction, leaf_type, status, sender, receiver, l1_height, b_sequence FROM cross_chain_message_host"
+	selectCrossChainMessageCount  = "SELECT COUNT(*) FROM cross_chain_message_host"
+	selectBatchSeqByXChainMessage = "SELECT b_sequence FROM cross_chain_message_host WHERE direction = '" + string(common.CrossChainOutbound) + "' AND message_hash = "
+	crossChainMessageColumnsCount = 9
+)
+
+// addOutboundCrossChainMessages indexes the leaves of the batch cross chain tree so that proofs and statuses can be located by message hash
+func addOutboundCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, batch *common.ExtBatch) error {
+	leaves, err := common.DeserializeCrossChainTree(batch.Header.CrossChainTree)
+	if err != nil {
+		return fmt.Errorf("could not decode cross chain tree. cause: %w", err)
+	}
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	// the messages are public in the batch header, so their senders can be indexed
+	senders := make(map[gethcommon.Hash]gethcommon.Address)
+	for _, msg := range batch.Header.CrossChainMessages {
+		senders[common.HashCrossChainMessage(msg)] = msg.Sender
+	}
+
+	var l1Height uint64
+	if batch.Header.LatestInboundCrossChainHeight != nil {
+		l1Height = batch.Header.LatestInboundCrossChainHeight.Uint64()
+	}
+
+	records := make([]common.CrossChainMessageRecord, 0, len(leaves))
+	for _, leaf := range leaves {
+		leafType, _ := leaf[0].(string)
+		record := common.CrossChainMessageRecord{
+			MessageHash: leaf[1].(gethcommon.Hash),
+			Direction:   common.CrossChainOutbound,
+			LeafType:    leafType,
+			L1Height:    l1Height,
+		}
+		if sender, ok := senders[record.MessageHash]; ok {
+			record.Sender = &sender
+		}
+		records = append(records, record)
+	}
+
+	return insertCrossChainMessages(dbtx, statements, records, common.CrossChainMessageExecuted, batch)
+}
+
+// AddInboundCrossChainMessages stores the messages and value transfers emitted on the L1 message bus as observed
+func AddInboundCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, records []common.CrossChainMessageRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return insertCrossChainMessages(dbtx, statements, records, common.CrossChainMessageObserved, nil)
+}
+
+func insertCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, records []common.CrossChainMessageRecord, status common.CrossChainMessageStatus, batch *common.ExtBatch) error {
+	insert := statements.InsertCrossChainMessages
+	args := make([]any, 0, len(records)*crossChainMessageColumnsCount)
+	for i, record := range records {
+		placeholders := make([]string, crossChainMessageColumnsCount)
+		for j := range placeholders {
+			placeholders[j] = statements.GetPlaceHolder(i*crossChainMessageColumnsCount + j + 1)
+		}
+		insert += fmt.Sprintf(" (%s),", strings.Join(placeholders, ", "))
+
+		var root []byte
+		var seqNo *uint64
+		if batch != nil {
+			root = batch.Header.CrossChainRoot.Bytes()
+			s := batch.SeqNo().Uint64()
+			seqNo = &s
+		}
+		args = append(args,
+			record.MessageHash.Bytes(),
+			string(record.Direction),
+			record.LeafType,
+			string(status),
+			addressBytes(record.Sender),
+			addressBytes(record.Receiver),
+			record.L1Height,
+			root,
+			seqNo,
+		)
+	}
+	insert = strings.TrimRight(insert, ",") + statements.OnConflictIgnore
+	_, err := dbtx.tx.Exec(insert, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert cross chain messages. cause: %w", err)
+	}
+	return nil
+}
+
+// AddOutboundValueTransfers sets the sender and receiver of the outbound value transfers, which the batch only contains
+// as hashes
+func AddOutboundValueTransfers(dbtx *dbTransaction, statements *SQLStatements, transfers common.ValueTransferEvents) error {
+	update := fmt.Sprintf("UPDATE cross_chain_message_host SET sender=%s, receiver=%s WHERE direction=%s AND message_hash=%s",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4))
+	for _, transfer := range transfers {
+		_, err := dbtx.tx.Exec(update,
+			transfer.Sender.Bytes(),
+			transfer.Receiver.Bytes(),
+			string(common.CrossChainOutbound),
+			common.HashValueTransfer(transfer).Bytes(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add outbound value transfer. cause: %w", err)
+		}
+	}
+	return nil
+}
+
+// executeInboundCrossChainMessages marks the observed inbound messages up to the L1 height processed by the batch as executed
+func executeInboundCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, batch *common.ExtBatch) error {
+	if batch.Header.LatestInboundCrossChainHeight == nil {
+		return nil
+	}
+	update := fmt.Sprintf("UPDATE cross_chain_message_host SET status=%s, b_sequence=%s WHERE direction=%s AND status=%s AND l1_height <= %s",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4), statements.GetPlaceHolder(5))
+	_, err := dbtx.tx.Exec(update,
+		string(common.CrossChainMessageExecuted),
+		batch.SeqNo().Uint64(),
+		string(common.CrossChainInbound),
+		string(common.CrossChainMessageObserved),
+		batch.Header.LatestInboundCrossChainHeight.Uint64(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark inbound cross chain messages as executed. cause: %w", err)
+	}
+	return nil
+}
+
+// bundleCrossChainMessages marks the messages of the batches included in a rollup as bundled
+func bundleCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, firstSeqNo uint64, lastSeqNo uint64, l1Height uint64) error {
+	update := fmt.Sprintf("UPDATE cross_chain_message_host SET status=%s, final_l1_height=%s WHERE status=%s AND b_sequence BETWEEN %s AND %s",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4), statements.GetPlaceHolder(5))
+	_, err := dbtx.tx.Exec(update,
+		string(common.CrossChainMessageBundled),
+		l1Height,
+		string(common.CrossChainMessageExecuted),
+		firstSeqNo,
+		lastSeqNo,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark cross chain messages as bundled. cause: %w", err)
+	}
+	return nil
+}
+
+// PublishCrossChainMessages marks the outbound messages whose batch root was included in a cross chain bundle as published
+func PublishCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, roots common.CrossChainRootHashes, l1Height uint64) error {
+	if len(roots) == 0 {
+		return nil
+	}
+	args := []any{
+		string(common.CrossChainMessagePublished),
+		l1Height,
+		string(common.CrossChainOutbound),
+		string(common.CrossChainMessageExecuted),
+		string(common.CrossChainMessageBundled),
+	}
+	rootPlaceholders := make([]string, len(roots))
+	for i, root := range roots {
+		rootPlaceholders[i] = statements.GetPlaceHolder(len(args) + 1)
+		args = append(args, gethcommon.BytesToHash(root).Bytes())
+	}
+	update := fmt.Sprintf("UPDATE cross_chain_message_host SET status=%s, final_l1_height=%s WHERE direction=%s AND status IN (%s, %s) AND xchain_root IN (%s)",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4), statements.GetPlaceHolder(5),
+		strings.Join(rootPlaceholders, ", "))
+	_, err := dbtx.tx.Exec(update, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark cross chain messages as published. cause: %w", err)
+	}
+	return nil
+}
+
+// FinaliseCrossChainMessages marks the messages bundled (inbound) or published (outbound) at or below the given L1 height as finalised
+func FinaliseCrossChainMessages(dbtx *dbTransaction, statements *SQLStatements, finalisedL1Height uint64) error {
+	update := fmt.Sprintf("UPDATE cross_chain_message_host SET status=%s WHERE ((direction=%s AND status=%s) OR (direction=%s AND status=%s)) AND final_l1_height <= %s",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4), statements.GetPlaceHolder(5), statements.GetPlaceHolder(6))
+	_, err := dbtx.tx.Exec(update,
+		string(common.CrossChainMessageFinalised),
+		string(common.CrossChainInbound),
+		string(common.CrossChainMessageBundled),
+		string(common.CrossChainOutbound),
+		string(common.CrossChainMessagePublished),
+		finalisedL1Height,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark cross chain messages as finalised. cause: %w", err)
+	}
+	return nil
+}
+
+// GetBatchByCrossChainMessage returns the batch whose cross chain tree contains the given message or value transfer hash.
+func GetBatchByCrossChainMessage(db HostDB, messageHash gethcommon.Hash) (*common.ExtBatch, error) {
+	var seqNo uint64
+	query := selectBatchSeqByXChainMessage + db.GetSQLStatement().Placeholder
+	err := db.GetSQLDB().QueryRow(query, messageHash.Bytes()).Scan(&seqNo)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to execute query %s - %w", query, err)
+	}
+	return GetBatchBySequenceNumber(db, seqNo)
+}
+
+// GetCrossChainMessage returns the latest indexed message with the given hash, without its participants
+func GetCrossChainMessage(db HostDB, messageHash gethcommon.Hash) (*common.PublicCrossChainMessage, error) {
+	query := selectCrossChainMessages + " WHERE message_hash=" + db.GetSQLStatement().Placeholder + " ORDER BY id DESC"
+	messages, err := fetchCrossChainMessages(db.GetSQLDB(), query, messageHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return &messages[0].PublicCrossChainMessage, nil
+}
+
+// GetCrossChainMessageListing returns the latest indexed messages, without their participants
+func GetCrossChainMessageListing(db HostDB, pagination *common.QueryPagination) (*common.CrossChainMessageListingResponse, error) {
+	query := selectCrossChainMessages + " ORDER BY id DESC " + db.GetSQLStatement().Pagination
+	messages, err := fetchCrossChainMessages(db.GetSQLDB(), query, pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var total uint64
+	err = db.GetSQLDB().QueryRow(selectCrossChainMessageCount).Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cross chain messages - %w", err)
+	}
+
+	publicMessages := make([]common.PublicCrossChainMessage, len(messages))
+	for i, msg := range messages {
+		publicMessages[i] = msg.PublicCrossChainMessage
+	}
+	return &common.CrossChainMessageListingResponse{
+		MessagesData: publicMessages,
+		Total:        total,
+	}, nil
+}
+
+// GetCrossChainMessagesByAddress returns the latest indexed messages sent or received by the address
+func GetCrossChainMessagesByAddress(db HostDB, address gethcommon.Address, pagination *common.QueryPagination) (*common.PersonalCrossChainMessageListingResponse, error) {
+	statements := db.GetSQLStatement()
+	whereQuery := fmt.Sprintf(" WHERE sender=%s OR receiver=%s", statements.GetPlaceHolder(1), statements.GetPlaceHolder(2))
+	query := fmt.Sprintf("%s%s ORDER BY id DESC LIMIT %s OFFSET %s", selectCrossChainMessages, whereQuery, statements.GetPlaceHolder(3), statements.GetPlaceHolder(4))
+	messages, err := fetchCrossChainMessages(db.GetSQLDB(), query, address.Bytes(), address.Bytes(), pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var total uint64
+	err = db.GetSQLDB().QueryRow(selectCrossChainMessageCount+whereQuery, address.Bytes(), address.Bytes()).Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cross chain messages - %w", err)
+	}
+
+	return &common.PersonalCrossChainMessageListingResponse{
+		MessagesData: messages,
+		Total:        total,
+	}, nil
+}
+
+func fetchCrossChainMessages(db *sql.DB, query string, args ...any) ([]common.PersonalCrossChainMessage, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query %s - %w", query, err)
+	}
+	defer rows.Close()
+
+	messages := make([]common.PersonalCrossChainMessage, 0)
+	for rows.Next() {
+		var hash, sender, receiver []byte
+		var direction, leafType, status string
+		var l1Height uint64
+		var seqNo sql.NullInt64
+		if err := rows.Scan(&hash, &direction, &leafType, &status, &sender, &receiver, &l1Height, &seqNo); err != nil {
+			return nil, fmt.Errorf("failed to scan query %s - %w", query, err)
+		}
+
+		msg := common.PersonalCrossChainMessage{
+			PublicCrossChainMessage: common.PublicCrossChainMessage{
+				MessageHash: gethcommon.BytesToHash(hash),
+				Direction:   common.CrossChainDirection(direction),
+				LeafType:    leafType,
+				Status:      common.CrossChainMessageStatus(status),
+				L1Height:    l1Height,
+			},
+			Sender:   bytesToAddress(sender),
+			Receiver: bytesToAddress(receiver),
+		}
+		if seqNo.Valid {
+			msg.BatchSeqNo = big.NewInt(seqNo.Int64)
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rows - %w", err)
+	}
+	return messages, nil
+}
+
+func addressBytes(address *gethcommon.Address) []byte {
+	if address == nil {
+		return nil
+	}
+	return address.Bytes()
+}
+
+func bytesToAddress(b []byte) *gethcommon.Address {
+	if len(b) == 0 {
+		return nil
+	}
+	address := gethcommon.BytesToAddress(b)
+	return &address
+}
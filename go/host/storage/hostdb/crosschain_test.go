@@ -0,0 +1,230 @@
+package hostdb
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanRetrieveBatchByCrossChainMessage(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	transferHash := gethcommon.BytesToHash([]byte("valueTransfer"))
+	messageHash := gethcommon.BytesToHash([]byte("message"))
+	batch := createCrossChainBatch(t, batchNumber, 0, transferHash, messageHash)
+
+	dbtx, _ := db.NewDBTransaction()
+	err := AddBatch(dbtx, db.GetSQLStatement(), &batch)
+	if err != nil {
+		t.Errorf("could not store batch. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	extBatch, err := GetBatchByCrossChainMessage(db, messageHash)
+	if err != nil {
+		t.Fatalf("stored batch but could not retrieve batch by cross chain message. Cause: %s", err)
+	}
+	if extBatch.Header.Number.Cmp(batch.Header.Number) != 0 {
+		t.Errorf("batch was not stored correctly against cross chain message hash")
+	}
+
+	_, leafType, proof, err := common.CrossChainTreeProof(extBatch.Header.CrossChainTree, messageHash)
+	if err != nil {
+		t.Errorf("could not build proof for stored cross chain message. Cause: %s", err)
+	}
+	if leafType != "m" || len(proof) != 1 {
+		t.Errorf("unexpected proof for cross chain message")
+	}
+
+	_, err = GetBatchByCrossChainMessage(db, gethcommon.BytesToHash([]byte("unknown")))
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store cross chain message but was able to retrieve its batch")
+	}
+}
+
+func TestInboundCrossChainMessageLifecycle(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	sender := gethcommon.BytesToAddress([]byte("sender"))
+	receiver := gethcommon.BytesToAddress([]byte("receiver"))
+	transferHash := gethcommon.BytesToHash([]byte("inboundTransfer"))
+
+	dbtx, _ := db.NewDBTransaction()
+	err := AddInboundCrossChainMessages(dbtx, db.GetSQLStatement(), []common.CrossChainMessageRecord{{
+		MessageHash: transferHash,
+		Direction:   common.CrossChainInbound,
+		LeafType:    "v",
+		Sender:      &sender,
+		Receiver:    &receiver,
+		L1Height:    10,
+	}})
+	if err != nil {
+		t.Fatalf("could not store inbound messages. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, transferHash, common.CrossChainMessageObserved)
+
+	// a batch that has not yet processed the L1 block leaves the message untouched
+	dbtx, _ = db.NewDBTransaction()
+	batchOne := createCrossChainBatch(t, batchNumber, 9)
+	if err = AddBatch(dbtx, db.GetSQLStatement(), &batchOne); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	batchTwo := createCrossChainBatch(t, batchNumber+1, 10)
+	if err = AddBatch(dbtx, db.GetSQLStatement(), &batchTwo); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	dbtx.Write()
+	msg := assertCrossChainStatus(t, db, transferHash, common.CrossChainMessageExecuted)
+	if msg.BatchSeqNo.Cmp(batchTwo.Header.SequencerOrderNo) != 0 {
+		t.Errorf("inbound message was executed in the wrong batch")
+	}
+
+	block := types.NewBlock(&types.Header{Number: big.NewInt(20)}, nil, nil, nil)
+	rollup := createRollup(batchNumber + 1)
+	metadata := createRollupMetadata(batchNumber)
+	dbtx, _ = db.NewDBTransaction()
+	if err = AddBlock(dbtx, db.GetSQLStatement(), block.Header()); err != nil {
+		t.Fatalf("could not store block. Cause: %s", err)
+	}
+	if err = AddRollup(dbtx, db.GetSQLStatement(), &rollup, &metadata, block); err != nil {
+		t.Fatalf("could not store rollup. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, transferHash, common.CrossChainMessageBundled)
+
+	dbtx, _ = db.NewDBTransaction()
+	if err = FinaliseCrossChainMessages(dbtx, db.GetSQLStatement(), 19); err != nil {
+		t.Fatalf("could not finalise messages. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, transferHash, common.CrossChainMessageBundled)
+
+	dbtx, _ = db.NewDBTransaction()
+	if err = FinaliseCrossChainMessages(dbtx, db.GetSQLStatement(), 20); err != nil {
+		t.Fatalf("could not finalise messages. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, transferHash, common.CrossChainMessageFinalised)
+
+	personal, err := GetCrossChainMessagesByAddress(db, receiver, &common.QueryPagination{Offset: 0, Size: 10})
+	if err != nil {
+		t.Fatalf("could not retrieve messages by address. Cause: %s", err)
+	}
+	if personal.Total != 1 || *personal.MessagesData[0].Sender != sender {
+		t.Errorf("personal cross chain messages were not retrieved correctly")
+	}
+}
+
+func TestOutboundCrossChainMessageLifecycle(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	messageHash := gethcommon.BytesToHash([]byte("outboundMessage"))
+	batch := createCrossChainBatch(t, batchNumber, 0, messageHash)
+
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddBatch(dbtx, db.GetSQLStatement(), &batch); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, messageHash, common.CrossChainMessageExecuted)
+
+	dbtx, _ = db.NewDBTransaction()
+	otherRoot := gethcommon.BytesToHash([]byte("otherRoot"))
+	if err := PublishCrossChainMessages(dbtx, db.GetSQLStatement(), common.CrossChainRootHashes{otherRoot.Bytes()}, 30); err != nil {
+		t.Fatalf("could not publish messages. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, messageHash, common.CrossChainMessageExecuted)
+
+	dbtx, _ = db.NewDBTransaction()
+	if err := PublishCrossChainMessages(dbtx, db.GetSQLStatement(), common.CrossChainRootHashes{batch.Header.CrossChainRoot.Bytes()}, 30); err != nil {
+		t.Fatalf("could not publish messages. Cause: %s", err)
+	}
+	if err := FinaliseCrossChainMessages(dbtx, db.GetSQLStatement(), 30); err != nil {
+		t.Fatalf("could not finalise messages. Cause: %s", err)
+	}
+	dbtx.Write()
+	assertCrossChainStatus(t, db, messageHash, common.CrossChainMessageFinalised)
+
+	listing, err := GetCrossChainMessageListing(db, &common.QueryPagination{Offset: 0, Size: 10})
+	if err != nil {
+		t.Fatalf("could not retrieve cross chain message listing. Cause: %s", err)
+	}
+	if listing.Total != 1 || listing.MessagesData[0].Direction != common.CrossChainOutbound {
+		t.Errorf("cross chain message listing was not retrieved correctly")
+	}
+}
+
+func TestOutboundValueTransferIsIndexedByParticipants(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	transfer := common.ValueTransferEvent{
+		Sender:   gethcommon.BytesToAddress([]byte("sender")),
+		Receiver: gethcommon.BytesToAddress([]byte("receiver")),
+		Amount:   big.NewInt(100),
+		Sequence: 1,
+	}
+	messageHash := gethcommon.BytesToHash([]byte("outboundMessage"))
+	batch := createCrossChainBatch(t, batchNumber, 0, common.HashValueTransfer(transfer), messageHash)
+
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddBatch(dbtx, db.GetSQLStatement(), &batch); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	if err := AddOutboundValueTransfers(dbtx, db.GetSQLStatement(), common.ValueTransferEvents{transfer}); err != nil {
+		t.Fatalf("could not store value transfers. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	for _, participant := range []gethcommon.Address{transfer.Sender, transfer.Receiver} {
+		listing, err := GetCrossChainMessagesByAddress(db, participant, &common.QueryPagination{Offset: 0, Size: 10})
+		if err != nil {
+			t.Fatalf("could not retrieve cross chain messages by address. Cause: %s", err)
+		}
+		if listing.Total != 1 || listing.MessagesData[0].LeafType != "v" {
+			t.Fatalf("expected the value transfer for %s", participant)
+		}
+		msg := listing.MessagesData[0]
+		if *msg.Sender != transfer.Sender || *msg.Receiver != transfer.Receiver {
+			t.Errorf("value transfer participants were not stored correctly")
+		}
+	}
+}
+
+func assertCrossChainStatus(t *testing.T, db HostDB, messageHash gethcommon.Hash, expected common.CrossChainMessageStatus) *common.PublicCrossChainMessage {
+	msg, err := GetCrossChainMessage(db, messageHash)
+	if err != nil {
+		t.Fatalf("could not retrieve cross chain message. Cause: %s", err)
+	}
+	if msg.Status != expected {
+		t.Errorf("expected cross chain message status %s, got %s", expected, msg.Status)
+	}
+	return msg
+}
+
+func createCrossChainBatch(t *testing.T, batchNum int64, l1Height int64, outboundHashes ...gethcommon.Hash) common.ExtBatch {
+	batch := createBatch(batchNum, []common.L2TxHash{})
+	batch.Header.LatestInboundCrossChainHeight = big.NewInt(l1Height)
+	if len(outboundHashes) == 0 {
+		return batch
+	}
+
+	leaves := make([][]interface{}, 0)
+	for i, hash := range outboundHashes {
+		leafType := "m"
+		if i == 0 && len(outboundHashes) > 1 {
+			leafType = "v"
+		}
+		leaves = append(leaves, []interface{}{leafType, hash})
+	}
+	tree, err := json.Marshal(leaves)
+	if err != nil {
+		t.Fatalf("could not encode cross chain tree. Cause: %s", err)
+	}
+	batch.Header.CrossChainTree = tree
+	batch.Header.CrossChainRoot = gethcommon.BytesToHash([]byte("root"))
+	return batch
+}
@@ -0,0 +1,100 @@
+package hostdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectL1Txs          = "SELECT nonce, tx_key, status, retries, signed_tx, tx_hashes FROM l1_tx_host"
+	deleteL1TxsBefore    = "DELETE FROM l1_tx_host WHERE status <> '" + string(common.L1TxPending) + "' AND nonce < "
+	selectPendingL1Txs   = selectL1Txs + " WHERE status = '" + string(common.L1TxPending) + "' ORDER BY nonce"
+	selectL1TxByKeyWhere = " WHERE tx_key = "
+)
+
+// UpsertL1Tx stores the state of the L1 transaction with the record nonce, replacing any previous state for that nonce
+func UpsertL1Tx(dbtx *dbTransaction, statements *SQLStatements, record *common.L1TxRecord) error {
+	hashes := make([]byte, 0, len(record.TxHashes)*gethcommon.HashLength)
+	for _, h := range record.TxHashes {
+		hashes = append(hashes, h.Bytes()...)
+	}
+	_, err := dbtx.tx.Exec(statements.UpsertL1Tx,
+		record.Nonce,          // nonce
+		record.Key,            // key
+		string(record.Status), // status
+		record.Retries,        // retries
+		record.SignedTx,       // latest signed attempt
+		hashes,                // hashes of all attempts
+	)
+	if err != nil {
+		return fmt.Errorf("could not store L1 tx with nonce %d. Cause: %w", record.Nonce, err)
+	}
+	return nil
+}
+
+// DeleteL1TxsBefore removes the completed L1 transactions with a nonce lower than the given one
+func DeleteL1TxsBefore(dbtx *dbTransaction, statements *SQLStatements, nonce uint64) error {
+	_, err := dbtx.tx.Exec(deleteL1TxsBefore+statements.GetPlaceHolder(1), nonce)
+	if err != nil {
+		return fmt.Errorf("could not delete L1 txs. Cause: %w", err)
+	}
+	return nil
+}
+
+// GetPendingL1Txs returns the L1 transactions that are still waiting for a receipt, in nonce order
+func GetPendingL1Txs(db HostDB) ([]*common.L1TxRecord, error) {
+	rows, err := db.GetSQLDB().Query(selectPendingL1Txs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending L1 txs. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*common.L1TxRecord
+	for rows.Next() {
+		record, err := scanL1Tx(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// GetL1TxByKey returns the most recent L1 transaction issued with the given key
+func GetL1TxByKey(db HostDB, key string) (*common.L1TxRecord, error) {
+	query := selectL1Txs + selectL1TxByKeyWhere + db.GetSQLStatement().Placeholder + " ORDER BY nonce DESC LIMIT 1"
+	record, err := scanL1Tx(db.GetSQLDB().QueryRow(query, key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanL1Tx(row rowScanner) (*common.L1TxRecord, error) {
+	var status string
+	var hashes []byte
+	record := &common.L1TxRecord{}
+	if err := row.Scan(&record.Nonce, &record.Key, &status, &record.Retries, &record.SignedTx, &hashes); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read L1 tx. Cause: %w", err)
+	}
+	record.Status = common.L1TxStatus(status)
+	for i := 0; i+gethcommon.HashLength <= len(hashes); i += gethcommon.HashLength {
+		record.TxHashes = append(record.TxHashes, gethcommon.BytesToHash(hashes[i:i+gethcommon.HashLength]))
+	}
+	return record, nil
+}
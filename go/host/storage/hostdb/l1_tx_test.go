@@ -0,0 +1,77 @@
+package hostdb
+
+import (
+	"errors"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanStoreAndRetrieveL1Txs(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	pending := &common.L1TxRecord{Nonce: 2, Key: "rollup", Status: common.L1TxPending, SignedTx: []byte{1}, TxHashes: []gethcommon.Hash{{1}}}
+	confirmed := &common.L1TxRecord{Nonce: 1, Key: "bundle", Status: common.L1TxConfirmed, SignedTx: []byte{2}, TxHashes: []gethcommon.Hash{{2}}}
+
+	dbtx, _ := db.NewDBTransaction()
+	for _, record := range []*common.L1TxRecord{pending, confirmed} {
+		if err := UpsertL1Tx(dbtx, db.GetSQLStatement(), record); err != nil {
+			t.Fatalf("could not store L1 tx. Cause: %s", err)
+		}
+	}
+	dbtx.Write()
+
+	// a later attempt replaces the state stored for the nonce
+	pending.Retries = 1
+	pending.TxHashes = append(pending.TxHashes, gethcommon.Hash{3})
+	dbtx, _ = db.NewDBTransaction()
+	if err := UpsertL1Tx(dbtx, db.GetSQLStatement(), pending); err != nil {
+		t.Fatalf("could not update L1 tx. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	records, err := GetPendingL1Txs(db)
+	if err != nil {
+		t.Fatalf("could not retrieve pending L1 txs. Cause: %s", err)
+	}
+	if len(records) != 1 || records[0].Retries != 1 || len(records[0].TxHashes) != 2 || records[0].TxHashes[1] != (gethcommon.Hash{3}) {
+		t.Errorf("pending L1 tx was not stored correctly")
+	}
+
+	record, err := GetL1TxByKey(db, "bundle")
+	if err != nil {
+		t.Fatalf("could not retrieve L1 tx by key. Cause: %s", err)
+	}
+	if record.Nonce != 1 || record.Status != common.L1TxConfirmed {
+		t.Errorf("L1 tx was not stored correctly")
+	}
+}
+
+func TestCanPruneCompletedL1Txs(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	dbtx, _ := db.NewDBTransaction()
+	for nonce, status := range []common.L1TxStatus{common.L1TxConfirmed, common.L1TxPending, common.L1TxFailed} {
+		record := &common.L1TxRecord{Nonce: uint64(nonce), Key: string(status), Status: status, SignedTx: []byte{1}}
+		if err := UpsertL1Tx(dbtx, db.GetSQLStatement(), record); err != nil {
+			t.Fatalf("could not store L1 tx. Cause: %s", err)
+		}
+	}
+	dbtx.Write()
+
+	dbtx, _ = db.NewDBTransaction()
+	if err := DeleteL1TxsBefore(dbtx, db.GetSQLStatement(), 2); err != nil {
+		t.Fatalf("could not prune L1 txs. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	if _, err := GetL1TxByKey(db, string(common.L1TxConfirmed)); !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("completed L1 tx was not pruned")
+	}
+	if _, err := GetL1TxByKey(db, string(common.L1TxPending)); err != nil {
+		t.Errorf("pending L1 tx was pruned. Cause: %s", err)
+	}
+	if _, err := GetL1TxByKey(db, string(common.L1TxFailed)); err != nil {
+		t.Errorf("L1 tx after the pruned nonce was pruned. Cause: %s", err)
+	}
+}
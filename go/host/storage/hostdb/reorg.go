@@ -0,0 +1,245 @@
+package hostdb
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	selectL1Forks           = "SELECT new_head, new_height, old_head, common_ancestor, ancestor_height, canonical_path, non_canonical_path FROM l1_fork_host ORDER BY id DESC "
+	selectL1ForkCount       = "SELECT COUNT(*) FROM l1_fork_host"
+	insertReorgedBatch      = "INSERT INTO reorged_batch_host (sequence, hash, height, reorged_at) VALUES "
+	selectReorgedBatchCount = "SELECT COUNT(*) FROM reorged_batch_host"
+	// the batches stored before the new one at its height or above are the ones it forked off the chain
+	selectForkedBatches  = "SELECT sequence, hash, height FROM batch_host WHERE sequence NOT IN (SELECT sequence FROM reorged_batch_host) AND height >= "
+	replaceReorgedBatch  = "UPDATE reorged_batch_host SET replaced_by = "
+	selectReorgedBatches = "SELECT r.sequence, r.hash, r.height, r.replaced_by, n.hash, r.reorged_at FROM reorged_batch_host r LEFT JOIN batch_host n ON n.sequence = r.replaced_by ORDER BY r.sequence DESC "
+	// each transaction of a reorged batch, with the first canonical batch that included it again
+	selectMovedTxs = "SELECT t.hash, r.sequence, r.hash, MIN(n.b_sequence) FROM reorged_batch_host r JOIN transaction_host t ON t.b_sequence = r.sequence " +
+		"LEFT JOIN transaction_host n ON n.hash = t.hash AND n.b_sequence > r.sequence AND n.b_sequence NOT IN (SELECT sequence FROM reorged_batch_host) " +
+		"GROUP BY t.id, t.hash, r.sequence, r.hash ORDER BY r.sequence DESC, t.id "
+	selectMovedTxCount   = "SELECT COUNT(*) FROM reorged_batch_host r JOIN transaction_host t ON t.b_sequence = r.sequence"
+	selectBatchHashBySeq = "SELECT hash FROM batch_host WHERE sequence = "
+)
+
+// AddL1Fork records an L1 reorg reported by the enclave. Every enclave of the host reports the same fork, so a fork
+// that is already recorded is ignored.
+func AddL1Fork(dbtx *dbTransaction, statements *SQLStatements, fork *common.L1Fork) error {
+	canonicalPath, err := rlp.EncodeToBytes(fork.CanonicalPath)
+	if err != nil {
+		return fmt.Errorf("could not encode canonical path. Cause: %w", err)
+	}
+	nonCanonicalPath, err := rlp.EncodeToBytes(fork.NonCanonicalPath)
+	if err != nil {
+		return fmt.Errorf("could not encode non-canonical path. Cause: %w", err)
+	}
+
+	insert := statements.InsertL1Fork + "("
+	for i := 1; i <= 7; i++ {
+		insert += statements.GetPlaceHolder(i) + ","
+	}
+	insert = insert[:len(insert)-1] + ")" + statements.OnConflictIgnore
+	_, err = dbtx.tx.Exec(insert,
+		fork.NewHead.Bytes(),
+		fork.NewHeight,
+		fork.OldHead.Bytes(),
+		fork.CommonAncestor.Bytes(),
+		fork.CommonAncestorHeight,
+		canonicalPath,
+		nonCanonicalPath,
+	)
+	if err != nil {
+		return fmt.Errorf("could not insert L1 fork. Cause: %w", err)
+	}
+	return nil
+}
+
+// markReorgedBatches records the batches made non-canonical by the new batch. The sequencer re-creates the batches of
+// an L1 fork at the heights they had, so any batch stored earlier at the height of the new batch or above was forked
+// off the chain. The batch it replaces at its own height is linked to it, and the ones above will be linked to their
+// replacements as they arrive.
+func markReorgedBatches(dbtx *dbTransaction, statements *SQLStatements, batch *common.ExtBatch) error {
+	seqNo := batch.SeqNo().Uint64()
+	height := batch.Header.Number.Uint64()
+
+	query := selectForkedBatches + statements.GetPlaceHolder(1) + " AND sequence < " + statements.GetPlaceHolder(2)
+	rows, err := dbtx.tx.Query(query, height, seqNo)
+	if err != nil {
+		return fmt.Errorf("could not query forked batches. Cause: %w", err)
+	}
+	type forkedBatch struct {
+		seqNo  uint64
+		hash   []byte
+		height uint64
+	}
+	var forked []forkedBatch
+	for rows.Next() {
+		var b forkedBatch
+		if err = rows.Scan(&b.seqNo, &b.hash, &b.height); err != nil {
+			rows.Close()
+			return fmt.Errorf("could not scan forked batch. Cause: %w", err)
+		}
+		forked = append(forked, b)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("could not read forked batches. Cause: %w", err)
+	}
+
+	insert := insertReorgedBatch + fmt.Sprintf("(%s, %s, %s, %s)",
+		statements.GetPlaceHolder(1), statements.GetPlaceHolder(2), statements.GetPlaceHolder(3), statements.GetPlaceHolder(4))
+	for _, b := range forked {
+		if _, err = dbtx.tx.Exec(insert, b.seqNo, b.hash, b.height, seqNo); err != nil {
+			return fmt.Errorf("could not insert reorged batch %d. Cause: %w", b.seqNo, err)
+		}
+	}
+
+	update := replaceReorgedBatch + statements.GetPlaceHolder(1) + " WHERE replaced_by IS NULL AND height = " + statements.GetPlaceHolder(2)
+	if _, err = dbtx.tx.Exec(update, seqNo, height); err != nil {
+		return fmt.Errorf("could not link reorged batches to batch %d. Cause: %w", seqNo, err)
+	}
+	return nil
+}
+
+// GetL1ForkListing returns the latest L1 forks
+func GetL1ForkListing(db HostDB, pagination *common.QueryPagination) (*common.L1ForkListingResponse, error) {
+	rows, err := db.GetSQLDB().Query(selectL1Forks+db.GetSQLStatement().Pagination, pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query L1 forks - %w", err)
+	}
+	defer rows.Close()
+
+	forks := make([]common.L1Fork, 0)
+	for rows.Next() {
+		var newHead, oldHead, ancestor, canonicalPath, nonCanonicalPath []byte
+		var fork common.L1Fork
+		err = rows.Scan(&newHead, &fork.NewHeight, &oldHead, &ancestor, &fork.CommonAncestorHeight, &canonicalPath, &nonCanonicalPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan L1 fork - %w", err)
+		}
+		fork.NewHead = gethcommon.BytesToHash(newHead)
+		fork.OldHead = gethcommon.BytesToHash(oldHead)
+		fork.CommonAncestor = gethcommon.BytesToHash(ancestor)
+		if err = rlp.DecodeBytes(canonicalPath, &fork.CanonicalPath); err != nil {
+			return nil, fmt.Errorf("could not decode canonical path - %w", err)
+		}
+		if err = rlp.DecodeBytes(nonCanonicalPath, &fork.NonCanonicalPath); err != nil {
+			return nil, fmt.Errorf("could not decode non-canonical path - %w", err)
+		}
+		forks = append(forks, fork)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read L1 forks - %w", err)
+	}
+
+	total, err := countRows(db, selectL1ForkCount)
+	if err != nil {
+		return nil, err
+	}
+	return &common.L1ForkListingResponse{ForksData: forks, Total: total}, nil
+}
+
+// GetReorgedBatchListing returns the latest batches that are no longer canonical
+func GetReorgedBatchListing(db HostDB, pagination *common.QueryPagination) (*common.ReorgedBatchListingResponse, error) {
+	rows, err := db.GetSQLDB().Query(selectReorgedBatches+db.GetSQLStatement().Pagination, pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reorged batches - %w", err)
+	}
+	defer rows.Close()
+
+	batches := make([]common.ReorgedBatch, 0)
+	for rows.Next() {
+		var seqNo, height, reorgedAt uint64
+		var replacedBy sql.NullInt64
+		var hash, replacedByHash []byte
+		if err = rows.Scan(&seqNo, &hash, &height, &replacedBy, &replacedByHash, &reorgedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reorged batch - %w", err)
+		}
+		batch := common.ReorgedBatch{
+			SequencerOrderNo:  new(big.Int).SetUint64(seqNo),
+			FullHash:          gethcommon.BytesToHash(hash),
+			Height:            new(big.Int).SetUint64(height),
+			ReorgedAtBatchSeq: new(big.Int).SetUint64(reorgedAt),
+		}
+		if replacedBy.Valid {
+			batch.ReplacedBySeqNo = big.NewInt(replacedBy.Int64)
+			h := gethcommon.BytesToHash(replacedByHash)
+			batch.ReplacedByHash = &h
+		}
+		batches = append(batches, batch)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read reorged batches - %w", err)
+	}
+
+	total, err := countRows(db, selectReorgedBatchCount)
+	if err != nil {
+		return nil, err
+	}
+	return &common.ReorgedBatchListingResponse{BatchesData: batches, Total: total}, nil
+}
+
+// GetMovedTransactionListing returns the transactions of the latest reorged batches, with the canonical batch that
+// included each of them again
+func GetMovedTransactionListing(db HostDB, pagination *common.QueryPagination) (*common.MovedTransactionListingResponse, error) {
+	rows, err := db.GetSQLDB().Query(selectMovedTxs+db.GetSQLStatement().Pagination, pagination.Size, pagination.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query moved transactions - %w", err)
+	}
+
+	txs := make([]common.MovedTransaction, 0)
+	for rows.Next() {
+		var txHash, oldBatchHash []byte
+		var oldSeqNo uint64
+		var newSeqNo sql.NullInt64
+		if err = rows.Scan(&txHash, &oldSeqNo, &oldBatchHash, &newSeqNo); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan moved transaction - %w", err)
+		}
+		tx := common.MovedTransaction{
+			TransactionHash: gethcommon.BytesToHash(txHash),
+			OldBatchSeqNo:   new(big.Int).SetUint64(oldSeqNo),
+			OldBatchHash:    gethcommon.BytesToHash(oldBatchHash),
+		}
+		if newSeqNo.Valid {
+			tx.NewBatchSeqNo = big.NewInt(newSeqNo.Int64)
+		}
+		txs = append(txs, tx)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read moved transactions - %w", err)
+	}
+
+	for i := range txs {
+		if txs[i].NewBatchSeqNo == nil {
+			continue
+		}
+		var hash []byte
+		err = db.GetSQLDB().QueryRow(selectBatchHashBySeq+db.GetSQLStatement().Placeholder, txs[i].NewBatchSeqNo.Uint64()).Scan(&hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch hash of batch %d - %w", txs[i].NewBatchSeqNo, err)
+		}
+		h := gethcommon.BytesToHash(hash)
+		txs[i].NewBatchHash = &h
+	}
+
+	total, err := countRows(db, selectMovedTxCount)
+	if err != nil {
+		return nil, err
+	}
+	return &common.MovedTransactionListingResponse{TransactionsData: txs, Total: total}, nil
+}
+
+func countRows(db HostDB, query string) (uint64, error) {
+	var total uint64
+	if err := db.GetSQLDB().QueryRow(query).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to execute count query %s - %w", query, err)
+	}
+	return total, nil
+}
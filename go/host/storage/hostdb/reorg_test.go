@@ -0,0 +1,189 @@
+package hostdb
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanStoreAndRetrieveL1Forks(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	fork := &common.L1Fork{
+		NewHead:              gethcommon.Hash{1},
+		NewHeight:            12,
+		OldHead:              gethcommon.Hash{2},
+		CommonAncestor:       gethcommon.Hash{3},
+		CommonAncestorHeight: 10,
+		CanonicalPath:        []gethcommon.Hash{{4}, {1}},
+		NonCanonicalPath:     []gethcommon.Hash{{5}, {2}},
+	}
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddL1Fork(dbtx, db.GetSQLStatement(), fork); err != nil {
+		t.Fatalf("could not store L1 fork. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	listing, err := GetL1ForkListing(db, &common.QueryPagination{Offset: 0, Size: 10})
+	if err != nil {
+		t.Fatalf("could not retrieve L1 forks. Cause: %s", err)
+	}
+	if listing.Total != 1 || len(listing.ForksData) != 1 {
+		t.Fatalf("expected one L1 fork, got %d", listing.Total)
+	}
+	stored := listing.ForksData[0]
+	if stored.NewHead != fork.NewHead || stored.CommonAncestorHeight != 10 || len(stored.NonCanonicalPath) != 2 || stored.NonCanonicalPath[1] != fork.OldHead {
+		t.Errorf("L1 fork was not stored correctly")
+	}
+}
+
+func TestL1ForkReportedAgainIsIgnored(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	fork := &common.L1Fork{
+		NewHead:              gethcommon.Hash{1},
+		NewHeight:            12,
+		OldHead:              gethcommon.Hash{2},
+		CommonAncestor:       gethcommon.Hash{3},
+		CommonAncestorHeight: 10,
+		CanonicalPath:        []gethcommon.Hash{{4}, {1}},
+		NonCanonicalPath:     []gethcommon.Hash{{5}, {2}},
+	}
+	// each enclave of the host reports the fork
+	for i := 0; i < 2; i++ {
+		dbtx, _ := db.NewDBTransaction()
+		if err := AddL1Fork(dbtx, db.GetSQLStatement(), fork); err != nil {
+			t.Fatalf("could not store L1 fork. Cause: %s", err)
+		}
+		dbtx.Write()
+	}
+
+	listing, err := GetL1ForkListing(db, &common.QueryPagination{Offset: 0, Size: 10})
+	if err != nil {
+		t.Fatalf("could not retrieve L1 forks. Cause: %s", err)
+	}
+	if listing.Total != 1 || len(listing.ForksData) != 1 {
+		t.Fatalf("expected one L1 fork, got %d", listing.Total)
+	}
+}
+
+func TestBatchesRecreatedAfterL1ForkAreTracked(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	txOne := gethcommon.BytesToHash([]byte("tx1"))
+	txTwo := gethcommon.BytesToHash([]byte("tx2"))
+
+	// the sequencer produces batches at heights 1 to 3, then re-creates the last two after an L1 fork
+	batches := []common.ExtBatch{
+		createForkedBatch(1, 1, nil),
+		createForkedBatch(2, 2, []common.L2TxHash{txOne}),
+		createForkedBatch(3, 3, []common.L2TxHash{txTwo}),
+		createForkedBatch(4, 2, []common.L2TxHash{txOne}),
+	}
+	dbtx, _ := db.NewDBTransaction()
+	for i := range batches {
+		if err := AddBatch(dbtx, db.GetSQLStatement(), &batches[i]); err != nil {
+			t.Fatalf("could not store batch. Cause: %s", err)
+		}
+	}
+	dbtx.Write()
+
+	pagination := &common.QueryPagination{Offset: 0, Size: 10}
+	reorged, err := GetReorgedBatchListing(db, pagination)
+	if err != nil {
+		t.Fatalf("could not retrieve reorged batches. Cause: %s", err)
+	}
+	if reorged.Total != 2 {
+		t.Fatalf("expected 2 reorged batches, got %d", reorged.Total)
+	}
+	// the batch above the fork is not replaced until the sequencer re-creates it
+	if reorged.BatchesData[0].SequencerOrderNo.Uint64() != 3 || reorged.BatchesData[0].ReplacedBySeqNo != nil {
+		t.Errorf("batch 3 was not marked as reorged")
+	}
+	if reorged.BatchesData[1].SequencerOrderNo.Uint64() != 2 || *reorged.BatchesData[1].ReplacedByHash != batches[3].Hash() {
+		t.Errorf("batch 2 was not linked to its replacement")
+	}
+
+	moved, err := GetMovedTransactionListing(db, pagination)
+	if err != nil {
+		t.Fatalf("could not retrieve moved transactions. Cause: %s", err)
+	}
+	if moved.Total != 2 || moved.TransactionsData[0].TransactionHash != txTwo || moved.TransactionsData[0].NewBatchHash != nil {
+		t.Errorf("tx 2 should be pending re-inclusion")
+	}
+	if moved.TransactionsData[1].TransactionHash != txOne || moved.TransactionsData[1].NewBatchSeqNo.Uint64() != 4 {
+		t.Errorf("tx 1 should have moved to batch 4")
+	}
+
+	// the re-created batch at height 3 completes the reorg
+	recreated := createForkedBatch(5, 3, []common.L2TxHash{txTwo})
+	dbtx, _ = db.NewDBTransaction()
+	if err := AddBatch(dbtx, db.GetSQLStatement(), &recreated); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	reorged, _ = GetReorgedBatchListing(db, pagination)
+	if reorged.Total != 2 || reorged.BatchesData[0].ReplacedBySeqNo.Uint64() != 5 {
+		t.Errorf("batch 3 was not linked to its replacement")
+	}
+	moved, _ = GetMovedTransactionListing(db, pagination)
+	if *moved.TransactionsData[0].NewBatchHash != recreated.Hash() {
+		t.Errorf("tx 2 should have moved to batch 5")
+	}
+
+	// lookups by height return the canonical batch
+	batch, err := GetBatchByHeight(db, big.NewInt(2))
+	if err != nil {
+		t.Fatalf("could not retrieve batch by height. Cause: %s", err)
+	}
+	if batch.FullHash != batches[3].Hash() {
+		t.Errorf("expected the re-created batch at height 2")
+	}
+}
+
+func TestTransactionLookupsReturnCanonicalBatch(t *testing.T) {
+	db, _ := createSQLiteDB(t)
+	txOne := gethcommon.BytesToHash([]byte("tx1"))
+	txTwo := gethcommon.BytesToHash([]byte("tx2"))
+
+	// tx 1 is included again after the L1 fork, tx 2 is not included again yet
+	batches := []common.ExtBatch{
+		createForkedBatch(1, 1, nil),
+		createForkedBatch(2, 2, []common.L2TxHash{txOne}),
+		createForkedBatch(3, 3, []common.L2TxHash{txTwo}),
+		createForkedBatch(4, 2, []common.L2TxHash{txOne}),
+	}
+	dbtx, _ := db.NewDBTransaction()
+	for i := range batches {
+		if err := AddBatch(dbtx, db.GetSQLStatement(), &batches[i]); err != nil {
+			t.Fatalf("could not store batch. Cause: %s", err)
+		}
+	}
+	dbtx.Write()
+
+	batch, err := GetBatchByTx(db, txOne)
+	if err != nil {
+		t.Fatalf("could not retrieve batch by tx. Cause: %s", err)
+	}
+	if batch.Hash() != batches[3].Hash() {
+		t.Errorf("expected the batch that included tx 1 again")
+	}
+	if _, err = GetBatchByTx(db, txTwo); !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("expected no batch for a tx only included in a reorged batch, got %v", err)
+	}
+	height, err := GetBatchNumber(db, txOne)
+	if err != nil {
+		t.Fatalf("could not retrieve batch number by tx. Cause: %s", err)
+	}
+	if height.Uint64() != 2 {
+		t.Errorf("expected tx 1 at height 2, got %d", height)
+	}
+}
+
+func createForkedBatch(seqNo int64, height int64, txHashes []common.L2TxHash) common.ExtBatch {
+	batch := createBatch(height, txHashes)
+	batch.Header.SequencerOrderNo = big.NewInt(seqNo)
+	return batch
+}
@@ -19,6 +19,8 @@ const (
 	selectLatestRollupCount = "SELECT id FROM rollup_host ORDER BY id DESC LIMIT 1"
 	selectRollupBatches     = "SELECT b.sequence, b.hash, b.height, b.ext_batch FROM rollup_host r JOIN batch_host b ON r.start_seq <= b.sequence AND r.end_seq >= b.sequence"
 	selectRollups           = "SELECT rh.id, rh.hash, rh.start_seq, rh.end_seq, rh.time_stamp, rh.ext_rollup, bh.hash FROM rollup_host rh join block_host bh on rh.compression_block=bh.id "
+	selectBundleEndSeq      = "SELECT MIN(end_seq) FROM rollup_host WHERE end_seq >= "
+	selectPrevBundleEndSeq  = "SELECT MAX(end_seq) FROM rollup_host WHERE end_seq < "
 )
 
 // AddRollup adds a rollup to the DB
@@ -45,6 +47,11 @@ func AddRollup(dbtx *dbTransaction, statements *SQLStatements, rollup *common.Ex
 	if err != nil {
 		return fmt.Errorf("could not insert rollup. Cause: %w", err)
 	}
+
+	err = bundleCrossChainMessages(dbtx, statements, metadata.FirstBatchSequence.Uint64(), rollup.Header.LastBatchSeqNo, block.NumberU64())
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -139,6 +146,29 @@ func GetRollupBySeqNo(db HostDB, seqNo uint64) (*common.PublicRollup, error) {
 	return fetchPublicRollup(db.GetSQLDB(), whereQuery, seqNo)
 }
 
+// GetCrossChainBundleRange returns the range of batches of the cross chain bundle containing the batch. The management
+// contract delimits the bundles by the last batches of the rollups, so a bundle starts after the last batch of the
+// previous rollup and can span more than one rollup, e.g. when a rollup overlaps the previous one or leaves a gap.
+func GetCrossChainBundleRange(db HostDB, seqNo uint64) (uint64, uint64, error) {
+	var end, prevEnd sql.NullInt64
+	err := db.GetSQLDB().QueryRow(selectBundleEndSeq+db.GetSQLStatement().Placeholder, seqNo).Scan(&end)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch bundle end: %w", err)
+	}
+	if !end.Valid {
+		return 0, 0, errutil.ErrNotFound
+	}
+	err = db.GetSQLDB().QueryRow(selectPrevBundleEndSeq+db.GetSQLStatement().Placeholder, end.Int64).Scan(&prevEnd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch previous bundle end: %w", err)
+	}
+	if !prevEnd.Valid {
+		// the first bundle starts at the genesis batch
+		return common.L2GenesisSeqNo, uint64(end.Int64), nil
+	}
+	return uint64(prevEnd.Int64) + 1, uint64(end.Int64), nil
+}
+
 func GetRollupBatches(db HostDB, rollupHash gethcommon.Hash) (*common.BatchListingResponse, error) {
 	whereQuery := " WHERE r.hash=" + db.GetSQLStatement().Placeholder
 	orderQuery := " ORDER BY b.height DESC"
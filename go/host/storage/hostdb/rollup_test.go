@@ -1,6 +1,7 @@
 package hostdb
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 )
 
 func TestCanStoreAndRetrieveRollup(t *testing.T) {
@@ -424,6 +426,53 @@ func TestGetRollupBatches(t *testing.T) {
 	}
 }
 
+func TestGetCrossChainBundleRange(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+
+	block := types.NewBlock(&types.Header{}, nil, nil, nil)
+	dbtx, _ := db.NewDBTransaction()
+	err = AddBlock(dbtx, db.GetSQLStatement(), block.Header())
+	if err != nil {
+		t.Errorf("could not store block. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	// the third rollup leaves a gap after the second one, and the fourth overlaps the third
+	dbtx, _ = db.NewDBTransaction()
+	for _, seqs := range [][2]int64{{1, 10}, {11, 20}, {25, 30}, {28, 40}} {
+		metadata := createRollupMetadata(seqs[0])
+		rollup := createRollup(seqs[1])
+		err = AddRollup(dbtx, db.GetSQLStatement(), &rollup, &metadata, block)
+		if err != nil {
+			t.Errorf("could not store rollup. Cause: %s", err)
+		}
+	}
+	dbtx.Write()
+
+	for seqNo, expected := range map[uint64][2]uint64{
+		5:  {1, 10},
+		15: {11, 20},
+		22: {21, 30}, // the bundle spans the gap before the third rollup
+		35: {31, 40}, // the bundle starts after the third rollup, not with the fourth
+	} {
+		from, to, err := GetCrossChainBundleRange(db, seqNo)
+		if err != nil {
+			t.Fatalf("could not get the bundle range of batch %d. Cause: %s", seqNo, err)
+		}
+		if from != expected[0] || to != expected[1] {
+			t.Errorf("batch %d: expected bundle range %v, got [%d %d]", seqNo, expected, from, to)
+		}
+	}
+
+	_, _, err = GetCrossChainBundleRange(db, 41)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("expected no bundle for a batch that is not rolled up yet, got %v", err)
+	}
+}
+
 func createRollup(lastBatch int64) common.ExtRollup {
 	header := common.RollupHeader{
 		LastBatchSeqNo: uint64(lastBatch),
@@ -0,0 +1,149 @@
+package hostdb
+
+import (
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	searchTxByHash        = "SELECT t.hash, b.height, b.sequence FROM transaction_host t JOIN batch_host b ON t.b_sequence = b.sequence WHERE t.hash = "
+	searchBatchByHash     = "SELECT hash, height, sequence FROM batch_host WHERE hash = "
+	searchBatchByHeight   = "SELECT hash, height, sequence FROM batch_host WHERE height = "
+	searchBatchBySequence = "SELECT hash, height, sequence FROM batch_host WHERE sequence = "
+	searchRollupByHash    = "SELECT hash, start_seq FROM rollup_host WHERE hash = "
+	searchRollupBySeq     = "SELECT hash, start_seq FROM rollup_host WHERE start_seq <= %s AND end_seq >= %s"
+	searchBlockByHash     = "SELECT hash, header FROM block_host WHERE hash = "
+)
+
+// SearchByHash returns the transactions, batches, rollups and L1 blocks with the given hash
+func SearchByHash(db HostDB, hash gethcommon.Hash) ([]common.SearchResult, error) {
+	placeholder := db.GetSQLStatement().GetPlaceHolder(1)
+	results := make([]common.SearchResult, 0)
+
+	txs, err := searchBatchItems(db, common.SearchResultTransaction, common.MatchedByHash, searchTxByHash+placeholder, hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, txs...)
+
+	batches, err := searchBatchItems(db, common.SearchResultBatch, common.MatchedByHash, searchBatchByHash+placeholder, hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, batches...)
+
+	rollups, err := searchRollups(db, common.MatchedByHash, searchRollupByHash+placeholder, hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, rollups...)
+
+	rows, err := db.GetSQLDB().Query(searchBlockByHash+placeholder, hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not search blocks. Cause: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var blockHash, header []byte
+		if err = rows.Scan(&blockHash, &header); err != nil {
+			return nil, fmt.Errorf("could not scan block. Cause: %w", err)
+		}
+		blockHeader := new(types.Header)
+		if err = rlp.DecodeBytes(header, blockHeader); err != nil {
+			return nil, fmt.Errorf("could not decode block header. Cause: %w", err)
+		}
+		h := gethcommon.BytesToHash(blockHash)
+		results = append(results, common.SearchResult{
+			Type:      common.SearchResultBlock,
+			MatchedBy: common.MatchedByHash,
+			Hash:      &h,
+			Height:    blockHeader.Number,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SearchByNumber returns the batches with the given height or sequence number, and the rollup containing the batch
+// with the given sequence number
+func SearchByNumber(db HostDB, number uint64) ([]common.SearchResult, error) {
+	statements := db.GetSQLStatement()
+	results := make([]common.SearchResult, 0)
+
+	byHeight, err := searchBatchItems(db, common.SearchResultBatch, common.MatchedByHeight, searchBatchByHeight+statements.GetPlaceHolder(1)+" ORDER BY sequence DESC", number)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, byHeight...)
+
+	bySequence, err := searchBatchItems(db, common.SearchResultBatch, common.MatchedBySequence, searchBatchBySequence+statements.GetPlaceHolder(1), number)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, bySequence...)
+
+	rollups, err := searchRollups(db, common.MatchedBySequence, fmt.Sprintf(searchRollupBySeq, statements.GetPlaceHolder(1), statements.GetPlaceHolder(2)), number, number)
+	if err != nil {
+		return nil, err
+	}
+	return append(results, rollups...), nil
+}
+
+// searchBatchItems runs a query returning the hash, batch height and batch sequence of the matching items
+func searchBatchItems(db HostDB, resultType common.SearchResultType, matchedBy common.SearchMatch, query string, args ...any) ([]common.SearchResult, error) {
+	rows, err := db.GetSQLDB().Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not search %ss. Cause: %w", resultType, err)
+	}
+	defer rows.Close()
+
+	var results []common.SearchResult
+	for rows.Next() {
+		var hash []byte
+		var height, sequence uint64
+		if err = rows.Scan(&hash, &height, &sequence); err != nil {
+			return nil, fmt.Errorf("could not scan %s. Cause: %w", resultType, err)
+		}
+		h := gethcommon.BytesToHash(hash)
+		results = append(results, common.SearchResult{
+			Type:      resultType,
+			MatchedBy: matchedBy,
+			Hash:      &h,
+			Height:    new(big.Int).SetUint64(height),
+			Sequence:  new(big.Int).SetUint64(sequence),
+		})
+	}
+	return results, rows.Err()
+}
+
+func searchRollups(db HostDB, matchedBy common.SearchMatch, query string, args ...any) ([]common.SearchResult, error) {
+	rows, err := db.GetSQLDB().Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not search rollups. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	var results []common.SearchResult
+	for rows.Next() {
+		var hash []byte
+		var firstSeq uint64
+		if err = rows.Scan(&hash, &firstSeq); err != nil {
+			return nil, fmt.Errorf("could not scan rollup. Cause: %w", err)
+		}
+		h := gethcommon.BytesToHash(hash)
+		results = append(results, common.SearchResult{
+			Type:      common.SearchResultRollup,
+			MatchedBy: matchedBy,
+			Hash:      &h,
+			Sequence:  new(big.Int).SetUint64(firstSeq),
+		})
+	}
+	return results, rows.Err()
+}
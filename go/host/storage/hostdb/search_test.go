@@ -0,0 +1,133 @@
+package hostdb
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestSearchByHashFindsEachItemType(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+	txHash := gethcommon.BytesToHash([]byte("tx"))
+	batch := createForkedBatch(5, 4, []common.L2TxHash{txHash})
+	rollup := createRollup(5)
+	metadata := createRollupMetadata(3)
+	block := types.NewBlock(&types.Header{Number: big.NewInt(42)}, nil, nil, nil)
+
+	dbtx, _ := db.NewDBTransaction()
+	if err = AddBatch(dbtx, db.GetSQLStatement(), &batch); err != nil {
+		t.Fatalf("could not store batch. Cause: %s", err)
+	}
+	if err = AddBlock(dbtx, db.GetSQLStatement(), block.Header()); err != nil {
+		t.Fatalf("could not store block. Cause: %s", err)
+	}
+	dbtx.Write()
+	dbtx, _ = db.NewDBTransaction()
+	if err = AddRollup(dbtx, db.GetSQLStatement(), &rollup, &metadata, block); err != nil {
+		t.Fatalf("could not store rollup. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	expected := map[gethcommon.Hash]common.SearchResult{
+		txHash:                {Type: common.SearchResultTransaction, Height: big.NewInt(4), Sequence: big.NewInt(5)},
+		batch.Hash():          {Type: common.SearchResultBatch, Height: big.NewInt(4), Sequence: big.NewInt(5)},
+		rollup.Header.Hash():  {Type: common.SearchResultRollup, Sequence: big.NewInt(3)},
+		block.Header().Hash(): {Type: common.SearchResultBlock, Height: big.NewInt(42)},
+	}
+	for hash, want := range expected {
+		results, err := SearchByHash(db, hash)
+		if err != nil {
+			t.Fatalf("could not search %s. Cause: %s", want.Type, err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("expected one result for the %s hash, got %d", want.Type, len(results))
+		}
+		result := results[0]
+		if result.Type != want.Type || result.MatchedBy != common.MatchedByHash || *result.Hash != hash {
+			t.Errorf("expected a %s matched by hash, got %+v", want.Type, result)
+		}
+		if !equalOrNil(result.Height, want.Height) || !equalOrNil(result.Sequence, want.Sequence) {
+			t.Errorf("%s result has height %v and sequence %v", want.Type, result.Height, result.Sequence)
+		}
+	}
+
+	results, err := SearchByHash(db, gethcommon.BytesToHash([]byte("unknown")))
+	if err != nil {
+		t.Fatalf("could not search unknown hash. Cause: %s", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results for an unknown hash, got %d", len(results))
+	}
+}
+
+func TestSearchByNumberMatchesHeightsAndSequences(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+	// the batch with sequence 3 is at height 2, and the one with sequence 2 was re-created at height 3
+	batches := []common.ExtBatch{createForkedBatch(2, 3, nil), createForkedBatch(3, 2, nil), createForkedBatch(4, 3, nil)}
+	block := types.NewBlock(&types.Header{}, nil, nil, nil)
+	rollup := createRollup(4)
+	metadata := createRollupMetadata(2)
+
+	dbtx, _ := db.NewDBTransaction()
+	for i := range batches {
+		if err = AddBatch(dbtx, db.GetSQLStatement(), &batches[i]); err != nil {
+			t.Fatalf("could not store batch. Cause: %s", err)
+		}
+	}
+	if err = AddBlock(dbtx, db.GetSQLStatement(), block.Header()); err != nil {
+		t.Fatalf("could not store block. Cause: %s", err)
+	}
+	dbtx.Write()
+	dbtx, _ = db.NewDBTransaction()
+	if err = AddRollup(dbtx, db.GetSQLStatement(), &rollup, &metadata, block); err != nil {
+		t.Fatalf("could not store rollup. Cause: %s", err)
+	}
+	dbtx.Write()
+
+	results, err := SearchByNumber(db, 3)
+	if err != nil {
+		t.Fatalf("could not search number. Cause: %s", err)
+	}
+	expected := []struct {
+		resultType common.SearchResultType
+		matchedBy  common.SearchMatch
+		sequence   uint64
+	}{
+		{common.SearchResultBatch, common.MatchedByHeight, 4},
+		{common.SearchResultBatch, common.MatchedByHeight, 2},
+		{common.SearchResultBatch, common.MatchedBySequence, 3},
+		{common.SearchResultRollup, common.MatchedBySequence, 2},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(results))
+	}
+	for i, want := range expected {
+		if results[i].Type != want.resultType || results[i].MatchedBy != want.matchedBy || results[i].Sequence.Uint64() != want.sequence {
+			t.Errorf("result %d: expected %s matched by %s with sequence %d, got %+v", i, want.resultType, want.matchedBy, want.sequence, results[i])
+		}
+	}
+
+	results, err = SearchByNumber(db, 99)
+	if err != nil {
+		t.Fatalf("could not search unknown number. Cause: %s", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results for an unknown number, got %d", len(results))
+	}
+}
+
+func equalOrNil(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Cmp(b) == 0
+}
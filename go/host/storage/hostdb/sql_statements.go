@@ -4,13 +4,18 @@ import "strconv"
 
 // SQLStatements struct holds SQL statements for a specific database type
 type SQLStatements struct {
-	InsertBatch        string
-	InsertTransactions string
-	UpdateTxCount      string
-	InsertRollup       string
-	InsertBlock        string
-	Pagination         string
-	Placeholder        string
+	InsertBatch              string
+	InsertTransactions       string
+	InsertCrossChainMessages string
+	InsertL1Fork             string
+	OnConflictIgnore         string
+	UpdateTxCount            string
+	InsertRollup             string
+	InsertBlock              string
+	InsertBlob               string
+	UpsertL1Tx               string
+	Pagination               string
+	Placeholder              string
 }
 
 func (s SQLStatements) GetPlaceHolder(pos int) string {
@@ -22,24 +27,34 @@ func (s SQLStatements) GetPlaceHolder(pos int) string {
 
 func SQLiteSQLStatements() *SQLStatements {
 	return &SQLStatements{
-		InsertBatch:        "INSERT INTO batch_host (sequence, hash, height, ext_batch) VALUES (?, ?, ?, ?)",
-		InsertTransactions: "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
-		UpdateTxCount:      "UPDATE transaction_count SET total=? WHERE id=1",
-		InsertRollup:       "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values (?,?,?,?,?,?)",
-		InsertBlock:        "INSERT OR REPLACE INTO block_host (hash, header) values (?,?)",
-		Pagination:         "LIMIT ? OFFSET ?",
-		Placeholder:        "?",
+		InsertBatch:              "INSERT INTO batch_host (sequence, hash, height, ext_batch) VALUES (?, ?, ?, ?)",
+		InsertTransactions:       "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
+		InsertCrossChainMessages: "INSERT OR IGNORE INTO cross_chain_message_host (message_hash, direction, leaf_type, status, sender, receiver, l1_height, xchain_root, b_sequence) VALUES ",
+		InsertL1Fork:             "INSERT OR IGNORE INTO l1_fork_host (new_head, new_height, old_head, common_ancestor, ancestor_height, canonical_path, non_canonical_path) VALUES ",
+		OnConflictIgnore:         "",
+		UpdateTxCount:            "UPDATE transaction_count SET total=? WHERE id=1",
+		InsertRollup:             "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values (?,?,?,?,?,?)",
+		InsertBlock:              "INSERT OR REPLACE INTO block_host (hash, header) values (?,?)",
+		InsertBlob:               "INSERT OR IGNORE INTO blob_host (versioned_hash, blob, l1_hash, l1_height, l1_time) values (?,?,?,?,?)",
+		UpsertL1Tx:               "INSERT OR REPLACE INTO l1_tx_host (nonce, tx_key, status, retries, signed_tx, tx_hashes) values (?,?,?,?,?,?)",
+		Pagination:               "LIMIT ? OFFSET ?",
+		Placeholder:              "?",
 	}
 }
 
 func PostgresSQLStatements() *SQLStatements {
 	return &SQLStatements{
-		InsertBatch:        "INSERT INTO batch_host (sequence, hash, height, ext_batch) VALUES ($1, $2, $3, $4)",
-		InsertTransactions: "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
-		UpdateTxCount:      "UPDATE transaction_count SET total=$1 WHERE id=1",
-		InsertRollup:       "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values ($1, $2, $3, $4, $5, $6)",
-		InsertBlock:        "INSERT INTO block_host (hash, header) VALUES ($1, $2)",
-		Pagination:         "LIMIT $1 OFFSET $2",
-		Placeholder:        "$1",
+		InsertBatch:              "INSERT INTO batch_host (sequence, hash, height, ext_batch) VALUES ($1, $2, $3, $4)",
+		InsertTransactions:       "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
+		InsertCrossChainMessages: "INSERT INTO cross_chain_message_host (message_hash, direction, leaf_type, status, sender, receiver, l1_height, xchain_root, b_sequence) VALUES ",
+		InsertL1Fork:             "INSERT INTO l1_fork_host (new_head, new_height, old_head, common_ancestor, ancestor_height, canonical_path, non_canonical_path) VALUES ",
+		OnConflictIgnore:         " ON CONFLICT DO NOTHING",
+		UpdateTxCount:            "UPDATE transaction_count SET total=$1 WHERE id=1",
+		InsertRollup:             "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values ($1, $2, $3, $4, $5, $6)",
+		InsertBlock:              "INSERT INTO block_host (hash, header) VALUES ($1, $2)",
+		InsertBlob:               "INSERT INTO blob_host (versioned_hash, blob, l1_hash, l1_height, l1_time) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING",
+		UpsertL1Tx:               "INSERT INTO l1_tx_host (nonce, tx_key, status, retries, signed_tx, tx_hashes) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (nonce) DO UPDATE SET tx_key = EXCLUDED.tx_key, status = EXCLUDED.status, retries = EXCLUDED.retries, signed_tx = EXCLUDED.signed_tx, tx_hashes = EXCLUDED.tx_hashes",
+		Pagination:               "LIMIT $1 OFFSET $2",
+		Placeholder:              "$1",
 	}
 }
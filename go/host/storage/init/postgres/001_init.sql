@@ -55,3 +55,76 @@ INSERT INTO transaction_count (id, total)
 VALUES (1, 0)
     ON CONFLICT (id)
 DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS cross_chain_message_host
+(
+    id               SERIAL PRIMARY KEY,
+    message_hash     BYTEA       NOT NULL,
+    direction        VARCHAR(8)  NOT NULL,
+    leaf_type        VARCHAR(1)  NOT NULL,
+    status           VARCHAR(16) NOT NULL,
+    sender           BYTEA,
+    receiver         BYTEA,
+    l1_height        INT         NOT NULL,
+    final_l1_height  INT,
+    xchain_root      BYTEA,
+    b_sequence       INT,
+    FOREIGN KEY (b_sequence) REFERENCES batch_host(sequence),
+    UNIQUE (message_hash, direction)
+);
+
+CREATE INDEX IF NOT EXISTS IDX_XCHAIN_MSG_HASH_HOST ON cross_chain_message_host USING HASH (message_hash);
+CREATE INDEX IF NOT EXISTS IDX_XCHAIN_MSG_SENDER_HOST ON cross_chain_message_host USING HASH (sender);
+CREATE INDEX IF NOT EXISTS IDX_XCHAIN_MSG_RECEIVER_HOST ON cross_chain_message_host USING HASH (receiver);
+CREATE INDEX IF NOT EXISTS IDX_XCHAIN_MSG_ROOT_HOST ON cross_chain_message_host USING HASH (xchain_root);
+CREATE INDEX IF NOT EXISTS IDX_XCHAIN_MSG_STATUS_HOST ON cross_chain_message_host (status, b_sequence);
+
+CREATE TABLE IF NOT EXISTS blob_host
+(
+    versioned_hash  BYTEA PRIMARY KEY,
+    blob            BYTEA  NOT NULL,
+    l1_hash         BYTEA  NOT NULL,
+    l1_height       INT    NOT NULL,
+    l1_time         BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS IDX_BLOB_TIME_HOST ON blob_host (l1_time);
+
+CREATE TABLE IF NOT EXISTS l1_tx_host
+(
+    nonce      BIGINT PRIMARY KEY,
+    tx_key     VARCHAR(128) NOT NULL,
+    status     VARCHAR(16)  NOT NULL,
+    retries    INT          NOT NULL,
+    signed_tx  BYTEA        NOT NULL,
+    tx_hashes  BYTEA        NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS IDX_L1_TX_KEY_HOST ON l1_tx_host (tx_key);
+CREATE INDEX IF NOT EXISTS IDX_L1_TX_STATUS_HOST ON l1_tx_host (status);
+
+CREATE TABLE IF NOT EXISTS l1_fork_host
+(
+    id                  SERIAL PRIMARY KEY,
+    new_head            BYTEA NOT NULL,
+    new_height          INT   NOT NULL,
+    old_head            BYTEA NOT NULL,
+    common_ancestor     BYTEA NOT NULL,
+    ancestor_height     INT   NOT NULL,
+    canonical_path      BYTEA NOT NULL,
+    non_canonical_path  BYTEA NOT NULL,
+    UNIQUE (new_head, old_head)
+);
+
+CREATE TABLE IF NOT EXISTS reorged_batch_host
+(
+    sequence     INT PRIMARY KEY,
+    hash         BYTEA NOT NULL,
+    height       INT   NOT NULL,
+    replaced_by  INT,
+    reorged_at   INT   NOT NULL,
+    FOREIGN KEY (sequence) REFERENCES batch_host(sequence),
+    FOREIGN KEY (replaced_by) REFERENCES batch_host(sequence)
+);
+
+CREATE INDEX IF NOT EXISTS IDX_REORGED_BATCH_HEIGHT_HOST ON reorged_batch_host (height);
@@ -47,4 +47,69 @@ create table if not exists transaction_count
 );
 
 insert into transaction_count (id, total)
-values (1, 0) on CONFLICT (id) DO NOTHING;
\ No newline at end of file
+values (1, 0) on CONFLICT (id) DO NOTHING;
+create table if not exists cross_chain_message_host
+(
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    message_hash     binary(32)  NOT NULL,
+    direction        varchar(8)  NOT NULL,
+    leaf_type        varchar(1)  NOT NULL,
+    status           varchar(16) NOT NULL,
+    sender           binary(20),
+    receiver         binary(20),
+    l1_height        int         NOT NULL,
+    final_l1_height  int,
+    xchain_root      binary(32),
+    b_sequence       int REFERENCES batch_host,
+    UNIQUE (message_hash, direction)
+);
+create index if not exists IDX_XCHAIN_MSG_HASH_HOST on cross_chain_message_host (message_hash);
+create index if not exists IDX_XCHAIN_MSG_SENDER_HOST on cross_chain_message_host (sender);
+create index if not exists IDX_XCHAIN_MSG_RECEIVER_HOST on cross_chain_message_host (receiver);
+create index if not exists IDX_XCHAIN_MSG_ROOT_HOST on cross_chain_message_host (xchain_root);
+create index if not exists IDX_XCHAIN_MSG_STATUS_HOST on cross_chain_message_host (status, b_sequence);
+
+create table if not exists blob_host
+(
+    versioned_hash  binary(32) PRIMARY KEY,
+    blob            blob       NOT NULL,
+    l1_hash         binary(32) NOT NULL,
+    l1_height       int        NOT NULL,
+    l1_time         int        NOT NULL
+);
+create index if not exists IDX_BLOB_TIME_HOST on blob_host (l1_time);
+
+create table if not exists l1_tx_host
+(
+    nonce      int PRIMARY KEY,
+    tx_key     varchar(128) NOT NULL,
+    status     varchar(16)  NOT NULL,
+    retries    int          NOT NULL,
+    signed_tx  blob         NOT NULL,
+    tx_hashes  blob         NOT NULL
+);
+create index if not exists IDX_L1_TX_KEY_HOST on l1_tx_host (tx_key);
+create index if not exists IDX_L1_TX_STATUS_HOST on l1_tx_host (status);
+
+create table if not exists l1_fork_host
+(
+    id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+    new_head            binary(32) NOT NULL,
+    new_height          int        NOT NULL,
+    old_head            binary(32) NOT NULL,
+    common_ancestor     binary(32) NOT NULL,
+    ancestor_height     int        NOT NULL,
+    canonical_path      blob       NOT NULL,
+    non_canonical_path  blob       NOT NULL,
+    UNIQUE (new_head, old_head)
+);
+
+create table if not exists reorged_batch_host
+(
+    sequence     int PRIMARY KEY REFERENCES batch_host,
+    hash         binary(32) NOT NULL,
+    height       int        NOT NULL,
+    replaced_by  int REFERENCES batch_host,
+    reorged_at   int        NOT NULL
+);
+create index if not exists IDX_REORGED_BATCH_HEIGHT_HOST on reorged_batch_host (height);
@@ -6,12 +6,18 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ten-protocol/go-ten/go/common"
 )
 
 type Storage interface {
 	BatchResolver
 	BlockResolver
+	CrossChainMessageResolver
+	BlobResolver
+	L1TxResolver
+	ReorgResolver
+	SearchResolver
 	io.Closer
 }
 
@@ -71,4 +77,67 @@ type BlockResolver interface {
 	FetchRollupBySeqNo(seqNo uint64) (*common.PublicRollup, error)
 	// FetchRollupBatches returns a list of public batch data within a given rollup hash
 	FetchRollupBatches(rollupHash gethcommon.Hash) (*common.BatchListingResponse, error)
+	// FetchCrossChainBundleRange returns the first and last seq numbers of the cross chain bundle containing the batch
+	FetchCrossChainBundleRange(seqNo uint64) (uint64, uint64, error)
+}
+
+type CrossChainMessageResolver interface {
+	// AddInboundCrossChainMessages stores the messages and value transfers observed on the L1 message bus
+	AddInboundCrossChainMessages(records []common.CrossChainMessageRecord) error
+	// AddOutboundValueTransfers stores the sender and receiver of the value transfers of a stored batch
+	AddOutboundValueTransfers(transfers common.ValueTransferEvents) error
+	// PublishCrossChainBundle marks the outbound messages of the batches with the given cross chain roots as published at the L1 height
+	PublishCrossChainBundle(roots common.CrossChainRootHashes, l1Height uint64) error
+	// FinaliseCrossChainMessages marks the messages that reached the L1 at or below the given height as finalised
+	FinaliseCrossChainMessages(finalisedL1Height uint64) error
+	// FetchBatchByCrossChainMessage returns the `ExtBatch` whose cross chain tree contains the given message hash
+	FetchBatchByCrossChainMessage(messageHash gethcommon.Hash) (*common.ExtBatch, error)
+	// FetchCrossChainMessage returns the public status of the cross chain message with the given hash
+	FetchCrossChainMessage(messageHash gethcommon.Hash) (*common.PublicCrossChainMessage, error)
+	// FetchCrossChainMessageListing returns a paginated list of the public cross chain message data
+	FetchCrossChainMessageListing(pagination *common.QueryPagination) (*common.CrossChainMessageListingResponse, error)
+	// FetchCrossChainMessagesByAddress returns a paginated list of the cross chain messages sent or received by the address
+	FetchCrossChainMessagesByAddress(address gethcommon.Address, pagination *common.QueryPagination) (*common.PersonalCrossChainMessageListingResponse, error)
+}
+
+type BlobResolver interface {
+	// AddBlobs stores the blobs fetched for the L1 block, keyed by their versioned hashes
+	AddBlobs(b *types.Header, hashes []gethcommon.Hash, blobs []*kzg4844.Blob) error
+	// PruneBlobs deletes the blobs fetched for L1 blocks with a timestamp before the given time
+	PruneBlobs(l1Time uint64) error
+	// FetchBlobs returns the stored blobs in the order of the hashes, or errutil.ErrNotFound if any of them is missing
+	FetchBlobs(hashes []gethcommon.Hash) ([]*kzg4844.Blob, error)
+	// FetchBlob returns the stored blob with the given versioned hash
+	FetchBlob(versionedHash gethcommon.Hash) (*common.StoredBlob, error)
+}
+
+type L1TxResolver interface {
+	// StoreL1Tx persists the state of an L1 transaction issued by the host, keyed by its nonce
+	StoreL1Tx(record *common.L1TxRecord) error
+	// PruneL1Txs deletes the completed L1 transactions with a nonce lower than the given one
+	PruneL1Txs(beforeNonce uint64) error
+	// FetchPendingL1Txs returns the L1 transactions still waiting for a receipt, in nonce order
+	FetchPendingL1Txs() ([]*common.L1TxRecord, error)
+	// FetchL1TxByKey returns the most recent L1 transaction issued with the given key
+	FetchL1TxByKey(key string) (*common.L1TxRecord, error)
+}
+
+type ReorgResolver interface {
+	// AddL1Fork records an L1 reorg reported by the enclave
+	AddL1Fork(fork *common.L1Fork) error
+	// FetchL1ForkListing returns a paginated list of the L1 forks, latest first
+	FetchL1ForkListing(pagination *common.QueryPagination) (*common.L1ForkListingResponse, error)
+	// FetchReorgedBatchListing returns a paginated list of the batches that are no longer canonical, with their replacements
+	FetchReorgedBatchListing(pagination *common.QueryPagination) (*common.ReorgedBatchListingResponse, error)
+	// FetchMovedTransactionListing returns a paginated list of the transactions of the non-canonical batches, with the
+	// canonical batch that included each of them again
+	FetchMovedTransactionListing(pagination *common.QueryPagination) (*common.MovedTransactionListingResponse, error)
+}
+
+type SearchResolver interface {
+	// SearchByHash returns the transactions, batches, rollups and L1 blocks with the given hash
+	SearchByHash(hash gethcommon.Hash) ([]common.SearchResult, error)
+	// SearchByNumber returns the batches with the given height or sequence number, and the rollup containing the batch
+	// with that sequence number
+	SearchByNumber(number uint64) ([]common.SearchResult, error)
 }
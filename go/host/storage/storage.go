@@ -8,6 +8,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
@@ -94,6 +95,213 @@ func (s *storageImpl) AddBlock(b *types.Header) error {
 	return nil
 }
 
+func (s *storageImpl) AddInboundCrossChainMessages(records []common.CrossChainMessageRecord) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddInboundCrossChainMessages(dbtx, s.db.GetSQLStatement(), records); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add inbound cross chain messages to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit cross chain messages tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) AddOutboundValueTransfers(transfers common.ValueTransferEvents) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddOutboundValueTransfers(dbtx, s.db.GetSQLStatement(), transfers); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add outbound value transfers to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit outbound value transfers tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) PublishCrossChainBundle(roots common.CrossChainRootHashes, l1Height uint64) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.PublishCrossChainMessages(dbtx, s.db.GetSQLStatement(), roots, l1Height); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not publish cross chain messages. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit cross chain bundle tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FinaliseCrossChainMessages(finalisedL1Height uint64) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.FinaliseCrossChainMessages(dbtx, s.db.GetSQLStatement(), finalisedL1Height); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not finalise cross chain messages. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit cross chain finality tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) AddBlobs(b *types.Header, hashes []gethcommon.Hash, blobs []*kzg4844.Blob) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddBlobs(dbtx, s.db.GetSQLStatement(), b, hashes, blobs); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add blobs to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit blobs tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) AddL1Fork(fork *common.L1Fork) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddL1Fork(dbtx, s.db.GetSQLStatement(), fork); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add L1 fork to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit L1 fork tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchL1ForkListing(pagination *common.QueryPagination) (*common.L1ForkListingResponse, error) {
+	return hostdb.GetL1ForkListing(s.db, pagination)
+}
+
+func (s *storageImpl) FetchReorgedBatchListing(pagination *common.QueryPagination) (*common.ReorgedBatchListingResponse, error) {
+	return hostdb.GetReorgedBatchListing(s.db, pagination)
+}
+
+func (s *storageImpl) FetchMovedTransactionListing(pagination *common.QueryPagination) (*common.MovedTransactionListingResponse, error) {
+	return hostdb.GetMovedTransactionListing(s.db, pagination)
+}
+
+func (s *storageImpl) SearchByHash(hash gethcommon.Hash) ([]common.SearchResult, error) {
+	return hostdb.SearchByHash(s.db, hash)
+}
+
+func (s *storageImpl) SearchByNumber(number uint64) ([]common.SearchResult, error) {
+	return hostdb.SearchByNumber(s.db, number)
+}
+
+func (s *storageImpl) PruneBlobs(l1Time uint64) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.DeleteBlobsBefore(dbtx, s.db.GetSQLStatement(), l1Time); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not prune blobs. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit blob pruning tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchBlobs(hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	return hostdb.GetBlobs(s.db, hashes)
+}
+
+func (s *storageImpl) FetchBlob(versionedHash gethcommon.Hash) (*common.StoredBlob, error) {
+	return hostdb.GetBlob(s.db, versionedHash)
+}
+
+func (s *storageImpl) StoreL1Tx(record *common.L1TxRecord) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.UpsertL1Tx(dbtx, s.db.GetSQLStatement(), record); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not store L1 tx. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit L1 tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) PruneL1Txs(beforeNonce uint64) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.DeleteL1TxsBefore(dbtx, s.db.GetSQLStatement(), beforeNonce); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not prune L1 txs. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit L1 tx pruning. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchPendingL1Txs() ([]*common.L1TxRecord, error) {
+	return hostdb.GetPendingL1Txs(s.db)
+}
+
+func (s *storageImpl) FetchL1TxByKey(key string) (*common.L1TxRecord, error) {
+	return hostdb.GetL1TxByKey(s.db, key)
+}
+
 func (s *storageImpl) FetchBatchBySeqNo(seqNum uint64) (*common.ExtBatch, error) {
 	return hostdb.GetBatchBySequenceNumber(s.db, seqNum)
 }
@@ -122,6 +330,22 @@ func (s *storageImpl) FetchBatchByTx(txHash gethcommon.Hash) (*common.ExtBatch,
 	return hostdb.GetBatchByTx(s.db, txHash)
 }
 
+func (s *storageImpl) FetchBatchByCrossChainMessage(messageHash gethcommon.Hash) (*common.ExtBatch, error) {
+	return hostdb.GetBatchByCrossChainMessage(s.db, messageHash)
+}
+
+func (s *storageImpl) FetchCrossChainMessage(messageHash gethcommon.Hash) (*common.PublicCrossChainMessage, error) {
+	return hostdb.GetCrossChainMessage(s.db, messageHash)
+}
+
+func (s *storageImpl) FetchCrossChainMessageListing(pagination *common.QueryPagination) (*common.CrossChainMessageListingResponse, error) {
+	return hostdb.GetCrossChainMessageListing(s.db, pagination)
+}
+
+func (s *storageImpl) FetchCrossChainMessagesByAddress(address gethcommon.Address, pagination *common.QueryPagination) (*common.PersonalCrossChainMessageListingResponse, error) {
+	return hostdb.GetCrossChainMessagesByAddress(s.db, address, pagination)
+}
+
 func (s *storageImpl) FetchLatestBatch() (*common.BatchHeader, error) {
 	return hostdb.GetLatestBatch(s.db)
 }
@@ -174,6 +398,10 @@ func (s *storageImpl) FetchRollupBySeqNo(seqNo uint64) (*common.PublicRollup, er
 	return hostdb.GetRollupBySeqNo(s.db, seqNo)
 }
 
+func (s *storageImpl) FetchCrossChainBundleRange(seqNo uint64) (uint64, uint64, error) {
+	return hostdb.GetCrossChainBundleRange(s.db, seqNo)
+}
+
 func (s *storageImpl) FetchRollupBatches(rollupHash gethcommon.Hash) (*common.BatchListingResponse, error) {
 	return hostdb.GetRollupBatches(s.db, rollupHash)
 }
@@ -6,16 +6,23 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/ten-protocol/go-ten/go/responses"
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/go/wallet"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -28,8 +35,22 @@ import (
 type AuthObsClient struct {
 	ObsClient
 	account gethcommon.Address
+
+	// the node only knows the nonces of the executed transactions, so the pending nonce is tracked by the client
+	nonceLock     sync.Mutex
+	nextNonce     uint64    // the nonce following the last transaction sent by the account through this client
+	nodeNonce     uint64    // the pending nonce last returned by the node
+	nonceProgress time.Time // when a transaction was last sent or the node nonce last advanced
 }
 
+// the transactions sent through the client are considered dropped if the node nonce does not advance for this long
+var _sentTxExpiry = time.Minute
+
+var (
+	_ bind.ContractBackend = (*AuthObsClient)(nil)
+	_ bind.DeployBackend   = (*AuthObsClient)(nil)
+)
+
 // NewAuthObsClient constructs an AuthObsClient for sensitive communication with an enclave.
 //
 // It requires an EncRPCClient specifically even though the AuthObsClient uses a Client interface in its struct because
@@ -166,7 +187,12 @@ func (ac *AuthObsClient) NonceAt(ctx context.Context, blockNumber *big.Int) (uin
 	return hexutil.DecodeUint64(result)
 }
 
+// CallContract executes the call as the account of the client when the message has no sender, since TEN only executes
+// calls from an authenticated account
 func (ac *AuthObsClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.From == (gethcommon.Address{}) {
+		msg.From = ac.account
+	}
 	var hex hexutil.Bytes
 	err := ac.rpcClient.CallContext(ctx, &hex, "eth_call", ToCallArg(msg), toBlockNumArg(blockNumber))
 	if err != nil {
@@ -178,12 +204,32 @@ func (ac *AuthObsClient) CallContract(ctx context.Context, msg ethereum.CallMsg,
 func (ac *AuthObsClient) SendTransaction(ctx context.Context, signedTx *types.Transaction) error {
 	var result responses.RawTxType
 	err := ac.rpcClient.CallContext(ctx, &result, rpc.SendRawTransaction, encodeTx(signedTx))
+	sender, senderErr := types.Sender(types.LatestSignerForChainID(signedTx.ChainId()), signedTx)
+	if senderErr != nil || sender != ac.account {
+		return err
+	}
+
+	ac.nonceLock.Lock()
+	defer ac.nonceLock.Unlock()
 	if err != nil {
+		// the nonces tracked by the client are out of line with the node, so the nonce of the node is used from now on
+		if isNonceError(err) {
+			ac.nextNonce = 0
+		}
 		return err
 	}
+	ac.nextNonce = max(ac.nextNonce, signedTx.Nonce()+1)
+	ac.nonceProgress = time.Now()
 	return nil
 }
 
+// isNonceError returns true if the node rejected a transaction because of its nonce. The errors are only known by their
+// message once they went through the RPC layer.
+func isNonceError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, core.ErrNonceTooLow.Error()) || strings.Contains(msg, core.ErrNonceTooHigh.Error())
+}
+
 // BalanceAt retrieves the native balance for the account registered on this client (due to obscuro privacy restrictions,
 // balance cannot be requested for other accounts)
 func (ac *AuthObsClient) BalanceAt(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
@@ -192,10 +238,32 @@ func (ac *AuthObsClient) BalanceAt(ctx context.Context, blockNumber *big.Int) (*
 	return (*big.Int)(&result), err
 }
 
-// Workaround for creating a ETH client interface to be used in generated contracts interacting.
-// Obviously any usage should avoid subscriptions as it will not work.
-func (ac *AuthObsClient) SubscribeFilterLogs(ctx context.Context, filterCriteria ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	panic("not implemented")
+// SubscribeFilterLogs subscribes to the logs matching the query that the account can view. It requires a websocket
+// connection to the node.
+func (ac *AuthObsClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	// the encrypted client decrypts the logs into a bidirectional channel
+	logs := make(chan types.Log)
+	sub, err := ac.SubscribeFilterLogsTEN(ctx, common.FilterCriteria(query), logs)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				select {
+				case ch <- l:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
 // Real method that we have used in TEN for subscription
@@ -205,7 +273,8 @@ func (ac *AuthObsClient) SubscribeFilterLogsTEN(ctx context.Context, filterCrite
 
 func (ac *AuthObsClient) GetLogs(ctx context.Context, filterCriteria common.FilterCriteria) ([]*types.Log, error) {
 	var result responses.LogsType
-	err := ac.rpcClient.CallContext(ctx, &result, rpc.GetLogs, filterCriteria)
+	// the block numbers must be encoded as block tags or hex numbers
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.GetLogs, common.SerializableFilterCriteria(filterCriteria))
 	if err != nil {
 		return nil, err
 	}
@@ -267,12 +336,27 @@ func (ac *AuthObsClient) CodeAt(ctx context.Context, address gethcommon.Address,
 	return result, nil
 }
 
-func (ac *AuthObsClient) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
-	return nil, errors.New("not implemented")
+// FilterLogs returns the logs matching the query that the account can view
+func (ac *AuthObsClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := ac.GetLogs(ctx, common.FilterCriteria(query))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]types.Log, len(logs))
+	for i, l := range logs {
+		result[i] = *l
+	}
+	return result, nil
 }
 
-func (ac *AuthObsClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
-	header, err := ac.GetBatchHeaderByNumber(number)
+// HeaderByNumber returns the header of the batch with the given number, or of the head batch if number is nil
+func (ac *AuthObsClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *common.BatchHeader
+	err := ac.rpcClient.CallContext(ctx, &header, rpc.GetBatchByNumber, toBlockNumArg(number), false)
+	if err == nil && header == nil {
+		err = ethereum.NotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -280,12 +364,34 @@ func (ac *AuthObsClient) HeaderByNumber(_ context.Context, number *big.Int) (*ty
 	return common.ConvertBatchHeaderToHeader(header), nil
 }
 
+// PendingCodeAt returns the code of the contract in the pending state, which is the state of the head batch
 func (ac *AuthObsClient) PendingCodeAt(ctx context.Context, address gethcommon.Address) ([]byte, error) {
-	return ac.CodeAt(ctx, address, nil)
+	return ac.CodeAt(ctx, address, big.NewInt(int64(gethrpc.PendingBlockNumber)))
 }
 
+// PendingNonceAt returns the nonce of the next transaction of the account registered on this client (due to TEN
+// privacy restrictions, nonces cannot be requested for other accounts). The transactions sent through this client that
+// are not executed yet are accounted for, unless the node nonce has not advanced for a while, in which case they are
+// assumed to have been dropped.
 func (ac *AuthObsClient) PendingNonceAt(ctx context.Context, address gethcommon.Address) (uint64, error) {
-	return ac.NonceAt(ctx, nil)
+	if address != ac.account {
+		return 0, fmt.Errorf("the nonce of %s cannot be requested by %s", address.Hex(), ac.account.Hex())
+	}
+	nonce, err := ac.NonceAt(ctx, big.NewInt(int64(gethrpc.PendingBlockNumber)))
+	if err != nil {
+		return 0, err
+	}
+
+	ac.nonceLock.Lock()
+	defer ac.nonceLock.Unlock()
+	if nonce != ac.nodeNonce {
+		ac.nodeNonce = nonce
+		ac.nonceProgress = time.Now()
+	}
+	if ac.nextNonce > nonce && time.Since(ac.nonceProgress) > _sentTxExpiry {
+		ac.nextNonce = nonce
+	}
+	return max(nonce, ac.nextNonce), nil
 }
 
 func (ac *AuthObsClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
@@ -2,13 +2,22 @@ package obsclient
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/ten-protocol/go-ten/go/responses"
 	"github.com/ten-protocol/go-ten/go/rpc"
+
+	tencommon "github.com/ten-protocol/go-ten/go/common"
 )
 
 // These tests use a mocked RPC client, they test any transformations of the Go objects -> RPC params, as well as any
@@ -44,6 +53,136 @@ func TestNonceAt_ConvertsNilBlockNumberToLatest(t *testing.T) {
 	assert.Equal(t, uint64(2), nonce)
 }
 
+func TestFilterLogs_SerializesBlockNumbers(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+
+	query := ethereum.FilterQuery{FromBlock: big.NewInt(16), ToBlock: big.NewInt(-1), Addresses: []common.Address{testAcc}}
+	// the filter criteria must be sent in their JSON form, with the block numbers as hex numbers or block tags
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*[]*types.Log"), rpc.GetLogs, mock.MatchedBy(func(args []interface{}) bool {
+			crit, ok := args[0].(tencommon.FilterCriteriaJSON)
+			return ok && len(args) == 1 && crit.FromBlock.String() == "0x10" && crit.ToBlock.String() == "pending"
+		}),
+	).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*responses.LogsType)
+		*res = []*types.Log{{Address: testAcc, BlockNumber: 16}, {Address: testAcc, BlockNumber: 17}}
+	})
+
+	logs, err := authClient.FilterLogs(testCtx, query)
+
+	mockRPC.AssertExpectations(t)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{{Address: testAcc, BlockNumber: 16}, {Address: testAcc, BlockNumber: 17}}, logs)
+}
+
+func TestPendingNonceAt_IncludesSentTransactions(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	authClient.account = crypto.PubkeyToAddress(key.PublicKey)
+
+	// the node has not executed any transaction of the account yet
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*string"), rpc.GetTransactionCount, []interface{}{authClient.account, "pending"},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*responses.NonceType) = "0x0"
+	})
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.Hash"), rpc.SendRawTransaction, mock.Anything,
+	).Return(nil)
+
+	nonce, err := authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonce)
+
+	signer := types.LatestSignerForChainID(big.NewInt(443))
+	for i := uint64(0); i < 2; i++ {
+		tx := types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: i, Gas: 21_000, GasPrice: big.NewInt(1), To: &testAcc})
+		require.NoError(t, authClient.SendTransaction(testCtx, tx))
+	}
+
+	nonce, err = authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce)
+
+	// due to the privacy restrictions, the nonces of other accounts cannot be requested
+	_, err = authClient.PendingNonceAt(testCtx, testAcc)
+	assert.Error(t, err)
+}
+
+func TestPendingNonceAt_ResetsAfterNonceError(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	authClient.account = crypto.PubkeyToAddress(key.PublicKey)
+
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*string"), rpc.GetTransactionCount, []interface{}{authClient.account, "pending"},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*responses.NonceType) = "0x0"
+	})
+	// the first transaction is accepted, the node then rejects the following one due to its nonce
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.Hash"), rpc.SendRawTransaction, mock.Anything,
+	).Return(nil).Once()
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.Hash"), rpc.SendRawTransaction, mock.Anything,
+	).Return(errors.New("nonce too high"))
+
+	signer := types.LatestSignerForChainID(big.NewInt(443))
+	tx := types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: 5, Gas: 21_000, GasPrice: big.NewInt(1), To: &testAcc})
+	require.NoError(t, authClient.SendTransaction(testCtx, tx))
+	nonce, err := authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), nonce)
+
+	tx = types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: 6, Gas: 21_000, GasPrice: big.NewInt(1), To: &testAcc})
+	require.Error(t, authClient.SendTransaction(testCtx, tx))
+	nonce, err = authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonce)
+}
+
+func TestPendingNonceAt_ResetsWhenNodeNonceDoesNotAdvance(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	authClient.account = crypto.PubkeyToAddress(key.PublicKey)
+
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*string"), rpc.GetTransactionCount, []interface{}{authClient.account, "pending"},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*responses.NonceType) = "0x1"
+	})
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.Hash"), rpc.SendRawTransaction, mock.Anything,
+	).Return(nil)
+
+	signer := types.LatestSignerForChainID(big.NewInt(443))
+	tx := types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: 1, Gas: 21_000, GasPrice: big.NewInt(1), To: &testAcc})
+	require.NoError(t, authClient.SendTransaction(testCtx, tx))
+	nonce, err := authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce)
+
+	// the node nonce does not advance, so the transaction was dropped
+	expiry := _sentTxExpiry
+	_sentTxExpiry = 0
+	defer func() { _sentTxExpiry = expiry }()
+	time.Sleep(time.Millisecond)
+	nonce, err = authClient.PendingNonceAt(testCtx, authClient.account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+}
+
 func createAuthClientWithMockRPCClient() (*rpcClientMock, *AuthObsClient) {
 	mockRPC := new(rpcClientMock)
 	authClient := &AuthObsClient{
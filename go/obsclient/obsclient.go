@@ -118,6 +118,16 @@ func (oc *ObsClient) GetTransaction(hash gethcommon.Hash) (*common.PublicTransac
 	return tx, err
 }
 
+// GetCode returns the runtime bytecode of the contract at the address, in the latest batch
+func (oc *ObsClient) GetCode(address gethcommon.Address) ([]byte, error) {
+	var code hexutil.Bytes
+	err := oc.rpcClient.Call(&code, rpc.GetCode, address, "latest")
+	if err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
 // Health returns the health of the node.
 func (oc *ObsClient) Health() (bool, error) {
 	var healthy *hostcommon.HealthCheck
@@ -260,3 +270,105 @@ func (oc *ObsClient) GetConfig() (*common.TenNetworkInfo, error) {
 	}
 	return &result, nil
 }
+
+// GetCrossChainProof returns the merkle proof and L1 publication status for an outbound cross chain message or value transfer
+func (oc *ObsClient) GetCrossChainProof(messageHash gethcommon.Hash) (*common.CrossChainProof, error) {
+	var result common.CrossChainProof
+	err := oc.rpcClient.Call(&result, rpc.GetCrossChainProof, messageHash)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCrossChainMessage returns the lifecycle status of an inbound or outbound cross chain message or value transfer
+func (oc *ObsClient) GetCrossChainMessage(messageHash gethcommon.Hash) (*common.PublicCrossChainMessage, error) {
+	var result common.PublicCrossChainMessage
+	err := oc.rpcClient.Call(&result, rpc.GetCrossChainMessage, messageHash)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCrossChainMessageListing returns a list of cross chain messages with their lifecycle status
+func (oc *ObsClient) GetCrossChainMessageListing(pagination *common.QueryPagination) (*common.CrossChainMessageListingResponse, error) {
+	var result common.CrossChainMessageListingResponse
+	err := oc.rpcClient.Call(&result, rpc.GetCrossChainMessageListing, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetL1ForkListing returns a list of the L1 reorgs processed by the network, latest first
+func (oc *ObsClient) GetL1ForkListing(pagination *common.QueryPagination) (*common.L1ForkListingResponse, error) {
+	var result common.L1ForkListingResponse
+	err := oc.rpcClient.Call(&result, rpc.GetL1ForkListing, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetReorgedBatchListing returns a list of the batches that are no longer canonical, with their replacements
+func (oc *ObsClient) GetReorgedBatchListing(pagination *common.QueryPagination) (*common.ReorgedBatchListingResponse, error) {
+	var result common.ReorgedBatchListingResponse
+	err := oc.rpcClient.Call(&result, rpc.GetReorgedBatchListing, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetMovedTransactionListing returns a list of the transactions of non-canonical batches, with their new batch
+func (oc *ObsClient) GetMovedTransactionListing(pagination *common.QueryPagination) (*common.MovedTransactionListingResponse, error) {
+	var result common.MovedTransactionListingResponse
+	err := oc.rpcClient.Call(&result, rpc.GetMovedTransactionListing, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetContract returns the creation and visibility configuration of the contract at the address
+func (oc *ObsClient) GetContract(address gethcommon.Address) (*common.PublicContract, error) {
+	var result common.PublicContract
+	err := oc.rpcClient.Call(&result, rpc.GetContract, address)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetContractEvents returns a list of the events of the contract that everyone can view, latest first
+func (oc *ObsClient) GetContractEvents(address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, error) {
+	var result common.PublicEventListingResponse
+	err := oc.rpcClient.Call(&result, rpc.GetContractEvents, address, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAddressActivity returns the contracts created by the address and a list of its transactions with transparent
+// contracts, latest first
+func (oc *ObsClient) GetAddressActivity(address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, error) {
+	var result common.AddressActivity
+	err := oc.rpcClient.Call(&result, rpc.GetAddressActivity, address, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Search returns a list of the batches, rollups, transactions, L1 blocks and contracts matching the hash, height,
+// sequence number or address
+func (oc *ObsClient) Search(query string, pagination *common.QueryPagination) (*common.SearchResponse, error) {
+	var result common.SearchResponse
+	err := oc.rpcClient.Call(&result, rpc.Search, query, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
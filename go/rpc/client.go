@@ -27,6 +27,10 @@ const (
 	Health = "obscuro_health"
 	Config = "obscuro_config"
 
+	GetCrossChainProof    = "ten_getCrossChainProof"
+	GetCrossChainMessages = "ten_getCrossChainMessages"
+	GetBlob               = "ten_getBlob"
+
 	StopHost                 = "test_stopHost"
 	SubscribeNamespace       = "eth"
 	SubscriptionTypeLogs     = "logs"
@@ -52,6 +56,19 @@ const (
 	GetRollupBySeqNo        = "scan_getRollupBySeqNo"
 	GetBatchTransactions    = "scan_getBatchTransactions"
 	GetPersonalTransactions = "scan_getPersonalTransactions"
+
+	GetCrossChainMessage        = "scan_getCrossChainMessage"
+	GetCrossChainMessageListing = "scan_getCrossChainMessageListing"
+
+	GetL1ForkListing           = "scan_getL1ForkListing"
+	GetReorgedBatchListing     = "scan_getReorgedBatchListing"
+	GetMovedTransactionListing = "scan_getMovedTransactionListing"
+
+	GetContract        = "scan_getContract"
+	GetContractEvents  = "scan_getContractEvents"
+	GetAddressActivity = "scan_getAddressActivity"
+
+	Search = "scan_search"
 )
 
 // Client is used by client applications to interact with the TEN node
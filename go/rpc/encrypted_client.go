@@ -45,6 +45,12 @@ var SensitiveMethods = []string{
 	GetPersonalTransactions,
 }
 
+// ViewingKeyAuthenticatedMethods are served by the host from its own index. The request is sent in plaintext together
+// with the signed viewing key, and the response is encrypted with the viewing key
+var ViewingKeyAuthenticatedMethods = []string{
+	GetCrossChainMessages,
+}
+
 // EncRPCClient is a Client wrapper that implements Client but also has extra functionality for managing viewing key registration and decryption
 type EncRPCClient struct {
 	obscuroClient    Client
@@ -91,6 +97,10 @@ func (c *EncRPCClient) CallContext(ctx context.Context, result interface{}, meth
 		return fmt.Errorf("call result parameter must be pointer or nil interface: %v", result)
 	}
 
+	if IsViewingKeyAuthenticatedMethod(method) {
+		return c.executeViewingKeyAuthenticatedCall(ctx, result, method, args...)
+	}
+
 	if !IsSensitiveMethod(method) {
 		// for non-sensitive methods or when viewing keys are disabled we just delegate directly to the geth RPC client
 		return c.executeRPCCall(ctx, result, method, args...)
@@ -127,7 +137,26 @@ func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interfac
 	if err != nil {
 		return err
 	}
+	return c.decodeEncryptedResponse(result, method, &rawResult)
+}
+
+// executeViewingKeyAuthenticatedCall appends the signed viewing key to the plaintext args, and decrypts the response
+func (c *EncRPCClient) executeViewingKeyAuthenticatedCall(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	vk := &viewingkey.RPCSignedViewingKey{
+		PublicKey:               c.viewingKey.PublicKey,
+		SignatureWithAccountKey: c.viewingKey.SignatureWithAccountKey,
+		SignatureType:           c.viewingKey.SignatureType,
+	}
+	var rawResult responses.EnclaveResponse
+	err := c.executeRPCCall(ctx, &rawResult, method, append(args, vk)...)
+	if err != nil {
+		return err
+	}
+	return c.decodeEncryptedResponse(result, method, &rawResult)
+}
 
+// decodeEncryptedResponse decrypts the user response with the viewing key and unmarshals it into the result
+func (c *EncRPCClient) decodeEncryptedResponse(result interface{}, method string, rawResult *responses.EnclaveResponse) error {
 	// if caller not interested in response, we're done
 	if result == nil {
 		return nil
@@ -315,3 +344,12 @@ func IsSensitiveMethod(method string) bool {
 	}
 	return false
 }
+
+func IsViewingKeyAuthenticatedMethod(method string) bool {
+	for _, m := range ViewingKeyAuthenticatedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -44,6 +44,7 @@ type Ports struct {
 	TestFaucetHTTPPort                          int
 	TestTenGatewayPort                          int
 	NetworkTestsPort                            int
+	TestInMemoryFaultInjectionSimulationPort    int
 }
 
 var TestPorts = Ports{
@@ -61,6 +62,7 @@ var TestPorts = Ports{
 	TestFaucetHTTPPort:                          23000,
 	TestTenGatewayPort:                          24000,
 	NetworkTestsPort:                            25000,
+	TestInMemoryFaultInjectionSimulationPort:    26000,
 }
 
 // GetTestName looks up the test name from the port number using reflection
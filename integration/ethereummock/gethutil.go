@@ -18,13 +18,16 @@ var EmptyHash = gethcommon.Hash{}
 // it also returns the blocks that became canonical, and the once that are now the fork
 func LCA(ctx context.Context, newCanonical *types.Block, oldCanonical *types.Block, resolver *blockResolverInMem) (*common.ChainFork, error) {
 	b, cp, ncp, err := internalLCA(ctx, newCanonical, oldCanonical, resolver, []common.L1BlockHash{}, []common.L1BlockHash{})
+	if err != nil {
+		return nil, err
+	}
 	return &common.ChainFork{
 		NewCanonical:     newCanonical.Header(),
 		OldCanonical:     oldCanonical.Header(),
 		CommonAncestor:   b.Header(),
 		CanonicalPath:    cp,
 		NonCanonicalPath: ncp,
-	}, err
+	}, nil
 }
 
 func internalLCA(ctx context.Context, newCanonical *types.Block, oldCanonical *types.Block, resolver *blockResolverInMem, canonicalPath []common.L1BlockHash, nonCanonicalPath []common.L1BlockHash) (*types.Block, []common.L1BlockHash, []common.L1BlockHash, error) {
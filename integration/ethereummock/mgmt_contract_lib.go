@@ -2,10 +2,12 @@ package ethereummock
 
 import (
 	"bytes"
+	"context"
 	"encoding/gob"
-	"fmt"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 	"github.com/ten-protocol/go-ten/integration/datagenerator"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -22,6 +24,9 @@ var (
 	storeSecretTxAddr      = datagenerator.RandomAddress()
 	requestSecretTxAddr    = datagenerator.RandomAddress()
 	initializeSecretTxAddr = datagenerator.RandomAddress()
+	rotateSecretTxAddr     = datagenerator.RandomAddress()
+	requestRotationTxAddr  = datagenerator.RandomAddress()
+	revokeEnclaveTxAddr    = datagenerator.RandomAddress()
 	// MgmtContractAddresses make all these addresses available for the host to know what receipts will be forwarded to the enclave
 	MgmtContractAddresses = []gethcommon.Address{
 		depositTxAddr,
@@ -29,6 +34,9 @@ var (
 		storeSecretTxAddr,
 		requestSecretTxAddr,
 		initializeSecretTxAddr,
+		rotateSecretTxAddr,
+		requestRotationTxAddr,
+		revokeEnclaveTxAddr,
 	}
 )
 
@@ -57,41 +65,35 @@ func (m *mockContractLib) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 	}
 
 	if tx.To().Hex() == rollupTxAddr.Hex() {
-		return &ethadapter.L1RollupHashes{
-			BlobHashes: tx.BlobHashes(),
+		if tx.Type() == types.BlobTxType {
+			return &ethadapter.L1RollupHashes{
+				DAMode:     common.BlobDA,
+				BlobHashes: tx.BlobHashes(),
+			}
 		}
+		// the mock rollup calldata only contains the reference appended by the DA layer
+		ref, err := da.DecodeReference(tx.Data())
+		if err != nil {
+			return nil
+		}
+		return ref
 	}
 	return decodeTx(tx)
 }
 
-func (m *mockContractLib) CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxData, error) {
-	var err error
-	blobs, err := ethadapter.EncodeBlobs(t.Rollup)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert rollup to blobs: %w", err)
-	}
-
-	var blobHashes []gethcommon.Hash
-	var sidecar *types.BlobTxSidecar
-	if sidecar, blobHashes, err = ethadapter.MakeSidecar(blobs); err != nil {
-		return nil, fmt.Errorf("failed to make sidecar: %w", err)
+// DecodeReceipt decodes the transaction itself, as the mock L1 does not execute the management contract
+func (m *mockContractLib) DecodeReceipt(tx *types.Transaction, receipt *types.Receipt) []ethadapter.L1Transaction {
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return nil
 	}
-
-	hashesTx := ethadapter.L1RollupHashes{BlobHashes: blobHashes}
-
-	var buf bytes.Buffer
-	enc := gob.NewEncoder(&buf)
-
-	if err := enc.Encode(hashesTx); err != nil {
-		panic(err)
+	if t := m.DecodeTx(tx); t != nil {
+		return []ethadapter.L1Transaction{t}
 	}
+	return nil
+}
 
-	return &types.BlobTx{
-		To:         rollupTxAddr,
-		Data:       buf.Bytes(),
-		BlobHashes: blobHashes,
-		Sidecar:    sidecar,
-	}, nil
+func (m *mockContractLib) CreateRollup(ctx context.Context, t *ethadapter.L1RollupTx, layer da.Layer) (types.TxData, error) {
+	return layer.CreateRollupTx(ctx, rollupTxAddr, nil, t.Rollup)
 }
 
 func (m *mockContractLib) CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData {
@@ -106,6 +108,18 @@ func (m *mockContractLib) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	return encodeTx(tx, initializeSecretTxAddr)
 }
 
+func (m *mockContractLib) CreateRotateSecret(tx *ethadapter.L1RotateSecretTx) types.TxData {
+	return encodeTx(tx, rotateSecretTxAddr)
+}
+
+func (m *mockContractLib) RequestSecretRotationMsg(uint64) (ethereum.CallMsg, error) {
+	return ethereum.CallMsg{}, nil
+}
+
+func (m *mockContractLib) RevokeEnclaveMsg(gethcommon.Address) (ethereum.CallMsg, error) {
+	return ethereum.CallMsg{}, nil
+}
+
 func (m *mockContractLib) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{}, nil
 }
@@ -156,6 +170,12 @@ func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 		t = &ethadapter.L1RequestSecretTx{}
 	case initializeSecretTxAddr.Hex():
 		t = &ethadapter.L1InitializeSecretTx{}
+	case rotateSecretTxAddr.Hex():
+		t = &ethadapter.L1RotateSecretTx{}
+	case requestRotationTxAddr.Hex():
+		t = &ethadapter.L1RequestSecretRotationTx{}
+	case revokeEnclaveTxAddr.Hex():
+		t = &ethadapter.L1RevokeEnclaveTx{}
 	default:
 		panic("unexpected type")
 	}
@@ -18,6 +18,14 @@ func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transacti
 		height = parent.NumberU64() + 1
 	}
 
+	// the mock node emits a log from the recipient of every transaction, see Node.GetLogs
+	var bloom types.Bloom
+	for _, tx := range txs {
+		if tx.To() != nil {
+			bloom.Add(tx.To().Bytes())
+		}
+	}
+
 	header := types.Header{
 		ParentHash:  parentHash,
 		UncleHash:   common.Hash{},
@@ -25,7 +33,7 @@ func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transacti
 		Root:        common.Hash{},
 		TxHash:      common.Hash{},
 		ReceiptHash: common.Hash{},
-		Bloom:       types.Bloom{},
+		Bloom:       bloom,
 		Difficulty:  big.NewInt(0),
 		Number:      big.NewInt(int64(height)),
 		GasLimit:    0,
@@ -60,6 +60,21 @@ func (n *MockEthNetwork) BroadcastBlock(b common.EncodedL1Block, p common.Encode
 	n.Stats.NewBlock(bl)
 }
 
+// BroadcastFork broadcast the blocks of a fork to the l1 nodes, in a single message so they are processed in order
+func (n *MockEthNetwork) BroadcastFork(blocks []common.EncodedL1Block) {
+	for _, m := range n.AllNodes {
+		if m.Info().L2ID != n.CurrentNode.Info().L2ID {
+			t := m
+			async.Schedule(n.delay(), func() { t.P2PReceiveFork(blocks) })
+		}
+	}
+
+	for _, b := range blocks[1:] {
+		bl, _ := b.DecodeBlock()
+		n.Stats.NewBlock(bl)
+	}
+}
+
 // BroadcastTx Broadcasts the L1 tx containing the rollup to the L1 network
 func (n *MockEthNetwork) BroadcastTx(tx *types.Transaction) {
 	for _, m := range n.AllNodes {
@@ -18,6 +18,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/integration/simulation/faults"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
@@ -38,6 +40,8 @@ const SecondsPerSlot = uint64(12)
 type L1Network interface {
 	// BroadcastBlock - send the block and the parent to make sure there are no gaps
 	BroadcastBlock(b common.EncodedL1Block, p common.EncodedL1Block)
+	// BroadcastFork - send the blocks of a fork in order, starting with their common ancestor with the abandoned chain
+	BroadcastFork(blocks []common.EncodedL1Block)
 	BroadcastTx(tx *types.Transaction)
 }
 
@@ -45,6 +49,7 @@ type MiningConfig struct {
 	PowTime      common.Latency
 	LogFile      string
 	L1BeaconPort int
+	Faults       *faults.Injector // decides the forks mined to reorg the L1, nil for none
 }
 
 type TxDB interface {
@@ -81,6 +86,7 @@ type Node struct {
 
 	p2pCh       chan *types.Block       // this is where blocks received from peers are dropped
 	miningCh    chan *types.Block       // this is where blocks created by the mining setup of the current node are dropped
+	forkCh      chan []*types.Block     // this is where the forks mined by the current node to reorg the L1 are dropped
 	canonicalCh chan *types.Block       // this is where the main processing routine drops blocks that are canonical
 	mempoolCh   chan *types.Transaction // where l1 transactions to be published in the next block are added
 
@@ -135,9 +141,10 @@ func (m *Node) SendTransaction(tx *types.Transaction) error {
 	return nil
 }
 
-func (m *Node) TransactionReceipt(_ gethcommon.Hash) (*types.Receipt, error) {
+func (m *Node) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
 	// all transactions are immediately processed
 	return &types.Receipt{
+		TxHash:      hash,
 		BlockNumber: big.NewInt(1),
 		Status:      types.ReceiptStatusSuccessful,
 	}, nil
@@ -269,8 +276,8 @@ func (m *Node) BalanceAt(gethcommon.Address, *big.Int) (*big.Int, error) {
 	panic("not implemented")
 }
 
-// GetLogs is a mock method - we don't really have logs on the mock transactions, so it returns a basic log for every tx
-// so the host recognises them as relevant
+// GetLogs is a mock method - we don't really have logs on the mock transactions, so it returns a basic log from the
+// recipient of every tx so the host recognises them as relevant
 func (m *Node) GetLogs(fq ethereum.FilterQuery) ([]types.Log, error) {
 	logs := make([]types.Log, 0)
 	if fq.BlockHash == nil {
@@ -281,7 +288,11 @@ func (m *Node) GetLogs(fq ethereum.FilterQuery) ([]types.Log, error) {
 		return nil, fmt.Errorf("could not retrieve block. Cause: %w", err)
 	}
 	for _, tx := range blk.Transactions() {
+		if tx.To() == nil {
+			continue
+		}
 		dummyLog := types.Log{
+			Address:   *tx.To(),
 			BlockHash: blk.Hash(),
 			TxHash:    tx.Hash(),
 		}
@@ -332,6 +343,21 @@ func (m *Node) Start() {
 				}
 				m.Network.BroadcastBlock(encodedBlock, encodedParentBlock)
 			}
+		case fork := <-m.forkCh: // Received from the local mining of a fork
+			for _, b := range fork[1:] {
+				head = m.processBlock(b, head)
+			}
+			if bytes.Equal(head.Hash().Bytes(), fork[len(fork)-1].Hash().Bytes()) { // Only broadcast if it's the new head
+				encodedFork := make([]common.EncodedL1Block, len(fork))
+				for i, b := range fork {
+					encoded, err := common.EncodeBlock(b)
+					if err != nil {
+						panic(fmt.Errorf("could not encode block. Cause: %w", err))
+					}
+					encodedFork[i] = encoded
+				}
+				m.Network.BroadcastFork(encodedFork)
+			}
 		case <-m.headInCh:
 			m.headOutCh <- head
 		case <-m.exitCh:
@@ -366,6 +392,11 @@ func (m *Node) processBlock(b *types.Block, head *types.Block) *types.Block {
 		m.stats.L1Reorg(m.l2ID)
 		fork, err := LCA(context.Background(), head, b, m.BlockResolver)
 		if err != nil {
+			// the blocks are stored before their parents arrive, so an older ancestor of the block can still be missing
+			if errors.Is(err, errutil.ErrNotFound) {
+				m.logger.Info(fmt.Sprintf("Ancestor block not found=b_%d", common.ShortHash(b.Hash())), log.ErrKey, err)
+				return head
+			}
 			panic(err)
 		}
 		m.logger.Info(
@@ -434,6 +465,21 @@ func (m *Node) P2PReceiveBlock(b common.EncodedL1Block, p common.EncodedL1Block)
 	m.p2pCh <- decodedBlock
 }
 
+// P2PReceiveFork is called by counterparties when there is a fork to broadcast
+// The blocks are dropped in the channel for processing in order, so each block is processed after its parent.
+func (m *Node) P2PReceiveFork(blocks []common.EncodedL1Block) {
+	if atomic.LoadInt32(m.interrupt) == 1 {
+		return
+	}
+	for _, b := range blocks {
+		decodedBlock, err := b.DecodeBlock()
+		if err != nil {
+			panic(fmt.Errorf("could not decode block. Cause: %w", err))
+		}
+		m.p2pCh <- decodedBlock
+	}
+}
+
 // startMining - listens on the canonicalCh and schedule a go routine that produces a block after a PowTime and drop it
 // on the miningCh channel
 func (m *Node) startMining() {
@@ -469,6 +515,10 @@ func (m *Node) startMining() {
 				if atomic.LoadInt32(m.interrupt) == 1 {
 					return
 				}
+				if depth := m.cfg.Faults.L1ReorgDepth(m.l2ID.Big().String(), canonicalBlock.NumberU64()); depth > 0 {
+					m.mineFork(canonicalBlock, depth, mempool, blockTime)
+					return
+				}
 				b := NewBlock(canonicalBlock, m.l2ID, toInclude, blockTime)
 				// there is a race condition if we process this at the same time as the blocks, so it has to be placed here
 				err := m.ProcessBlobs(b)
@@ -481,6 +531,34 @@ func (m *Node) startMining() {
 	}
 }
 
+// mineFork mines a chain from the block depth blocks below the head that is one block longer than the chain of the
+// head, so it reorgs the L1 nodes by that depth
+func (m *Node) mineFork(head *types.Block, depth int, mempool []*types.Transaction, blockTime uint64) {
+	ancestor := head
+	for i := 0; i < depth; i++ {
+		parent, err := m.BlockResolver.FetchBlock(context.Background(), ancestor.ParentHash())
+		if err != nil {
+			m.logger.Error("Could not mine fork. Parent block not found.", log.ErrKey, err)
+			return
+		}
+		ancestor = parent
+	}
+
+	fork := []*types.Block{ancestor}
+	// the transactions of the abandoned blocks are included again in the first block of the fork
+	txs := findNotIncludedTxs(ancestor, mempool, m.BlockResolver, m.db)
+	for i := 0; i <= depth; i++ {
+		b := NewBlock(fork[i], m.l2ID, txs, blockTime)
+		if err := m.ProcessBlobs(b); err != nil {
+			m.logger.Crit("Failed to store blobs. Cause: %w", err)
+		}
+		fork = append(fork, b)
+		txs = nil
+	}
+	m.logger.Info(fmt.Sprintf("Mined fork of depth %d from b_%d(%d)", depth, common.ShortHash(ancestor.Hash()), ancestor.NumberU64()))
+	m.forkCh <- fork
+}
+
 // P2PGossipTx receive rollups to publish from the linked aggregators
 func (m *Node) P2PGossipTx(tx *types.Transaction) {
 	if atomic.LoadInt32(m.interrupt) == 1 {
@@ -595,6 +673,7 @@ func NewMiner(
 		interrupt:        new(int32),
 		p2pCh:            make(chan *types.Block),
 		miningCh:         make(chan *types.Block),
+		forkCh:           make(chan []*types.Block),
 		canonicalCh:      make(chan *types.Block),
 		mempoolCh:        make(chan *types.Transaction),
 		headInCh:         make(chan bool),
@@ -102,11 +102,11 @@ func StartSequencerHost() networktest.Action {
 	})
 }
 
-func StopSequencerEnclave() networktest.Action {
+func StopSequencerEnclave(enclaveIdx int) networktest.Action {
 	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
-		fmt.Println("Sequencer: stopping enclave")
+		fmt.Printf("Sequencer: stopping enclave %d\n", enclaveIdx)
 		sequencer := network.GetSequencerNode()
-		err := sequencer.StopEnclave(0)
+		err := sequencer.StopEnclave(enclaveIdx)
 		if err != nil {
 			return nil, err
 		}
@@ -114,11 +114,11 @@ func StopSequencerEnclave() networktest.Action {
 	})
 }
 
-func StartSequencerEnclave() networktest.Action {
+func StartSequencerEnclave(enclaveIdx int) networktest.Action {
 	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
-		fmt.Println("Sequencer: starting enclave")
+		fmt.Printf("Sequencer: starting enclave %d\n", enclaveIdx)
 		sequencer := network.GetSequencerNode()
-		err := sequencer.StartEnclave(0)
+		err := sequencer.StartEnclave(enclaveIdx)
 		if err != nil {
 			return nil, err
 		}
@@ -22,7 +22,7 @@ func SepoliaTestnet(opts ...TestnetEnvOption) networktest.Environment {
 	connector := newTestnetConnector(
 		"http://erpc.sepolia-testnet.ten.xyz:80", // this is actually a validator...
 		[]string{"http://erpc.sepolia-testnet.ten.xyz:80"},
-		"http://sepolia-testnet-faucet.uksouth.azurecontainer.io/fund/eth",
+		"http://sepolia-testnet-faucet.uksouth.azurecontainer.io/fund/eth?wait=true",
 		"https://rpc.sepolia.org/",
 		"https://testnet.ten.xyz",
 		"wss://testnet.ten.xyz:81",
@@ -34,7 +34,7 @@ func UATTestnet(opts ...TestnetEnvOption) networktest.Environment {
 	connector := newTestnetConnector(
 		"http://erpc.uat-testnet.ten.xyz:80", // this is actually a validator...
 		[]string{"http://erpc.uat-testnet.ten.xyz:80"},
-		"http://uat-testnet-faucet.uksouth.azurecontainer.io/fund/eth",
+		"http://uat-testnet-faucet.uksouth.azurecontainer.io/fund/eth?wait=true",
 		"ws://uat-testnet-eth2network.uksouth.cloudapp.azure.com:9000",
 		"https://uat-testnet.ten.xyz",
 		"wss://uat-testnet.ten.xyz:81",
@@ -46,7 +46,7 @@ func DevTestnet(opts ...TestnetEnvOption) networktest.Environment {
 	connector := newTestnetConnector(
 		"http://erpc.dev-testnet.ten.xyz:80", // this is actually a validator...
 		[]string{"http://erpc.dev-testnet.ten.xyz:80"},
-		"http://dev-testnet-faucet.uksouth.azurecontainer.io/fund/eth",
+		"http://dev-testnet-faucet.uksouth.azurecontainer.io/fund/eth?wait=true",
 		"ws://dev-testnet-eth2network.uksouth.cloudapp.azure.com:9000",
 		"https://dev-testnet.ten.xyz",
 		"wss://dev-testnet.ten.xyz:81",
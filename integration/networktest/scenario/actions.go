@@ -0,0 +1,301 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ten-protocol/go-ten/integration/networktest"
+	"github.com/ten-protocol/go-ten/integration/networktest/actions"
+)
+
+// _defaultHealthTimeout is how long the health of a node is awaited for when the step does not set a timeout
+const _defaultHealthTimeout = 30 * time.Second
+
+// builder builds the action of a step from its parameters
+type builder func(step *Step) (networktest.Action, error)
+
+// _builders maps the action names of the scenarios to the actions in integration/networktest/actions
+var _builders map[string]builder
+
+func init() {
+	// assigned in init because the series and parallel builders refer to the map
+	_builders = map[string]builder{
+		"series":   multiAction(actions.NamedSeries),
+		"parallel": multiAction(actions.NamedParallel),
+
+		"create_user":           withParams(createUser),
+		"create_and_fund_users": withParams(createAndFundUsers),
+		"allocate_faucet_funds": withParams(allocateFaucetFunds),
+		"send_native_funds":     withParams(sendNativeFunds),
+		"traffic":               withParams(traffic),
+		"sleep":                 withParams(sleep),
+		"snapshot_balances":     withParams(snapshotBalances),
+
+		"start_validator_enclave":   withParams(validatorAction(actions.StartValidatorEnclave)),
+		"stop_validator_enclave":    withParams(validatorAction(actions.StopValidatorEnclave)),
+		"start_validator_host":      withParams(validatorAction(actions.StartValidatorHost)),
+		"stop_validator_host":       withParams(validatorAction(actions.StopValidatorHost)),
+		"start_sequencer_enclave":   withParams(sequencerEnclaveAction(actions.StartSequencerEnclave)),
+		"stop_sequencer_enclave":    withParams(sequencerEnclaveAction(actions.StopSequencerEnclave)),
+		"start_sequencer_host":      withoutParams(actions.StartSequencerHost),
+		"stop_sequencer_host":       withoutParams(actions.StopSequencerHost),
+		"wait_for_validator_health": withParams(waitForValidatorHealth),
+		"wait_for_sequencer_health": withParams(waitForSequencerHealth),
+
+		"verify_balance":         withParams(verifyBalance),
+		"verify_balance_diff":    withParams(verifyBalanceDiff),
+		"verify_balances_sanity": withoutParams(actions.VerifyUserBalancesSanity),
+	}
+}
+
+// ActionNames returns the names of the actions the scenario steps can use
+func ActionNames() []string {
+	names := make([]string, 0, len(_builders))
+	for name := range _builders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// actions builds the actions of the top level steps of the scenario
+func (s *Scenario) actions() ([]networktest.Action, error) {
+	result := make([]networktest.Action, len(s.Steps))
+	for i := range s.Steps {
+		action, err := buildStep(&s.Steps[i])
+		if err != nil {
+			return nil, fmt.Errorf("step %d - %w", i+1, err)
+		}
+		result[i] = action
+	}
+	return result, nil
+}
+
+func buildStep(step *Step) (networktest.Action, error) {
+	build, ok := _builders[step.Action]
+	if !ok {
+		return nil, fmt.Errorf("unknown action %q, expected one of %v", step.Action, ActionNames())
+	}
+	if len(step.Steps) > 0 && step.Action != "series" && step.Action != "parallel" {
+		return nil, fmt.Errorf("action %s cannot contain steps", step.Action)
+	}
+	action, err := build(step)
+	if err != nil {
+		return nil, fmt.Errorf("%s - %w", step.DisplayName(), err)
+	}
+	return action, nil
+}
+
+func multiAction(newMultiAction func(string, ...networktest.Action) *actions.MultiAction) builder {
+	return func(step *Step) (networktest.Action, error) {
+		if !step.With.IsZero() {
+			return nil, errors.New("series and parallel steps have no parameters")
+		}
+		if len(step.Steps) == 0 {
+			return nil, errors.New("series and parallel steps require steps")
+		}
+		children := make([]networktest.Action, len(step.Steps))
+		for i := range step.Steps {
+			child, err := buildStep(&step.Steps[i])
+			if err != nil {
+				return nil, fmt.Errorf("step %d - %w", i+1, err)
+			}
+			children[i] = child
+		}
+		return newMultiAction(step.Name, children...), nil
+	}
+}
+
+// withParams decodes the `with` parameters of the step before building its action
+func withParams[P any](build func(P) (networktest.Action, error)) builder {
+	return func(step *Step) (networktest.Action, error) {
+		var params P
+		if !step.With.IsZero() {
+			if err := decodeStrict(&step.With, &params); err != nil {
+				return nil, fmt.Errorf("invalid parameters - %w", err)
+			}
+		}
+		return build(params)
+	}
+}
+
+func withoutParams(build func() networktest.Action) builder {
+	return func(step *Step) (networktest.Action, error) {
+		if !step.With.IsZero() {
+			return nil, errors.New("the action has no parameters")
+		}
+		return build(), nil
+	}
+}
+
+type userParams struct {
+	User    int  `yaml:"user"`
+	Gateway bool `yaml:"gateway"`
+}
+
+func createUser(p userParams) (networktest.Action, error) {
+	return &actions.CreateTestUser{UserID: p.User, UseGateway: p.Gateway}, nil
+}
+
+func allocateFaucetFunds(p userParams) (networktest.Action, error) {
+	if p.Gateway {
+		return nil, errors.New("gateway is only a parameter of create_user")
+	}
+	return &actions.AllocateFaucetFunds{UserID: p.User}, nil
+}
+
+type countParams struct {
+	Count int `yaml:"count"`
+}
+
+func createAndFundUsers(p countParams) (networktest.Action, error) {
+	if p.Count <= 0 {
+		return nil, fmt.Errorf("count must be positive, got %d", p.Count)
+	}
+	return actions.CreateAndFundTestUsers(p.Count), nil
+}
+
+type transferParams struct {
+	From       int    `yaml:"from"`
+	To         int    `yaml:"to"`
+	Amount     string `yaml:"amount"`
+	SkipVerify bool   `yaml:"skipVerify"`
+}
+
+func sendNativeFunds(p transferParams) (networktest.Action, error) {
+	amount, err := parseAmount(p.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &actions.SendNativeFunds{FromUser: p.From, ToUser: p.To, Amount: amount, SkipVerify: p.SkipVerify}, nil
+}
+
+type trafficParams struct {
+	TPS      int           `yaml:"tps"`
+	Duration time.Duration `yaml:"duration"`
+}
+
+func traffic(p trafficParams) (networktest.Action, error) {
+	if p.TPS <= 0 || p.Duration <= 0 {
+		return nil, fmt.Errorf("tps and duration must be positive, got %d and %s", p.TPS, p.Duration)
+	}
+	return actions.GenerateUsersRandomisedTransferActionsInParallel(p.TPS, p.Duration), nil
+}
+
+type sleepParams struct {
+	Duration time.Duration `yaml:"duration"`
+	// Min and Max sleep for a random duration between them instead
+	Min time.Duration `yaml:"min"`
+	Max time.Duration `yaml:"max"`
+}
+
+func sleep(p sleepParams) (networktest.Action, error) {
+	if p.Duration > 0 {
+		return actions.SleepAction(p.Duration), nil
+	}
+	if p.Min <= 0 || p.Max < p.Min {
+		return nil, errors.New("either a duration or a min and max duration are required")
+	}
+	return actions.RandomSleepAction(p.Min, p.Max), nil
+}
+
+type snapshotParams struct {
+	Snapshot string `yaml:"snapshot"`
+}
+
+func snapshotBalances(p snapshotParams) (networktest.Action, error) {
+	if p.Snapshot == "" {
+		return nil, errors.New("snapshot is required")
+	}
+	return actions.SnapshotUserBalances(p.Snapshot), nil
+}
+
+type validatorParams struct {
+	Validator int `yaml:"validator"`
+	// Timeout is only a parameter of the health checks
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func validatorAction(newAction func(int) networktest.Action) func(validatorParams) (networktest.Action, error) {
+	return func(p validatorParams) (networktest.Action, error) {
+		if p.Timeout != 0 {
+			return nil, errors.New("timeout is only a parameter of the health checks")
+		}
+		return newAction(p.Validator), nil
+	}
+}
+
+func waitForValidatorHealth(p validatorParams) (networktest.Action, error) {
+	return actions.WaitForValidatorHealthCheck(p.Validator, healthTimeout(p.Timeout)), nil
+}
+
+type sequencerParams struct {
+	Enclave int           `yaml:"enclave"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func sequencerEnclaveAction(newAction func(int) networktest.Action) func(sequencerParams) (networktest.Action, error) {
+	return func(p sequencerParams) (networktest.Action, error) {
+		if p.Timeout != 0 {
+			return nil, errors.New("timeout is only a parameter of the health checks")
+		}
+		return newAction(p.Enclave), nil
+	}
+}
+
+func waitForSequencerHealth(p sequencerParams) (networktest.Action, error) {
+	if p.Enclave != 0 {
+		return nil, errors.New("the health check is the health of the sequencer host, it has no enclave parameter")
+	}
+	return actions.WaitForSequencerHealthCheck(healthTimeout(p.Timeout)), nil
+}
+
+type balanceParams struct {
+	User     int    `yaml:"user"`
+	Expected string `yaml:"expected"`
+}
+
+func verifyBalance(p balanceParams) (networktest.Action, error) {
+	expected, err := parseAmount(p.Expected)
+	if err != nil {
+		return nil, err
+	}
+	return &actions.VerifyBalanceAfterTest{UserID: p.User, ExpectedBalance: expected}, nil
+}
+
+type balanceDiffParams struct {
+	User int `yaml:"user"`
+	// Snapshot defaults to the snapshot taken after the users are funded
+	Snapshot string `yaml:"snapshot"`
+	Diff     string `yaml:"diff"`
+}
+
+func verifyBalanceDiff(p balanceDiffParams) (networktest.Action, error) {
+	diff, err := parseAmount(p.Diff)
+	if err != nil {
+		return nil, err
+	}
+	if p.Snapshot == "" {
+		p.Snapshot = actions.SnapAfterAllocation
+	}
+	return &actions.VerifyBalanceDiffAfterTest{UserID: p.User, Snapshot: p.Snapshot, ExpectedDiff: diff}, nil
+}
+
+func healthTimeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return _defaultHealthTimeout
+	}
+	return timeout
+}
+
+// parseAmount parses an amount of wei written as an integer, negative for the balance decreases
+func parseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q, expected an integer amount of wei", amount)
+	}
+	return value, nil
+}
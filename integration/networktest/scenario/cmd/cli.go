@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Flag names, defaults and usages.
+	scenarioName    = "scenario"
+	scenarioDefault = ""
+	scenarioUsage   = "The YAML or JSON file of the scenario to run. No default, must be set."
+
+	varName  = "var"
+	varUsage = "A variable of the scenario as name=value, overriding the vars of the file. Can be repeated."
+
+	reportName    = "report"
+	reportDefault = ""
+	reportUsage   = "The file the JUnit XML report is written to. No report if empty."
+
+	logDirName    = "logDir"
+	logDirDefault = "./.build/scenario/"
+	logDirUsage   = "The directory the logs of the network and the test users are written to."
+
+	logLevelName    = "logLevel"
+	logLevelDefault = 3 // info
+	logLevelUsage   = "The log level, from 0 (critical) to 5 (trace)."
+)
+
+type cliConfig struct {
+	scenarioPath string
+	vars         map[string]string
+	reportPath   string
+	logDir       string
+	logLevel     int
+}
+
+// varsFlag collects the repeated -var flags
+type varsFlag map[string]string
+
+func (v varsFlag) String() string {
+	entries := make([]string, 0, len(v))
+	for name, value := range v {
+		entries = append(entries, name+"="+value)
+	}
+	return strings.Join(entries, ",")
+}
+
+func (v varsFlag) Set(entry string) error {
+	name, value, found := strings.Cut(entry, "=")
+	if !found || name == "" {
+		return fmt.Errorf("invalid variable %q, expected name=value", entry)
+	}
+	v[name] = value
+	return nil
+}
+
+func parseCLIArgs() *cliConfig {
+	vars := varsFlag{}
+	scenarioPath := flag.String(scenarioName, scenarioDefault, scenarioUsage)
+	flag.Var(vars, varName, varUsage)
+	reportPath := flag.String(reportName, reportDefault, reportUsage)
+	logDir := flag.String(logDirName, logDirDefault, logDirUsage)
+	logLevel := flag.Int(logLevelName, logLevelDefault, logLevelUsage)
+	flag.Parse()
+
+	return &cliConfig{
+		scenarioPath: *scenarioPath,
+		vars:         vars,
+		reportPath:   *reportPath,
+		logDir:       *logDir,
+		logLevel:     *logLevel,
+	}
+}
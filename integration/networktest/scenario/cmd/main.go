@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/networktest"
+	"github.com/ten-protocol/go-ten/integration/networktest/scenario"
+)
+
+// Runs a network test scenario described in YAML or JSON, e.g. from the integration folder:
+//
+//	go run ./networktest/scenario/cmd -scenario networktest/scenario/examples/native_transfers.yaml -var users=3 -report report.xml
+//
+// The process exits with 1 if the scenario fails.
+func main() {
+	cfg := parseCLIArgs()
+	if cfg.scenarioPath == "" {
+		fmt.Fprintln(os.Stderr, "no scenario, set -scenario")
+		os.Exit(2)
+	}
+
+	s, err := scenario.Load(cfg.scenarioPath, cfg.vars)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid scenario %s - %s\n", cfg.scenarioPath, err)
+		os.Exit(2)
+	}
+
+	subtype := strings.TrimSuffix(filepath.Base(cfg.scenarioPath), filepath.Ext(cfg.scenarioPath))
+	logFile := testlog.Setup(&testlog.Cfg{
+		LogDir:      cfg.logDir,
+		TestType:    "scenario",
+		TestSubtype: subtype,
+		LogLevel:    gethlog.FromLegacyLevel(cfg.logLevel),
+	})
+	fmt.Println("Logging to:", logFile.Name())
+
+	// an interrupt skips the remaining steps, and the network is still torn down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	report := scenario.Run(context.WithValue(ctx, networktest.LogFileKey, logFile), s)
+
+	fmt.Print(report.Summary())
+	if cfg.reportPath != "" {
+		if err := writeReport(cfg.reportPath, report); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	if report.Failed() {
+		os.Exit(1)
+	}
+}
+
+func writeReport(path string, report *scenario.Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create the report - %w", err)
+	}
+	defer f.Close()
+	return report.WriteJUnit(f)
+}
@@ -0,0 +1,75 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/integration/networktest"
+	"github.com/ten-protocol/go-ten/integration/networktest/env"
+	"github.com/ten-protocol/go-ten/integration/simulation/devnetwork"
+)
+
+// the environments a scenario can run against
+const (
+	EnvLocal            = "local"
+	EnvLongRunningLocal = "long-running-local"
+	EnvDevTestnet       = "dev-testnet"
+	EnvUATTestnet       = "uat-testnet"
+	EnvSepoliaTestnet   = "sepolia-testnet"
+)
+
+// environment returns the network test environment described by the network section of the scenario
+func (s *Scenario) environment() (networktest.Environment, error) {
+	n := s.Network
+	if n.Env == "" || n.Env == EnvLocal {
+		if n.Validators < 0 {
+			return nil, fmt.Errorf("network has %d validators", n.Validators)
+		}
+		if n.L1WSURL != "" {
+			return nil, errors.New("l1WSURL is only a parameter of the long-running-local network")
+		}
+		var opts []devnetwork.TenConfigOption
+		if n.Validators > 0 {
+			validators := n.Validators
+			opts = append(opts, func(cfg *devnetwork.TenConfig) {
+				cfg.InitNumValidators = validators
+				cfg.NumNodes = validators + 1 // and the sequencer
+			})
+		}
+		if n.Gateway {
+			opts = append(opts, devnetwork.WithGateway())
+		}
+		if n.HASequencer {
+			opts = append(opts, devnetwork.WithHASequencer())
+		}
+		return env.LocalDevNetwork(opts...), nil
+	}
+
+	// the other networks are already running, so they cannot be configured
+	if n.Validators != 0 || n.HASequencer {
+		return nil, fmt.Errorf("validators and haSequencer are only parameters of the local network, not of %s", n.Env)
+	}
+	if n.Env != EnvLongRunningLocal && n.L1WSURL != "" {
+		return nil, fmt.Errorf("l1WSURL is only a parameter of the long-running-local network, not of %s", n.Env)
+	}
+	var opts []env.TestnetEnvOption
+	if n.Gateway {
+		opts = append(opts, env.WithLocalTenGateway())
+	}
+	switch n.Env {
+	case EnvLongRunningLocal:
+		if n.Gateway {
+			return nil, errors.New("the long-running-local network does not run a gateway")
+		}
+		return env.LongRunningLocalNetwork(n.L1WSURL), nil
+	case EnvDevTestnet:
+		return env.DevTestnet(opts...), nil
+	case EnvUATTestnet:
+		return env.UATTestnet(opts...), nil
+	case EnvSepoliaTestnet:
+		return env.SepoliaTestnet(opts...), nil
+	default:
+		return nil, fmt.Errorf("unknown network env %q, expected one of %v", n.Env,
+			[]string{EnvLocal, EnvLongRunningLocal, EnvDevTestnet, EnvUATTestnet, EnvSepoliaTestnet})
+	}
+}
@@ -0,0 +1,146 @@
+package scenario
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Report is the outcome of a scenario run
+type Report struct {
+	Name     string
+	Steps    []StepResult
+	Duration time.Duration
+	// Err is set when the scenario could not run at all, e.g. the network could not be prepared
+	Err error
+}
+
+// StepResult is the outcome of a top level step of the scenario
+type StepResult struct {
+	Name           string
+	RunDuration    time.Duration
+	VerifyDuration time.Duration
+	// Err is the error of the step run or verification
+	Err     error
+	Skipped bool
+}
+
+// Failed returns whether the scenario or any of its steps failed
+func (r *Report) Failed() bool {
+	if r.Err != nil {
+		return true
+	}
+	for _, step := range r.Steps {
+		if step.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary returns a human-readable outcome of the scenario and its steps
+func (r *Report) Summary() string {
+	var sb strings.Builder
+	outcome := "PASSED"
+	if r.Failed() {
+		outcome = "FAILED"
+	}
+	fmt.Fprintf(&sb, "Scenario %s %s in %s\n", r.Name, outcome, r.Duration.Round(time.Millisecond))
+	if r.Err != nil {
+		fmt.Fprintf(&sb, "  %s\n", r.Err)
+	}
+	for _, step := range r.Steps {
+		switch {
+		case step.Skipped:
+			fmt.Fprintf(&sb, "  SKIP %s\n", step.Name)
+		case step.Err != nil:
+			fmt.Fprintf(&sb, "  FAIL %s: %s\n", step.Name, step.Err)
+		default:
+			fmt.Fprintf(&sb, "  PASS %s (%s)\n", step.Name, (step.RunDuration + step.VerifyDuration).Round(time.Millisecond))
+		}
+	}
+	return sb.String()
+}
+
+// the JUnit XML format read by the CI tools, with a test case per step
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as a JUnit XML test suite named after the scenario, with a test case per step. A scenario
+// that could not run is reported as an error of the suite.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:  r.Name,
+		Tests: len(r.Steps),
+		Time:  junitSeconds(r.Duration),
+	}
+	for _, step := range r.Steps {
+		tc := junitTestCase{
+			Name:      step.Name,
+			ClassName: r.Name,
+			Time:      junitSeconds(step.RunDuration + step.VerifyDuration),
+		}
+		switch {
+		case step.Skipped:
+			suite.Skipped++
+			tc.Skipped = &junitMessage{}
+		case step.Err != nil:
+			suite.Failures++
+			tc.Failure = &junitMessage{Message: step.Err.Error(), Text: step.Err.Error()}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	if r.Err != nil {
+		suite.Errors++
+		suite.Tests++
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      "setup",
+			ClassName: r.Name,
+			Time:      junitSeconds(0),
+			Error:     &junitMessage{Message: r.Err.Error(), Text: r.Err.Error()},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return fmt.Errorf("could not write the JUnit report - %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
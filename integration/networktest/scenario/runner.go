@@ -0,0 +1,85 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// _propagationWait is how long the latest transactions of the steps are given to propagate before verifying them, like
+// networktest.Run does
+const _propagationWait = 2 * time.Second
+
+// Run runs the steps of the scenario in series against its network, and then verifies them in the same order.
+//
+// Unlike networktest.Run, it does not stop at the first failed verification, so the report has the outcome of every step.
+// When a step fails to run, the following steps are skipped and no step is verified.
+func Run(ctx context.Context, s *Scenario) *Report {
+	report := &Report{Name: s.Name, Steps: make([]StepResult, len(s.Steps))}
+	start := time.Now()
+	defer func() { report.Duration = time.Since(start) }()
+	for i := range s.Steps {
+		report.Steps[i].Name = fmt.Sprintf("%d. %s", i+1, s.Steps[i].DisplayName())
+	}
+
+	fail := func(err error) *Report {
+		report.Err = err
+		for i := range report.Steps {
+			report.Steps[i].Skipped = true
+		}
+		return report
+	}
+
+	steps, err := s.actions()
+	if err != nil {
+		return fail(err)
+	}
+	environment, err := s.environment()
+	if err != nil {
+		return fail(err)
+	}
+	network, cleanup, err := environment.Prepare()
+	if err != nil {
+		return fail(fmt.Errorf("could not prepare the network - %w", err))
+	}
+	defer cleanup()
+
+	fmt.Println("Started scenario:", s.Name)
+	for i, step := range steps {
+		result := &report.Steps[i]
+		if ctx.Err() != nil {
+			result.Skipped = true
+			continue
+		}
+		fmt.Println("Running step:", result.Name)
+		stepStart := time.Now()
+		var stepCtx context.Context
+		stepCtx, result.Err = step.Run(ctx, network)
+		result.RunDuration = time.Since(stepStart)
+		if result.Err != nil {
+			// the following steps most likely depend on this one
+			for j := i + 1; j < len(steps); j++ {
+				report.Steps[j].Skipped = true
+			}
+			return report
+		}
+		ctx = stepCtx
+	}
+	if ctx.Err() != nil {
+		report.Err = fmt.Errorf("scenario interrupted - %w", ctx.Err())
+		return report
+	}
+
+	time.Sleep(_propagationWait)
+	fmt.Println("Verifying scenario:", s.Name)
+	for i, step := range steps {
+		result := &report.Steps[i]
+		verifyStart := time.Now()
+		err = step.Verify(ctx, network)
+		result.VerifyDuration = time.Since(verifyStart)
+		if err != nil {
+			result.Err = fmt.Errorf("verification failed - %w", err)
+		}
+	}
+	return report
+}
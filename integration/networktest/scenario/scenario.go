@@ -0,0 +1,200 @@
+package scenario
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario is a network test described declaratively: the network to run against and the steps to run on it, each step
+// being one of the actions of integration/networktest/actions. The scenarios are written in YAML, or JSON since it is a
+// subset of YAML.
+//
+// Example:
+//
+//	name: restart-validator-enclave
+//	vars:
+//	  users: 5
+//	network:
+//	  env: local
+//	steps:
+//	  - action: create_and_fund_users
+//	    with:
+//	      count: ${users}
+//	  - action: traffic
+//	    with:
+//	      tps: 4
+//	      duration: 10s
+//	  - action: stop_validator_enclave
+//	    with:
+//	      validator: 1
+//	  - action: verify_balances_sanity
+type Scenario struct {
+	Name string `yaml:"name"`
+	// Vars are the default values of the variables referenced as ${name} in the rest of the scenario
+	Vars    map[string]string `yaml:"vars"`
+	Network Network           `yaml:"network"`
+	Steps   []Step            `yaml:"steps"`
+}
+
+// Network describes the network the scenario runs against
+type Network struct {
+	// Env is one of local (the default), long-running-local, dev-testnet, uat-testnet or sepolia-testnet
+	Env string `yaml:"env"`
+	// Validators is the number of validators of a local network
+	Validators int `yaml:"validators"`
+	// Gateway runs a TEN gateway, for the users created with `gateway: true`
+	Gateway bool `yaml:"gateway"`
+	// HASequencer runs the sequencer of a local network with a standby enclave
+	HASequencer bool `yaml:"haSequencer"`
+	// L1WSURL is the L1 of a long-running local network, only required to test L1 interactions
+	L1WSURL string `yaml:"l1WSURL"`
+}
+
+// Step is a step of a scenario, it maps to an action with the parameters in `with`. The series and parallel steps
+// contain other steps instead.
+type Step struct {
+	Action string    `yaml:"action"`
+	Name   string    `yaml:"name"`
+	With   yaml.Node `yaml:"with"`
+	Steps  []Step    `yaml:"steps"`
+}
+
+// DisplayName is the name of the step in the logs and reports
+func (s *Step) DisplayName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Action
+}
+
+var _varRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Load reads the scenario from the file, see Parse
+func Load(path string, overrides map[string]string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read scenario - %w", err)
+	}
+	return Parse(data, overrides)
+}
+
+// Parse parses the scenario and checks its steps can be built into actions.
+//
+// The ${name} references are substituted with the value of the variable in the overrides, in the vars of the scenario or
+// in the environment, in that order. A value that only is a reference takes the type of the variable value, so
+// `count: "${users}"` is a number when users is.
+func Parse(data []byte, overrides map[string]string) (*Scenario, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("could not parse scenario - %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, errors.New("scenario is empty")
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("scenario must be a mapping")
+	}
+
+	vars := map[string]string{}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "vars" {
+			if err := root.Content[i+1].Decode(&vars); err != nil {
+				return nil, fmt.Errorf("could not parse vars - %w", err)
+			}
+			continue
+		}
+		if err := substitute(root.Content[i+1], func(name string) (string, bool) {
+			return lookupVar(name, overrides, vars)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	s := &Scenario{}
+	if err := decodeStrict(root, s); err != nil {
+		return nil, fmt.Errorf("could not parse scenario - %w", err)
+	}
+	for name, value := range overrides {
+		if s.Vars == nil {
+			s.Vars = map[string]string{}
+		}
+		s.Vars[name] = value
+	}
+	if s.Name == "" {
+		return nil, errors.New("scenario has no name")
+	}
+	if len(s.Steps) == 0 {
+		return nil, errors.New("scenario has no steps")
+	}
+	if _, err := s.environment(); err != nil {
+		return nil, err
+	}
+	if _, err := s.actions(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func lookupVar(name string, overrides map[string]string, vars map[string]string) (string, bool) {
+	if v, ok := overrides[name]; ok {
+		return v, true
+	}
+	if v, ok := vars[name]; ok {
+		return v, true
+	}
+	return os.LookupEnv(name)
+}
+
+// substitute replaces the variable references in the scalars of the node tree
+func substitute(node *yaml.Node, lookup func(string) (string, bool)) error {
+	if node.Kind != yaml.ScalarNode {
+		for _, child := range node.Content {
+			if err := substitute(child, lookup); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var missing []string
+	value := _varRef.ReplaceAllStringFunc(node.Value, func(ref string) string {
+		name := _varRef.FindStringSubmatch(ref)[1]
+		v, ok := lookup(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("line %d: undefined variables %v", node.Line, missing)
+	}
+	if value == node.Value {
+		return nil
+	}
+	// a value made of a single reference is resolved like the variable value written in place of it
+	if _varRef.FindString(node.Value) == node.Value {
+		node.Tag = ""
+		node.Style = 0
+	}
+	node.Value = value
+	return nil
+}
+
+// decodeStrict decodes the node into v, rejecting the fields v does not have so typos in the scenarios are reported
+func decodeStrict(node *yaml.Node, v interface{}) error {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	return decoder.Decode(v)
+}
@@ -0,0 +1,164 @@
+package scenario
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/integration/networktest/actions"
+)
+
+const testScenario = `
+name: test
+vars:
+  users: 4
+  validator: 2
+network:
+  env: ${env}
+  validators: ${validators}
+steps:
+  - action: create_and_fund_users
+    with:
+      count: ${users}
+  - action: parallel
+    steps:
+      - action: traffic
+        with:
+          tps: 2
+          duration: 5s
+      - action: series
+        name: restart
+        steps:
+          - action: stop_validator_enclave
+            with:
+              validator: ${validator}
+          - action: start_validator_enclave
+            with:
+              validator: "${validator}"
+  - action: verify_balance_diff
+    name: user ${validator} balance
+    with:
+      user: 1
+      diff: "-${amount}"
+`
+
+func TestParseSubstitutesVariables(t *testing.T) {
+	t.Setenv("amount", "100")
+	s, err := Parse([]byte(testScenario), map[string]string{"env": "local", "validators": "2", "validator": "1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "local", s.Network.Env)
+	assert.Equal(t, 2, s.Network.Validators)
+	// the overrides win over the vars of the scenario, and are part of its vars
+	assert.Equal(t, map[string]string{"users": "4", "validator": "1", "env": "local", "validators": "2"}, s.Vars)
+	assert.Equal(t, "user 1 balance", s.Steps[2].DisplayName())
+
+	built, err := s.actions()
+	require.NoError(t, err)
+	require.Len(t, built, 3)
+	diff, ok := built[2].(*actions.VerifyBalanceDiffAfterTest)
+	require.True(t, ok)
+	assert.Equal(t, int64(-100), diff.ExpectedDiff.Int64())
+	assert.Equal(t, actions.SnapAfterAllocation, diff.Snapshot)
+}
+
+func TestParseRejectsInvalidScenarios(t *testing.T) {
+	testCases := map[string]struct {
+		scenario string
+		err      string
+	}{
+		"undefined variable": {
+			scenario: "name: x\nsteps:\n  - action: sleep\n    with:\n      duration: ${undefined_scenario_var}\n",
+			err:      "line 5: undefined variables [undefined_scenario_var]",
+		},
+		"unknown action": {
+			scenario: "name: x\nsteps:\n  - action: explode\n",
+			err:      `step 1 - unknown action "explode"`,
+		},
+		"unknown parameter": {
+			scenario: "name: x\nsteps:\n  - action: stop_validator_host\n    with:\n      validatr: 1\n",
+			err:      "step 1 - stop_validator_host - invalid parameters",
+		},
+		"unknown field": {
+			scenario: "name: x\nnetwork:\n  validatorz: 3\nsteps:\n  - action: verify_balances_sanity\n",
+			err:      "field validatorz not found",
+		},
+		"nested invalid step": {
+			scenario: "name: x\nsteps:\n  - action: series\n    steps:\n      - action: sleep\n",
+			err:      "step 1 - series - step 1 - sleep - either a duration or a min and max duration are required",
+		},
+		"steps in a leaf action": {
+			scenario: "name: x\nsteps:\n  - action: sleep\n    steps:\n      - action: sleep\n",
+			err:      "action sleep cannot contain steps",
+		},
+		"invalid amount": {
+			scenario: "name: x\nsteps:\n  - action: verify_balance\n    with:\n      user: 0\n      expected: 1.5\n",
+			err:      `invalid amount "1.5"`,
+		},
+		"validators on a testnet": {
+			scenario: "name: x\nnetwork:\n  env: sepolia-testnet\n  validators: 2\nsteps:\n  - action: verify_balances_sanity\n",
+			err:      "only parameters of the local network",
+		},
+		"unknown env": {
+			scenario: "name: x\nnetwork:\n  env: mainnet\nsteps:\n  - action: verify_balances_sanity\n",
+			err:      `unknown network env "mainnet"`,
+		},
+		"no steps": {
+			scenario: "name: x\n",
+			err:      "scenario has no steps",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.scenario), nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestExamplesParse(t *testing.T) {
+	paths, err := filepath.Glob("examples/*")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+	for _, path := range paths {
+		_, err := Load(path, nil)
+		assert.NoError(t, err, path)
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	report := &Report{
+		Name:     "test",
+		Duration: 3 * time.Second,
+		Steps: []StepResult{
+			{Name: "1. create_and_fund_users", RunDuration: time.Second, VerifyDuration: 500 * time.Millisecond},
+			{Name: "2. traffic", RunDuration: time.Second, Err: errors.New("verification failed - insufficient funds")},
+			{Name: "3. verify_balances_sanity", Skipped: true},
+		},
+	}
+	require.True(t, report.Failed())
+
+	var out bytes.Buffer
+	require.NoError(t, report.WriteJUnit(&out))
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suites))
+
+	require.Len(t, suites.Suites, 1)
+	suite := suites.Suites[0]
+	assert.Equal(t, "test", suite.Name)
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+	assert.Equal(t, "3.000", suite.Time)
+	require.Len(t, suite.Cases, 3)
+	assert.Equal(t, "1.500", suite.Cases[0].Time)
+	assert.Nil(t, suite.Cases[0].Failure)
+	assert.Equal(t, "verification failed - insufficient funds", suite.Cases[1].Failure.Message)
+	assert.NotNil(t, suite.Cases[2].Skipped)
+}
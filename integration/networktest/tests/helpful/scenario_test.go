@@ -0,0 +1,25 @@
+package helpful
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ten-protocol/go-ten/integration/networktest"
+	"github.com/ten-protocol/go-ten/integration/networktest/scenario"
+)
+
+// Runs a scenario file from the IDE, with the variables overriding the vars of the file. See scenario/examples.
+func TestRunScenario(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	s, err := scenario.Load("../../scenario/examples/restart_validator_enclave.yaml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logFile := networktest.EnsureTestLogsSetUp("scenario-" + s.Name)
+
+	report := scenario.Run(context.WithValue(context.Background(), networktest.LogFileKey, logFile), s)
+	t.Log(report.Summary())
+	if report.Failed() {
+		t.Fatal("scenario failed")
+	}
+}
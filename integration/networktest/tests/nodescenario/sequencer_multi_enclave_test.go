@@ -3,6 +3,7 @@ package nodescenario
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ten-protocol/go-ten/integration/networktest"
 	"github.com/ten-protocol/go-ten/integration/networktest/actions"
@@ -28,8 +29,20 @@ func TestMultiEnclaveSequencer(t *testing.T) {
 
 			&actions.SendNativeFunds{FromUser: 0, ToUser: 1, Amount: _transferAmount},
 
-			&actions.VerifyBalanceAfterTest{UserID: 1, ExpectedBalance: _transferAmount},
-			&actions.VerifyBalanceDiffAfterTest{UserID: 0, Snapshot: actions.SnapAfterAllocation, ExpectedDiff: big.NewInt(0).Neg(_transferAmount)},
+			// stop the enclave producing the batches, the standby enclave should be promoted and carry on the chain
+			actions.StopSequencerEnclave(0),
+			actions.WaitForSequencerHealthCheck(30*time.Second),
+
+			&actions.SendNativeFunds{FromUser: 0, ToUser: 1, Amount: _transferAmount},
+
+			// the recovered enclave rejoins as a standby
+			actions.StartSequencerEnclave(0),
+			actions.WaitForSequencerHealthCheck(30*time.Second),
+
+			&actions.SendNativeFunds{FromUser: 0, ToUser: 1, Amount: _transferAmount},
+
+			&actions.VerifyBalanceAfterTest{UserID: 1, ExpectedBalance: big.NewInt(0).Mul(_transferAmount, big.NewInt(3))},
+			&actions.VerifyBalanceDiffAfterTest{UserID: 0, Snapshot: actions.SnapAfterAllocation, ExpectedDiff: big.NewInt(0).Mul(_transferAmount, big.NewInt(-3))},
 		),
 	)
 }
@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/contracts/generated/ConstantSupplyERC20"
+	"github.com/ten-protocol/go-ten/go/obsclient"
+	"github.com/ten-protocol/go-ten/integration"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
+	"github.com/ten-protocol/go-ten/integration/simulation/network"
+	"github.com/ten-protocol/go-ten/integration/simulation/params"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	simstats "github.com/ten-protocol/go-ten/integration/simulation/stats"
+)
+
+// This test checks that the AuthObsClient is a complete backend for abigen bindings, by deploying, calling, transacting
+// with and filtering the events of a contract on a network of in memory nodes.
+// Subscriptions (the Watch* methods of the bindings) need a websocket connection, so they are not covered here.
+func TestAuthObsClientIsAContractBackend(t *testing.T) {
+	setupSimTestLog("contract-backend")
+
+	// a single node, so the L1 mined by the in memory nodes does not fork
+	numberOfNodes := 1
+	wallets := params.NewSimWallets(1, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+	simParams := params.SimParams{
+		NumberOfNodes:              numberOfNodes,
+		AvgBlockDuration:           180 * time.Millisecond,
+		MgmtContractLib:            ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:           ethereummock.NewERC20ContractLibMock(),
+		BlobResolver:               ethereummock.NewMockBlobResolver(),
+		Wallets:                    wallets,
+		IsInMem:                    true,
+		L1TenData:                  &params.L1TenData{},
+		ReceiptTimeout:             10 * time.Second,
+		NodeWithInboundP2PDisabled: numberOfNodes, // all the nodes accept inbound connections
+	}
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	netw := network.NewBasicNetworkOfInMemoryNodes()
+	defer netw.TearDown()
+	rpcHandles, err := netw.Create(&simParams, simstats.NewStats(numberOfNodes))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// the faucet is prefunded in the genesis
+	owner := wallets.L2FaucetWallet
+	client := rpcHandles.TenWalletClient(owner.Address(), 0)
+	waitForFunds(ctx, t, client)
+
+	// the nonces, gas prices and gas limits are all left to the backend
+	auth, err := bind.NewKeyedTransactorWithChainID(owner.PrivateKey(), owner.ChainID())
+	require.NoError(t, err)
+	auth.Context = ctx
+
+	supply := big.NewInt(1_000_000)
+	address, deployTx, token, err := ConstantSupplyERC20.DeployConstantSupplyERC20(auth, client, "Conformance", "CNF", supply)
+	require.NoError(t, err)
+	deployedAt, err := bind.WaitDeployed(ctx, client, deployTx)
+	require.NoError(t, err)
+	assert.Equal(t, address, deployedAt)
+
+	code, err := client.PendingCodeAt(ctx, address)
+	require.NoError(t, err)
+	assert.NotEmpty(t, code)
+
+	balance, err := token.BalanceOf(&bind.CallOpts{Context: ctx}, owner.Address())
+	require.NoError(t, err)
+	assert.Equal(t, supply, balance)
+
+	// the second transfer is sent before the first is executed, so its nonce comes from the pending state of the client
+	recipient := gethcommon.BigToAddress(big.NewInt(0xc0ffee))
+	amounts := []int64{10, 20}
+	receipts := make([]*types.Receipt, len(amounts))
+	txs := make([]*types.Transaction, len(amounts))
+	for i, amount := range amounts {
+		txs[i], err = token.Transfer(auth, recipient, big.NewInt(amount))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, txs[0].Nonce()+1, txs[1].Nonce())
+	for i, tx := range txs {
+		receipts[i], err = bind.WaitMined(ctx, client, tx)
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipts[i].Status)
+	}
+
+	pendingNonce, err := client.PendingNonceAt(ctx, owner.Address())
+	require.NoError(t, err)
+	assert.Equal(t, txs[1].Nonce()+1, pendingNonce)
+
+	transfers, err := token.FilterTransfer(&bind.FilterOpts{Start: receipts[0].BlockNumber.Uint64(), Context: ctx}, []gethcommon.Address{owner.Address()}, nil)
+	require.NoError(t, err)
+	defer transfers.Close()
+	var transferred []int64
+	for transfers.Next() {
+		assert.Equal(t, recipient, transfers.Event.To)
+		transferred = append(transferred, transfers.Event.Value.Int64())
+	}
+	require.NoError(t, transfers.Error())
+	assert.Equal(t, amounts, transferred)
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, head.Number.Cmp(receipts[1].BlockNumber), 0)
+	header, err := client.HeaderByNumber(ctx, receipts[1].BlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, receipts[1].BlockNumber, header.Number)
+}
+
+// waitForFunds waits until the network has produced the batches funding the account of the client
+func waitForFunds(ctx context.Context, t *testing.T, client *obsclient.AuthObsClient) {
+	for {
+		balance, err := client.BalanceAt(ctx, nil)
+		if err == nil && balance.Sign() > 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("account %s was not funded - %s", client.Address().Hex(), ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
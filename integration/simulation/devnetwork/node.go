@@ -179,7 +179,7 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 	}
 	rpcServer := node.NewServer(&rpcConfig, n.logger)
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&hostConfig.ManagementContractAddress, n.logger)
-	l1Repo := l1.NewL1Repository(n.l1Client, []gethcommon.Address{hostConfig.ManagementContractAddress, hostConfig.MessageBusAddress}, n.logger)
+	l1Repo := l1.NewL1Repository(n.l1Client, []gethcommon.Address{hostConfig.ManagementContractAddress, hostConfig.MessageBusAddress}, hostConfig.HealthThresholds.L1HeadAge, n.logger)
 	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(ethadapter.NewBeaconHTTPClient(new(http.Client), fmt.Sprintf("127.0.0.1:%d", n.config.L1BeaconPort))))
 	return hostcontainer.NewHostContainer(hostConfig, svcLocator, nodeP2p, n.l1Client, l1Repo, enclaveClients, mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger), blobResolver)
 }
@@ -193,16 +193,11 @@ func (n *InMemNodeOperator) createEnclaveContainer(idx int) *enclavecontainer.En
 	hostAddr := fmt.Sprintf("%s:%d", network.Localhost, hostPort)
 
 	defaultCfg := integrationCommon.DefaultEnclaveConfig()
-	enclaveType := n.nodeType
-	if n.nodeType == common.Sequencer && idx > 0 {
-		// we only want one sequencer enclave for now
-		enclaveType = common.Validator
-	}
 	enclaveConfig := &config.EnclaveConfig{
 		HostID:                    n.l1Wallet.Address(),
 		HostAddress:               hostAddr,
 		Address:                   enclaveAddr,
-		NodeType:                  enclaveType,
+		NodeType:                  n.nodeType,
 		L1ChainID:                 integration.EthereumChainID,
 		ObscuroChainID:            integration.TenChainID,
 		ValidateL1Blocks:          false,
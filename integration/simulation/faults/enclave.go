@@ -0,0 +1,494 @@
+package faults
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+// ErrEnclaveCrashed is returned by the calls to an enclave while it is crashed
+var ErrEnclaveCrashed = errors.New("enclave crashed")
+
+// crashingEnclave is an enclave that crashes while creating or processing batches, as decided by the injector. A crash
+// stops the enclave, and after the downtime a new enclave is started from the database of the crashed one, so only the
+// state the enclave persisted survives the crash, as with an enclave process that is killed and restarted.
+//
+// Every call fails while the enclave is down, as it would with the host connected to an enclave process that is
+// restarting, and the stream of L2 updates ends so the host reconnects to the restarted enclave.
+type crashingEnclave struct {
+	id       string
+	injector *Injector
+	start    func() common.Enclave // starts the enclave from its database
+
+	mu      sync.RWMutex
+	enclave common.Enclave // nil while the enclave is down
+	crashed chan struct{}  // closed when the running enclave crashes
+	stopped bool
+}
+
+// Enclave returns the enclave started by the function, wrapped so it crashes and restarts as the injector decides.
+// Restarting an enclave calls the function again, so it must start the enclave from the database of the previous one.
+func (i *Injector) Enclave(id string, start func() common.Enclave) common.Enclave {
+	if !i.CrashesEnclaves() {
+		return start()
+	}
+	return &crashingEnclave{id: id, injector: i, start: start, enclave: start(), crashed: make(chan struct{})}
+}
+
+// CrashesEnclaves returns whether the injector crashes the enclaves, which then need a database that outlives them
+func (i *Injector) CrashesEnclaves() bool {
+	return i != nil && i.cfg.Enclave.CrashRate > 0
+}
+
+func (e *crashingEnclave) GetTotalContractCount(ctx context.Context) (*big.Int, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetTotalContractCount(ctx)
+}
+
+func (e *crashingEnclave) GetPersonalTransactions(ctx context.Context, encryptedParams common.EncryptedParamsGetPersonalTransactions) (*responses.PersonalTransactionsResponse, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetPersonalTransactions(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) EnclavePublicConfig(ctx context.Context) (*common.EnclavePublicConfig, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.EnclavePublicConfig(ctx)
+}
+
+func (e *crashingEnclave) GetPublicContract(ctx context.Context, address gethcommon.Address) (*common.PublicContract, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetPublicContract(ctx, address)
+}
+
+func (e *crashingEnclave) GetPublicContractEvents(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.PublicEventListingResponse, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetPublicContractEvents(ctx, address, pagination)
+}
+
+func (e *crashingEnclave) GetPublicAddressActivity(ctx context.Context, address gethcommon.Address, pagination *common.QueryPagination) (*common.AddressActivity, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetPublicAddressActivity(ctx, address, pagination)
+}
+
+func (e *crashingEnclave) Status(ctx context.Context) (common.Status, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return common.Status{StatusCode: common.Unavailable}, err
+	}
+	return enclave.Status(ctx)
+}
+
+func (e *crashingEnclave) Attestation(ctx context.Context) (*common.AttestationReport, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.Attestation(ctx)
+}
+
+func (e *crashingEnclave) GenerateSecret(ctx context.Context) (common.EncryptedSharedEnclaveSecret, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GenerateSecret(ctx)
+}
+
+func (e *crashingEnclave) InitEnclave(ctx context.Context, secret common.EncryptedSharedEnclaveSecret) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	return enclave.InitEnclave(ctx, secret)
+}
+
+func (e *crashingEnclave) EnclaveID(ctx context.Context) (common.EnclaveID, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return common.EnclaveID{}, err
+	}
+	return enclave.EnclaveID(ctx)
+}
+
+func (e *crashingEnclave) SubmitL1Block(ctx context.Context, blockHeader *gethtypes.Header, receipts []*common.TxAndReceiptAndBlobs) (*common.BlockSubmissionResponse, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.SubmitL1Block(ctx, blockHeader, receipts)
+}
+
+func (e *crashingEnclave) SubmitTx(ctx context.Context, tx common.EncryptedTx) (*responses.RawTx, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.SubmitTx(ctx, tx)
+}
+
+func (e *crashingEnclave) SubmitBatch(ctx context.Context, batch *common.ExtBatch) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	return e.crashDuring(ctx, enclave, "SubmitBatch", batch.Header.SequencerOrderNo.Uint64(), func(ctx context.Context) common.SystemError {
+		return enclave.SubmitBatch(ctx, batch)
+	})
+}
+
+func (e *crashingEnclave) ObsCall(ctx context.Context, encryptedParams common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.ObsCall(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) GetTransactionCount(ctx context.Context, encryptedParams common.EncryptedParamsGetTxCount) (*responses.TxCount, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetTransactionCount(ctx, encryptedParams)
+}
+
+// Stop stops the running enclave, and prevents a crashed enclave from restarting
+func (e *crashingEnclave) Stop() common.SystemError {
+	e.mu.Lock()
+	enclave := e.enclave
+	e.enclave = nil
+	e.stopped = true
+	if enclave != nil {
+		close(e.crashed)
+	}
+	e.mu.Unlock()
+	if enclave == nil {
+		return nil
+	}
+	return enclave.Stop()
+}
+
+func (e *crashingEnclave) GetTransaction(ctx context.Context, encryptedParams common.EncryptedParamsGetTxByHash) (*responses.TxByHash, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetTransaction(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) GetTransactionReceipt(ctx context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetTransactionReceipt(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) GetBalance(ctx context.Context, encryptedParams common.EncryptedParamsGetBalance) (*responses.Balance, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetBalance(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) GetCode(ctx context.Context, address gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetCode(ctx, address, blockNrOrHash)
+}
+
+func (e *crashingEnclave) GetStorageSlot(ctx context.Context, encryptedParams common.EncryptedParamsGetStorageSlot) (*responses.EnclaveResponse, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetStorageSlot(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) Subscribe(ctx context.Context, id rpc.ID, encryptedParams common.EncryptedParamsLogSubscription) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	return enclave.Subscribe(ctx, id, encryptedParams)
+}
+
+func (e *crashingEnclave) Unsubscribe(id rpc.ID) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	return enclave.Unsubscribe(id)
+}
+
+func (e *crashingEnclave) StopClient() common.SystemError {
+	return nil // the enclave is local so there is no client to stop
+}
+
+func (e *crashingEnclave) EstimateGas(ctx context.Context, encryptedParams common.EncryptedParamsEstimateGas) (*responses.Gas, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.EstimateGas(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) GetLogs(ctx context.Context, encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetLogs(ctx, encryptedParams)
+}
+
+func (e *crashingEnclave) HealthCheck(ctx context.Context) (bool, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return false, err
+	}
+	return enclave.HealthCheck(ctx)
+}
+
+func (e *crashingEnclave) Metrics(ctx context.Context) ([]byte, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.Metrics(ctx)
+}
+
+func (e *crashingEnclave) GetBatch(ctx context.Context, hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetBatch(ctx, hash)
+}
+
+func (e *crashingEnclave) GetBatchBySeqNo(ctx context.Context, seqNo uint64) (*common.ExtBatch, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetBatchBySeqNo(ctx, seqNo)
+}
+
+func (e *crashingEnclave) GetRollupData(ctx context.Context, hash common.L2RollupHash) (*common.PublicRollupMetadata, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.GetRollupData(ctx, hash)
+}
+
+func (e *crashingEnclave) CreateBatch(ctx context.Context, skipIfEmpty bool) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	// the batch created is identified by the sequence number following the head of the enclave
+	status, err := enclave.Status(ctx)
+	if err != nil {
+		return err
+	}
+	return e.crashDuring(ctx, enclave, "CreateBatch", status.L2Head.Uint64()+1, func(ctx context.Context) common.SystemError {
+		return enclave.CreateBatch(ctx, skipIfEmpty)
+	})
+}
+
+func (e *crashingEnclave) SetActiveSequencer(ctx context.Context, active bool) common.SystemError {
+	enclave, err := e.running()
+	if err != nil {
+		return err
+	}
+	return enclave.SetActiveSequencer(ctx, active)
+}
+
+func (e *crashingEnclave) CreateRollup(ctx context.Context, fromSeqNo uint64, daMode common.DAMode) (*common.ExtRollup, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.CreateRollup(ctx, fromSeqNo, daMode)
+}
+
+func (e *crashingEnclave) DebugTraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.DebugTraceTransaction(ctx, hash, config)
+}
+
+// StreamL2Updates streams the updates of the running enclave until it crashes. The stream is closed then, and the host
+// opens a new one, which is closed straight away until the enclave has restarted.
+func (e *crashingEnclave) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
+	e.mu.RLock()
+	enclave, crashed := e.enclave, e.crashed
+	e.mu.RUnlock()
+	out := make(chan common.StreamL2UpdatesResponse)
+	if enclave == nil {
+		close(out)
+		return out, func() {}
+	}
+
+	updates, stopUpdates := enclave.StreamL2Updates()
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			stopUpdates()
+		})
+	}
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case resp, ok := <-updates:
+				if !ok {
+					return
+				}
+				select {
+				case out <- resp:
+				case <-crashed:
+					return
+				case <-done:
+					return
+				}
+			case <-crashed:
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out, stop
+}
+
+func (e *crashingEnclave) DebugEventLogRelevancy(ctx context.Context, params common.EncryptedParamsDebugLogRelevancy) (*responses.DebugLogs, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.DebugEventLogRelevancy(ctx, params)
+}
+
+func (e *crashingEnclave) ExportCrossChainData(ctx context.Context, fromSeqNo uint64, toSeqNo uint64) (*common.ExtCrossChainBundle, common.SystemError) {
+	enclave, err := e.running()
+	if err != nil {
+		return nil, err
+	}
+	return enclave.ExportCrossChainData(ctx, fromSeqNo, toSeqNo)
+}
+
+// crashDuring makes the call to the enclave for the batch with that sequence number, crashing the enclave before,
+// during or after the call if the injector decides so
+func (e *crashingEnclave) crashDuring(ctx context.Context, enclave common.Enclave, call string, batch uint64, do func(context.Context) common.SystemError) common.SystemError {
+	point, delay := e.injector.EnclaveCrash(e.id, call, batch)
+	switch point {
+	case NoCrash:
+		return do(ctx)
+	case CrashBefore:
+	case CrashDuring:
+		// the call is cut short, only what the enclave persisted before the crash survives the restart
+		callCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = do(callCtx)
+		}()
+		select {
+		case <-time.After(delay):
+		case <-done:
+		}
+		cancel()
+	case CrashAfter:
+		// the enclave completed the call, but crashed before the host got the response
+		_ = do(ctx)
+	}
+	e.crash(enclave)
+	return syserr.NewRPCError(ErrEnclaveCrashed)
+}
+
+// crash stops the enclave and starts it again from its database after the downtime
+func (e *crashingEnclave) crash(enclave common.Enclave) {
+	e.mu.Lock()
+	if e.enclave != enclave {
+		// the enclave already crashed during another call, or was stopped
+		e.mu.Unlock()
+		return
+	}
+	e.enclave = nil
+	close(e.crashed)
+	e.mu.Unlock()
+
+	restartAt := time.Now().Add(e.injector.EnclaveDowntime())
+	go func() {
+		// the enclave must have released its database before it is started from it again
+		_ = enclave.Stop()
+		time.Sleep(time.Until(restartAt))
+
+		if e.isStopped() {
+			return
+		}
+		restarted := e.start()
+		e.mu.Lock()
+		if e.stopped {
+			e.mu.Unlock()
+			_ = restarted.Stop()
+			return
+		}
+		e.enclave = restarted
+		e.crashed = make(chan struct{})
+		e.mu.Unlock()
+	}()
+}
+
+func (e *crashingEnclave) isStopped() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.stopped
+}
+
+// running returns the running enclave, or an error if it is crashed
+func (e *crashingEnclave) running() (common.Enclave, common.SystemError) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.enclave == nil {
+		return nil, syserr.NewRPCError(ErrEnclaveCrashed)
+	}
+	return e.enclave, nil
+}
@@ -0,0 +1,329 @@
+package faults
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// the kinds of faults the injector records
+const (
+	KindDrop         = "drop"
+	KindDuplicate    = "duplicate"
+	KindDelay        = "delay"
+	KindReorder      = "reorder"
+	KindL1Reorg      = "l1-reorg"
+	KindEnclaveCrash = "enclave-crash"
+)
+
+// _defaultMaxDelay bounds the delays and the reorderings of the P2P messages when the config does not
+const _defaultMaxDelay = 500 * time.Millisecond
+
+// _maxCrashDelay bounds how long into a call an enclave crashes during the call
+const _maxCrashDelay = 50 * time.Millisecond
+
+// CrashPoint is when an enclave crashes during a call
+type CrashPoint int
+
+const (
+	NoCrash     CrashPoint = iota
+	CrashBefore            // the enclave crashes before starting the call
+	CrashDuring            // the enclave crashes while making the call, which is cut short
+	CrashAfter             // the enclave completes the call, but crashes before returning the response
+)
+
+func (p CrashPoint) String() string {
+	switch p {
+	case CrashBefore:
+		return "before"
+	case CrashDuring:
+		return "during"
+	case CrashAfter:
+		return "after"
+	default:
+		return "none"
+	}
+}
+
+// Config describes the faults injected into an in-memory simulation. Each rate is the probability that a message,
+// mined block or batch is affected.
+type Config struct {
+	// Seed decides every fault, a run with the same seed injects the same fault into each message, block and batch it
+	// shares with the first run. It does not replay the first run exactly, see Injector.
+	Seed    int64
+	P2P     P2PConfig
+	L1      L1Config
+	Enclave EnclaveConfig
+}
+
+// P2PConfig describes the faults of the messages between the hosts. A message is affected by one fault at most, so the
+// rates add up to 1 at most.
+type P2PConfig struct {
+	DropRate      float64
+	DuplicateRate float64
+	DelayRate     float64
+	// ReorderRate is the rate of the messages delivered after the next message between the same nodes
+	ReorderRate float64
+	// MaxDelay is the longest extra delay of a message, and how long a reordered message waits for the message overtaking it
+	MaxDelay time.Duration
+}
+
+// L1Config describes the reorgs of the mock L1
+type L1Config struct {
+	// ReorgRate is the rate of the blocks mined on a fork rather than on the head
+	ReorgRate float64
+	// MaxReorgDepth is the deepest the mock L1 forks below its head, the depth of each reorg being between 1 and this
+	MaxReorgDepth int
+}
+
+// EnclaveConfig describes the crashes of the enclaves
+type EnclaveConfig struct {
+	// CrashRate is the rate of the batches an enclave crashes while creating or processing
+	CrashRate float64
+	// Downtime is how long a crashed enclave takes to restart
+	Downtime time.Duration
+}
+
+// Validate checks the rates are probabilities and the reorgs do not go deeper than the mock L1 keeps transactions for
+func (c *Config) Validate() error {
+	rates := map[string]float64{
+		"P2P.DropRate":      c.P2P.DropRate,
+		"P2P.DuplicateRate": c.P2P.DuplicateRate,
+		"P2P.DelayRate":     c.P2P.DelayRate,
+		"P2P.ReorderRate":   c.P2P.ReorderRate,
+		"L1.ReorgRate":      c.L1.ReorgRate,
+		"Enclave.CrashRate": c.Enclave.CrashRate,
+	}
+	for name, rate := range rates {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("%s must be between 0 and 1, got %f", name, rate)
+		}
+	}
+	if c.P2P.DropRate+c.P2P.DuplicateRate+c.P2P.DelayRate+c.P2P.ReorderRate > 1 {
+		return errors.New("the P2P rates add up to more than 1")
+	}
+	if c.L1.ReorgRate > 0 && (c.L1.MaxReorgDepth < 1 || c.L1.MaxReorgDepth >= common.HeightCommittedBlocks) {
+		return fmt.Errorf("L1.MaxReorgDepth must be between 1 and %d", common.HeightCommittedBlocks-1)
+	}
+	if c.Enclave.CrashRate > 0 && c.Enclave.Downtime <= 0 {
+		return errors.New("Enclave.Downtime must be positive")
+	}
+	return nil
+}
+
+// Event is a fault the injector injected
+type Event struct {
+	Time   time.Time
+	Kind   string
+	Target string // the message, block or enclave affected
+	Detail string
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s %s %s", e.Time.Format("15:04:05.000"), e.Kind, e.Target, e.Detail)
+}
+
+// Injector decides the faults injected into the simulation and records them.
+//
+// Every decision is derived from the seed and a deterministic key, the identity of what it applies to: the kind, ends
+// and content of a P2P message and how many times that same message was sent before, the miner and height of an L1
+// block, the enclave, call and sequence number of a batch. No decision depends on the order the goroutines ask for it.
+//
+// Exact replay of a run is not delivered. The scheduling of the goroutines and the wall clock decide which messages,
+// blocks and batches a run produces, and when, so a run with the same seed injects the same fault into the messages,
+// blocks and batches it shares with the first run, but it diverges from the first run as soon as their timing differs.
+//
+// The injector only injects faults between Start and Stop, so the network can bootstrap and recover.
+type Injector struct {
+	cfg    Config
+	logger gethlog.Logger
+
+	active atomic.Bool
+
+	mu          sync.Mutex
+	occurrences map[string]int
+	events      []Event
+}
+
+// NewInjector returns an injector of the faults described by the config, inactive until it is started
+func NewInjector(cfg Config, logger gethlog.Logger) (*Injector, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fault config - %w", err)
+	}
+	if cfg.P2P.MaxDelay <= 0 {
+		cfg.P2P.MaxDelay = _defaultMaxDelay
+	}
+	return &Injector{
+		cfg:         cfg,
+		logger:      logger,
+		occurrences: map[string]int{},
+	}, nil
+}
+
+// Seed returns the seed the faults are derived from
+func (i *Injector) Seed() int64 {
+	return i.cfg.Seed
+}
+
+// Start starts injecting faults
+func (i *Injector) Start() {
+	i.logger.Info("Starting fault injection.", "seed", i.cfg.Seed)
+	i.active.Store(true)
+}
+
+// Stop stops injecting faults. The faults already injected still play out, e.g. a crashed enclave restarts after its downtime.
+func (i *Injector) Stop() {
+	i.active.Store(false)
+	i.logger.Info("Stopped fault injection.", "seed", i.cfg.Seed, "faults", i.Summary())
+}
+
+// Events returns the faults injected so far
+func (i *Injector) Events() []Event {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]Event(nil), i.events...)
+}
+
+// Summary returns the number of faults of each kind injected so far
+func (i *Injector) Summary() string {
+	counts := map[string]int{}
+	for _, e := range i.Events() {
+		counts[e.Kind]++
+	}
+	kinds := make([]string, 0, len(counts))
+	for kind, count := range counts {
+		kinds = append(kinds, fmt.Sprintf("%s=%d", kind, count))
+	}
+	sort.Strings(kinds)
+	return strings.Join(kinds, " ")
+}
+
+// P2PFault is the fault of a P2P message, at most one of its fields is set
+type P2PFault struct {
+	Drop      bool
+	Duplicate bool
+	Delay     time.Duration
+	Reorder   bool
+}
+
+// P2PFault returns the fault of the message of that kind from one host to another, identified by its content
+func (i *Injector) P2PFault(kind string, from string, to string, id string) P2PFault {
+	var fault P2PFault
+	if i == nil || !i.active.Load() {
+		return fault
+	}
+	target := fmt.Sprintf("%s(%s->%s %s)", kind, from, to, id)
+	// the same message can be sent again, e.g. a batch request retried after it was dropped, so it gets a new decision
+	r := i.rand("p2p", target, i.occurrence(target))
+
+	cfg := i.cfg.P2P
+	x := r.Float64()
+	switch {
+	case x < cfg.DropRate:
+		fault.Drop = true
+		i.record(KindDrop, target, "")
+	case x < cfg.DropRate+cfg.DuplicateRate:
+		fault.Duplicate = true
+		i.record(KindDuplicate, target, "")
+	case x < cfg.DropRate+cfg.DuplicateRate+cfg.DelayRate:
+		fault.Delay = time.Duration(1 + r.Int63n(int64(cfg.MaxDelay)))
+		i.record(KindDelay, target, fault.Delay.String())
+	case x < cfg.DropRate+cfg.DuplicateRate+cfg.DelayRate+cfg.ReorderRate:
+		fault.Reorder = true
+		i.record(KindReorder, target, "")
+	}
+	return fault
+}
+
+// MaxP2PDelay is how long a reordered message waits for the message overtaking it before it is delivered anyway
+func (i *Injector) MaxP2PDelay() time.Duration {
+	return i.cfg.P2P.MaxDelay
+}
+
+// L1ReorgDepth returns how many blocks below its head the miner mines its next block from, 0 to mine on the head
+func (i *Injector) L1ReorgDepth(miner string, headHeight uint64) int {
+	if i == nil || !i.active.Load() {
+		return 0
+	}
+	target := fmt.Sprintf("block(miner=%s height=%d)", miner, headHeight+1)
+	r := i.rand("l1", target)
+	if r.Float64() >= i.cfg.L1.ReorgRate {
+		return 0
+	}
+	depth := 1 + r.Intn(i.cfg.L1.MaxReorgDepth)
+	// the genesis block is never reorged
+	if uint64(depth) >= headHeight {
+		return 0
+	}
+	i.record(KindL1Reorg, target, fmt.Sprintf("depth=%d", depth))
+	return depth
+}
+
+// MaxL1ReorgDepth is the deepest reorg the injector can make the mock L1 go through
+func (i *Injector) MaxL1ReorgDepth() int {
+	if i == nil || i.cfg.L1.ReorgRate == 0 {
+		return 0
+	}
+	return i.cfg.L1.MaxReorgDepth
+}
+
+// EnclaveCrash returns when the enclave crashes during the call for the batch, if it does, and for a crash during the
+// call how long into the call it crashes
+func (i *Injector) EnclaveCrash(enclave string, call string, batch uint64) (CrashPoint, time.Duration) {
+	if i == nil || !i.active.Load() {
+		return NoCrash, 0
+	}
+	target := fmt.Sprintf("enclave(%s %s batch=%d)", enclave, call, batch)
+	r := i.rand("enclave", target, i.occurrence(target))
+	if r.Float64() >= i.cfg.Enclave.CrashRate {
+		return NoCrash, 0
+	}
+	point := CrashPoint(1 + r.Intn(3))
+	var delay time.Duration
+	if point == CrashDuring {
+		delay = time.Duration(1 + r.Int63n(int64(_maxCrashDelay)))
+	}
+	i.record(KindEnclaveCrash, target, fmt.Sprintf("point=%s delay=%s downtime=%s", point, delay, i.cfg.Enclave.Downtime))
+	return point, delay
+}
+
+// EnclaveDowntime is how long a crashed enclave takes to restart
+func (i *Injector) EnclaveDowntime() time.Duration {
+	return i.cfg.Enclave.Downtime
+}
+
+// rand returns a source of randomness determined by the seed and the parts
+func (i *Injector) rand(parts ...any) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = fmt.Fprint(h, i.cfg.Seed)
+	for _, part := range parts {
+		_, _ = fmt.Fprintf(h, "/%v", part)
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64()))) //nolint:gosec
+}
+
+// occurrence returns how many times the target was decided on before
+func (i *Injector) occurrence(target string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	n := i.occurrences[target]
+	i.occurrences[target] = n + 1
+	return n
+}
+
+func (i *Injector) record(kind string, target string, detail string) {
+	event := Event{Time: time.Now(), Kind: kind, Target: target, Detail: detail}
+	i.mu.Lock()
+	i.events = append(i.events, event)
+	i.mu.Unlock()
+	i.logger.Info("Injected fault.", "kind", kind, "target", target, "detail", detail)
+}
@@ -0,0 +1,221 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+var _testConfig = Config{
+	Seed: 42,
+	P2P: P2PConfig{
+		DropRate:      0.1,
+		DuplicateRate: 0.1,
+		DelayRate:     0.1,
+		ReorderRate:   0.1,
+	},
+	L1:      L1Config{ReorgRate: 0.2, MaxReorgDepth: 3},
+	Enclave: EnclaveConfig{CrashRate: 0.2, Downtime: 100 * time.Millisecond},
+}
+
+func TestSameSeedInjectsSameFaults(t *testing.T) {
+	first := decisions(t, _testConfig)
+	if len(first) == 0 {
+		t.Fatal("expected faults to be injected")
+	}
+	second := decisions(t, _testConfig)
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Errorf("expected the same faults for the same seed, got %v and %v", first, second)
+	}
+
+	otherSeed := _testConfig
+	otherSeed.Seed++
+	if fmt.Sprint(first) == fmt.Sprint(decisions(t, otherSeed)) {
+		t.Error("expected different faults for a different seed")
+	}
+}
+
+func TestNoFaultsUntilStarted(t *testing.T) {
+	cfg := _testConfig
+	cfg.P2P = P2PConfig{DropRate: 1}
+	injector, err := NewInjector(cfg, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if injector.P2PFault("tx", "0", "1", "a").Drop {
+		t.Error("expected no fault before the injector is started")
+	}
+	injector.Start()
+	if !injector.P2PFault("tx", "0", "1", "a").Drop {
+		t.Error("expected a fault once the injector is started")
+	}
+	injector.Stop()
+	if injector.P2PFault("tx", "0", "1", "a").Drop {
+		t.Error("expected no fault after the injector is stopped")
+	}
+
+	var noInjector *Injector
+	if noInjector.P2PFault("tx", "0", "1", "a") != (P2PFault{}) || noInjector.L1ReorgDepth("0", 100) != 0 || noInjector.MaxL1ReorgDepth() != 0 {
+		t.Error("expected no faults without an injector")
+	}
+}
+
+func TestInvalidConfigsAreRejected(t *testing.T) {
+	invalid := map[string]func(*Config){
+		"rate above 1":          func(c *Config) { c.L1.ReorgRate = 1.5 },
+		"negative rate":         func(c *Config) { c.P2P.DropRate = -0.1 },
+		"P2P rates above 1":     func(c *Config) { c.P2P.DelayRate = 0.8 },
+		"reorg too deep":        func(c *Config) { c.L1.MaxReorgDepth = common.HeightCommittedBlocks },
+		"reorg without depth":   func(c *Config) { c.L1.MaxReorgDepth = 0 },
+		"crash without restart": func(c *Config) { c.Enclave.Downtime = 0 },
+	}
+	for name, mutate := range invalid {
+		cfg := _testConfig
+		mutate(&cfg)
+		if _, err := NewInjector(cfg, gethlog.New()); err == nil {
+			t.Errorf("%s: expected the config to be rejected", name)
+		}
+	}
+}
+
+func TestCrashedEnclaveRestartsFromItsDatabase(t *testing.T) {
+	cfg := _testConfig
+	cfg.Enclave.CrashRate = 1
+	injector, err := NewInjector(cfg, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var started []*stubEnclave
+	enclave := injector.Enclave("0", func() common.Enclave {
+		started = append(started, &stubEnclave{})
+		return started[len(started)-1]
+	})
+	batch := &common.ExtBatch{Header: &common.BatchHeader{SequencerOrderNo: big.NewInt(1)}}
+
+	if err := enclave.SubmitBatch(context.Background(), batch); err != nil {
+		t.Fatalf("expected the enclave not to crash before the injector is started, got %s", err)
+	}
+	injector.Start()
+	if err := enclave.SubmitBatch(context.Background(), batch); !errors.Is(err, ErrEnclaveCrashed) {
+		t.Fatalf("expected the enclave to crash, got %v", err)
+	}
+	injector.Stop()
+	if _, err := enclave.Status(context.Background()); !errors.Is(err, ErrEnclaveCrashed) {
+		t.Fatalf("expected the enclave to be down, got %v", err)
+	}
+	updates, _ := enclave.StreamL2Updates()
+	if _, ok := <-updates; ok {
+		t.Fatal("expected no stream of updates while the enclave is down")
+	}
+
+	time.Sleep(2 * cfg.Enclave.Downtime)
+	if _, err := enclave.Status(context.Background()); err != nil {
+		t.Fatalf("expected the enclave to have restarted, got %s", err)
+	}
+	if len(started) != 2 || !started[0].stopped.Load() {
+		t.Fatalf("expected the crashed enclave to be stopped and a new one started, got %d enclaves started", len(started))
+	}
+	if err := enclave.SubmitBatch(context.Background(), batch); err != nil {
+		t.Fatalf("expected the enclave to process batches after restarting, got %s", err)
+	}
+	if started[1].batches != 1 {
+		t.Errorf("expected the batch to be processed by the restarted enclave")
+	}
+}
+
+func TestEnclaveCrashDuringCallCutsItShort(t *testing.T) {
+	cfg := _testConfig
+	cfg.Enclave.CrashRate = 1
+	// finds a batch the enclave crashes in the middle of processing, with a separate injector so the decisions of the
+	// injector under test are not used up
+	finder, err := NewInjector(cfg, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder.Start()
+	seqNo := uint64(0)
+	for point, _ := finder.EnclaveCrash("0", "SubmitBatch", seqNo); point != CrashDuring; point, _ = finder.EnclaveCrash("0", "SubmitBatch", seqNo) {
+		seqNo++
+	}
+
+	injector, err := NewInjector(cfg, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stub := &stubEnclave{slowBatches: true}
+	enclave := injector.Enclave("0", func() common.Enclave { return stub })
+	injector.Start()
+	batch := &common.ExtBatch{Header: &common.BatchHeader{SequencerOrderNo: big.NewInt(int64(seqNo))}}
+	if err := enclave.SubmitBatch(context.Background(), batch); !errors.Is(err, ErrEnclaveCrashed) {
+		t.Fatalf("expected the enclave to crash, got %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if !stub.interrupted.Load() || stub.batches != 0 {
+		t.Error("expected the batch processing to be cut short by the crash")
+	}
+}
+
+// decisions returns the faults injected into a fixed set of messages, blocks and batches
+func decisions(t *testing.T, cfg Config) []string {
+	injector, err := NewInjector(cfg, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	injector.Start()
+	defer injector.Stop()
+
+	var result []string
+	for i := 0; i < 50; i++ {
+		// the same message is sent twice, so each occurrence is decided on
+		for j := 0; j < 2; j++ {
+			if fault := injector.P2PFault("tx", "0", "1", fmt.Sprint(i)); fault != (P2PFault{}) {
+				result = append(result, fmt.Sprintf("p2p %d/%d %+v", i, j, fault))
+			}
+		}
+		if depth := injector.L1ReorgDepth("0", uint64(10+i)); depth > 0 {
+			result = append(result, fmt.Sprintf("l1 %d depth=%d", i, depth))
+		}
+		if point, delay := injector.EnclaveCrash("0", "SubmitBatch", uint64(i)); point != NoCrash {
+			result = append(result, fmt.Sprintf("enclave %d point=%s delay=%s", i, point, delay))
+		}
+	}
+	return result
+}
+
+// stubEnclave answers the calls the crashing enclave intercepts
+type stubEnclave struct {
+	common.Enclave
+	slowBatches bool // batches take a second to process
+	batches     int
+	interrupted atomic.Bool
+	stopped     atomic.Bool
+}
+
+func (e *stubEnclave) Status(context.Context) (common.Status, common.SystemError) {
+	return common.Status{StatusCode: common.Running}, nil
+}
+
+func (e *stubEnclave) SubmitBatch(ctx context.Context, _ *common.ExtBatch) common.SystemError {
+	if e.slowBatches {
+		select {
+		case <-ctx.Done():
+			e.interrupted.Store(true)
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+	e.batches++
+	return nil
+}
+
+func (e *stubEnclave) Stop() common.SystemError {
+	e.stopped.Store(true)
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/enclave"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 	"github.com/ten-protocol/go-ten/go/host/container"
@@ -25,6 +26,7 @@ import (
 	"github.com/ten-protocol/go-ten/integration"
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 	"github.com/ten-protocol/go-ten/integration/ethereummock"
+	"github.com/ten-protocol/go-ten/integration/simulation/faults"
 	"github.com/ten-protocol/go-ten/integration/simulation/stats"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -38,9 +40,10 @@ const (
 	DefaultL1RPCTimeout     = 15 * time.Second
 )
 
-func createMockEthNode(id int, nrNodes int, avgBlockDuration time.Duration, avgNetworkLatency time.Duration, stats *stats.Stats, blobResolver l1.BlobResolver) *ethereummock.Node {
+func createMockEthNode(id int, nrNodes int, avgBlockDuration time.Duration, avgNetworkLatency time.Duration, stats *stats.Stats, blobResolver l1.BlobResolver, faultInjector *faults.Injector) *ethereummock.Node {
 	mockEthNetwork := ethereummock.NewMockEthNetwork(avgBlockDuration, avgNetworkLatency, stats)
 	ethereumMockCfg := defaultMockEthNodeCfg(nrNodes, avgBlockDuration)
+	ethereumMockCfg.Faults = faultInjector
 	logger := log.New(log.EthereumL1Cmp, int(gethlog.LvlInfo), ethereumMockCfg.LogFile, log.NodeIDKey, id)
 	// create an in memory mock ethereum node responsible with notifying the layer 2 node about blocks
 	miner := ethereummock.NewMiner(gethcommon.BigToAddress(big.NewInt(int64(id))), ethereumMockCfg, mockEthNetwork, stats, blobResolver, logger)
@@ -64,6 +67,7 @@ func createInMemTenNode(
 	incomingP2PDisabled bool,
 	l1BlockTime time.Duration,
 	blobResolver l1.BlobResolver,
+	faultInjector *faults.Injector,
 ) *container.HostContainer {
 	mgtContractAddress := mgmtContractLib.GetContractAddr()
 
@@ -103,13 +107,27 @@ func createInMemTenNode(
 		RPCTimeout:                5 * time.Second,
 	}
 
+	if faultInjector.CrashesEnclaves() {
+		// a crashed enclave is started again from its database, so the database must outlive it
+		dbPath, err := sqlite.CreateTempDBFile()
+		if err != nil {
+			panic(fmt.Errorf("failed to create temp sqlite db path - %w", err))
+		}
+		enclaveConfig.UseInMemoryDB = false
+		enclaveConfig.SqliteDBPath = dbPath
+	}
+
 	enclaveLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.EnclaveCmp)
-	enclaveClients := []common.Enclave{enclave.NewEnclave(enclaveConfig, &genesis.TestnetGenesis, mgmtContractLib, enclaveLogger)}
+	enclaveClients := []common.Enclave{
+		faultInjector.Enclave(fmt.Sprintf("%d", id), func() common.Enclave {
+			return enclave.NewEnclave(enclaveConfig, &genesis.TestnetGenesis, mgmtContractLib, enclaveLogger)
+		}),
+	}
 
 	// create an in memory TEN node
 	hostLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.HostCmp)
 	metricsService := metrics.New(hostConfig.MetricsEnabled, hostConfig.MetricsHTTPPort, hostLogger)
-	l1Repo := l1.NewL1Repository(ethClient, ethereummock.MgmtContractAddresses, hostLogger)
+	l1Repo := l1.NewL1Repository(ethClient, ethereummock.MgmtContractAddresses, hostConfig.HealthThresholds.L1HeadAge, hostLogger)
 	currentContainer := container.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Repo, enclaveClients, mgmtContractLib, ethWallet, nil, hostLogger, metricsService, blobResolver)
 
 	return currentContainer
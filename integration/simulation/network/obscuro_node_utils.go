@@ -35,7 +35,7 @@ func startInMemoryTenNodes(params *params.SimParams, genesisJSON []byte, l1Clien
 	// Create the in memory TEN nodes, each connect each to a geth node
 	tenNodes := make([]*hostcontainer.HostContainer, params.NumberOfNodes)
 	tenHosts := make([]host.Host, params.NumberOfNodes)
-	mockP2PNetw := p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.AvgNetworkLatency, params.NodeWithInboundP2PDisabled)
+	mockP2PNetw := p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.AvgNetworkLatency, params.NodeWithInboundP2PDisabled, params.Faults)
 	blobResolver := ethereummock.NewMockBlobResolver()
 	for i := 0; i < params.NumberOfNodes; i++ {
 		isGenesis := i == 0
@@ -56,6 +56,7 @@ func startInMemoryTenNodes(params *params.SimParams, genesisJSON []byte, l1Clien
 			true,
 			params.AvgBlockDuration,
 			blobResolver,
+			params.Faults,
 		)
 		tenHosts[i] = tenNodes[i].Host()
 	}
@@ -3,8 +3,10 @@ package p2p
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -205,7 +207,7 @@ func (c *inMemTenClient) tenConfig(result interface{}) error {
 }
 
 func (c *inMemTenClient) Subscribe(context.Context, string, interface{}, ...interface{}) (*gethrpc.ClientSubscription, error) {
-	panic("not implemented")
+	return nil, errors.New("subscriptions are not supported by the in-memory client")
 }
 
 func (c *inMemTenClient) sendRawTransaction(result interface{}, args []interface{}) error {
@@ -293,17 +295,19 @@ func (c *inMemTenClient) getLogs(result interface{}, args []interface{}) error {
 }
 
 func (c *inMemTenClient) getBatchByNumber(result interface{}, args []interface{}) error {
-	blockNumberHex, ok := args[0].(string)
+	blockNumberArg, ok := args[0].(string)
 	if !ok {
-		return fmt.Errorf("arg to %s is of type %T, expected int64", rpc.GetBatchByNumber, args[0])
+		return fmt.Errorf("arg to %s is of type %T, expected string", rpc.GetBatchByNumber, args[0])
 	}
 
-	blockNumber, err := hexutil.DecodeUint64(blockNumberHex)
+	// the batch number is either a hex number or a block tag such as "latest"
+	var blockNumber gethrpc.BlockNumber
+	err := blockNumber.UnmarshalJSON([]byte(strconv.Quote(blockNumberArg)))
 	if err != nil {
-		return fmt.Errorf("arg to %s could not be decoded from hex. Cause: %w", rpc.GetBatchByNumber, err)
+		return fmt.Errorf("arg to %s could not be decoded to a batch number. Cause: %w", rpc.GetBatchByNumber, err)
 	}
 
-	headerMap, err := c.ethAPI.GetBlockByNumber(nil, gethrpc.BlockNumber(blockNumber), false) //nolint:staticcheck
+	headerMap, err := c.ethAPI.GetBlockByNumber(nil, blockNumber, false) //nolint:staticcheck
 	if err != nil {
 		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetBatchByNumber, err)
 	}
@@ -2,12 +2,16 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ten-protocol/go-ten/go/common/subscription"
+	"github.com/ten-protocol/go-ten/integration/simulation/faults"
 
 	"github.com/ten-protocol/go-ten/go/common/async"
 
@@ -19,24 +23,39 @@ import (
 
 const _sequencerID = "0"
 
+// the kinds of the messages, as reported by the fault injector
+const (
+	_txMessage            = "tx"
+	_batchesMessage       = "batches"
+	_batchRequestMessage  = "batch-request"
+	_batchResponseMessage = "batch-response"
+)
+
 type MockP2PNetwork struct {
 	nodes map[string]*MockP2P
 
 	avgLatency                  time.Duration
 	avgBlockDuration            time.Duration
 	nodeWithIncomingP2PDisabled int
+
+	faults *faults.Injector // nil when no faults are injected
+	heldMu sync.Mutex
+	held   map[string]*heldMessage // the reordered message waiting for the next message of each link
 }
 
 type MockP2PNetworkIntf interface {
 	NewNode(id int) host.P2PHostService
 }
 
-func NewMockP2PNetwork(avgBlockDuration time.Duration, avgLatency time.Duration, nodeWithIncomingP2PDisabled int) MockP2PNetworkIntf {
+// NewMockP2PNetwork returns a network of mock P2P services, with faults injected in the messages if an injector is given
+func NewMockP2PNetwork(avgBlockDuration time.Duration, avgLatency time.Duration, nodeWithIncomingP2PDisabled int, injector *faults.Injector) MockP2PNetworkIntf {
 	return &MockP2PNetwork{
 		nodes:                       make(map[string]*MockP2P),
 		avgBlockDuration:            avgBlockDuration,
 		avgLatency:                  avgLatency,
 		nodeWithIncomingP2PDisabled: nodeWithIncomingP2PDisabled,
+		faults:                      injector,
+		held:                        make(map[string]*heldMessage),
 	}
 }
 
@@ -48,27 +67,28 @@ func (m *MockP2PNetwork) NewNode(id int) host.P2PHostService {
 	return node
 }
 
-func (m *MockP2PNetwork) RequestBatchesFromSequencer(id string, fromSeqNo *big.Int) {
+func (m *MockP2PNetwork) RequestBatchesFromSequencer(request *common.BatchRequest) {
 	seqNode := m.nodes[_sequencerID]
-	async.Schedule(m.delay()/2, func() { seqNode.ReceiveBatchRequest(id, fromSeqNo) })
+	id := fmt.Sprintf("from=%v to=%v", request.FromSeqNo, request.ToSeqNo)
+	m.send(_batchRequestMessage, request.Requester, _sequencerID, id, func() { seqNode.ReceiveBatchRequest(request.Requester, request) })
 }
 
-func (m *MockP2PNetwork) SendTransactionToSequencer(tx common.EncryptedTx) {
+func (m *MockP2PNetwork) SendTransactionToSequencer(fromNodeID string, tx common.EncryptedTx) {
 	seqNode := m.nodes[_sequencerID]
-	async.Schedule(m.delay()/2, func() { seqNode.ReceiveTransaction(tx) })
+	m.send(_txMessage, fromNodeID, _sequencerID, crypto.Keccak256Hash(tx).Hex(), func() { seqNode.ReceiveTransaction(tx) })
 }
 
 func (m *MockP2PNetwork) BroadcastBatch(fromNodeID string, batches []*common.ExtBatch) {
 	for _, node := range m.nodes {
 		if node.id != fromNodeID {
 			tempNode := node
-			async.Schedule(m.delay()/2, func() { tempNode.ReceiveBatches(batches, true) })
+			m.send(_batchesMessage, fromNodeID, node.id, batchesID(batches), func() { tempNode.ReceiveBatches(batches, true) })
 		}
 	}
 }
 
-func (m *MockP2PNetwork) RespondToBatchRequest(requesterID string, batches []*common.ExtBatch) {
-	async.Schedule(m.delay()/2, func() {
+func (m *MockP2PNetwork) RespondToBatchRequest(fromNodeID string, requesterID string, batches []*common.ExtBatch) {
+	m.send(_batchResponseMessage, fromNodeID, requesterID, batchesID(batches), func() {
 		requester, ok := m.nodes[requesterID]
 		if !ok {
 			panic("requester not found in mock p2p service")
@@ -82,6 +102,73 @@ func (m *MockP2PNetwork) delay() time.Duration {
 	return testcommon.RndBtwTime(m.avgLatency/10, 2*m.avgLatency)
 }
 
+// heldMessage is a reordered message, delivered after the next message between the same nodes
+type heldMessage struct {
+	deliver func()
+}
+
+// send delivers the message after the network latency, unless the fault injector drops, duplicates, delays or
+// reorders it
+func (m *MockP2PNetwork) send(kind string, from string, to string, id string, deliver func()) {
+	delay := m.delay() / 2
+	fault := m.faults.P2PFault(kind, from, to, id)
+	link := from + "->" + to
+	switch {
+	case fault.Drop:
+		return
+	case fault.Duplicate:
+		async.Schedule(delay+m.delay()/2, deliver)
+	case fault.Reorder:
+		m.hold(link, deliver)
+		return
+	}
+	async.Schedule(delay+fault.Delay, func() {
+		deliver()
+		// a message held on the link is overtaken by this one
+		if held := m.release(link, nil); held != nil {
+			held.deliver()
+		}
+	})
+}
+
+// hold keeps the message until the next message on the link is delivered, or until the longest delay
+func (m *MockP2PNetwork) hold(link string, deliver func()) {
+	msg := &heldMessage{deliver: deliver}
+	m.heldMu.Lock()
+	previous := m.held[link]
+	m.held[link] = msg
+	m.heldMu.Unlock()
+	if previous != nil {
+		// only one message is held per link, so the previous one is overtaken by this one
+		go previous.deliver()
+	}
+	async.Schedule(m.faults.MaxP2PDelay(), func() {
+		if m.release(link, msg) != nil {
+			msg.deliver()
+		}
+	})
+}
+
+// release returns the message held on the link and stops holding it, or nil if it is not the expected one
+func (m *MockP2PNetwork) release(link string, expected *heldMessage) *heldMessage {
+	m.heldMu.Lock()
+	defer m.heldMu.Unlock()
+	held := m.held[link]
+	if held == nil || (expected != nil && held != expected) {
+		return nil
+	}
+	delete(m.held, link)
+	return held
+}
+
+// batchesID identifies the batches of a message by their sequence numbers
+func batchesID(batches []*common.ExtBatch) string {
+	if len(batches) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("seqNo=%d-%d", batches[0].Header.SequencerOrderNo, batches[len(batches)-1].Header.SequencerOrderNo)
+}
+
 // MockP2P - models the p2p service of a host, but instead of sending messages over tcp it uses the `MockP2PNetwork` to distribute messages
 type MockP2P struct {
 	id      string
@@ -132,7 +219,7 @@ func (n *MockP2P) SendTxToSequencer(tx common.EncryptedTx) error {
 	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return nil
 	}
-	n.network.SendTransactionToSequencer(tx)
+	n.network.SendTransactionToSequencer(n.id, tx)
 	return nil
 }
 
@@ -172,7 +259,7 @@ func (n *MockP2P) SubscribeForBatchRequests(handler host.P2PBatchRequestHandler)
 	return n.batchReqHandlers.Subscribe(handler)
 }
 
-func (n *MockP2P) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+func (n *MockP2P) RequestBatches(fromSeqNo *big.Int, toSeqNo *big.Int, snapshot bool) error {
 	if n.isIncomingP2PDisabled {
 		return nil
 	}
@@ -180,7 +267,7 @@ func (n *MockP2P) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
 	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return nil
 	}
-	n.network.RequestBatchesFromSequencer(n.id, fromSeqNo)
+	n.network.RequestBatchesFromSequencer(&common.BatchRequest{Requester: n.id, FromSeqNo: fromSeqNo, ToSeqNo: toSeqNo, Snapshot: snapshot})
 	return nil
 }
 
@@ -192,7 +279,7 @@ func (n *MockP2P) RespondToBatchRequest(requesterID string, batches []*common.Ex
 	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return nil
 	}
-	n.network.RespondToBatchRequest(requesterID, batches)
+	n.network.RespondToBatchRequest(n.id, requesterID, batches)
 	return nil
 }
 
@@ -215,13 +302,13 @@ func (n *MockP2P) ReceiveBatches(batches []*common.ExtBatch, isLive bool) {
 }
 
 // ReceiveBatchRequest is a mock method that simulates receiving a batch request from a peer and then forwarding to all subscribers
-func (n *MockP2P) ReceiveBatchRequest(requestID string, fromSeqNo *big.Int) {
+func (n *MockP2P) ReceiveBatchRequest(requestID string, request *common.BatchRequest) {
 	if n.isIncomingP2PDisabled {
 		return
 	}
 
 	for _, sub := range n.batchReqHandlers.Subscribers() {
-		sub.HandleBatchRequest(requestID, fromSeqNo)
+		sub.HandleBatchRequest(requestID, request)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/host/l1"
+	"github.com/ten-protocol/go-ten/integration/simulation/faults"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -43,6 +44,9 @@ type SimParams struct {
 	StoppingDelay              time.Duration // How long to wait between injection and verification
 	NodeWithInboundP2PDisabled int
 	WithPrefunding             bool
+
+	// Faults injects faults into the in-memory networks while the transactions are injected, nil for none
+	Faults *faults.Injector
 }
 
 type L1TenData struct {
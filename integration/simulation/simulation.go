@@ -74,6 +74,9 @@ func (s *Simulation) Start() {
 	fmt.Printf("Starting injection\n")
 	testlog.Logger().Info("Starting injection")
 	go s.TxInjector.Start()
+	if s.Params.Faults != nil {
+		s.Params.Faults.Start()
+	}
 
 	// Allow for some time after tx injection was stopped so that the network can process all transactions, catch up
 	// on missed batches, etc.
@@ -84,6 +87,10 @@ func (s *Simulation) Start() {
 	testlog.Logger().Info("Stopping injection")
 
 	s.TxInjector.Stop()
+	if s.Params.Faults != nil {
+		// the network recovers from the faults during the stopping delay
+		s.Params.Faults.Stop()
+	}
 
 	time.Sleep(s.Params.StoppingDelay)
 
@@ -0,0 +1,84 @@
+package simulation
+
+import (
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ten-protocol/go-ten/integration"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
+	"github.com/ten-protocol/go-ten/integration/simulation/faults"
+	"github.com/ten-protocol/go-ten/integration/simulation/network"
+	"github.com/ten-protocol/go-ten/integration/simulation/params"
+)
+
+const (
+	faultTestEnv = "SIM_FAULTS_ENABLED"
+)
+
+// This test runs a smaller in memory network than TestInMemoryMonteCarloSimulation while dropping, delaying, duplicating and
+// reordering the P2P messages, reorging the L1 and crashing the enclaves, and then checks the chains still hold their
+// invariants. The faults are derived from a random seed, printed by the test. Set SIM_FAULT_SEED to run again with the
+// same seed, which injects the same faults into the same messages, blocks and batches but does not replay the run
+// exactly, as the timing of the network differs between runs.
+func TestInMemoryFaultInjectionSimulation(t *testing.T) {
+	seed := time.Now().UnixNano()
+	if s, found := os.LookupEnv(faultSeedEnv); found {
+		var err error
+		seed, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			t.Fatalf("invalid %s - %s", faultSeedEnv, err)
+		}
+	} else if os.Getenv(faultTestEnv) == "" {
+		t.Skipf("set the variable to run this test: `%s=true`, or `%s=<seed>` to run it with the seed of a previous run", faultTestEnv, faultSeedEnv)
+	}
+	setupSimTestLog("in-mem-faults")
+	injector, err := faults.NewInjector(faults.Config{
+		Seed: seed,
+		P2P: faults.P2PConfig{
+			DropRate:      0.05,
+			DuplicateRate: 0.05,
+			DelayRate:     0.1,
+			ReorderRate:   0.05,
+		},
+		L1: faults.L1Config{
+			ReorgRate:     0.05,
+			MaxReorgDepth: 3,
+		},
+		Enclave: faults.EnclaveConfig{
+			CrashRate: 0.01,
+			Downtime:  2 * time.Second,
+		},
+	}, testlog.Logger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numberOfNodes := 3
+	numberOfSimWallets := 10
+	wallets := params.NewSimWallets(numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+
+	simParams := params.SimParams{
+		NumberOfNodes:              numberOfNodes,
+		AvgBlockDuration:           180 * time.Millisecond,
+		SimulationTime:             45 * time.Second,
+		MgmtContractLib:            ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:           ethereummock.NewERC20ContractLibMock(),
+		BlobResolver:               ethereummock.NewMockBlobResolver(),
+		Wallets:                    wallets,
+		StartPort:                  integration.TestPorts.TestInMemoryFaultInjectionSimulationPort,
+		IsInMem:                    true,
+		L1TenData:                  &params.L1TenData{},
+		ReceiptTimeout:             5 * time.Second,
+		StoppingDelay:              15 * time.Second,
+		NodeWithInboundP2PDisabled: 2,
+		L1BeaconPort:               integration.TestPorts.TestInMemoryFaultInjectionSimulationPort + integration.DefaultPrysmGatewayPortOffset,
+		Faults:                     injector,
+	}
+
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
+}
@@ -20,6 +20,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// faultSeedEnv is the environment variable setting the seed of the faults injected, to inject the faults of a failed run again
+const faultSeedEnv = "SIM_FAULT_SEED"
+
 // testSimulation encapsulates the shared logic for simulating and testing various types of nodes.
 func testSimulation(t *testing.T, netw network.Network, params *params.SimParams) {
 	defer func() {
@@ -28,6 +31,15 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	}()
 	testlog.Logger().Info(fmt.Sprintf("goroutine leak monitor - simulation start - %d goroutines currently running", runtime.NumGoroutine()))
 	rand.Seed(time.Now().UnixNano()) //nolint: staticcheck
+	seed := time.Now().UnixNano()
+	if params.Faults != nil {
+		// the choices of the transaction injector are derived from the same seed as the faults, so a run with the seed
+		// injects similar transactions
+		seed = params.Faults.Seed()
+		// printed rather than only logged, so the seed is known even if a node exits the process
+		fmt.Printf("Injecting faults with seed %d\n", seed)
+		testlog.Logger().Info("Injecting faults.", "seed", seed)
+	}
 	uuid.EnableRandPool()
 
 	stats := simstats.NewStats(params.NumberOfNodes)
@@ -52,6 +64,7 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 		params.ERC20ContractLib,
 		0,
 		params,
+		seed,
 	)
 
 	simulation := Simulation{
@@ -74,7 +87,11 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	fmt.Printf("Validating simulation results\n")
 	testlog.Logger().Info("Validating simulation results")
 
-	checkNetworkValidity(t, &simulation)
+	if params.Faults != nil {
+		checkFaultyNetworkValidity(t, &simulation)
+	} else {
+		checkNetworkValidity(t, &simulation)
+	}
 
 	fmt.Printf("Stopping simulation\n")
 	testlog.Logger().Info("Stopping simulation")
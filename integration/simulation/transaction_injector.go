@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -77,6 +78,10 @@ type TransactionInjector struct {
 
 	params *params.SimParams
 
+	// the random choices of the injector, derived from the seed of the run
+	rndLock sync.Mutex
+	rnd     *rand.Rand
+
 	logger gethlog.Logger
 }
 
@@ -91,6 +96,7 @@ func NewTransactionInjector(
 	erc20ContractLib erc20contractlib.ERC20ContractLib,
 	txsToIssue int,
 	params *params.SimParams,
+	seed int64,
 ) *TransactionInjector {
 	interrupt := int32(0)
 
@@ -117,6 +123,7 @@ func NewTransactionInjector(
 		params:           params,
 		ctx:              context.Background(), // for now we create a new context here, should allow it to be passed in
 		logger:           testlog.Logger().New(log.CmpKey, log.TxInjectCmp),
+		rnd:              rand.New(rand.NewSource(seed)), //nolint:gosec
 	}
 }
 
@@ -184,7 +191,7 @@ func (ti *TransactionInjector) issueRandomValueTransfers() {
 		toWalletAddr := toWallet.Address()
 		txData := &types.LegacyTx{
 			Nonce:    fromWallet.GetNonceAndIncrement(),
-			Value:    big.NewInt(int64(testcommon.RndBtw(1, 100))),
+			Value:    big.NewInt(int64(ti.rndBtw(1, 100))),
 			Gas:      uint64(50_000),
 			GasPrice: gethcommon.Big1,
 			To:       &toWalletAddr,
@@ -222,7 +229,7 @@ func (ti *TransactionInjector) issueRandomTransfers() {
 		for len(ti.wallets.SimObsWallets) > 1 && fromWallet.Address().Hex() == toWallet.Address().Hex() {
 			toWallet = ti.rndObsWallet()
 		}
-		tx := ti.newTenTransferTx(fromWallet, toWallet.Address(), testcommon.RndBtw(1, 500), testcommon.HOC)
+		tx := ti.newTenTransferTx(fromWallet, toWallet.Address(), ti.rndBtw(1, 500), testcommon.HOC)
 		tx = tenClient.EstimateGasAndGasPrice(tx)
 		signedTx, err := fromWallet.SignTransaction(tx)
 		if err != nil {
@@ -272,7 +279,7 @@ func (ti *TransactionInjector) bridgeRandomGasTransfers() {
 		}
 
 		receiverWallet := datagenerator.RandomWallet(ti.rndObsWallet().ChainID().Int64())
-		amount := big.NewInt(0).SetUint64(testcommon.RndBtw(500, 100_000))
+		amount := big.NewInt(0).SetUint64(ti.rndBtw(500, 100_000))
 		opts.Value = big.NewInt(0).Set(amount)
 
 		tx, err := busCtr.SendValueToL2(opts, receiverWallet.Address(), amount)
@@ -298,7 +305,7 @@ func (ti *TransactionInjector) issueRandomDeposits() {
 		fromWallet := ti.wallets.Tokens[fromWalletToken].L2Owner
 		toWallet := ti.rndObsWallet()
 		tenClient := ti.rpcHandles.TenWalletRndClient(fromWallet)
-		v := testcommon.RndBtw(500, 2000)
+		v := ti.rndBtw(500, 2000)
 		txData := ti.newTenTransferTx(fromWallet, toWallet.Address(), v, fromWalletToken)
 		tx := tenClient.EstimateGasAndGasPrice(txData)
 		signedTx, err := fromWallet.SignTransaction(tx)
@@ -483,7 +490,7 @@ func (ti *TransactionInjector) issueRandomWithdrawals() {
 
 		go ti.awaitAndFinalizeWithdrawal(signedTx, fromWallet)
 
-		time.Sleep(testcommon.RndBtwTime(ti.avgBlockDuration/4, ti.avgBlockDuration))
+		time.Sleep(ti.rndBtwTime(ti.avgBlockDuration/4, ti.avgBlockDuration))
 	}
 }
 
@@ -498,7 +505,7 @@ func (ti *TransactionInjector) issueInvalidL2Txs() {
 		for len(ti.wallets.SimObsWallets) > 1 && fromWallet.Address().Hex() == toWallet.Address().Hex() {
 			toWallet = ti.rndObsWallet()
 		}
-		txData := ti.newCustomTenWithdrawalTx(testcommon.RndBtw(1, 100))
+		txData := ti.newCustomTenWithdrawalTx(ti.rndBtw(1, 100))
 
 		tx := ti.rpcHandles.TenWalletRndClient(fromWallet).EstimateGasAndGasPrice(txData)
 		signedTx := ti.createInvalidSignage(tx, fromWallet)
@@ -507,13 +514,13 @@ func (ti *TransactionInjector) issueInvalidL2Txs() {
 		if err != nil {
 			ti.logger.Info("Failed to issue withdrawal via RPC. ", log.ErrKey, err)
 		}
-		time.Sleep(testcommon.RndBtwTime(ti.avgBlockDuration/4, ti.avgBlockDuration))
+		time.Sleep(ti.rndBtwTime(ti.avgBlockDuration/4, ti.avgBlockDuration))
 	}
 }
 
 // Uses one of the approaches to create an invalidly-signed transaction.
 func (ti *TransactionInjector) createInvalidSignage(tx types.TxData, w wallet.Wallet) *types.Transaction {
-	switch rand.Intn(2) { //nolint:gosec
+	switch ti.rndIntn(2) {
 	case 0: // We sign the transaction with a bad signer.
 		incorrectChainID := int64(integration.EthereumChainID + 1)
 		signer := types.NewLondonSigner(big.NewInt(incorrectChainID))
@@ -527,7 +534,31 @@ func (ti *TransactionInjector) createInvalidSignage(tx types.TxData, w wallet.Wa
 }
 
 func (ti *TransactionInjector) rndObsWallet() wallet.Wallet {
-	return ti.wallets.SimObsWallets[rand.Intn(len(ti.wallets.SimObsWallets))] //nolint:gosec
+	return ti.wallets.SimObsWallets[ti.rndIntn(len(ti.wallets.SimObsWallets))]
+}
+
+// rndIntn returns a random number in [0, n), the injector goroutines sharing its source of randomness
+func (ti *TransactionInjector) rndIntn(n int) int {
+	ti.rndLock.Lock()
+	defer ti.rndLock.Unlock()
+	return ti.rnd.Intn(n)
+}
+
+// rndBtw returns a random number in [min, max)
+func (ti *TransactionInjector) rndBtw(min uint64, max uint64) uint64 {
+	if min >= max {
+		panic(fmt.Sprintf("rndBtw requires min (%d) to be less than max (%d)", min, max))
+	}
+	ti.rndLock.Lock()
+	defer ti.rndLock.Unlock()
+	return uint64(ti.rnd.Int63n(int64(max-min))) + min
+}
+
+func (ti *TransactionInjector) rndBtwTime(min time.Duration, max time.Duration) time.Duration {
+	if min <= 0 || max <= 0 {
+		panic(fmt.Sprintf("invalid durations min=%s max=%s", min, max))
+	}
+	return time.Duration(ti.rndBtw(uint64(min.Nanoseconds()), uint64(max.Nanoseconds())))
 }
 
 func (ti *TransactionInjector) newTenTransferTx(from wallet.Wallet, dest gethcommon.Address, amount uint64, ercType testcommon.ERC20) types.TxData {
@@ -62,6 +62,18 @@ func checkNetworkValidity(t *testing.T, s *Simulation) {
 	checkReceivedLogs(t, s)
 	checkTenscan(t, s)
 	checkZenBaseMinting(t, s)
+	checkInvariants(t, s)
+}
+
+// After a simulation with faults injected has run, check the transactions were injected and the chains hold the invariants.
+// The other checks do not apply, since the faults lose transactions and slow the chains down.
+func checkFaultyNetworkValidity(t *testing.T, s *Simulation) {
+	checkTransactionsInjected(t, s)
+	checkInvariants(t, s)
+	t.Logf("Faults injected with seed %d: %s", s.Params.Faults.Seed(), s.Params.Faults.Summary())
+	if t.Failed() {
+		t.Logf("Inject the same faults by running the test with %s=%d", faultSeedEnv, s.Params.Faults.Seed())
+	}
 }
 
 // Ensures that L1 and L2 txs were actually issued.
@@ -924,3 +936,168 @@ func getRollupFromBlobHashes(ctx context.Context, blobResolver l1.BlobResolver,
 	}
 	return &rollup, nil
 }
+
+// checkInvariants checks the properties of the chains that hold whatever faults were injected during the run:
+// - the L1 chain of each node is linked down to the genesis, and the nodes agree on the final L1 blocks
+// - the batch chain of each node is linked down to the genesis, with increasing sequence numbers and no transaction
+// included twice
+// - the final batches are built on canonical L1 blocks, and the nodes agree on them
+//
+// A block is final once it is deeper than the nodes can fall behind plus the deepest reorg injected, and a batch once
+// it is built on a final block.
+func checkInvariants(t *testing.T, s *Simulation) {
+	finalityDepth := uint64(maxBlockDelay + s.Params.Faults.MaxL1ReorgDepth())
+
+	l1Chains := make([]*l1Chain, len(s.RPCHandles.EthClients))
+	for idx, client := range s.RPCHandles.EthClients {
+		chain, err := fetchL1Chain(client)
+		if err != nil {
+			t.Errorf("Node %d: invariant broken - %s", idx, err)
+			return
+		}
+		l1Chains[idx] = chain
+	}
+	minHead := l1Chains[0].headHeight()
+	for _, chain := range l1Chains {
+		minHead = min(minHead, chain.headHeight())
+	}
+	if minHead <= finalityDepth {
+		t.Errorf("The L1 chains are too short to check the invariants. Lowest head: %d", minHead)
+		return
+	}
+	finalHeight := minHead - finalityDepth
+	for idx, chain := range l1Chains {
+		if chain.byHeight[finalHeight] != l1Chains[0].byHeight[finalHeight] {
+			t.Errorf("Node %d: invariant broken - the final L1 block at height %d is b_%d, but it is b_%d for node 0",
+				idx, finalHeight, common.ShortHash(chain.byHeight[finalHeight]), common.ShortHash(l1Chains[0].byHeight[finalHeight]))
+		}
+	}
+
+	finalBatches := map[uint64]gethcommon.Hash{} // the final batches by height, as seen by the first node that has them
+	for idx, client := range s.RPCHandles.TenClients {
+		batches, err := fetchBatchChain(client)
+		if err != nil {
+			t.Errorf("Node %d: invariant broken - %s", idx, err)
+			continue
+		}
+		checkBatchChain(t, idx, batches)
+		for _, batch := range batches {
+			proofHeight, canonical, err := l1Chains[idx].blockHeight(s.RPCHandles.EthClients[idx], batch.Header.L1Proof)
+			if err != nil {
+				t.Errorf("Node %d: invariant broken - batch %d is built on an unknown L1 block. Cause: %s", idx, batch.Header.Number, err)
+				continue
+			}
+			if proofHeight > finalHeight {
+				continue
+			}
+			if !canonical {
+				t.Errorf("Node %d: invariant broken - final batch %d is built on the abandoned L1 block b_%d",
+					idx, batch.Header.Number, common.ShortHash(batch.Header.L1Proof))
+				continue
+			}
+			height := batch.Header.Number.Uint64()
+			if expected, found := finalBatches[height]; !found {
+				finalBatches[height] = batch.Hash()
+			} else if expected != batch.Hash() {
+				t.Errorf("Node %d: invariant broken - final batch %d is b_%d, but another node has b_%d",
+					idx, height, common.ShortHash(batch.Hash()), common.ShortHash(expected))
+			}
+		}
+	}
+}
+
+// checkBatchChain checks the batches from the genesis to the head are numbered consecutively, with increasing
+// sequence numbers and each transaction included once
+func checkBatchChain(t *testing.T, nodeIdx int, batches []*common.ExtBatch) {
+	includedIn := map[gethcommon.Hash]uint64{}
+	for i, batch := range batches {
+		height := batch.Header.Number.Uint64()
+		if i > 0 {
+			parent := batches[i-1].Header
+			if height != parent.Number.Uint64()+1 {
+				t.Errorf("Node %d: invariant broken - batch %d follows batch %d", nodeIdx, height, parent.Number)
+			}
+			if batch.Header.SequencerOrderNo.Cmp(parent.SequencerOrderNo) <= 0 {
+				t.Errorf("Node %d: invariant broken - batch %d has sequence number %d, not after its parent's %d",
+					nodeIdx, height, batch.Header.SequencerOrderNo, parent.SequencerOrderNo)
+			}
+		}
+		for _, txHash := range batch.TxHashes {
+			if other, found := includedIn[txHash]; found {
+				t.Errorf("Node %d: invariant broken - transaction %s is included in batches %d and %d", nodeIdx, txHash, other, height)
+			}
+			includedIn[txHash] = height
+		}
+	}
+}
+
+// l1Chain is the canonical L1 chain of a node
+type l1Chain struct {
+	byHeight []gethcommon.Hash
+	heights  map[gethcommon.Hash]uint64
+}
+
+func (c *l1Chain) headHeight() uint64 {
+	return uint64(len(c.byHeight) - 1)
+}
+
+// blockHeight returns the height of the block and whether it is in the chain, fetching the blocks that are not
+func (c *l1Chain) blockHeight(client ethadapter.EthClient, hash gethcommon.Hash) (uint64, bool, error) {
+	if height, found := c.heights[hash]; found {
+		return height, true, nil
+	}
+	block, err := client.BlockByHash(hash)
+	if err != nil {
+		return 0, false, err
+	}
+	return block.NumberU64(), false, nil
+}
+
+// fetchL1Chain walks the L1 chain of the node from its head down to the genesis
+func fetchL1Chain(client ethadapter.EthClient) (*l1Chain, error) {
+	block, err := client.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the L1 head. Cause: %w", err)
+	}
+	chain := &l1Chain{
+		byHeight: make([]gethcommon.Hash, block.NumberU64()+1),
+		heights:  map[gethcommon.Hash]uint64{},
+	}
+	for {
+		chain.byHeight[block.NumberU64()] = block.Hash()
+		chain.heights[block.Hash()] = block.NumberU64()
+		if block.NumberU64() == 0 {
+			return chain, nil
+		}
+		parent, err := client.BlockByHash(block.ParentHash())
+		if err != nil {
+			return nil, fmt.Errorf("the parent of L1 block b_%d is missing. Cause: %w", common.ShortHash(block.Hash()), err)
+		}
+		if parent.NumberU64()+1 != block.NumberU64() {
+			return nil, fmt.Errorf("L1 block b_%d at height %d has its parent at height %d", common.ShortHash(block.Hash()), block.NumberU64(), parent.NumberU64())
+		}
+		block = parent
+	}
+}
+
+// fetchBatchChain walks the batch chain of the node from its head down to the genesis, and returns it from the genesis
+func fetchBatchChain(client *obsclient.ObsClient) ([]*common.ExtBatch, error) {
+	head, err := getHeadBatchHeader(client)
+	if err != nil {
+		return nil, err
+	}
+	batches := make([]*common.ExtBatch, head.Number.Uint64()+1)
+	hash := head.Hash()
+	for i := len(batches) - 1; i >= 0; i-- {
+		batch, err := client.GetBatchByHash(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch batch b_%d. Cause: %w", common.ShortHash(hash), err)
+		}
+		if batch.Header.Number.Uint64() != uint64(i) {
+			return nil, fmt.Errorf("batch b_%d at height %d is the parent of a batch at height %d", common.ShortHash(hash), batch.Header.Number, i+1)
+		}
+		batches[i] = batch
+		hash = batch.Header.ParentHash
+	}
+	return batches, nil
+}
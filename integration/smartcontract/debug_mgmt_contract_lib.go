@@ -2,6 +2,7 @@ package smartcontract
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	generatedManagementContract "github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -41,7 +43,7 @@ func (d *debugMgmtContractLib) AwaitedIssueRollup(rollup common.ExtRollup, clien
 	if err != nil {
 		return err
 	}
-	txData, err := d.CreateBlobRollup(&ethadapter.L1RollupTx{Rollup: encodedRollup})
+	txData, err := d.CreateRollup(context.Background(), &ethadapter.L1RollupTx{Rollup: encodedRollup}, da.NewBlobLayer(nil))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package smartcontract
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/constants"
 	"github.com/ten-protocol/go-ten/go/common/signature"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration"
@@ -155,7 +157,7 @@ func nonAttestedNodesCannotCreateRollup(t *testing.T, mgmtContractLib *debugMgmt
 	if err != nil {
 		t.Error(err)
 	}
-	txData, err := mgmtContractLib.CreateBlobRollup(&ethadapter.L1RollupTx{Rollup: encodedRollup})
+	txData, err := mgmtContractLib.CreateRollup(context.Background(), &ethadapter.L1RollupTx{Rollup: encodedRollup}, da.NewBlobLayer(nil))
 	if err != nil {
 		t.Error(err)
 	}
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/httputil"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration"
@@ -115,6 +116,7 @@ func TestTenGateway(t *testing.T) {
 		"testInvokeNonSensitiveMethod":         testInvokeNonSensitiveMethod,
 		"testGetStorageAtForReturningUserID":   testGetStorageAtForReturningUserID,
 		"testRateLimiter":                      testRateLimiter,
+		"testClientAccountLifecycle":           testClientAccountLifecycle,
 	} {
 		t.Run(name, func(t *testing.T) {
 			test(t, startPort, httpURL, wsURL, w)
@@ -128,6 +130,51 @@ func TestTenGateway(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func testClientAccountLifecycle(t *testing.T, _ int, httpURL, wsURL string, w wallet.Wallet) {
+	ctx := context.Background()
+	client := lib.NewClient(httpURL, wsURL, testlog.Logger())
+	_, err := client.Accounts(ctx)
+	require.ErrorIs(t, err, lib.ErrNotJoined)
+
+	require.NoError(t, client.Join(ctx))
+	other := datagenerator.RandomWallet(integration.TenChainID)
+	require.NoError(t, client.RegisterAccount(ctx, w.PrivateKey(), viewingkey.EIP712Signature))
+	require.NoError(t, client.RegisterAccount(ctx, other.PrivateKey(), viewingkey.PersonalSign))
+
+	accounts, err := client.Accounts(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []gethcommon.Address{w.Address(), other.Address()}, accounts)
+
+	backend, err := client.Backend(ctx)
+	require.NoError(t, err)
+	defer backend.Close()
+	balance, err := backend.BalanceAt(ctx, w.Address(), nil)
+	require.NoError(t, err)
+	require.True(t, balance.Sign() > 0)
+
+	heads := make(chan *types.Header)
+	sub, err := backend.SubscribeNewHead(ctx, heads)
+	require.NoError(t, err)
+	select {
+	case <-heads:
+	case <-time.After(10 * time.Second):
+		t.Fatal("no batch header received")
+	}
+	sub.Unsubscribe()
+
+	require.NoError(t, client.RevokeAccount(ctx, other.Address()))
+	registered, err := client.IsRegistered(ctx, other.Address())
+	require.NoError(t, err)
+	assert.False(t, registered)
+	assert.Error(t, client.RevokeAccount(ctx, other.Address()))
+	accounts, err = client.Accounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []gethcommon.Address{w.Address()}, accounts)
+
+	require.NoError(t, client.Revoke(ctx))
+	assert.Nil(t, client.UserID())
+}
+
 func testRateLimiter(t *testing.T, _ int, httpURL, wsURL string, w wallet.Wallet) {
 	user0, err := NewGatewayUser([]wallet.Wallet{w, datagenerator.RandomWallet(integration.TenChainID)}, httpURL, wsURL)
 	require.NoError(t, err)
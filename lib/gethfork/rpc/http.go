@@ -30,6 +30,8 @@ import (
 	"strconv"
 	"sync"
 	"time"
+
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 )
 
 const (
@@ -218,6 +220,8 @@ func (hc *httpConn) doRequest(ctx context.Context, msg interface{}) (io.ReadClos
 	req.Header = hc.headers.Clone()
 	hc.mu.Unlock()
 	setHeaders(req.Header, headersFromContext(ctx))
+	// added by TEN to carry the trace context of the request to the server
+	tracing.InjectHTTP(ctx, req.Header)
 
 	if hc.auth != nil {
 		if err := hc.auth(req.Header); err != nil {
@@ -325,6 +329,8 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	connInfo.HTTP.UserAgent = r.Header.Get("User-Agent")
 	ctx := r.Context()
 	ctx = context.WithValue(ctx, peerInfoContextKey{}, connInfo)
+	// added by TEN to continue the trace of the client
+	ctx = tracing.ExtractHTTP(ctx, r.Header)
 
 	// All checks passed, create a codec that reads directly from the request body
 	// until EOF, writes the response to w, and orders the server to process a
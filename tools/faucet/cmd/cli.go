@@ -2,7 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/params"
 
@@ -34,6 +38,38 @@ const (
 	defaultAmountName    = "defaultAmount"
 	defaultAmountDefault = 100.0
 	defaultAmountUsage   = "Default amount of token to fund (in ETH)"
+
+	erc20TokensName    = "erc20Tokens"
+	erc20TokensDefault = ""
+	erc20TokensUsage   = "The ERC-20 tokens held by the faucet, as a comma-separated list of name=address (e.g. usdc=0x...,weth=0x...). Each is funded at /fund/<name>"
+
+	erc20AmountName    = "erc20Amount"
+	erc20AmountDefault = 100
+	erc20AmountUsage   = "Amount of an ERC-20 token to fund (in whole tokens)"
+
+	addressCooldownName    = "addressCooldown"
+	addressCooldownDefault = 0
+	addressCooldownUsage   = "The time a recipient waits between two fundings of a token from the unauthenticated route. 0 disables it"
+
+	ipCooldownName    = "ipCooldown"
+	ipCooldownDefault = 0
+	ipCooldownUsage   = "The time a requester IP waits between two fundings of a token from the unauthenticated route. 0 disables it"
+
+	addressDailyQuotaName    = "addressDailyQuota"
+	addressDailyQuotaDefault = 0
+	addressDailyQuotaUsage   = "The fundings of a token a recipient can get per day from the unauthenticated route. 0 disables it"
+
+	ipDailyQuotaName    = "ipDailyQuota"
+	ipDailyQuotaDefault = 0
+	ipDailyQuotaUsage   = "The fundings of a token a requester IP can get per day from the unauthenticated route. 0 disables it"
+
+	limitsStorePathName    = "limitsStorePath"
+	limitsStorePathDefault = ""
+	limitsStorePathUsage   = "The file the funding records of the limits are kept in. In memory if empty"
+
+	trustedProxiesName    = "trustedProxies"
+	trustedProxiesDefault = ""
+	trustedProxiesUsage   = "The IPs or CIDRs of the reverse proxies in front of the faucet, as a comma-separated list. The requester IP the limits apply to is read from the forwarding headers they set. None if empty"
 )
 
 func parseCLIArgs() *faucet.Config {
@@ -43,8 +79,21 @@ func parseCLIArgs() *faucet.Config {
 	jwtSecret := flag.String(jwtSecretName, jwtSecretDefault, jwtSecretUsage)
 	serverPort := flag.Int(serverPortName, serverPortDefault, serverPortUsage)
 	defaultAmount := flag.Float64(defaultAmountName, defaultAmountDefault, defaultAmountUsage)
+	erc20Tokens := flag.String(erc20TokensName, erc20TokensDefault, erc20TokensUsage)
+	erc20Amount := flag.Int64(erc20AmountName, erc20AmountDefault, erc20AmountUsage)
+	addressCooldown := flag.Duration(addressCooldownName, addressCooldownDefault, addressCooldownUsage)
+	ipCooldown := flag.Duration(ipCooldownName, ipCooldownDefault, ipCooldownUsage)
+	addressDailyQuota := flag.Uint(addressDailyQuotaName, addressDailyQuotaDefault, addressDailyQuotaUsage)
+	ipDailyQuota := flag.Uint(ipDailyQuotaName, ipDailyQuotaDefault, ipDailyQuotaUsage)
+	limitsStorePath := flag.String(limitsStorePathName, limitsStorePathDefault, limitsStorePathUsage)
+	trustedProxies := flag.String(trustedProxiesName, trustedProxiesDefault, trustedProxiesUsage)
 	flag.Parse()
 
+	tokens, err := parseERC20Tokens(*erc20Tokens)
+	if err != nil {
+		panic(err)
+	}
+
 	return &faucet.Config{
 		Host:              *nodeHost,
 		HTTPPort:          *nodeHTTPPort,
@@ -53,7 +102,47 @@ func parseCLIArgs() *faucet.Config {
 		ServerPort:        *serverPort,
 		ChainID:           big.NewInt(443), // TODO make this configurable
 		DefaultFundAmount: toWei(defaultAmount),
+		ERC20Tokens:       tokens,
+		ERC20FundAmount:   big.NewInt(*erc20Amount),
+		Limits: faucet.Limits{
+			AddressCooldown:   *addressCooldown,
+			IPCooldown:        *ipCooldown,
+			AddressDailyQuota: *addressDailyQuota,
+			IPDailyQuota:      *ipDailyQuota,
+		},
+		LimitsStorePath: *limitsStorePath,
+		TrustedProxies:  parseList(*trustedProxies),
+	}
+}
+
+// parseERC20Tokens reads a comma-separated list of name=address
+func parseERC20Tokens(list string) (map[string]common.Address, error) {
+	tokens := map[string]common.Address{}
+	if list == "" {
+		return tokens, nil
+	}
+	for _, entry := range strings.Split(list, ",") {
+		name, address, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || name == "" || !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid ERC-20 token %q, expected name=address", entry)
+		}
+		if name == faucet.NativeToken || name == faucet.DeprecatedNativeToken {
+			return nil, fmt.Errorf("ERC-20 token cannot be named %s", name)
+		}
+		tokens[name] = common.HexToAddress(address)
+	}
+	return tokens, nil
+}
+
+// parseList reads a comma-separated list, ignoring the empty entries
+func parseList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
 	}
+	return entries
 }
 
 func toWei(amount *float64) *big.Int {
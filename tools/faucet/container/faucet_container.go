@@ -16,12 +16,19 @@ func NewFaucetContainerFromConfig(cfg *faucet.Config) (*FaucetContainer, error)
 	// we connect to the node via HTTP (config HTTPPort must not be the WSPort for the host)
 	nodeAddr := fmt.Sprintf("http://%s:%d", cfg.Host, cfg.HTTPPort)
 
-	f, err := faucet.NewFaucet(nodeAddr, cfg.ChainID.Int64(), cfg.PK[2:])
+	f, err := faucet.NewFaucet(nodeAddr, cfg.ChainID.Int64(), cfg.PK[2:], cfg.ERC20Tokens)
+	if err != nil {
+		return nil, err
+	}
+	limiter, err := faucet.NewRateLimiter(cfg.Limits, cfg.LimitsStorePath)
 	if err != nil {
 		return nil, err
 	}
 	bindAddress := fmt.Sprintf(":%d", cfg.ServerPort)
-	server := webserver.NewWebServer(f, bindAddress, []byte(cfg.JWTSecret), cfg.DefaultFundAmount)
+	server, err := webserver.NewWebServer(f, bindAddress, []byte(cfg.JWTSecret), cfg.DefaultFundAmount, cfg.ERC20FundAmount, limiter, cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewFaucetContainer(f, server)
 }
@@ -34,9 +41,12 @@ func NewFaucetContainer(faucetServer *faucet.Faucet, webServer *webserver.WebSer
 }
 
 func (c *FaucetContainer) Start() error {
+	c.faucetServer.Start()
 	return c.webServer.Start()
 }
 
 func (c *FaucetContainer) Stop() error {
-	return c.webServer.Stop()
+	err := c.webServer.Stop()
+	c.faucetServer.Stop()
+	return err
 }
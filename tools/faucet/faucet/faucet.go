@@ -2,40 +2,37 @@ package faucet
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
-	"time"
 
 	"github.com/ethereum/go-ethereum"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter/erc20contractlib"
 	"github.com/ten-protocol/go-ten/go/obsclient"
 	"github.com/ten-protocol/go-ten/go/wallet"
 )
 
 const (
-	_timeout    = 60 * time.Second
 	NativeToken = "eth"
 	// DeprecatedNativeToken is left in temporarily for tooling that is getting native funds using `/ten` URL
 	DeprecatedNativeToken = "ten" // todo (@matt) remove this once we have fixed the /ten usages
-	WrappedOBX            = "wobx"
-	WrappedEth            = "weth"
-	WrappedUSDC           = "usdc"
 )
 
 type Faucet struct {
-	client    *obsclient.AuthObsClient
-	fundMutex sync.Mutex
-	wallet    wallet.Wallet
-	Logger    log.Logger
+	client *obsclient.AuthObsClient
+	wallet wallet.Wallet
+	queue  *fundingQueue
+	Logger log.Logger
+
+	erc20Tokens   map[string]common.Address // the ERC-20 tokens the faucet holds, by name
+	erc20Decimals sync.Map                  // the decimals of the ERC-20 tokens, fetched on first use
 }
 
-func NewFaucet(rpcURL string, chainID int64, pkString string) (*Faucet, error) {
+func NewFaucet(rpcURL string, chainID int64, pkString string, erc20Tokens map[string]common.Address) (*Faucet, error) {
 	logger := log.New()
 	w := wallet.NewInMemoryWalletFromConfig(pkString, chainID, logger)
 	obsClient, err := obsclient.DialWithAuth(rpcURL, w, logger)
@@ -43,102 +40,127 @@ func NewFaucet(rpcURL string, chainID int64, pkString string) (*Faucet, error) {
 		return nil, fmt.Errorf("unable to connect with the node: %w", err)
 	}
 
-	return &Faucet{
-		client: obsClient,
-		wallet: w,
-		Logger: logger,
-	}, nil
+	f := &Faucet{
+		client:      obsClient,
+		wallet:      w,
+		Logger:      logger,
+		erc20Tokens: erc20Tokens,
+	}
+	f.queue = newFundingQueue(obsClient, w, f.fundingTx, logger)
+	return f, nil
 }
 
-func (f *Faucet) Fund(address *common.Address, token string, amount *big.Int) (string, error) {
-	var err error
-	var signedTx *types.Transaction
-
-	if token == NativeToken || token == DeprecatedNativeToken {
-		signedTx, err = f.fundNativeToken(address, amount)
-	} else {
-		return "", fmt.Errorf("token not fundable atm")
-		// todo implement this when contracts are deployable somewhere
-	}
-	if err != nil {
-		return "", err
-	}
+// IsERC20 returns whether the token is one of the ERC-20 tokens the faucet is configured with
+func (f *Faucet) IsERC20(token string) bool {
+	_, ok := f.erc20Tokens[token]
+	return ok
+}
 
-	// the faucet should be the only user of the faucet pk
-	txMarshal, err := json.Marshal(signedTx)
-	if err != nil {
-		return "", err
+// Tokens returns the names of the tokens the faucet can fund, the native token first
+func (f *Faucet) Tokens() []string {
+	tokens := make([]string, 0, len(f.erc20Tokens)+1)
+	for token := range f.erc20Tokens {
+		tokens = append(tokens, token)
 	}
-	f.Logger.Info(fmt.Sprintf("Funded address: %s - tx: %+v\n", address.Hex(), string(txMarshal)))
-	// todo handle tx receipt
+	sort.Strings(tokens)
+	return append([]string{NativeToken}, tokens...)
+}
 
-	if err := f.validateTx(signedTx); err != nil {
-		return "", fmt.Errorf("unable to validate tx %s: %w", signedTx.Hash(), err)
-	}
+// Start starts the worker of the funding queue
+func (f *Faucet) Start() {
+	f.queue.start()
+}
 
-	return signedTx.Hash().Hex(), nil
+// Stop stops the worker of the funding queue. The requests that are not confirmed yet are failed.
+func (f *Faucet) Stop() {
+	f.queue.close()
 }
 
-func (f *Faucet) validateTx(tx *types.Transaction) error {
-	for now := time.Now(); time.Since(now) < _timeout; time.Sleep(time.Second) {
-		receipt, err := f.client.TransactionReceipt(context.Background(), tx.Hash())
-		// end eagerly for unexpected errors
-		if err != nil && !errors.Is(err, ethereum.NotFound) {
-			return fmt.Errorf("could not retrieve transaction receipt in eth_getTransactionReceipt request. Cause: %w", err)
-		}
+// Enqueue queues the funding of the address with the amount of the token, and returns the request to follow it with.
+// The amount of the native token is in wei, and the amount of an ERC-20 token is in whole tokens. onFailed, if set, is
+// called if the funding fails.
+func (f *Faucet) Enqueue(address common.Address, token string, amount *big.Int, onFailed func()) (FundingRequest, error) {
+	if token == DeprecatedNativeToken {
+		token = NativeToken
+	}
+	if token != NativeToken && !f.IsERC20(token) {
+		return FundingRequest{}, fmt.Errorf("token not fundable: %s", token)
+	}
+	return f.queue.enqueue(address, token, amount, onFailed), nil
+}
 
-		// try again until timeout
-		if receipt == nil {
-			continue
-		}
+// Request returns the current state of a funding request
+func (f *Faucet) Request(id string) (FundingRequest, error) {
+	return f.queue.request(id)
+}
 
-		txReceiptBytes, err := receipt.MarshalJSON()
-		if err != nil {
-			return fmt.Errorf("could not marshal transaction receipt to JSON in eth_getTransactionReceipt request. Cause: %w", err)
-		}
-		fmt.Println(string(txReceiptBytes))
+// Subscribe returns a channel receiving the current state of the funding request and its updates, closed once the
+// request is confirmed or failed, and a function to stop the subscription
+func (f *Faucet) Subscribe(id string) (<-chan FundingRequest, func(), error) {
+	return f.queue.subscribe(id)
+}
 
-		if receipt.Status != 1 {
-			return fmt.Errorf("tx status is not 0x1")
-		}
-		return nil
-	}
-	return fmt.Errorf("unable to fetch tx receipt after %s", _timeout)
+// Await waits for the funding request to be confirmed or failed, and returns its final state
+func (f *Faucet) Await(ctx context.Context, id string) (FundingRequest, error) {
+	return f.queue.await(ctx, id)
 }
 
-func (f *Faucet) fundNativeToken(address *common.Address, amount *big.Int) (*types.Transaction, error) {
-	// only one funding at the time
-	f.fundMutex.Lock()
-	defer f.fundMutex.Unlock()
+// fundingTx returns the recipient, value and data of the transaction that funds the request
+func (f *Faucet) fundingTx(request *FundingRequest) (common.Address, *big.Int, []byte, error) {
+	if request.Token == NativeToken {
+		return request.Address, request.amount, nil, nil
+	}
 
-	nonce, err := f.client.NonceAt(context.Background(), nil)
+	contract := f.erc20Tokens[request.Token]
+	decimals, err := f.decimals(contract)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch %s nonce: %w", f.wallet.Address(), err)
-	}
-	// this isn't great as the tx count might be incremented in between calls
-	// but only after removing the pk from other apps can we use a proper counter
-
-	tx := &types.LegacyTx{
-		Nonce:    nonce,
-		GasPrice: big.NewInt(225),
-		To:       address,
-		Value:    amount,
+		return common.Address{}, nil, nil, err
 	}
+	units := new(big.Int).Mul(request.amount, new(big.Int).Exp(big.NewInt(10), decimals, nil))
+	return contract, big.NewInt(0), erc20contractlib.CreateTransferTxData(request.Address, units), nil
+}
 
-	estimatedTx := f.client.EstimateGasAndGasPrice(tx)
+func (f *Faucet) Balance(ctx context.Context) (*big.Int, error) {
+	return f.client.BalanceAt(ctx, nil)
+}
 
-	signedTx, err := f.wallet.SignTransaction(estimatedTx)
+// TokenBalances returns the balance of the faucet for each token it can fund, in wei or in the smallest unit of the
+// ERC-20 token
+func (f *Faucet) TokenBalances(ctx context.Context) (map[string]*big.Int, error) {
+	balances := make(map[string]*big.Int, len(f.erc20Tokens)+1)
+	native, err := f.Balance(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to fetch native balance: %w", err)
 	}
+	balances[NativeToken] = native
 
-	if err = f.client.SendTransaction(context.Background(), signedTx); err != nil {
-		return signedTx, err
+	for token, contract := range f.erc20Tokens {
+		balance, err := f.callERC20(ctx, contract, erc20contractlib.CreateBalanceOfData(f.wallet.Address()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch %s balance: %w", token, err)
+		}
+		balances[token] = balance
 	}
+	return balances, nil
+}
 
-	return signedTx, nil
+func (f *Faucet) decimals(contract common.Address) (*big.Int, error) {
+	if decimals, ok := f.erc20Decimals.Load(contract); ok {
+		return decimals.(*big.Int), nil
+	}
+	decimals, err := f.callERC20(context.Background(), contract, erc20contractlib.CreateDecimalsData())
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch decimals of token %s: %w", contract, err)
+	}
+	f.erc20Decimals.Store(contract, decimals)
+	return decimals, nil
 }
 
-func (f *Faucet) Balance(ctx context.Context) (*big.Int, error) {
-	return f.client.BalanceAt(ctx, nil)
+// callERC20 calls a view function of the token that returns a single number
+func (f *Faucet) callERC20(ctx context.Context, contract common.Address, data []byte) (*big.Int, error) {
+	result, err := f.client.CallContract(ctx, ethereum.CallMsg{From: f.wallet.Address(), To: &contract, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(result), nil
 }
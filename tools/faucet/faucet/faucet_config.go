@@ -1,6 +1,10 @@
 package faucet
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type Config struct {
 	Host              string
@@ -9,5 +13,10 @@ type Config struct {
 	JWTSecret         string
 	ChainID           *big.Int
 	ServerPort        int
-	DefaultFundAmount *big.Int // how much token to fund by default (in wei)
+	DefaultFundAmount *big.Int                  // how much token to fund by default (in wei)
+	ERC20Tokens       map[string]common.Address // the ERC-20 tokens held by the faucet, by the name used in the funding route
+	ERC20FundAmount   *big.Int                  // how much of an ERC-20 token to fund (in whole tokens)
+	Limits            Limits                    // the cooldowns and quotas of the unauthenticated funding route
+	LimitsStorePath   string                    // the file the funding records are kept in (in memory if empty)
+	TrustedProxies    []string                  // the proxies whose forwarding headers give the requester IP (none if empty)
 }
@@ -0,0 +1,207 @@
+package faucet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when a funding request exceeds a cooldown or a daily quota
+var ErrRateLimited = errors.New("rate limited")
+
+const _dayFormat = "2006-01-02"
+
+// Limits are the per-recipient and per-IP limits applied to each token of the public funding route. Zero disables a limit.
+type Limits struct {
+	AddressCooldown   time.Duration // the time a recipient waits between two fundings of a token
+	IPCooldown        time.Duration // the time a requester IP waits between two fundings of a token
+	AddressDailyQuota uint          // the fundings of a token a recipient can get per (UTC) day
+	IPDailyQuota      uint          // the fundings of a token a requester IP can get per (UTC) day
+}
+
+// Allowance is what a recipient can still request for a token, from a given IP
+type Allowance struct {
+	Remaining   *uint `json:"remaining"`   // the fundings left today, nil if there is no quota
+	AvailableAt int64 `json:"availableAt"` // the unix time from which the cooldowns allow the next funding, 0 if they already do
+}
+
+// fundingRecord tracks the fundings of a token for a recipient or an IP
+type fundingRecord struct {
+	Last  time.Time `json:"last"`
+	Day   string    `json:"day"`
+	Count uint      `json:"count"`
+}
+
+// RateLimiter applies the Limits to the funding requests. The records are kept in a small JSON file, so the
+// limits survive restarts, or only in memory if no path is set.
+type RateLimiter struct {
+	limits  Limits
+	path    string
+	mu      sync.Mutex
+	records map[string]fundingRecord
+	now     func() time.Time
+}
+
+func NewRateLimiter(limits Limits, path string) (*RateLimiter, error) {
+	r := &RateLimiter{limits: limits, path: path, records: map[string]fundingRecord{}, now: time.Now}
+	if path == "" {
+		return r, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read funding records - %w", err)
+	}
+	if err = json.Unmarshal(data, &r.records); err != nil {
+		return nil, fmt.Errorf("could not decode funding records - %w", err)
+	}
+	return r, nil
+}
+
+// Reserve checks the limits for funding the recipient with the token from the IP, and records the funding if they
+// allow it. The returned function releases the reservation, for fundings that fail.
+func (r *RateLimiter) Reserve(token, address, ip string) (func(), error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	allowance := r.allowance(token, address, ip, now)
+	if allowance.Remaining != nil && *allowance.Remaining == 0 {
+		return nil, fmt.Errorf("%w: daily quota of %s reached", ErrRateLimited, token)
+	}
+	if allowance.AvailableAt > 0 {
+		return nil, fmt.Errorf("%w: next %s funding available at %s", ErrRateLimited, token, time.Unix(allowance.AvailableAt, 0).UTC().Format(time.RFC3339))
+	}
+
+	keys := []string{addressKey(token, address), ipKey(token, ip)}
+	previous := make(map[string]fundingRecord, len(keys))
+	for _, key := range keys {
+		record, found := r.records[key]
+		if found {
+			previous[key] = record
+		}
+		if record.Day != now.UTC().Format(_dayFormat) {
+			record = fundingRecord{Day: now.UTC().Format(_dayFormat)}
+		}
+		record.Last = now
+		record.Count++
+		r.records[key] = record
+	}
+	// restore must be called holding the lock
+	restore := func() {
+		for _, key := range keys {
+			if record, found := previous[key]; found {
+				r.records[key] = record
+			} else {
+				delete(r.records, key)
+			}
+		}
+	}
+	if err := r.persist(); err != nil {
+		// a funding that could not be recorded is not made, so it must not count towards the limits either
+		restore()
+		return nil, err
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		restore()
+		_ = r.persist()
+	}, nil
+}
+
+// Allowance returns what the recipient can still request for the token from the IP
+func (r *RateLimiter) Allowance(token, address, ip string) *Allowance {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.allowance(token, address, ip, r.now())
+}
+
+func (r *RateLimiter) allowance(token, address, ip string, now time.Time) *Allowance {
+	addressRemaining, addressAvailable := r.check(addressKey(token, address), r.limits.AddressCooldown, r.limits.AddressDailyQuota, now)
+	ipRemaining, ipAvailable := r.check(ipKey(token, ip), r.limits.IPCooldown, r.limits.IPDailyQuota, now)
+
+	allowance := &Allowance{}
+	for _, remaining := range []*uint{addressRemaining, ipRemaining} {
+		if remaining != nil && (allowance.Remaining == nil || *remaining < *allowance.Remaining) {
+			allowance.Remaining = remaining
+		}
+	}
+	available := addressAvailable
+	if ipAvailable.After(available) {
+		available = ipAvailable
+	}
+	if available.After(now) {
+		allowance.AvailableAt = available.Unix()
+	}
+	return allowance
+}
+
+// check returns the fundings left today under the quota (nil without quota) and the time the cooldown ends
+func (r *RateLimiter) check(key string, cooldown time.Duration, quota uint, now time.Time) (*uint, time.Time) {
+	record, found := r.records[key]
+	var count uint
+	if found && record.Day == now.UTC().Format(_dayFormat) {
+		count = record.Count
+	}
+
+	var remaining *uint
+	if quota > 0 {
+		left := uint(0)
+		if count < quota {
+			left = quota - count
+		}
+		remaining = &left
+	}
+
+	var availableAt time.Time
+	if found && cooldown > 0 {
+		availableAt = record.Last.Add(cooldown)
+	}
+	return remaining, availableAt
+}
+
+// persist drops the records that no longer limit a request (from previous days and past their cooldown), and writes
+// the others to the store
+func (r *RateLimiter) persist() error {
+	now := r.now()
+	today := now.UTC().Format(_dayFormat)
+	longestCooldown := max(r.limits.AddressCooldown, r.limits.IPCooldown)
+	for key, record := range r.records {
+		if record.Day != today && record.Last.Add(longestCooldown).Before(now) {
+			delete(r.records, key)
+		}
+	}
+
+	if r.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(r.records)
+	if err != nil {
+		return fmt.Errorf("could not encode funding records - %w", err)
+	}
+	// write to a temporary file first, so a crash never leaves a truncated store
+	tmp := filepath.Join(filepath.Dir(r.path), "."+filepath.Base(r.path)+".tmp")
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write funding records - %w", err)
+	}
+	if err = os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("could not replace funding records - %w", err)
+	}
+	return nil
+}
+
+func addressKey(token, address string) string {
+	return "address/" + token + "/" + address
+}
+
+func ipKey(token, ip string) string {
+	return "ip/" + token + "/" + ip
+}
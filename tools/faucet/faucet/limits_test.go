@@ -0,0 +1,106 @@
+package faucet
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	recipient      = "0x0d2166b7b3A1522186E809e83d925d7b0B6db084"
+	otherRecipient = "0x61f991693aee28dbF4B7CBBA0bB1b7e6fD1D4e41"
+)
+
+func TestCooldownsApplyPerRecipientAndIP(t *testing.T) {
+	limiter := newTestLimiter(t, Limits{AddressCooldown: time.Hour, IPCooldown: time.Minute}, "")
+
+	_, err := limiter.Reserve("usdc", recipient, "1.1.1.1")
+	require.NoError(t, err)
+
+	// the same recipient waits for an hour, whatever the IP
+	_, err = limiter.Reserve("usdc", recipient, "2.2.2.2")
+	require.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, limiter.now().Add(time.Hour).Unix(), limiter.Allowance("usdc", recipient, "2.2.2.2").AvailableAt)
+
+	// the same IP waits for a minute, whatever the recipient
+	_, err = limiter.Reserve("usdc", otherRecipient, "1.1.1.1")
+	require.True(t, errors.Is(err, ErrRateLimited))
+
+	// the cooldowns are per token
+	_, err = limiter.Reserve(NativeToken, recipient, "1.1.1.1")
+	require.NoError(t, err)
+
+	limiter.now = func() time.Time { return time.Date(2024, 1, 1, 13, 1, 0, 0, time.UTC) }
+	_, err = limiter.Reserve("usdc", otherRecipient, "1.1.1.1")
+	require.NoError(t, err)
+}
+
+func TestDailyQuotasResetEachDay(t *testing.T) {
+	limiter := newTestLimiter(t, Limits{AddressDailyQuota: 2, IPDailyQuota: 3}, "")
+
+	for i := 0; i < 2; i++ {
+		_, err := limiter.Reserve("weth", recipient, "1.1.1.1")
+		require.NoError(t, err)
+	}
+	_, err := limiter.Reserve("weth", recipient, "2.2.2.2")
+	require.True(t, errors.Is(err, ErrRateLimited))
+
+	// the IP has one funding left, the other recipient two
+	assert.Equal(t, uint(1), *limiter.Allowance("weth", otherRecipient, "1.1.1.1").Remaining)
+	assert.Equal(t, uint(2), *limiter.Allowance("weth", otherRecipient, "2.2.2.2").Remaining)
+
+	limiter.now = func() time.Time { return time.Date(2024, 1, 2, 0, 0, 1, 0, time.UTC) }
+	_, err = limiter.Reserve("weth", recipient, "1.1.1.1")
+	require.NoError(t, err)
+}
+
+func TestReleasedFundingsDoNotCount(t *testing.T) {
+	limiter := newTestLimiter(t, Limits{AddressCooldown: time.Hour, AddressDailyQuota: 1}, "")
+
+	release, err := limiter.Reserve("usdc", recipient, "1.1.1.1")
+	require.NoError(t, err)
+	release()
+
+	allowance := limiter.Allowance("usdc", recipient, "1.1.1.1")
+	assert.Equal(t, uint(1), *allowance.Remaining)
+	assert.Zero(t, allowance.AvailableAt)
+	_, err = limiter.Reserve("usdc", recipient, "1.1.1.1")
+	require.NoError(t, err)
+}
+
+func TestFundingRecordsArePersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limits.json")
+	limits := Limits{AddressDailyQuota: 1}
+	limiter := newTestLimiter(t, limits, path)
+	_, err := limiter.Reserve("usdc", recipient, "1.1.1.1")
+	require.NoError(t, err)
+
+	restarted := newTestLimiter(t, limits, path)
+	_, err = restarted.Reserve("usdc", recipient, "1.1.1.1")
+	require.True(t, errors.Is(err, ErrRateLimited))
+}
+
+func TestFundingsThatCannotBePersistedDoNotCount(t *testing.T) {
+	// the directory of the store does not exist, so the records cannot be written
+	path := filepath.Join(t.TempDir(), "missing", "limits.json")
+	limiter := newTestLimiter(t, Limits{AddressCooldown: time.Hour, AddressDailyQuota: 1}, path)
+
+	_, err := limiter.Reserve("usdc", recipient, "1.1.1.1")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrRateLimited))
+
+	allowance := limiter.Allowance("usdc", recipient, "1.1.1.1")
+	assert.Equal(t, uint(1), *allowance.Remaining)
+	assert.Zero(t, allowance.AvailableAt)
+}
+
+func newTestLimiter(t *testing.T, limits Limits, path string) *RateLimiter {
+	limiter, err := NewRateLimiter(limits, path)
+	require.NoError(t, err)
+	limiter.now = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+	return limiter
+}
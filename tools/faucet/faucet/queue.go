@@ -0,0 +1,511 @@
+package faucet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/google/uuid"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+// ErrUnknownRequest is returned for a funding request the queue does not know, or no longer remembers
+var ErrUnknownRequest = errors.New("unknown funding request")
+
+// errQueueClosed is the error of the requests that were not confirmed when the queue was closed
+var errQueueClosed = errors.New("the faucet stopped before the funding was confirmed")
+
+const (
+	_pollInterval     = time.Second
+	_roundTimeout     = 30 * time.Second
+	_resubmitAfter    = 30 * time.Second // how long a transaction waits for a receipt before it is resubmitted
+	_maxGasPriceBumps = 5                // after these, a stuck transaction is resubmitted at the same gas price
+	_maxSendAttempts  = 3
+	_maxBatchSize     = 32  // the most requests sent in one round
+	_maxInflight      = 128 // the most transactions waiting for a receipt
+	_retention        = time.Hour
+)
+
+type FundingStatus string
+
+const (
+	StatusQueued    FundingStatus = "queued"
+	StatusSent      FundingStatus = "sent"
+	StatusConfirmed FundingStatus = "confirmed"
+	StatusFailed    FundingStatus = "failed"
+)
+
+// FundingRequest is a funding in the queue, and what clients poll to follow it
+type FundingRequest struct {
+	ID            string         `json:"id"`
+	Address       common.Address `json:"address"`
+	Token         string         `json:"token"`
+	Status        FundingStatus  `json:"status"`
+	TxHash        *common.Hash   `json:"txHash,omitempty"`        // the latest transaction sent for the request
+	Resubmissions int            `json:"resubmissions,omitempty"` // the times the transaction was resubmitted with a higher gas price
+	Error         string         `json:"error,omitempty"`
+	CreatedAt     int64          `json:"createdAt"`
+	UpdatedAt     int64          `json:"updatedAt"`
+
+	amount       *big.Int
+	sendAttempts int
+	signedTx     *types.Transaction // the transaction signed for the request, until it is sent
+	onFailed     func()
+}
+
+// Finished returns whether the request is confirmed or failed
+func (r *FundingRequest) Finished() bool {
+	return r.Status == StatusConfirmed || r.Status == StatusFailed
+}
+
+// nodeClient is the part of the node client used by the queue
+type nodeClient interface {
+	NonceAt(ctx context.Context, blockNumber *big.Int) (uint64, error)
+	EstimateGasAndGasPrice(txData types.TxData) types.TxData
+	SendTransaction(ctx context.Context, signedTx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// txBuilder returns the recipient, value and data of the transaction that funds the request
+type txBuilder func(request *FundingRequest) (common.Address, *big.Int, []byte, error)
+
+// inflightTx is a funding transaction waiting for its receipt
+type inflightTx struct {
+	request *FundingRequest
+	txs     []*types.Transaction // the transaction and its resubmissions, the latest last
+	sentAt  time.Time            // when the latest was sent
+}
+
+// fundingQueue sends the funding transactions from a single worker. It tracks the nonce of the faucet account locally,
+// so the queued requests are sent in batches with consecutive nonces without waiting for each other's receipts, and it
+// resubmits the transactions that get stuck with a higher gas price.
+type fundingQueue struct {
+	client  nodeClient
+	wallet  wallet.Wallet
+	buildTx txBuilder
+	logger  gethlog.Logger
+	now     func() time.Time
+
+	mu          sync.Mutex
+	requests    map[string]*FundingRequest
+	queued      []*FundingRequest
+	subscribers map[string][]chan FundingRequest
+	closed      bool
+
+	// only used by the worker
+	inflight    map[uint64]*inflightTx
+	nextNonce   uint64
+	nonceSynced bool // false until the nonce is read from the node, and again after a request fails to be sent
+
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newFundingQueue(client nodeClient, w wallet.Wallet, buildTx txBuilder, logger gethlog.Logger) *fundingQueue {
+	return &fundingQueue{
+		client:      client,
+		wallet:      w,
+		buildTx:     buildTx,
+		logger:      logger,
+		now:         time.Now,
+		requests:    map[string]*FundingRequest{},
+		subscribers: map[string][]chan FundingRequest{},
+		inflight:    map[uint64]*inflightTx{},
+		wake:        make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+func (q *fundingQueue) start() {
+	go q.run()
+}
+
+// close stops the worker, and fails the requests that are not confirmed yet, so their onFailed callbacks are called
+func (q *fundingQueue) close() {
+	close(q.stop)
+	<-q.done
+
+	q.mu.Lock()
+	q.closed = true
+	unfinished := q.queued
+	q.queued = nil
+	q.mu.Unlock()
+	for _, sent := range q.inflight {
+		unfinished = append(unfinished, sent.request)
+	}
+	q.inflight = map[uint64]*inflightTx{}
+	for _, request := range unfinished {
+		q.fail(request, errQueueClosed)
+	}
+}
+
+func (q *fundingQueue) run() {
+	defer close(q.done)
+	ticker := time.NewTicker(_pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-q.wake:
+		case <-ticker.C:
+		}
+		q.process()
+	}
+}
+
+// enqueue adds a funding to the queue. onFailed is called if it fails.
+func (q *fundingQueue) enqueue(address common.Address, token string, amount *big.Int, onFailed func()) FundingRequest {
+	now := q.now().Unix()
+	request := &FundingRequest{
+		ID:        uuid.NewString(),
+		Address:   address,
+		Token:     token,
+		Status:    StatusQueued,
+		CreatedAt: now,
+		UpdatedAt: now,
+		amount:    amount,
+		onFailed:  onFailed,
+	}
+
+	q.mu.Lock()
+	q.requests[request.ID] = request
+	if q.closed {
+		q.mu.Unlock()
+		q.fail(request, errQueueClosed)
+		return *request
+	}
+	q.queued = append(q.queued, request)
+	snapshot := *request
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return snapshot
+}
+
+func (q *fundingQueue) request(id string) (FundingRequest, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	request, ok := q.requests[id]
+	if !ok {
+		return FundingRequest{}, ErrUnknownRequest
+	}
+	return *request, nil
+}
+
+// subscribe returns a channel receiving the current state of the request and its updates, closed once the request is
+// finished, and a function to stop the subscription. Updates are dropped for a subscriber that does not keep up, but
+// the final state can always be read once the channel is closed.
+func (q *fundingQueue) subscribe(id string) (<-chan FundingRequest, func(), error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	request, ok := q.requests[id]
+	if !ok {
+		return nil, nil, ErrUnknownRequest
+	}
+
+	ch := make(chan FundingRequest, 8)
+	ch <- *request
+	if request.Finished() {
+		close(ch)
+		return ch, func() {}, nil
+	}
+	q.subscribers[id] = append(q.subscribers[id], ch)
+
+	unsubscribe := func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		subs := q.subscribers[id]
+		for i, sub := range subs {
+			if sub == ch {
+				q.subscribers[id] = append(subs[:i], subs[i+1:]...)
+				return
+			}
+		}
+	}
+	return ch, unsubscribe, nil
+}
+
+// await waits for the request to be finished, and returns its final state
+func (q *fundingQueue) await(ctx context.Context, id string) (FundingRequest, error) {
+	updates, unsubscribe, err := q.subscribe(id)
+	if err != nil {
+		return FundingRequest{}, err
+	}
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return FundingRequest{}, fmt.Errorf("funding request %s not finished: %w", id, ctx.Err())
+		case _, ok := <-updates:
+			if !ok {
+				return q.request(id)
+			}
+		}
+	}
+}
+
+// process runs a round of the worker: it checks the sent transactions, then sends a batch of the queued requests
+func (q *fundingQueue) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), _roundTimeout)
+	defer cancel()
+
+	q.checkInflight(ctx)
+	q.sendQueued(ctx)
+	q.prune()
+}
+
+func (q *fundingQueue) checkInflight(ctx context.Context) {
+	if len(q.inflight) == 0 {
+		return
+	}
+	// read before the receipts, so a nonce below it belongs to a transaction with a receipt
+	confirmedNonce, err := q.client.NonceAt(ctx, nil)
+	if err != nil {
+		q.logger.Warn("Could not fetch faucet nonce", log.ErrKey, err)
+		return
+	}
+
+	for nonce, sent := range q.inflight {
+		receipt, err := q.receipt(ctx, sent)
+		switch {
+		case err != nil:
+			q.logger.Warn("Could not fetch funding receipt", "request", sent.request.ID, log.ErrKey, err)
+		case receipt != nil && receipt.Status == types.ReceiptStatusSuccessful:
+			delete(q.inflight, nonce)
+			q.update(sent.request, func(r *FundingRequest) {
+				r.Status = StatusConfirmed
+				r.TxHash = &receipt.TxHash
+			})
+		case receipt != nil:
+			delete(q.inflight, nonce)
+			q.fail(sent.request, fmt.Errorf("transaction %s reverted", receipt.TxHash))
+		case nonce < confirmedNonce:
+			// the faucet key was used to send another transaction with this nonce, so the local nonce is behind as well
+			delete(q.inflight, nonce)
+			q.nonceSynced = false
+			q.fail(sent.request, fmt.Errorf("nonce %d was used by another transaction", nonce))
+		case q.now().Sub(sent.sentAt) >= _resubmitAfter:
+			q.resubmit(ctx, nonce, sent)
+		}
+	}
+}
+
+// receipt returns the receipt of whichever of the transactions sent for the nonce was mined, if any
+func (q *fundingQueue) receipt(ctx context.Context, sent *inflightTx) (*types.Receipt, error) {
+	for i := len(sent.txs) - 1; i >= 0; i-- {
+		receipt, err := q.client.TransactionReceipt(ctx, sent.txs[i].Hash())
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+	return nil, nil //nolint:nilnil
+}
+
+// resubmit sends a stuck transaction again with the same nonce. The node only replaces a pending transaction paying a
+// higher gas price, so the price is bumped by 25%, up to _maxGasPriceBumps times.
+func (q *fundingQueue) resubmit(ctx context.Context, nonce uint64, sent *inflightTx) {
+	latest := sent.txs[len(sent.txs)-1]
+	gasPrice := latest.GasPrice()
+	if len(sent.txs) <= _maxGasPriceBumps {
+		gasPrice = new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(125)), big.NewInt(100))
+		gasPrice.Add(gasPrice, big.NewInt(1))
+	}
+
+	signedTx, err := q.wallet.SignTransaction(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      latest.Gas(),
+		To:       latest.To(),
+		Value:    latest.Value(),
+		Data:     latest.Data(),
+	})
+	if err != nil {
+		q.logger.Error("Could not sign funding resubmission", "request", sent.request.ID, log.ErrKey, err)
+		return
+	}
+	sent.sentAt = q.now()
+	if err = q.client.SendTransaction(ctx, signedTx); err != nil {
+		q.logger.Warn("Could not resubmit funding transaction", "request", sent.request.ID, "nonce", nonce, log.ErrKey, err)
+		return
+	}
+	if signedTx.Hash() == latest.Hash() {
+		return
+	}
+
+	sent.txs = append(sent.txs, signedTx)
+	q.logger.Info("Resubmitted stuck funding transaction", "request", sent.request.ID, "nonce", nonce, "gasPrice", gasPrice)
+	q.update(sent.request, func(r *FundingRequest) {
+		hash := signedTx.Hash()
+		r.TxHash = &hash
+		r.Resubmissions = len(sent.txs) - 1
+	})
+}
+
+func (q *fundingQueue) sendQueued(ctx context.Context) {
+	q.mu.Lock()
+	size := min(len(q.queued), _maxBatchSize, _maxInflight-len(q.inflight))
+	if size <= 0 {
+		q.mu.Unlock()
+		return
+	}
+	batch := make([]*FundingRequest, size)
+	copy(batch, q.queued)
+	q.queued = q.queued[size:]
+	q.mu.Unlock()
+
+	for i, request := range batch {
+		err := q.send(ctx, request)
+		if err == nil {
+			continue
+		}
+
+		// the rest of the batch waits for the next round, so a node that is down does not fail every request at once
+		retry := batch[i+1:]
+		request.sendAttempts++
+		if request.sendAttempts < _maxSendAttempts {
+			q.logger.Warn("Could not send funding transaction, will retry", "request", request.ID, log.ErrKey, err)
+			retry = batch[i:]
+		} else {
+			// the nonce is read from the node again, as the transaction may or may not have reached it
+			request.signedTx = nil
+			q.nonceSynced = false
+			q.fail(request, err)
+		}
+		q.mu.Lock()
+		q.queued = append(append([]*FundingRequest{}, retry...), q.queued...)
+		q.mu.Unlock()
+		return
+	}
+}
+
+func (q *fundingQueue) send(ctx context.Context, request *FundingRequest) error {
+	// a transaction whose send failed is sent again as it is, so its nonce is never given to another transaction
+	signedTx := request.signedTx
+	if signedTx == nil {
+		to, value, data, err := q.buildTx(request)
+		if err != nil {
+			return err
+		}
+		nonce, err := q.nonce(ctx)
+		if err != nil {
+			return err
+		}
+		tx := q.client.EstimateGasAndGasPrice(&types.LegacyTx{Nonce: nonce, To: &to, Value: value, Data: data})
+		signedTx, err = q.wallet.SignTransaction(tx)
+		if err != nil {
+			return fmt.Errorf("unable to sign funding transaction: %w", err)
+		}
+		q.nextNonce = nonce + 1
+	}
+
+	// a failed send may have reached the node, in which case it already has the transaction
+	err := q.client.SendTransaction(ctx, signedTx)
+	if err != nil && !(request.signedTx != nil && isKnownTxErr(err)) {
+		request.signedTx = signedTx
+		return fmt.Errorf("unable to send funding transaction: %w", err)
+	}
+	if err != nil && strings.Contains(err.Error(), "nonce too low") {
+		// the nonce may have been used by another transaction sent with the faucet key
+		q.nonceSynced = false
+	}
+
+	request.signedTx = nil
+	q.inflight[signedTx.Nonce()] = &inflightTx{request: request, txs: []*types.Transaction{signedTx}, sentAt: q.now()}
+	q.logger.Info("Sent funding transaction", "request", request.ID, "address", request.Address, "token", request.Token, "tx", signedTx.Hash(), "nonce", signedTx.Nonce())
+	q.update(request, func(r *FundingRequest) {
+		hash := signedTx.Hash()
+		r.Status = StatusSent
+		r.TxHash = &hash
+	})
+	return nil
+}
+
+// isKnownTxErr returns whether the node rejected a transaction because it already has it, or already executed a
+// transaction with its nonce (the receipts then tell whether it was this one)
+func isKnownTxErr(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "nonce too low")
+}
+
+// nonce returns the nonce of the next transaction, read from the node if the local one is not in sync
+func (q *fundingQueue) nonce(ctx context.Context) (uint64, error) {
+	if q.nonceSynced {
+		return q.nextNonce, nil
+	}
+	nonce, err := q.client.NonceAt(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch %s nonce: %w", q.wallet.Address(), err)
+	}
+	// the node does not count the transactions that are still pending
+	for inflightNonce := range q.inflight {
+		if inflightNonce >= nonce {
+			nonce = inflightNonce + 1
+		}
+	}
+	q.nextNonce = nonce
+	q.nonceSynced = true
+	return nonce, nil
+}
+
+func (q *fundingQueue) fail(request *FundingRequest, err error) {
+	q.logger.Warn("Funding request failed", "request", request.ID, "address", request.Address, "token", request.Token, log.ErrKey, err)
+	q.update(request, func(r *FundingRequest) {
+		r.Status = StatusFailed
+		r.Error = err.Error()
+	})
+}
+
+// update changes the request and notifies its subscribers
+func (q *fundingQueue) update(request *FundingRequest, change func(r *FundingRequest)) {
+	q.mu.Lock()
+	change(request)
+	request.UpdatedAt = q.now().Unix()
+	snapshot := *request
+	for _, sub := range q.subscribers[request.ID] {
+		select {
+		case sub <- snapshot:
+		default:
+		}
+		if request.Finished() {
+			close(sub)
+		}
+	}
+	if request.Finished() {
+		delete(q.subscribers, request.ID)
+	}
+	q.mu.Unlock()
+
+	if snapshot.Status == StatusFailed && request.onFailed != nil {
+		request.onFailed()
+	}
+}
+
+// prune forgets the requests finished for longer than the retention
+func (q *fundingQueue) prune() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	cutoff := q.now().Add(-_retention).Unix()
+	for id, request := range q.requests {
+		if request.Finished() && request.UpdatedAt < cutoff {
+			delete(q.requests, id)
+		}
+	}
+}
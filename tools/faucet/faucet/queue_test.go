@@ -0,0 +1,291 @@
+package faucet
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+func TestQueuedRequestsAreSentWithConsecutiveNonces(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+
+	ids := make([]string, 3)
+	for i := range ids {
+		ids[i] = queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil).ID
+	}
+	updates, _, err := queue.subscribe(ids[0])
+	require.NoError(t, err)
+
+	queue.process()
+	require.Len(t, node.sent, 3)
+	for i, tx := range node.sent {
+		assert.Equal(t, uint64(5+i), tx.Nonce())
+		request, _ := queue.request(ids[i])
+		assert.Equal(t, StatusSent, request.Status)
+		assert.Equal(t, tx.Hash(), *request.TxHash)
+	}
+	// the nonce was read once for the whole batch
+	assert.Equal(t, 1, node.nonceReads)
+
+	node.mine(node.sent...)
+	queue.process()
+	var statuses []FundingStatus
+	for update := range updates {
+		statuses = append(statuses, update.Status)
+	}
+	assert.Equal(t, []FundingStatus{StatusQueued, StatusSent, StatusConfirmed}, statuses)
+	assert.Empty(t, queue.inflight)
+}
+
+func TestFailedSendIsRetriedWithTheSameNonce(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+	failed := false
+	first := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), func() { failed = true }).ID
+	second := queue.enqueue(common.HexToAddress(otherRecipient), NativeToken, big.NewInt(100), nil).ID
+
+	// the node takes the first transaction, but the send times out
+	node.sendErr = errors.New("timeout")
+	node.acceptOnErr = true
+	queue.process()
+	request, _ := queue.request(first)
+	assert.Equal(t, StatusQueued, request.Status)
+	require.Len(t, node.sent, 1)
+
+	// the retry sends the same transaction, and the next request gets the next nonce
+	node.sendErr = nil
+	queue.process()
+	request, _ = queue.request(first)
+	assert.Equal(t, StatusSent, request.Status)
+	assert.Equal(t, node.sent[0].Hash(), *request.TxHash)
+	request, _ = queue.request(second)
+	assert.Equal(t, StatusSent, request.Status)
+	require.Len(t, node.sent, 2)
+	assert.Equal(t, uint64(6), node.sent[1].Nonce())
+	assert.False(t, failed)
+}
+
+func TestRequestFailsAfterLastSendAttempt(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+	failed := false
+	id := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), func() { failed = true }).ID
+
+	node.sendErr = errors.New("node down")
+	for i := 0; i < _maxSendAttempts; i++ {
+		queue.process()
+	}
+	request, _ := queue.request(id)
+	assert.Equal(t, StatusFailed, request.Status)
+	assert.True(t, failed)
+
+	// the nonce is read again once the node is back
+	node.sendErr = nil
+	queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil)
+	queue.process()
+	require.Len(t, node.sent, 1)
+	assert.Equal(t, uint64(5), node.sent[0].Nonce())
+	assert.Equal(t, 2, node.nonceReads)
+}
+
+func TestStuckTransactionIsResubmittedWithHigherGasPrice(t *testing.T) {
+	node := newFakeNode(0)
+	queue := newTestQueue(t, node)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	queue.now = func() time.Time { return now }
+	id := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil).ID
+
+	queue.process()
+	require.Len(t, node.sent, 1)
+
+	now = now.Add(_resubmitAfter)
+	queue.process()
+	require.Len(t, node.sent, 2)
+	original, resubmitted := node.sent[0], node.sent[1]
+	assert.Equal(t, original.Nonce(), resubmitted.Nonce())
+	// the node only replaces a pending transaction paying at least 10% more
+	minReplacementPrice := new(big.Int).Div(new(big.Int).Mul(original.GasPrice(), big.NewInt(11)), big.NewInt(10))
+	assert.True(t, resubmitted.GasPrice().Cmp(minReplacementPrice) >= 0)
+
+	// the original transaction may still be the one mined
+	node.mine(original)
+	queue.process()
+	request, _ := queue.request(id)
+	assert.Equal(t, StatusConfirmed, request.Status)
+	assert.Equal(t, original.Hash(), *request.TxHash)
+	assert.Equal(t, 1, request.Resubmissions)
+}
+
+func TestNonceIsReadAgainAfterFaucetKeyIsUsedElsewhere(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+	id := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil).ID
+	queue.process()
+	require.Len(t, node.sent, 1)
+
+	// another process mines transactions signed with the faucet key, replacing the funding transaction
+	node.mine(externalTxs(t, queue, 5, 6, 7)...)
+	queue.process()
+	request, _ := queue.request(id)
+	assert.Equal(t, StatusFailed, request.Status)
+
+	queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil)
+	queue.process()
+	require.Len(t, node.sent, 2)
+	assert.Equal(t, uint64(8), node.sent[1].Nonce())
+}
+
+func TestNonceIsReadAgainAfterNonceTooLow(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+	queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil)
+
+	// the send fails, and the nonce is used by another process before it is retried
+	node.sendErr = errors.New("timeout")
+	queue.process()
+	require.Empty(t, node.sent)
+	node.sendErr = nil
+	node.mine(externalTxs(t, queue, 5, 6)...)
+
+	// the next request in the batch of the retry does not take the nonce after the used one
+	queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), nil)
+	queue.process()
+	require.Len(t, node.sent, 1)
+	assert.Equal(t, uint64(7), node.sent[0].Nonce())
+}
+
+func TestUnfinishedRequestsFailWhenQueueIsClosed(t *testing.T) {
+	node := newFakeNode(5)
+	queue := newTestQueue(t, node)
+	var mu sync.Mutex
+	failures := 0
+	onFailed := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		failures++
+	}
+	queue.start()
+
+	sent := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), onFailed).ID
+	updates, unsubscribe, err := queue.subscribe(sent)
+	require.NoError(t, err)
+	for update := range updates {
+		if update.Status == StatusSent {
+			break
+		}
+	}
+	unsubscribe()
+
+	node.mu.Lock()
+	node.sendErr = errors.New("node down")
+	node.mu.Unlock()
+	queued := queue.enqueue(common.HexToAddress(otherRecipient), NativeToken, big.NewInt(100), onFailed).ID
+
+	queue.close()
+	for _, id := range []string{sent, queued} {
+		request, _ := queue.request(id)
+		assert.Equal(t, StatusFailed, request.Status)
+	}
+	late := queue.enqueue(common.HexToAddress(recipient), NativeToken, big.NewInt(100), onFailed)
+	assert.Equal(t, StatusFailed, late.Status)
+	assert.Equal(t, 3, failures)
+}
+
+// externalTxs returns transactions signed with the faucet key by another process
+func externalTxs(t *testing.T, queue *fundingQueue, nonces ...uint64) []*types.Transaction {
+	txs := make([]*types.Transaction, len(nonces))
+	for i, nonce := range nonces {
+		to := common.HexToAddress(otherRecipient)
+		tx, err := queue.wallet.SignTransaction(&types.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(2_000), Gas: 21_000, To: &to, Value: big.NewInt(1)})
+		require.NoError(t, err)
+		txs[i] = tx
+	}
+	return txs
+}
+
+func newTestQueue(t *testing.T, node *fakeNode) *fundingQueue {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	w := wallet.NewInMemoryWalletFromPK(big.NewInt(443), key, gethlog.New())
+	buildTx := func(request *FundingRequest) (common.Address, *big.Int, []byte, error) {
+		return request.Address, request.amount, nil, nil
+	}
+	return newFundingQueue(node, w, buildTx, gethlog.New())
+}
+
+// fakeNode accepts the transactions sent to it, and only returns receipts for the ones it is told to mine
+type fakeNode struct {
+	mu          sync.Mutex
+	nonce       uint64 // the nonce after the mined transactions, as the node does not count the pending ones
+	nonceReads  int
+	sent        []*types.Transaction
+	sendErr     error
+	acceptOnErr bool // whether the node keeps the transaction it returns an error for
+	receipts    map[common.Hash]*types.Receipt
+}
+
+func newFakeNode(nonce uint64) *fakeNode {
+	return &fakeNode{nonce: nonce, receipts: map[common.Hash]*types.Receipt{}}
+}
+
+func (n *fakeNode) mine(txs ...*types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, tx := range txs {
+		n.receipts[tx.Hash()] = &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}
+		if tx.Nonce() >= n.nonce {
+			n.nonce = tx.Nonce() + 1
+		}
+	}
+}
+
+func (n *fakeNode) NonceAt(context.Context, *big.Int) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.nonceReads++
+	return n.nonce, nil
+}
+
+func (n *fakeNode) EstimateGasAndGasPrice(txData types.TxData) types.TxData {
+	tx := types.NewTx(txData)
+	return &types.LegacyTx{Nonce: tx.Nonce(), GasPrice: big.NewInt(1_000), Gas: 21_000, To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+}
+
+func (n *fakeNode) SendTransaction(_ context.Context, signedTx *types.Transaction) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, tx := range n.sent {
+		if tx.Hash() == signedTx.Hash() {
+			return errors.New("already known")
+		}
+	}
+	if signedTx.Nonce() < n.nonce {
+		return errors.New("nonce too low")
+	}
+	if n.sendErr == nil || n.acceptOnErr {
+		n.sent = append(n.sent, signedTx)
+	}
+	return n.sendErr
+}
+
+func (n *fakeNode) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if receipt, ok := n.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
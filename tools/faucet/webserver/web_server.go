@@ -2,6 +2,7 @@ package webserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -11,9 +12,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/ten-protocol/go-ten/tools/faucet/faucet"
 )
 
+// how long the blocking mode of the funding routes waits for the funding to be confirmed
+const _waitTimeout = 2 * time.Minute
+
 type WebServer struct {
 	engine      *gin.Engine
 	faucet      *faucet.Faucet
@@ -25,25 +30,50 @@ type requestAddr struct {
 	Address string `json:"address" binding:"required"`
 }
 
-func NewWebServer(faucetServer *faucet.Faucet, bindAddress string, jwtSecret []byte, defaultAmount *big.Int) *WebServer {
+// fundAmounts are the amounts sent by the funding routes
+type fundAmounts struct {
+	native *big.Int // in wei
+	erc20  *big.Int // in whole tokens
+}
+
+func (a fundAmounts) of(faucetServer *faucet.Faucet, token string) *big.Int {
+	if faucetServer.IsERC20(token) {
+		return a.erc20
+	}
+	return a.native
+}
+
+func NewWebServer(faucetServer *faucet.Faucet, bindAddress string, jwtSecret []byte, defaultAmount *big.Int, erc20Amount *big.Int, limiter *faucet.RateLimiter, trustedProxies []string) (*WebServer, error) {
 	r := gin.New()
 	gin.SetMode(gin.ReleaseMode)
+	// the limits are keyed by the requester IP, so the forwarding headers are only read from the configured proxies,
+	// otherwise the requester could set them. Without proxies, the remote address is used.
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies - %w", err)
+	}
 
-	// authed endpoint
-	r.POST("/auth/fund/:token", jwtTokenChecker(jwtSecret, faucetServer.Logger), fundingHandler(faucetServer, defaultAmount))
+	amounts := fundAmounts{native: defaultAmount, erc20: erc20Amount}
+
+	// authed endpoint, not subject to the limits
+	r.POST("/auth/fund/:token", jwtTokenChecker(jwtSecret, faucetServer.Logger), fundingHandler(faucetServer, amounts, nil))
 
 	// todo (@matt) we need to remove this unsecure endpoint before we provide a fully public sepolia faucet
-	r.POST("/fund/:token", fundingHandler(faucetServer, defaultAmount))
+	r.POST("/fund/:token", fundingHandler(faucetServer, amounts, limiter))
+
+	r.GET("/fund/status/:id", fundingStatusHandler(faucetServer))
+	r.GET("/fund/status/:id/ws", fundingStatusStreamHandler(faucetServer))
 
 	r.GET("/balance", balanceReqHandler(faucetServer))
 
+	r.GET("/allowance/:address", allowanceReqHandler(faucetServer, limiter))
+
 	r.GET("/health", healthReqHandler())
 
 	return &WebServer{
 		engine:      r,
 		faucet:      faucetServer,
 		bindAddress: bindAddress,
-	}
+	}, nil
 }
 
 func jwtTokenChecker(jwtSecret []byte, logger log.Logger) gin.HandlerFunc {
@@ -111,26 +141,18 @@ func extractBearerToken(header string) (string, error) {
 	return jwtToken[1], nil
 }
 
-func fundingHandler(faucetServer *faucet.Faucet, defaultAmount *big.Int) gin.HandlerFunc {
+func fundingHandler(faucetServer *faucet.Faucet, amounts fundAmounts, limiter *faucet.RateLimiter) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenReq := c.Params.ByName("token")
-		var token string
+		token := c.Params.ByName("token")
 
 		// check the token request type
-		switch tokenReq {
-		case faucet.NativeToken:
-			token = faucet.NativeToken
+		switch {
 		// we leave this option in temporarily for tools that are still using `/ten` endpoint for native funds
-		case faucet.DeprecatedNativeToken:
+		case token == faucet.NativeToken || token == faucet.DeprecatedNativeToken:
 			token = faucet.NativeToken
-		case faucet.WrappedOBX:
-			token = faucet.WrappedOBX
-		case faucet.WrappedEth:
-			token = faucet.WrappedEth
-		case faucet.WrappedUSDC:
-			token = faucet.WrappedUSDC
+		case faucetServer.IsERC20(token):
 		default:
-			errorHandler(c, fmt.Errorf("token not recognized: %s", tokenReq), faucetServer.Logger)
+			errorHandler(c, fmt.Errorf("token not recognized: %s", token), faucetServer.Logger)
 			return
 		}
 
@@ -146,16 +168,141 @@ func fundingHandler(faucetServer *faucet.Faucet, defaultAmount *big.Int) gin.Han
 			errorHandler(c, fmt.Errorf("unexpected address %s", req.Address), faucetServer.Logger)
 			return
 		}
-
-		// fund the address
 		addr := common.HexToAddress(req.Address)
-		hash, err := faucetServer.Fund(&addr, token, defaultAmount)
+
+		// only fundings that do not fail count towards the limits
+		var release func()
+		if limiter != nil {
+			var err error
+			release, err = limiter.Reserve(token, addr.Hex(), c.ClientIP())
+			if errors.Is(err, faucet.ErrRateLimited) {
+				c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+					"error":     err.Error(),
+					"allowance": limiter.Allowance(token, addr.Hex(), c.ClientIP()),
+				})
+				return
+			}
+			if err != nil {
+				errorHandler(c, fmt.Errorf("unable to check funding limits %w", err), faucetServer.Logger)
+				return
+			}
+		}
+
+		request, err := faucetServer.Enqueue(addr, token, amounts.of(faucetServer, token), release)
 		if err != nil {
+			if release != nil {
+				release()
+			}
 			errorHandler(c, fmt.Errorf("unable to fund request %w", err), faucetServer.Logger)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "ok", "tx": hash})
+		if c.Query("wait") != "true" {
+			c.JSON(http.StatusAccepted, gin.H{"status": request.Status, "requestId": request.ID})
+			return
+		}
+
+		// the blocking mode of the route, for the clients that expect the funding to be done when it returns
+		ctx, cancel := context.WithTimeout(c, _waitTimeout)
+		defer cancel()
+		request, err = faucetServer.Await(ctx, request.ID)
+		if err != nil {
+			errorHandler(c, fmt.Errorf("unable to fund request %w", err), faucetServer.Logger)
+			return
+		}
+		if request.Status != faucet.StatusConfirmed {
+			errorHandler(c, fmt.Errorf("unable to fund request %s: %s", request.ID, request.Error), faucetServer.Logger)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "tx": request.TxHash.Hex(), "requestId": request.ID})
+	}
+}
+
+// returns the current state of a funding request
+func fundingStatusHandler(faucetServer *faucet.Faucet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request, err := faucetServer.Request(c.Params.ByName("id"))
+		if errors.Is(err, faucet.ErrUnknownRequest) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			errorHandler(c, err, faucetServer.Logger)
+			return
+		}
+
+		c.JSON(http.StatusOK, request)
+	}
+}
+
+// streams the state of a funding request over a websocket, which is closed once the request is confirmed or failed
+func fundingStatusStreamHandler(faucetServer *faucet.Faucet) gin.HandlerFunc {
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	return func(c *gin.Context) {
+		id := c.Params.ByName("id")
+		updates, unsubscribe, err := faucetServer.Subscribe(id)
+		if errors.Is(err, faucet.ErrUnknownRequest) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			errorHandler(c, err, faucetServer.Logger)
+			return
+		}
+		defer unsubscribe()
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			faucetServer.Logger.Warn("Could not upgrade funding status stream", "request", id, "err", err)
+			return
+		}
+		defer conn.Close()
+
+		var last faucet.FundingRequest
+		for last = range updates {
+			if err = conn.WriteJSON(last); err != nil {
+				return
+			}
+		}
+		// the final state is dropped for a client that does not keep up
+		if final, err := faucetServer.Request(id); err == nil && final.Status != last.Status {
+			_ = conn.WriteJSON(final)
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}
+}
+
+// returns, for each token, what the address can still request from the caller IP and the balance of the faucet
+func allowanceReqHandler(faucetServer *faucet.Faucet, limiter *faucet.RateLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		address := c.Params.ByName("address")
+		if !common.IsHexAddress(address) {
+			errorHandler(c, fmt.Errorf("unexpected address %s", address), faucetServer.Logger)
+			return
+		}
+		addr := common.HexToAddress(address)
+
+		balances, err := faucetServer.TokenBalances(c)
+		if err != nil {
+			errorHandler(c, fmt.Errorf("unable to get balances %w", err), faucetServer.Logger)
+			return
+		}
+
+		tokens := make(map[string]gin.H, len(balances))
+		for _, token := range faucetServer.Tokens() {
+			allowance := &faucet.Allowance{}
+			if limiter != nil {
+				allowance = limiter.Allowance(token, addr.Hex(), c.ClientIP())
+			}
+			tokens[token] = gin.H{
+				"remaining":     allowance.Remaining,
+				"availableAt":   allowance.AvailableAt,
+				"faucetBalance": balances[token].String(),
+			}
+		}
+
+		c.JSON(http.StatusOK, gin.H{"address": addr.Hex(), "tokens": tokens})
 	}
 }
 
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/host/l1"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/tools/rolluparchive"
+)
+
+const usage = `Usage: rolluparchive <verify|rebuild> [flags]
+
+verify   checks that every rollup published on the L1 is in the archive and matches its L1 blob hashes
+rebuild  restores the blobs of the published rollups from the archive into a host DB, so the node can sync
+         without a beacon node (leave blobRetention at 0 on the host so they are not pruned)
+`
+
+func main() {
+	if len(os.Args) < 2 || (os.Args[1] != "verify" && os.Args[1] != "rebuild") {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	l1RPCAddress := flags.String("l1RPCAddress", "ws://127.0.0.1:8546", "The websocket address of the L1 execution node")
+	mgmtContractAddress := flags.String("managementContractAddress", "", "The address of the management contract")
+	archiveURL := flags.String("archiveUrl", "", "The directory or S3 url (s3://host/bucket[/prefix]) of the rollup archive")
+	fromBlock := flags.Uint64("fromBlock", 0, "The L1 height to start from, usually the height the management contract was deployed at")
+	toBlock := flags.Uint64("toBlock", 0, "The last L1 height to check (Defaults to the L1 head)")
+	postgresDBHost := flags.String("postgresDBHost", "", "rebuild: the Postgres DB host of the node to rebuild")
+	hostID := flags.String("hostID", "", "rebuild: the ID of the node to rebuild, which names its DB")
+	daStoreURL := flags.String("daStoreUrl", "", "rebuild: the store of the external DA layer, for the rollups published on it")
+	_ = flags.Parse(os.Args[2:])
+
+	testlog.SetupSysOut()
+	logger := testlog.Logger()
+
+	if *mgmtContractAddress == "" || *archiveURL == "" {
+		exitWithError(fmt.Errorf("managementContractAddress and archiveUrl are required"))
+	}
+	archiveStore, err := da.NewStore(*archiveURL, new(http.Client))
+	if err != nil {
+		exitWithError(fmt.Errorf("could not open the rollup archive - %w", err))
+	}
+	ethClient, err := ethadapter.NewEthClientFromURL(*l1RPCAddress, time.Minute, gethcommon.Address{}, logger)
+	if err != nil {
+		exitWithError(err)
+	}
+	defer ethClient.Stop()
+
+	contractAddr := gethcommon.HexToAddress(*mgmtContractAddress)
+	tool := rolluparchive.NewTool(ethClient, mgmtcontractlib.NewMgmtContractLib(&contractAddr, logger), l1.NewRollupArchive(archiveStore), logger)
+
+	last := *toBlock
+	if last == 0 {
+		if last, err = ethClient.BlockNumber(); err != nil {
+			exitWithError(fmt.Errorf("could not fetch the L1 head - %w", err))
+		}
+	}
+	ctx := context.Background()
+	rollups, err := tool.FindRollups(ctx, *fromBlock, last)
+	if err != nil {
+		exitWithError(err)
+	}
+	fmt.Printf("Found %d rollups published between L1 heights %d and %d\n", len(rollups), *fromBlock, last)
+
+	var report *rolluparchive.Report
+	switch command {
+	case "verify":
+		report = tool.Verify(ctx, rollups)
+	case "rebuild":
+		if *postgresDBHost == "" || *hostID == "" {
+			exitWithError(fmt.Errorf("postgresDBHost and hostID are required to rebuild a node"))
+		}
+		hostStorage := storage.NewHostStorageFromConfig(&config.HostConfig{
+			ID:             gethcommon.HexToAddress(*hostID),
+			PostgresDBHost: *postgresDBHost,
+		}, logger)
+		defer hostStorage.Close()
+
+		var daStore da.Store
+		if *daStoreURL != "" {
+			if daStore, err = da.NewStore(*daStoreURL, new(http.Client)); err != nil {
+				exitWithError(fmt.Errorf("could not open the DA store - %w", err))
+			}
+		}
+		if report, err = tool.Rebuild(ctx, rollups, hostStorage, daStore); err != nil {
+			exitWithError(err)
+		}
+	}
+
+	fmt.Println(report)
+	for _, hash := range report.Missing {
+		fmt.Printf("missing: %s\n", hash)
+	}
+	for hash, err := range report.Mismatched {
+		fmt.Printf("mismatched: %s - %s\n", hash, err)
+	}
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,173 @@
+package rolluparchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/da"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/host/l1"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+// the range of L1 blocks requested at once when scanning for rollups, kept under the limits of the usual providers
+const _logsRange = 2000
+
+// PublishedRollup is a rollup announced on the L1 by the RollupAdded event of the management contract
+type PublishedRollup struct {
+	Hash  gethcommon.Hash
+	Block *types.Header
+	Ref   *ethadapter.L1RollupHashes
+}
+
+// Report counts the outcome of checking each published rollup against the archive
+type Report struct {
+	Verified   int
+	Missing    []gethcommon.Hash
+	Mismatched map[gethcommon.Hash]error
+}
+
+func (r *Report) OK() bool {
+	return len(r.Missing) == 0 && len(r.Mismatched) == 0
+}
+
+func (r *Report) String() string {
+	return fmt.Sprintf("verified=%d missing=%d mismatched=%d", r.Verified, len(r.Missing), len(r.Mismatched))
+}
+
+// Tool checks the rollup archive against the rollups published on the L1. It only needs an L1 execution node, not a
+// beacon node, since the blobs are rebuilt from the archived rollups.
+type Tool struct {
+	ethClient   ethadapter.EthClient
+	contractLib mgmtcontractlib.MgmtContractLib
+	archive     *l1.RollupArchive
+	logger      gethlog.Logger
+}
+
+func NewTool(ethClient ethadapter.EthClient, contractLib mgmtcontractlib.MgmtContractLib, archive *l1.RollupArchive, logger gethlog.Logger) *Tool {
+	return &Tool{ethClient: ethClient, contractLib: contractLib, archive: archive, logger: logger}
+}
+
+// FindRollups returns the rollups published between the two L1 heights (inclusive), in the order they were published
+func (t *Tool) FindRollups(ctx context.Context, from, to uint64) ([]*PublishedRollup, error) {
+	contractABI, err := ManagementContract.ManagementContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse management contract ABI. Cause: %w", err)
+	}
+	rollupAdded := contractABI.Events[mgmtcontractlib.RollupAddedEvent].ID
+
+	var rollups []*PublishedRollup
+	for start := from; start <= to; start += _logsRange {
+		end := min(start+_logsRange-1, to)
+		logs, err := t.ethClient.GetLogs(ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []gethcommon.Address{*t.contractLib.GetContractAddr()},
+			Topics:    [][]gethcommon.Hash{{rollupAdded}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the rollup events of blocks %d to %d. Cause: %w", start, end, err)
+		}
+		for i := range logs {
+			rollup, err := t.publishedRollup(ctx, &logs[i])
+			if err != nil {
+				return nil, err
+			}
+			rollups = append(rollups, rollup)
+		}
+	}
+	return rollups, nil
+}
+
+func (t *Tool) publishedRollup(ctx context.Context, l *types.Log) (*PublishedRollup, error) {
+	if len(l.Data) != gethcommon.HashLength {
+		return nil, fmt.Errorf("unexpected RollupAdded event in tx %s", l.TxHash)
+	}
+	tx, _, err := t.ethClient.EthClient().TransactionByHash(ctx, l.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch rollup tx %s. Cause: %w", l.TxHash, err)
+	}
+	receipt, err := t.ethClient.TransactionReceipt(l.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch receipt of rollup tx %s. Cause: %w", l.TxHash, err)
+	}
+	block, err := t.ethClient.BlockByHash(l.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch block %s of rollup tx %s. Cause: %w", l.BlockHash, l.TxHash, err)
+	}
+
+	for _, decoded := range t.contractLib.DecodeReceipt(tx, receipt) {
+		if ref, ok := decoded.(*ethadapter.L1RollupHashes); ok {
+			return &PublishedRollup{Hash: gethcommon.BytesToHash(l.Data), Block: block.Header(), Ref: ref}, nil
+		}
+	}
+	return nil, fmt.Errorf("could not decode the rollup reference of tx %s", l.TxHash)
+}
+
+// Verify checks that every published rollup is archived and matches the data published on the L1: the blob versioned
+// hashes, the calldata, or the hash recorded for the external DA layer
+func (t *Tool) Verify(ctx context.Context, rollups []*PublishedRollup) *Report {
+	report := &Report{Mismatched: map[gethcommon.Hash]error{}}
+	for _, rollup := range rollups {
+		_, err := t.archive.VerifyRollup(ctx, rollup.Hash, rollup.Ref)
+		switch {
+		case err == nil:
+			report.Verified++
+		case errors.Is(err, ethereum.NotFound):
+			t.logger.Warn("Rollup missing from the archive", log.RollupHashKey, rollup.Hash, log.BlockHeightKey, rollup.Block.Number)
+			report.Missing = append(report.Missing, rollup.Hash)
+		default:
+			t.logger.Error("Archived rollup does not match the L1", log.RollupHashKey, rollup.Hash, log.ErrKey, err)
+			report.Mismatched[rollup.Hash] = err
+		}
+	}
+	return report
+}
+
+// Rebuild restores the data a node needs to ingest the published rollups without a beacon node: the blobs are written
+// to the blob store of the host DB, and the rollups published on the external DA layer to its store (if not nil).
+// Each rollup is verified against the L1 first, and the rollups that are missing or don't match are reported.
+func (t *Tool) Rebuild(ctx context.Context, rollups []*PublishedRollup, blobs storage.BlobResolver, daStore da.Store) (*Report, error) {
+	report := &Report{Mismatched: map[gethcommon.Hash]error{}}
+	for _, rollup := range rollups {
+		payload, err := t.archive.VerifyRollup(ctx, rollup.Hash, rollup.Ref)
+		if errors.Is(err, ethereum.NotFound) {
+			report.Missing = append(report.Missing, rollup.Hash)
+			continue
+		}
+		if err != nil {
+			report.Mismatched[rollup.Hash] = err
+			continue
+		}
+
+		switch rollup.Ref.DAMode {
+		case common.BlobDA:
+			if err = blobs.AddBlobs(rollup.Block, rollup.Ref.BlobHashes, payload.Blobs); err != nil {
+				return nil, fmt.Errorf("could not store the blobs of rollup %s. Cause: %w", rollup.Hash, err)
+			}
+		case common.ExternalDA:
+			if daStore == nil {
+				return nil, fmt.Errorf("rollup %s was published on the external DA layer, but no DA store is set", rollup.Hash)
+			}
+			if err = daStore.Put(ctx, crypto.Keccak256Hash(payload.Data), payload.Data); err != nil {
+				return nil, fmt.Errorf("could not store rollup %s on the external DA layer. Cause: %w", rollup.Hash, err)
+			}
+		case common.CalldataDA:
+			// the rollup is part of the L1 transaction
+		}
+		t.logger.Info("Restored rollup", log.RollupHashKey, rollup.Hash, "da_mode", rollup.Ref.DAMode)
+		report.Verified++
+	}
+	return report, nil
+}
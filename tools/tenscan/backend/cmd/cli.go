@@ -16,13 +16,17 @@ func parseCLIArgs() *config.Config {
 	nodeHostAddress := flag.String(nodeHostAddressName, defaultConfig.NodeHostAddress, nodeHostAddressUsage)
 	serverAddress := flag.String(serverAddressName, defaultConfig.ServerAddress, serverAddressUsage)
 	logPath := flag.String(logPathName, defaultConfig.LogPath, logPathUsage)
+	solcPath := flag.String(solcPathName, defaultConfig.SolcPath, solcPathUsage)
+	verifiedContractsDir := flag.String(verifiedContractsDirName, defaultConfig.VerifiedContractsDir, verifiedContractsDirUsage)
 
 	flag.Parse()
 
 	return &config.Config{
-		NodeHostAddress: *nodeHostAddress,
-		ServerAddress:   *serverAddress,
-		LogPath:         *logPath,
+		NodeHostAddress:      *nodeHostAddress,
+		ServerAddress:        *serverAddress,
+		LogPath:              *logPath,
+		SolcPath:             *solcPath,
+		VerifiedContractsDir: *verifiedContractsDir,
 	}
 }
 
@@ -35,4 +39,10 @@ const (
 
 	logPathName  = "logPath"
 	logPathUsage = "The path to use for tenscan's log file"
+
+	solcPathName  = "solcPath"
+	solcPathUsage = "The solc binary used to verify contract sources. Contract verification is disabled if not set"
+
+	verifiedContractsDirName  = "verifiedContractsDir"
+	verifiedContractsDirUsage = "The directory to store verified contracts in. They are lost on restart if not set"
 )
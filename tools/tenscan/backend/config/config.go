@@ -4,4 +4,8 @@ type Config struct {
 	NodeHostAddress string
 	ServerAddress   string
 	LogPath         string
+	// SolcPath is the solc binary used to verify contracts. Contract verification is disabled if it is empty.
+	SolcPath string
+	// VerifiedContractsDir is where the verified contracts are stored. They are kept in memory only if it is empty.
+	VerifiedContractsDir string
 }
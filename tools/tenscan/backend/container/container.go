@@ -5,6 +5,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend"
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend/config"
+	"github.com/ten-protocol/go-ten/tools/tenscan/backend/verification"
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend/webserver"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -25,14 +26,23 @@ func NewTenScanContainer(config *config.Config) (*TenScanContainer, error) {
 	}
 
 	obsClient := obsclient.NewObsClient(client)
-
-	scanBackend := backend.NewBackend(obsClient)
 	logger := log.New(log.TenscanCmp, int(gethlog.LvlInfo), config.LogPath)
+
+	var verifier *verification.Verifier
+	if config.SolcPath != "" {
+		store, err := verification.NewStore(config.VerifiedContractsDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load verified contracts - %w", err)
+		}
+		verifier = verification.NewVerifier(verification.NewSolcCompiler(config.SolcPath), obsClient, store, logger)
+	}
+
+	scanBackend := backend.NewBackend(obsClient, verifier)
 	webServer := webserver.New(scanBackend, config.ServerAddress, logger)
 
 	logger.Info("Created Obscuro Scan with the following: ", "args", config)
 	return &TenScanContainer{
-		backend:   backend.NewBackend(obsClient),
+		backend:   scanBackend,
 		webServer: webServer,
 	}, nil
 }
@@ -1,12 +1,17 @@
 package backend
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/tools/tenscan/backend/verification"
+
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common/compression"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
@@ -17,13 +22,19 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
+// ErrVerificationDisabled is returned for contract verifications when no compiler is configured
+var ErrVerificationDisabled = errors.New("contract verification is not enabled")
+
 type Backend struct {
 	obsClient *obsclient.ObsClient
+	verifier  *verification.Verifier
 }
 
-func NewBackend(obsClient *obsclient.ObsClient) *Backend {
+// NewBackend creates the backend. The verifier is nil if contract verification is disabled.
+func NewBackend(obsClient *obsclient.ObsClient, verifier *verification.Verifier) *Backend {
 	return &Backend{
 		obsClient: obsClient,
+		verifier:  verifier,
 	}
 }
 
@@ -111,6 +122,112 @@ func (b *Backend) GetRollupListing(offset uint64, size uint64) (*common.RollupLi
 	})
 }
 
+func (b *Backend) GetL1ForkListing(offset uint64, size uint64) (*common.L1ForkListingResponse, error) {
+	return b.obsClient.GetL1ForkListing(&common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) GetReorgedBatchListing(offset uint64, size uint64) (*common.ReorgedBatchListingResponse, error) {
+	return b.obsClient.GetReorgedBatchListing(&common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) GetMovedTransactionListing(offset uint64, size uint64) (*common.MovedTransactionListingResponse, error) {
+	return b.obsClient.GetMovedTransactionListing(&common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) GetContract(address gethcommon.Address) (*common.PublicContract, error) {
+	return b.obsClient.GetContract(address)
+}
+
+func (b *Backend) GetContractEvents(address gethcommon.Address, offset uint64, size uint64) (*common.PublicEventListingResponse, error) {
+	return b.obsClient.GetContractEvents(address, &common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) GetAddressActivity(address gethcommon.Address, offset uint64, size uint64) (*common.AddressActivity, error) {
+	return b.obsClient.GetAddressActivity(address, &common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) Search(query string, offset uint64, size uint64) (*common.SearchResponse, error) {
+	return b.obsClient.Search(query, &common.QueryPagination{
+		Offset: offset,
+		Size:   uint(size),
+	})
+}
+
+func (b *Backend) VerifyContract(ctx context.Context, address gethcommon.Address, request *verification.Request) (*verification.VerifiedContract, error) {
+	if b.verifier == nil {
+		return nil, ErrVerificationDisabled
+	}
+	return b.verifier.Verify(ctx, address, request)
+}
+
+// GetVerifiedContract returns the sources and ABI of the contract, or nil if it was not verified
+func (b *Backend) GetVerifiedContract(address gethcommon.Address) *verification.VerifiedContract {
+	if b.verifier == nil {
+		return nil
+	}
+	return b.verifier.Get(address)
+}
+
+// DecodeEvents decodes the events emitted by the contract with its ABI. The events that cannot be decoded, because
+// the contract is not verified or the ABI does not describe them, are nil.
+func (b *Backend) DecodeEvents(address gethcommon.Address, events []types.Log) ([]*verification.DecodedEvent, error) {
+	decoded := make([]*verification.DecodedEvent, len(events))
+	if b.verifier == nil {
+		return decoded, nil
+	}
+	decoder, err := b.verifier.Decoder(address)
+	if err != nil || decoder == nil {
+		return decoded, err
+	}
+	for i := range events {
+		// the events of other types are left undecoded
+		decoded[i], _ = decoder.DecodeLog(&events[i])
+	}
+	return decoded, nil
+}
+
+// DecodeCalls decodes the calldata of the transactions to verified contracts. The calls that cannot be decoded are nil.
+func (b *Backend) DecodeCalls(txs []*common.L2Tx) ([]*verification.DecodedCall, error) {
+	decoded := make([]*verification.DecodedCall, len(txs))
+	if b.verifier == nil {
+		return decoded, nil
+	}
+	decoders := map[gethcommon.Address]*verification.Decoder{}
+	for i, tx := range txs {
+		if tx.To() == nil {
+			continue
+		}
+		decoder, found := decoders[*tx.To()]
+		if !found {
+			var err error
+			decoder, err = b.verifier.Decoder(*tx.To())
+			if err != nil {
+				return nil, err
+			}
+			decoders[*tx.To()] = decoder
+		}
+		if decoder != nil {
+			decoded[i], _ = decoder.DecodeCall(tx.Data())
+		}
+	}
+	return decoded, nil
+}
+
 func (b *Backend) GetRollupByHash(hash gethcommon.Hash) (*common.PublicRollup, error) {
 	return b.obsClient.GetRollupByHash(hash)
 }
@@ -0,0 +1,112 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+)
+
+var solcVersionRegex = regexp.MustCompile(`Version: (\S+)`)
+
+// Compiler compiles Solidity sources given in the solc standard JSON format
+type Compiler interface {
+	// Version returns the full compiler version, e.g. 0.8.20+commit.a1b79de6
+	Version(ctx context.Context) (string, error)
+	Compile(ctx context.Context, input *StandardInput) (*StandardOutput, error)
+}
+
+// StandardInput is the subset of the solc standard JSON input used for verification
+type StandardInput struct {
+	Language string                    `json:"language"`
+	Sources  map[string]StandardSource `json:"sources"`
+	Settings StandardSettings          `json:"settings"`
+}
+
+type StandardSource struct {
+	Content string `json:"content"`
+}
+
+type StandardSettings struct {
+	Optimizer       StandardOptimizer              `json:"optimizer"`
+	EVMVersion      string                         `json:"evmVersion,omitempty"`
+	Libraries       map[string]map[string]string   `json:"libraries,omitempty"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+type StandardOptimizer struct {
+	Enabled bool `json:"enabled"`
+	Runs    uint `json:"runs"`
+}
+
+// StandardOutput is the subset of the solc standard JSON output used for verification
+type StandardOutput struct {
+	Errors    []StandardError                                `json:"errors"`
+	Contracts map[string]map[string]StandardCompiledContract `json:"contracts"`
+}
+
+type StandardError struct {
+	Severity         string `json:"severity"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+type StandardCompiledContract struct {
+	ABI json.RawMessage `json:"abi"`
+	EVM struct {
+		DeployedBytecode struct {
+			Object              string                       `json:"object"`
+			ImmutableReferences map[string][]ImmutableOffset `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// ImmutableOffset is where the value of an immutable variable is written into the deployed bytecode
+type ImmutableOffset struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// SolcCompiler runs a local solc binary
+type SolcCompiler struct {
+	path string
+}
+
+func NewSolcCompiler(path string) *SolcCompiler {
+	return &SolcCompiler{path: path}
+}
+
+func (s *SolcCompiler) Version(ctx context.Context) (string, error) {
+	out, err := exec.CommandContext(ctx, s.path, "--version").Output() //nolint:gosec
+	if err != nil {
+		return "", fmt.Errorf("could not run %s - %w", s.path, err)
+	}
+	match := solcVersionRegex.FindSubmatch(out)
+	if match == nil {
+		return "", fmt.Errorf("unexpected solc version output: %s", out)
+	}
+	return string(match[1]), nil
+}
+
+func (s *SolcCompiler) Compile(ctx context.Context, input *StandardInput) (*StandardOutput, error) {
+	encodedInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode compiler input - %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.path, "--standard-json") //nolint:gosec
+	cmd.Stdin = bytes.NewReader(encodedInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("could not run %s: %s - %w", s.path, stderr.String(), err)
+	}
+
+	var output StandardOutput
+	if err = json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("could not decode compiler output - %w", err)
+	}
+	return &output, nil
+}
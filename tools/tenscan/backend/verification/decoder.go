@@ -0,0 +1,120 @@
+package verification
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DecodedCall is the method and arguments of a call to a verified contract
+type DecodedCall struct {
+	Method    string       `json:"method"`
+	Signature string       `json:"signature"`
+	Args      []DecodedArg `json:"args"`
+}
+
+// DecodedEvent is the event and arguments of a log emitted by a verified contract
+type DecodedEvent struct {
+	Event     string       `json:"event"`
+	Signature string       `json:"signature"`
+	Args      []DecodedArg `json:"args"`
+}
+
+type DecodedArg struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+	Value   any    `json:"value"`
+}
+
+// Decoder decodes the calldata and logs of a verified contract with its ABI
+type Decoder struct {
+	abi abi.ABI
+}
+
+// Decoder returns the decoder of the contract at the address, or nil if the contract was not verified
+func (v *Verifier) Decoder(address gethcommon.Address) (*Decoder, error) {
+	contract := v.store.Get(address)
+	if contract == nil {
+		return nil, nil
+	}
+	parsed, err := abi.JSON(bytes.NewReader(contract.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ABI of %s - %w", address, err)
+	}
+	return &Decoder{abi: parsed}, nil
+}
+
+// DecodeCall decodes the calldata of a transaction to the contract
+func (d *Decoder) DecodeCall(data []byte) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata too short for a method call")
+	}
+	method, err := d.abi.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack arguments of %s - %w", method.Name, err)
+	}
+
+	args := make([]DecodedArg, len(method.Inputs))
+	for i, input := range method.Inputs {
+		args[i] = DecodedArg{Name: input.Name, Type: input.Type.String(), Value: formatValue(values[i])}
+	}
+	return &DecodedCall{Method: method.Name, Signature: method.Sig, Args: args}, nil
+}
+
+// DecodeLog decodes a log emitted by the contract
+func (d *Decoder) DecodeLog(log *types.Log) (*DecodedEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("anonymous events cannot be decoded")
+	}
+	event, err := d.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	if err = event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return nil, fmt.Errorf("could not unpack data of %s - %w", event.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err = abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("could not parse topics of %s - %w", event.Name, err)
+	}
+
+	args := make([]DecodedArg, len(event.Inputs))
+	for i, input := range event.Inputs {
+		args[i] = DecodedArg{Name: input.Name, Type: input.Type.String(), Indexed: input.Indexed, Value: formatValue(values[input.Name])}
+	}
+	return &DecodedEvent{Event: event.Name, Signature: event.Sig, Args: args}, nil
+}
+
+// formatValue encodes byte arrays as hex, instead of the JSON arrays of numbers they would be encoded to
+func formatValue(value any) any {
+	switch v := value.(type) {
+	case gethcommon.Address, gethcommon.Hash:
+		return v
+	case []byte:
+		return hexutil.Bytes(v)
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Bytes(b)
+	}
+	return value
+}
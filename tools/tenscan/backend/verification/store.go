@@ -0,0 +1,85 @@
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Store keeps the verified contracts in memory, and in a JSON file per contract in the directory if one is given
+type Store struct {
+	mu        sync.RWMutex
+	dir       string
+	contracts map[gethcommon.Address]*VerifiedContract
+}
+
+// NewStore loads the verified contracts from the directory, creating it if needed
+func NewStore(dir string) (*Store, error) {
+	s := &Store{dir: dir, contracts: map[gethcommon.Address]*VerifiedContract{}}
+	if dir == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create verified contracts directory - %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read verified contracts directory - %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read verified contract %s - %w", entry.Name(), err)
+		}
+		var contract VerifiedContract
+		if err = json.Unmarshal(data, &contract); err != nil {
+			return nil, fmt.Errorf("could not decode verified contract %s - %w", entry.Name(), err)
+		}
+		s.contracts[contract.Address] = &contract
+	}
+	return s, nil
+}
+
+// Add stores the verified contract, replacing any earlier verification of the same address unless it was an exact
+// match and this one is not, as the sources of an exact match are the ones deployed. It returns the contract kept.
+func (s *Store) Add(contract *VerifiedContract) (*VerifiedContract, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, found := s.contracts[contract.Address]; found && existing.ExactMatch && !contract.ExactMatch {
+		return existing, nil
+	}
+
+	if s.dir != "" {
+		data, err := json.Marshal(contract)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode verified contract - %w", err)
+		}
+		// written to a temporary file first, so a crash does not leave a truncated contract
+		path := filepath.Join(s.dir, contract.Address.Hex()+".json")
+		if err = os.WriteFile(path+".tmp", data, 0o600); err != nil {
+			return nil, fmt.Errorf("could not write verified contract - %w", err)
+		}
+		if err = os.Rename(path+".tmp", path); err != nil {
+			return nil, fmt.Errorf("could not write verified contract - %w", err)
+		}
+	}
+	s.contracts[contract.Address] = contract
+	return contract, nil
+}
+
+// Get returns the verified contract at the address, or nil if it was not verified
+func (s *Store) Get(address gethcommon.Address) *VerifiedContract {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.contracts[address]
+}
@@ -0,0 +1,220 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrNoCode            = errors.New("no contract deployed at the address")
+	ErrCompilerVersion   = errors.New("compiler version not available")
+	ErrCompilation       = errors.New("sources do not compile")
+	ErrContractNotFound  = errors.New("contract not found in the compiled sources")
+	ErrBytecodeMismatch  = errors.New("compiled bytecode does not match the deployed bytecode")
+	ErrInvalidSubmission = errors.New("invalid verification request")
+)
+
+// Request is a submission of the sources of a deployed contract, with the settings it was compiled with
+type Request struct {
+	// ContractName is the fully qualified name of the contract, e.g. contracts/Token.sol:Token
+	ContractName    string                       `json:"contractName"`
+	CompilerVersion string                       `json:"compilerVersion"`
+	Sources         map[string]string            `json:"sources"`
+	Optimizer       bool                         `json:"optimizer"`
+	OptimizerRuns   uint                         `json:"optimizerRuns"`
+	EVMVersion      string                       `json:"evmVersion"`
+	Libraries       map[string]map[string]string `json:"libraries"`
+}
+
+// VerifiedContract is a contract whose deployed bytecode was reproduced from its sources
+type VerifiedContract struct {
+	Address         gethcommon.Address           `json:"address"`
+	ContractName    string                       `json:"contractName"`
+	CompilerVersion string                       `json:"compilerVersion"`
+	Optimizer       bool                         `json:"optimizer"`
+	OptimizerRuns   uint                         `json:"optimizerRuns"`
+	EVMVersion      string                       `json:"evmVersion"`
+	Libraries       map[string]map[string]string `json:"libraries,omitempty"`
+	Sources         map[string]string            `json:"sources"`
+	ABI             json.RawMessage              `json:"abi"`
+	// ExactMatch is whether the metadata hash appended by the compiler also matches, meaning the sources are
+	// byte-for-byte the ones deployed, comments included
+	ExactMatch bool  `json:"exactMatch"`
+	VerifiedAt int64 `json:"verifiedAt"`
+}
+
+// CodeReader returns the deployed bytecode of a contract
+type CodeReader interface {
+	GetCode(address gethcommon.Address) ([]byte, error)
+}
+
+// Verifier recompiles the submitted sources of contracts, and stores the ones matching their deployed bytecode
+type Verifier struct {
+	compiler Compiler
+	code     CodeReader
+	store    *Store
+	logger   log.Logger
+}
+
+func NewVerifier(compiler Compiler, code CodeReader, store *Store, logger log.Logger) *Verifier {
+	return &Verifier{
+		compiler: compiler,
+		code:     code,
+		store:    store,
+		logger:   logger,
+	}
+}
+
+// Verify compiles the sources with the configured compiler, and stores the contract if its runtime bytecode matches
+// the bytecode deployed at the address
+func (v *Verifier) Verify(ctx context.Context, address gethcommon.Address, request *Request) (*VerifiedContract, error) {
+	sourcePath, contractName, err := validate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := v.compiler.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the requested version may leave out the commit, e.g. 0.8.20 for 0.8.20+commit.a1b79de6
+	requested := strings.TrimPrefix(request.CompilerVersion, "v")
+	if requested != "" && requested != version && !strings.HasPrefix(version, requested+"+") {
+		return nil, fmt.Errorf("%w: %s requested, %s configured", ErrCompilerVersion, request.CompilerVersion, version)
+	}
+
+	deployed, err := v.code.GetCode(address)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deployed bytecode - %w", err)
+	}
+	if len(deployed) == 0 {
+		return nil, ErrNoCode
+	}
+
+	output, err := v.compiler.Compile(ctx, standardInput(request))
+	if err != nil {
+		return nil, err
+	}
+	var compileErrors []string
+	for _, e := range output.Errors {
+		if e.Severity == "error" {
+			compileErrors = append(compileErrors, e.FormattedMessage)
+		}
+	}
+	if len(compileErrors) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCompilation, strings.Join(compileErrors, "\n"))
+	}
+	compiled, found := output.Contracts[sourcePath][contractName]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrContractNotFound, request.ContractName)
+	}
+
+	runtime := gethcommon.FromHex(compiled.EVM.DeployedBytecode.Object)
+	exactMatch, matches := compareBytecode(deployed, runtime, compiled.EVM.DeployedBytecode.ImmutableReferences)
+	if !matches {
+		return nil, ErrBytecodeMismatch
+	}
+
+	contract := &VerifiedContract{
+		Address:         address,
+		ContractName:    request.ContractName,
+		CompilerVersion: version,
+		Optimizer:       request.Optimizer,
+		OptimizerRuns:   request.OptimizerRuns,
+		EVMVersion:      request.EVMVersion,
+		Libraries:       request.Libraries,
+		Sources:         request.Sources,
+		ABI:             compiled.ABI,
+		ExactMatch:      exactMatch,
+		VerifiedAt:      time.Now().Unix(),
+	}
+	stored, err := v.store.Add(contract)
+	if err != nil {
+		return nil, err
+	}
+	if stored != contract {
+		v.logger.Info("Contract already verified with an exact match", "address", address, "name", request.ContractName)
+		return stored, nil
+	}
+	v.logger.Info("Verified contract", "address", address, "name", request.ContractName, "exactMatch", exactMatch)
+	return contract, nil
+}
+
+// Get returns the verified contract at the address, or nil if it was not verified
+func (v *Verifier) Get(address gethcommon.Address) *VerifiedContract {
+	return v.store.Get(address)
+}
+
+func validate(request *Request) (string, string, error) {
+	separator := strings.LastIndex(request.ContractName, ":")
+	if separator <= 0 || separator == len(request.ContractName)-1 {
+		return "", "", fmt.Errorf("%w: contract name must be <source path>:<contract>", ErrInvalidSubmission)
+	}
+	sourcePath := request.ContractName[:separator]
+	if _, found := request.Sources[sourcePath]; !found {
+		return "", "", fmt.Errorf("%w: source %s is missing", ErrInvalidSubmission, sourcePath)
+	}
+	return sourcePath, request.ContractName[separator+1:], nil
+}
+
+func standardInput(request *Request) *StandardInput {
+	sources := make(map[string]StandardSource, len(request.Sources))
+	for path, content := range request.Sources {
+		sources[path] = StandardSource{Content: content}
+	}
+	return &StandardInput{
+		Language: "Solidity",
+		Sources:  sources,
+		Settings: StandardSettings{
+			Optimizer:  StandardOptimizer{Enabled: request.Optimizer, Runs: request.OptimizerRuns},
+			EVMVersion: request.EVMVersion,
+			Libraries:  request.Libraries,
+			OutputSelection: map[string]map[string][]string{
+				"*": {"*": {"abi", "evm.deployedBytecode.object", "evm.deployedBytecode.immutableReferences"}},
+			},
+		},
+	}
+}
+
+// compareBytecode returns whether the deployed bytecode matches the compiled one exactly, and whether it matches once
+// the values of the immutable variables and the metadata hash appended by the compiler are ignored
+func compareBytecode(deployed []byte, compiled []byte, immutables map[string][]ImmutableOffset) (bool, bool) {
+	if len(deployed) != len(compiled) {
+		return false, false
+	}
+	// the immutable values are only written into the bytecode by the constructor
+	deployed = bytes.Clone(deployed)
+	for _, offsets := range immutables {
+		for _, offset := range offsets {
+			if offset.Start < 0 || offset.Start+offset.Length > len(deployed) {
+				return false, false
+			}
+			copy(deployed[offset.Start:offset.Start+offset.Length], compiled[offset.Start:offset.Start+offset.Length])
+		}
+	}
+	if bytes.Equal(deployed, compiled) {
+		return true, true
+	}
+	return false, bytes.Equal(stripMetadata(deployed), stripMetadata(compiled))
+}
+
+// stripMetadata removes the CBOR-encoded metadata solc appends to the runtime bytecode, whose length is given by the
+// last two bytes
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if length+2 > len(code) {
+		return code
+	}
+	return code[:len(code)-length-2]
+}
@@ -0,0 +1,216 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+var (
+	tokenAddress = gethcommon.HexToAddress("0x1234")
+	// the runtime code, with an immutable variable at bytes 2 to 5, followed by the metadata and its length
+	compiledCode = []byte{0x60, 0x80, 0, 0, 0, 0, 0x56, 0xa2, 0x64, 0x01, 0x00, 0x03}
+	deployedCode = []byte{0x60, 0x80, 0, 0, 0, 7, 0x56, 0xa2, 0x64, 0x02, 0x00, 0x03}
+)
+
+func TestVerifiedContractIsStoredAndReloaded(t *testing.T) {
+	dir := t.TempDir()
+	verifier := newTestVerifier(t, dir, deployedCode)
+
+	contract, err := verifier.Verify(context.Background(), tokenAddress, tokenRequest())
+	require.NoError(t, err)
+	// the sources match, but not the metadata hash
+	assert.False(t, contract.ExactMatch)
+	assert.Equal(t, "0.8.20+commit.a1b79de6", contract.CompilerVersion)
+	assert.Equal(t, contract, verifier.Get(tokenAddress))
+
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	reloaded := store.Get(tokenAddress)
+	require.NotNil(t, reloaded)
+	assert.Equal(t, "contracts/Token.sol:Token", reloaded.ContractName)
+	assert.JSONEq(t, tokenABI, string(reloaded.ABI))
+}
+
+func TestIdenticalBytecodeIsAnExactMatch(t *testing.T) {
+	verifier := newTestVerifier(t, "", compiledCode)
+
+	contract, err := verifier.Verify(context.Background(), tokenAddress, tokenRequest())
+	require.NoError(t, err)
+	assert.True(t, contract.ExactMatch)
+}
+
+func TestExactMatchIsNotReplacedByPartialMatch(t *testing.T) {
+	dir := t.TempDir()
+	exact, err := newTestVerifier(t, dir, compiledCode).Verify(context.Background(), tokenAddress, tokenRequest())
+	require.NoError(t, err)
+	require.True(t, exact.ExactMatch)
+
+	// the same address verified again with sources that only match partially
+	verifier := newTestVerifier(t, dir, deployedCode)
+	contract, err := verifier.Verify(context.Background(), tokenAddress, tokenRequest())
+	require.NoError(t, err)
+	assert.True(t, contract.ExactMatch)
+	assert.True(t, verifier.Get(tokenAddress).ExactMatch)
+
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	assert.True(t, store.Get(tokenAddress).ExactMatch)
+}
+
+func TestVerificationFailures(t *testing.T) {
+	tests := map[string]struct {
+		deployed []byte
+		request  func(*Request)
+		compiler func(*fakeCompiler)
+		expected error
+	}{
+		"different code": {
+			deployed: []byte{0x60, 0x81, 0, 0, 0, 7, 0x56, 0xa2, 0x64, 0x02, 0x00, 0x03},
+			expected: ErrBytecodeMismatch,
+		},
+		"no code": {
+			deployed: []byte{},
+			expected: ErrNoCode,
+		},
+		"other compiler version": {
+			request:  func(r *Request) { r.CompilerVersion = "v0.8.19" },
+			expected: ErrCompilerVersion,
+		},
+		"missing source": {
+			request:  func(r *Request) { r.ContractName = "contracts/Other.sol:Token" },
+			expected: ErrInvalidSubmission,
+		},
+		"unknown contract": {
+			request:  func(r *Request) { r.ContractName = "contracts/Token.sol:Other" },
+			expected: ErrContractNotFound,
+		},
+		"compilation error": {
+			compiler: func(c *fakeCompiler) {
+				c.output.Errors = []StandardError{{Severity: "error", FormattedMessage: "ParserError: expected ';'"}}
+			},
+			expected: ErrCompilation,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			deployed := deployedCode
+			if test.deployed != nil {
+				deployed = test.deployed
+			}
+			verifier := newTestVerifier(t, "", deployed)
+			if test.compiler != nil {
+				test.compiler(verifier.compiler.(*fakeCompiler))
+			}
+			request := tokenRequest()
+			if test.request != nil {
+				test.request(request)
+			}
+
+			_, err := verifier.Verify(context.Background(), tokenAddress, request)
+			assert.True(t, errors.Is(err, test.expected), "expected %s, got %v", test.expected, err)
+			assert.Nil(t, verifier.Get(tokenAddress))
+		})
+	}
+}
+
+func TestVerifiedContractCallsAndEventsAreDecoded(t *testing.T) {
+	verifier := newTestVerifier(t, "", deployedCode)
+	decoder, err := verifier.Decoder(tokenAddress)
+	require.NoError(t, err)
+	assert.Nil(t, decoder)
+
+	_, err = verifier.Verify(context.Background(), tokenAddress, tokenRequest())
+	require.NoError(t, err)
+	decoder, err = verifier.Decoder(tokenAddress)
+	require.NoError(t, err)
+	require.NotNil(t, decoder)
+
+	parsed, err := abi.JSON(strings.NewReader(tokenABI))
+	require.NoError(t, err)
+	from, to := gethcommon.HexToAddress("0xaa"), gethcommon.HexToAddress("0xbb")
+	calldata, err := parsed.Pack("transfer", to, big.NewInt(100))
+	require.NoError(t, err)
+
+	call, err := decoder.DecodeCall(calldata)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer", call.Method)
+	assert.Equal(t, "transfer(address,uint256)", call.Signature)
+	assert.Equal(t, []DecodedArg{
+		{Name: "to", Type: "address", Value: to},
+		{Name: "amount", Type: "uint256", Value: big.NewInt(100)},
+	}, call.Args)
+
+	data, err := parsed.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(5))
+	require.NoError(t, err)
+	event, err := decoder.DecodeLog(&types.Log{
+		Address: tokenAddress,
+		Topics:  []gethcommon.Hash{parsed.Events["Transfer"].ID, gethcommon.BytesToHash(from.Bytes()), gethcommon.BytesToHash(to.Bytes())},
+		Data:    data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", event.Event)
+	assert.Equal(t, []DecodedArg{
+		{Name: "from", Type: "address", Indexed: true, Value: from},
+		{Name: "to", Type: "address", Indexed: true, Value: to},
+		{Name: "value", Type: "uint256", Value: big.NewInt(5)},
+	}, event.Args)
+
+	_, err = decoder.DecodeCall(hexutil.MustDecode("0xdeadbeef"))
+	assert.Error(t, err)
+}
+
+func tokenRequest() *Request {
+	return &Request{
+		ContractName:    "contracts/Token.sol:Token",
+		CompilerVersion: "0.8.20",
+		Sources:         map[string]string{"contracts/Token.sol": "contract Token {}"},
+		Optimizer:       true,
+		OptimizerRuns:   200,
+	}
+}
+
+func newTestVerifier(t *testing.T, dir string, deployed []byte) *Verifier {
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	compiled := StandardCompiledContract{ABI: []byte(tokenABI)}
+	compiled.EVM.DeployedBytecode.Object = hexutil.Encode(compiledCode)
+	compiled.EVM.DeployedBytecode.ImmutableReferences = map[string][]ImmutableOffset{"3": {{Start: 2, Length: 4}}}
+	compiler := &fakeCompiler{output: StandardOutput{
+		Contracts: map[string]map[string]StandardCompiledContract{"contracts/Token.sol": {"Token": compiled}},
+	}}
+	return NewVerifier(compiler, fakeCode{tokenAddress: deployed}, store, gethlog.New())
+}
+
+type fakeCompiler struct {
+	output StandardOutput
+}
+
+func (f *fakeCompiler) Version(context.Context) (string, error) {
+	return "0.8.20+commit.a1b79de6", nil
+}
+
+func (f *fakeCompiler) Compile(context.Context, *StandardInput) (*StandardOutput, error) {
+	return &f.output, nil
+}
+
+type fakeCode map[gethcommon.Address][]byte
+
+func (f fakeCode) GetCode(address gethcommon.Address) ([]byte, error) {
+	return f[address], nil
+}
@@ -3,23 +3,33 @@ package webserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend"
+	"github.com/ten-protocol/go-ten/tools/tenscan/backend/verification"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// the verifications run the compiler, so they are limited in size, number and duration
+	_maxVerificationRequestSize = 5 * 1024 * 1024
+	_maxConcurrentVerifications = 2
+	_verificationTimeout        = 2 * time.Minute
+)
+
 type WebServer struct {
-	engine      *gin.Engine
-	backend     *backend.Backend
-	bindAddress string
-	logger      log.Logger
-	server      *http.Server
+	engine        *gin.Engine
+	backend       *backend.Backend
+	bindAddress   string
+	logger        log.Logger
+	server        *http.Server
+	verifications chan struct{} // a slot is held by each verification in progress
 }
 
 func New(backend *backend.Backend, bindAddress string, logger log.Logger) *WebServer {
@@ -35,10 +45,11 @@ func New(backend *backend.Backend, bindAddress string, logger log.Logger) *WebSe
 	r.Use(cors.New(config))
 
 	server := &WebServer{
-		engine:      r,
-		backend:     backend,
-		bindAddress: bindAddress,
-		logger:      logger,
+		engine:        r,
+		backend:       backend,
+		bindAddress:   bindAddress,
+		logger:        logger,
+		verifications: make(chan struct{}, _maxConcurrentVerifications),
 	}
 
 	// routes
@@ -50,6 +61,7 @@ func New(backend *backend.Backend, bindAddress string, logger log.Logger) *WebSe
 	r.GET("/batchHeader/:hash", server.getBatchHeader)
 	r.GET("/tx/:hash", server.getTransaction)
 	r.POST("/actions/decryptTxBlob/", server.decryptTxBlob)
+	r.POST("/actions/verifyContract/:address", server.verifyContract)
 
 	return server
 }
@@ -108,8 +120,58 @@ func (w *WebServer) decryptTxBlob(c *gin.Context) {
 		errorHandler(c, fmt.Errorf("unable to execute request %w", err), w.logger)
 		return
 	}
+	decoded, err := w.backend.DecodeCalls(result)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to decode calls %w", err), w.logger)
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{"result": result})
+	c.JSON(http.StatusOK, gin.H{"result": result, "decoded": decoded})
+}
+
+func (w *WebServer) verifyContract(c *gin.Context) {
+	address, err := parseAddress(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var request verification.Request
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, _maxVerificationRequestSize)
+	if err = c.ShouldBindJSON(&request); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("verification request larger than %d bytes", tooLarge.Limit)})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid verification request: %s", err)})
+		return
+	}
+
+	select {
+	case w.verifications <- struct{}{}:
+		defer func() { <-w.verifications }()
+	default:
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many verifications in progress, try again later"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), _verificationTimeout)
+	defer cancel()
+	contract, err := w.backend.VerifyContract(ctx, address, &request)
+	switch {
+	case err == nil:
+		c.JSON(http.StatusOK, gin.H{"item": contract})
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		c.JSON(http.StatusGatewayTimeout, gin.H{"error": fmt.Sprintf("verification did not complete within %s", _verificationTimeout)})
+	case errors.Is(err, backend.ErrVerificationDisabled):
+		c.JSON(http.StatusNotImplemented, gin.H{"error": err.Error()})
+	case errors.Is(err, verification.ErrInvalidSubmission), errors.Is(err, verification.ErrCompilerVersion),
+		errors.Is(err, verification.ErrCompilation), errors.Is(err, verification.ErrContractNotFound),
+		errors.Is(err, verification.ErrBytecodeMismatch), errors.Is(err, verification.ErrNoCode):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		errorHandler(c, fmt.Errorf("unable to execute verifyContract request %w", err), w.logger)
+	}
 }
 
 type PostData struct {
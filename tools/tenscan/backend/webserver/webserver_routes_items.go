@@ -35,6 +35,20 @@ func routeItems(r *gin.Engine, server *WebServer) {
 	r.GET("/items/transaction/:hash", server.getTransaction)
 	r.GET("/items/transactions/count", server.getTotalTxCount)
 	r.GET("/items/blocks/", server.getBlockListing) // Deprecated
+
+	// reorgs
+	r.GET("/items/reorgs/l1forks/", server.getL1ForkListing)
+	r.GET("/items/reorgs/batches/", server.getReorgedBatchListing)
+	r.GET("/items/reorgs/transactions/", server.getMovedTransactionListing)
+
+	// contracts and addresses
+	r.GET("/items/contract/:address", server.getContract)
+	r.GET("/items/contract/:address/events", server.getContractEvents)
+	r.GET("/items/contract/:address/verified", server.getVerifiedContract)
+	r.GET("/items/address/:address", server.getAddressActivity)
+
+	// search
+	r.GET("/items/search", server.search)
 }
 
 func (w *WebServer) getHealthStatus(c *gin.Context) {
@@ -312,3 +326,166 @@ func (w *WebServer) getConfig(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"item": config})
 }
+
+func (w *WebServer) getL1ForkListing(c *gin.Context) {
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getL1ForkListing pagination %w", err), w.logger)
+		return
+	}
+
+	forkListing, err := w.backend.GetL1ForkListing(offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getL1ForkListing request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": forkListing})
+}
+
+func (w *WebServer) getReorgedBatchListing(c *gin.Context) {
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getReorgedBatchListing pagination %w", err), w.logger)
+		return
+	}
+
+	batchListing, err := w.backend.GetReorgedBatchListing(offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getReorgedBatchListing request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": batchListing})
+}
+
+func (w *WebServer) getMovedTransactionListing(c *gin.Context) {
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getMovedTransactionListing pagination %w", err), w.logger)
+		return
+	}
+
+	txListing, err := w.backend.GetMovedTransactionListing(offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getMovedTransactionListing request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": txListing})
+}
+
+// parsePagination reads the offset and size query parameters, defaulting to the first 10 items
+func (w *WebServer) getContract(c *gin.Context) {
+	address, err := parseAddress(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getContract address %w", err), w.logger)
+		return
+	}
+
+	contract, err := w.backend.GetContract(address)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getContract request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"item": contract})
+}
+
+func (w *WebServer) getContractEvents(c *gin.Context) {
+	address, err := parseAddress(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getContractEvents address %w", err), w.logger)
+		return
+	}
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getContractEvents pagination %w", err), w.logger)
+		return
+	}
+
+	events, err := w.backend.GetContractEvents(address, offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getContractEvents request %w", err), w.logger)
+		return
+	}
+	decoded, err := w.backend.DecodeEvents(address, events.EventsData)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to decode contract events %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": events, "decoded": decoded})
+}
+
+func (w *WebServer) getVerifiedContract(c *gin.Context) {
+	address, err := parseAddress(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getVerifiedContract address %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"item": w.backend.GetVerifiedContract(address)})
+}
+
+func (w *WebServer) getAddressActivity(c *gin.Context) {
+	address, err := parseAddress(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getAddressActivity address %w", err), w.logger)
+		return
+	}
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse getAddressActivity pagination %w", err), w.logger)
+		return
+	}
+
+	activity, err := w.backend.GetAddressActivity(address, offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getAddressActivity request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": activity})
+}
+
+func (w *WebServer) search(c *gin.Context) {
+	query := c.Query("q")
+	if query == "" {
+		errorHandler(c, fmt.Errorf("missing search query"), w.logger)
+		return
+	}
+	offset, size, err := parsePagination(c)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to parse search pagination %w", err), w.logger)
+		return
+	}
+
+	results, err := w.backend.Search(query, offset, size)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute search request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": results})
+}
+
+func parseAddress(c *gin.Context) (gethcommon.Address, error) {
+	address := c.Param("address")
+	if !gethcommon.IsHexAddress(address) {
+		return gethcommon.Address{}, fmt.Errorf("invalid address: %s", address)
+	}
+	return gethcommon.HexToAddress(address), nil
+}
+
+func parsePagination(c *gin.Context) (uint64, uint64, error) {
+	offset, err := strconv.ParseUint(c.DefaultQuery("offset", "0"), 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid offset: %w", err)
+	}
+	size, err := strconv.ParseUint(c.DefaultQuery("size", "10"), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid size: %w", err)
+	}
+	return offset, size, nil
+}
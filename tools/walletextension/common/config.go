@@ -19,4 +19,8 @@ type Config struct {
 	RateLimitUserComputeTime       time.Duration
 	RateLimitWindow                time.Duration
 	RateLimitMaxConcurrentRequests int
+	MetricsEnabled                 bool
+	MetricsHTTPPort                int
+	TracingExporter                string
+	TracingEndpoint                string
 }
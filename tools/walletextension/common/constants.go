@@ -21,6 +21,8 @@ const (
 	PathAuthenticate              = "/authenticate/"
 	PathQuery                     = "/query/"
 	PathRevoke                    = "/revoke/"
+	PathAccounts                  = "/accounts/"
+	PathRevokeAccount             = "/revoke-account/"
 	PathHealth                    = "/health/"
 	PathNetworkHealth             = "/network-health/"
 	PathNetworkConfig             = "/network-config/"
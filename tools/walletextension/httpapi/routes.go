@@ -3,9 +3,13 @@ package httpapi
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+
 	"github.com/status-im/keycard-go/hexutils"
 
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
@@ -46,6 +50,14 @@ func NewHTTPRoutes(walletExt *rpcapi.Services) []node.Route {
 			Name: common.APIVersion1 + common.PathRevoke,
 			Func: httpHandler(walletExt, revokeRequestHandler),
 		},
+		{
+			Name: common.APIVersion1 + common.PathAccounts,
+			Func: httpHandler(walletExt, accountsRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathRevokeAccount,
+			Func: httpHandler(walletExt, revokeAccountRequestHandler),
+		},
 		{
 			Name: common.APIVersion1 + common.PathHealth,
 			Func: httpHandler(walletExt, healthRequestHandler),
@@ -262,6 +274,88 @@ func revokeRequestHandler(walletExt *rpcapi.Services, conn UserConn) {
 	}
 }
 
+// This function handles request to /accounts endpoint.
+// It requires userID as query parameter and returns the addresses of the accounts registered for that user
+func accountsRequestHandler(walletExt *rpcapi.Services, conn UserConn) {
+	// read the request
+	_, err := conn.ReadRequest()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
+		return
+	}
+
+	userID, err := getUserID(conn)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("user ('u') not found in query parameters"))
+		walletExt.Logger().Info("user not found in the query params", log.ErrKey, err)
+		return
+	}
+
+	addresses, err := walletExt.GetUserAccounts(userID)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
+		return
+	}
+
+	res := struct {
+		Accounts []gethcommon.Address `json:"accounts"`
+	}{Accounts: addresses}
+
+	msg, err := json.Marshal(res)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	err = conn.WriteResponse(msg)
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
+// This function handles request to /revoke-account endpoint.
+// It requires userID and address as query parameters and deletes the viewing key of that account, keeping the user
+// and its other accounts
+func revokeAccountRequestHandler(walletExt *rpcapi.Services, conn UserConn) {
+	// read the request
+	_, err := conn.ReadRequest()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
+		return
+	}
+
+	userID, err := getUserID(conn)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("user ('u') not found in query parameters"))
+		walletExt.Logger().Info("user not found in the query params", log.ErrKey, err)
+		return
+	}
+	address, err := getQueryParameter(conn.ReadRequestParams(), common.AddressQueryParameter)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("address ('a') not found in query parameters"))
+		return
+	}
+	if len(address) != common.EthereumAddressLen || !gethcommon.IsHexAddress(address) {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("provided address %s is not a valid address", address))
+		return
+	}
+
+	err = walletExt.DeleteAccountFromUser(userID, gethcommon.HexToAddress(address))
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			handleError(conn, walletExt.Logger(), fmt.Errorf("account %s is not registered for this user", address))
+			return
+		}
+		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
+		return
+	}
+
+	err = conn.WriteResponse([]byte(common.SuccessMsg))
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
 // Handles request to /health endpoint.
 func healthRequestHandler(walletExt *rpcapi.Services, conn UserConn) {
 	// read the request
@@ -0,0 +1,197 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+// maxResubscribeBackoff is the longest wait between two attempts to restore a dropped subscription
+const maxResubscribeBackoff = 30 * time.Second
+
+var (
+	_ bind.ContractBackend = (*Backend)(nil)
+	_ ethereum.LogFilterer = (*Backend)(nil)
+)
+
+// Backend is a bind.ContractBackend and ethereum.LogFilterer acting as the accounts registered for a gateway user.
+// Requests go over http, and subscriptions over a websocket connection that is redialled when it drops.
+type Backend struct {
+	*ethclient.Client
+	wsURL  string
+	logger gethlog.Logger
+}
+
+func dialBackend(ctx context.Context, httpURL, wsURL string, logger gethlog.Logger) (*Backend, error) {
+	client, err := ethclient.DialContext(ctx, httpURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the gateway - %w", err)
+	}
+	return &Backend{Client: client, wsURL: wsURL, logger: logger}, nil
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the query that the accounts of the user can view. When the
+// connection drops, it is redialled and the logs emitted in the meantime are delivered before the new ones.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	s := &logSubscription{backend: b, query: query, out: ch, delivered: map[logKey]struct{}{}}
+	first, err := s.subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.resubscribe("logs", first, s.subscribe), nil
+}
+
+// SubscribeNewHead subscribes to the new batch headers, redialling the connection when it drops
+func (b *Backend) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	subscribe := func(ctx context.Context) (event.Subscription, error) {
+		client, err := ethclient.DialContext(ctx, b.wsURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to the gateway - %w", err)
+		}
+		sub, err := client.SubscribeNewHead(ctx, ch)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		return closingSubscription{Subscription: sub, client: client}, nil
+	}
+	first, err := subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.resubscribe("heads", first, subscribe), nil
+}
+
+// resubscribe returns a subscription starting with the first one, which is restored with the subscribe function
+// every time it fails, until it is unsubscribed
+func (b *Backend) resubscribe(name string, first event.Subscription, subscribe func(context.Context) (event.Subscription, error)) event.Subscription {
+	var once sync.Once
+	return event.ResubscribeErr(maxResubscribeBackoff, func(ctx context.Context, lastErr error) (event.Subscription, error) {
+		var sub event.Subscription
+		once.Do(func() { sub = first })
+		if sub != nil {
+			return sub, nil
+		}
+		b.logger.Warn("Gateway subscription dropped. Resubscribing.", "subscription", name, log.ErrKey, lastErr)
+		sub, err := subscribe(ctx)
+		if err != nil {
+			b.logger.Warn("Could not resubscribe to the gateway.", "subscription", name, log.ErrKey, err)
+		}
+		return sub, err
+	})
+}
+
+type logKey struct {
+	txHash gethcommon.Hash
+	index  uint
+}
+
+// logSubscription delivers the logs of consecutive websocket subscriptions, filling the gaps between them
+type logSubscription struct {
+	backend *Backend
+	query   ethereum.FilterQuery
+	out     chan<- types.Log
+
+	// the subscriptions are sequential, so these are only accessed by one at a time
+	lastBlock uint64
+	delivered map[logKey]struct{} // the logs delivered from the last block
+}
+
+func (s *logSubscription) subscribe(ctx context.Context) (event.Subscription, error) {
+	client, err := ethclient.DialContext(ctx, s.backend.wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the gateway - %w", err)
+	}
+	in := make(chan types.Log)
+	sub, err := client.SubscribeFilterLogs(ctx, s.query, in)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	// the logs emitted while disconnected are fetched once subscribed again, so none are lost in between
+	var missed []types.Log
+	if s.lastBlock > 0 {
+		query := s.query
+		query.FromBlock = new(big.Int).SetUint64(s.lastBlock)
+		query.ToBlock = nil
+		missed, err = s.backend.FilterLogs(ctx, query)
+		if err != nil {
+			sub.Unsubscribe()
+			client.Close()
+			return nil, fmt.Errorf("unable to fetch the logs missed while disconnected - %w", err)
+		}
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer client.Close()
+		defer sub.Unsubscribe()
+		for _, l := range missed {
+			if !s.deliver(l, quit) {
+				return nil
+			}
+		}
+		for {
+			select {
+			case l := <-in:
+				if !s.deliver(l, quit) {
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// deliver sends the log unless it was already delivered, and returns false if the subscription was closed meanwhile
+func (s *logSubscription) deliver(l types.Log, quit <-chan struct{}) bool {
+	key := logKey{txHash: l.TxHash, index: l.Index}
+	if !l.Removed {
+		if l.BlockNumber < s.lastBlock {
+			return true
+		}
+		if _, found := s.delivered[key]; found {
+			return true
+		}
+	}
+
+	select {
+	case s.out <- l:
+	case <-quit:
+		return false
+	}
+
+	if l.BlockNumber > s.lastBlock {
+		s.lastBlock = l.BlockNumber
+		s.delivered = map[logKey]struct{}{}
+	}
+	if l.BlockNumber == s.lastBlock {
+		s.delivered[key] = struct{}{}
+	}
+	return true
+}
+
+// closingSubscription closes the connection of the subscription with it
+type closingSubscription struct {
+	event.Subscription
+	client *ethclient.Client
+}
+
+func (s closingSubscription) Unsubscribe() {
+	s.Subscription.Unsubscribe()
+	s.client.Close()
+}
@@ -0,0 +1,296 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+)
+
+// ErrNotJoined is returned by the calls that need a user when the client has not joined the gateway yet
+var ErrNotJoined = errors.New("client has not joined the gateway")
+
+// SignFn signs the hash of the registration message with the key of the account being registered, returning a
+// 65-byte [R || S || V] signature
+type SignFn func(messageHash []byte) ([]byte, error)
+
+// Client is a client of the TEN gateway covering the lifecycle of a user: joining, registering, listing and revoking
+// accounts, and querying the network as those accounts through a standard contract backend.
+type Client struct {
+	httpURL    string
+	wsURL      string
+	httpClient *http.Client
+	logger     gethlog.Logger
+
+	mu      sync.Mutex
+	userID  []byte
+	chainID *big.Int
+}
+
+// NewClient returns a client of the gateway at the http and ws URLs, e.g. https://testnet.ten.xyz and
+// wss://testnet.ten.xyz. The user is created with Join, or reused with SetUserID.
+func NewClient(httpURL, wsURL string, logger gethlog.Logger) *Client {
+	return &Client{
+		httpURL:    strings.TrimSuffix(httpURL, "/"),
+		wsURL:      strings.TrimSuffix(wsURL, "/"),
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+// SetUserID makes the client act as an existing user of the gateway
+func (c *Client) SetUserID(userID []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.userID = userID
+}
+
+// UserID returns the user the client acts as, or nil if it has not joined
+func (c *Client) UserID() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.userID
+}
+
+// Join creates a new user on the gateway, which the client then acts as
+func (c *Client) Join(ctx context.Context) error {
+	response, err := c.request(ctx, http.MethodGet, wecommon.PathJoin, nil, nil)
+	if err != nil {
+		return fmt.Errorf("unable to join - %w", err)
+	}
+	userID, err := hexutil.Decode("0x" + string(response))
+	if err != nil || len(userID) != viewingkey.UserIDLength {
+		return fmt.Errorf("unable to join - unexpected response %s", response)
+	}
+	c.SetUserID(userID)
+	return nil
+}
+
+// RegisterAccount registers the account of the private key for the user, signing the registration message with the
+// given signature type
+func (c *Client) RegisterAccount(ctx context.Context, pk *ecdsa.PrivateKey, signatureType viewingkey.SignatureType) error {
+	return c.RegisterAccountWithSigner(ctx, crypto.PubkeyToAddress(pk.PublicKey), signatureType, func(messageHash []byte) ([]byte, error) {
+		return crypto.Sign(messageHash, pk)
+	})
+}
+
+// RegisterAccountWithSigner registers the account for the user, with a signer of the registration message holding the
+// key of the account, e.g. a remote signer
+func (c *Client) RegisterAccountWithSigner(ctx context.Context, address gethcommon.Address, signatureType viewingkey.SignatureType, sign SignFn) error {
+	userID := c.UserID()
+	if userID == nil {
+		return ErrNotJoined
+	}
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	message, err := viewingkey.GenerateMessage(userID, chainID.Int64(), viewingkey.PersonalSignVersion, signatureType)
+	if err != nil {
+		return fmt.Errorf("unable to generate the registration message - %w", err)
+	}
+	messageHash, err := viewingkey.GetMessageHash(message, signatureType)
+	if err != nil {
+		return fmt.Errorf("unable to hash the registration message - %w", err)
+	}
+	signature, err := sign(messageHash)
+	if err != nil {
+		return fmt.Errorf("unable to sign the registration message - %w", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("unexpected signature length %d", len(signature))
+	}
+	// the gateway expects the recovery id of the signature in the Ethereum format
+	signature = bytes.Clone(signature)
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		wecommon.JSONKeySignature: hexutil.Encode(signature),
+		wecommon.JSONKeyAddress:   address.Hex(),
+		wecommon.JSONKeyType:      viewingkey.GetSignatureTypeString(signatureType),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal the registration request - %w", err)
+	}
+	response, err := c.request(ctx, http.MethodPost, wecommon.PathAuthenticate, userTokenParams(userID), payload)
+	if err != nil {
+		return fmt.Errorf("unable to register %s - %w", address.Hex(), err)
+	}
+	return expectSuccess(response)
+}
+
+// IsRegistered returns whether the account is registered for the user
+func (c *Client) IsRegistered(ctx context.Context, address gethcommon.Address) (bool, error) {
+	userID := c.UserID()
+	if userID == nil {
+		return false, ErrNotJoined
+	}
+	params := userTokenParams(userID)
+	params.Set(wecommon.AddressQueryParameter, address.Hex())
+	response, err := c.request(ctx, http.MethodGet, wecommon.PathQuery, params, nil)
+	if err != nil {
+		return false, fmt.Errorf("unable to query %s - %w", address.Hex(), err)
+	}
+	var result struct {
+		Status bool `json:"status"`
+	}
+	if err = json.Unmarshal(response, &result); err != nil {
+		return false, fmt.Errorf("unexpected response %s", response)
+	}
+	return result.Status, nil
+}
+
+// Accounts returns the accounts registered for the user
+func (c *Client) Accounts(ctx context.Context) ([]gethcommon.Address, error) {
+	userID := c.UserID()
+	if userID == nil {
+		return nil, ErrNotJoined
+	}
+	response, err := c.request(ctx, http.MethodGet, wecommon.PathAccounts, userTokenParams(userID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list accounts - %w", err)
+	}
+	var result struct {
+		Accounts []gethcommon.Address `json:"accounts"`
+	}
+	if err = json.Unmarshal(response, &result); err != nil {
+		return nil, fmt.Errorf("unexpected response %s", response)
+	}
+	return result.Accounts, nil
+}
+
+// RevokeAccount deletes the viewing key of the account, so the user can no longer act as that account
+func (c *Client) RevokeAccount(ctx context.Context, address gethcommon.Address) error {
+	userID := c.UserID()
+	if userID == nil {
+		return ErrNotJoined
+	}
+	params := userTokenParams(userID)
+	params.Set(wecommon.AddressQueryParameter, address.Hex())
+	response, err := c.request(ctx, http.MethodPost, wecommon.PathRevokeAccount, params, nil)
+	if err != nil {
+		return fmt.Errorf("unable to revoke %s - %w", address.Hex(), err)
+	}
+	return expectSuccess(response)
+}
+
+// Revoke deletes the user and all its accounts from the gateway. The client can join again afterwards.
+func (c *Client) Revoke(ctx context.Context) error {
+	userID := c.UserID()
+	if userID == nil {
+		return ErrNotJoined
+	}
+	response, err := c.request(ctx, http.MethodPost, wecommon.PathRevoke, userTokenParams(userID), nil)
+	if err != nil {
+		return fmt.Errorf("unable to revoke the user - %w", err)
+	}
+	if err = expectSuccess(response); err != nil {
+		return err
+	}
+	c.SetUserID(nil)
+	return nil
+}
+
+// ChainID returns the id of the TEN chain behind the gateway
+func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	c.mu.Lock()
+	chainID := c.chainID
+	c.mu.Unlock()
+	if chainID != nil {
+		return chainID, nil
+	}
+
+	client, err := ethclient.DialContext(ctx, c.httpURL+wecommon.APIVersion1+"/")
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the gateway - %w", err)
+	}
+	defer client.Close()
+	chainID, err = client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the chain id - %w", err)
+	}
+
+	c.mu.Lock()
+	c.chainID = chainID
+	c.mu.Unlock()
+	return chainID, nil
+}
+
+// HTTP returns the RPC endpoint of the user on the gateway
+func (c *Client) HTTP() string {
+	return fmt.Sprintf("%s%s/?%s", c.httpURL, wecommon.APIVersion1, userTokenParams(c.UserID()).Encode())
+}
+
+// WS returns the websocket RPC endpoint of the user on the gateway
+func (c *Client) WS() string {
+	return fmt.Sprintf("%s%s/?%s", c.wsURL, wecommon.APIVersion1, userTokenParams(c.UserID()).Encode())
+}
+
+// Backend returns a contract backend acting as the accounts registered for the user, e.g. for abigen bindings
+func (c *Client) Backend(ctx context.Context) (*Backend, error) {
+	if c.UserID() == nil {
+		return nil, ErrNotJoined
+	}
+	return dialBackend(ctx, c.HTTP(), c.WS(), c.logger)
+}
+
+func (c *Client) request(ctx context.Context, method string, path string, params url.Values, body []byte) ([]byte, error) {
+	endpoint := c.httpURL + wecommon.APIVersion1 + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request - %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to issue request - %w", err)
+	}
+	defer response.Body.Close()
+	r, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response - %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, r)
+	}
+	return r, nil
+}
+
+func userTokenParams(userID []byte) url.Values {
+	params := url.Values{}
+	params.Set(wecommon.EncryptedTokenQueryParameter, hexutil.Encode(userID))
+	return params
+}
+
+// expectSuccess converts the error messages the gateway returns in place of the success message to errors
+func expectSuccess(response []byte) error {
+	if string(response) != wecommon.SuccessMsg {
+		return fmt.Errorf("expected %s, got %s", wecommon.SuccessMsg, response)
+	}
+	return nil
+}
@@ -72,6 +72,22 @@ const (
 	rateLimitMaxConcurrentRequestsName    = "maxConcurrentRequestsPerUser"
 	rateLimitMaxConcurrentRequestsDefault = 3
 	rateLimitMaxConcurrentRequestsUsage   = "Number of concurrent requests allowed per user. Default: 3"
+
+	metricsEnabledName    = "metricsEnabled"
+	metricsEnabledDefault = false
+	metricsEnabledUsage   = "Whether the metrics are served in the Prometheus format on /metrics. Default: false"
+
+	metricsHTTPPortName    = "metricsHTTPPort"
+	metricsHTTPPortDefault = 3002
+	metricsHTTPPortUsage   = "The port on which to serve the metrics. Default: 3002."
+
+	tracingExporterName    = "tracingExporter"
+	tracingExporterDefault = ""
+	tracingExporterUsage   = "Where the request traces are sent: otlp, file, or empty to disable tracing. Default: empty"
+
+	tracingEndpointName    = "tracingEndpoint"
+	tracingEndpointDefault = ""
+	tracingEndpointUsage   = "The address of the OTLP collector, or the path of the file the traces are written to. Default: empty"
 )
 
 func parseCLIArgs() wecommon.Config {
@@ -91,6 +107,10 @@ func parseCLIArgs() wecommon.Config {
 	rateLimitUserComputeTime := flag.Duration(rateLimitUserComputeTimeName, rateLimitUserComputeTimeDefault, rateLimitUserComputeTimeUsage)
 	rateLimitWindow := flag.Duration(rateLimitWindowName, rateLimitWindowDefault, rateLimitWindowUsage)
 	rateLimitMaxConcurrentRequests := flag.Int(rateLimitMaxConcurrentRequestsName, rateLimitMaxConcurrentRequestsDefault, rateLimitMaxConcurrentRequestsUsage)
+	metricsEnabled := flag.Bool(metricsEnabledName, metricsEnabledDefault, metricsEnabledUsage)
+	metricsHTTPPort := flag.Int(metricsHTTPPortName, metricsHTTPPortDefault, metricsHTTPPortUsage)
+	tracingExporter := flag.String(tracingExporterName, tracingExporterDefault, tracingExporterUsage)
+	tracingEndpoint := flag.String(tracingEndpointName, tracingEndpointDefault, tracingEndpointUsage)
 	flag.Parse()
 
 	return wecommon.Config{
@@ -109,5 +129,9 @@ func parseCLIArgs() wecommon.Config {
 		RateLimitUserComputeTime:       *rateLimitUserComputeTime,
 		RateLimitWindow:                *rateLimitWindow,
 		RateLimitMaxConcurrentRequests: *rateLimitMaxConcurrentRequests,
+		MetricsEnabled:                 *metricsEnabled,
+		MetricsHTTPPort:                *metricsHTTPPort,
+		TracingExporter:                *tracingExporter,
+		TracingEndpoint:                *tracingEndpoint,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 
 	"github.com/google/uuid"
 
@@ -130,6 +131,7 @@ type RateLimiter struct {
 	maxConcurrentRequests uint32
 	totalRequests         uint64
 	rateLimitedRequests   uint64
+	rejections            gethmetrics.Counter
 	logger                gethlog.Logger
 }
 
@@ -145,6 +147,7 @@ func (rl *RateLimiter) IncrementRateLimitedRequests() {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 	rl.rateLimitedRequests++
+	rl.rejections.Inc(1)
 }
 
 // GetMaxConcurrentRequest returns the maximum number of concurrent requests allowed.
@@ -161,12 +164,13 @@ func (rl *RateLimiter) GetUserComputeTime() time.Duration {
 	return rl.userComputeTime
 }
 
-func NewRateLimiter(rateLimitUserComputeTime time.Duration, rateLimitWindow time.Duration, concurrentRequestsLimit uint32, logger gethlog.Logger) *RateLimiter {
+func NewRateLimiter(rateLimitUserComputeTime time.Duration, rateLimitWindow time.Duration, concurrentRequestsLimit uint32, metricsRegistry gethmetrics.Registry, logger gethlog.Logger) *RateLimiter {
 	rl := &RateLimiter{
 		users:                 make(map[common.Address]*RateLimitUser),
 		userComputeTime:       rateLimitUserComputeTime,
 		window:                rateLimitWindow,
 		maxConcurrentRequests: concurrentRequestsLimit,
+		rejections:            gethmetrics.GetOrRegisterCounter("gateway/ratelimiter/rejections", metricsRegistry),
 		logger:                logger,
 	}
 
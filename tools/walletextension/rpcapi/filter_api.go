@@ -56,6 +56,10 @@ func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
 	}
 	subscription := notifier.CreateSubscription()
 	api.we.NewHeadsService.RegisterNotifier(notifier, subscription)
+	api.we.metrics.activeSubscriptions.Inc(1)
+	go subscriptioncommon.HandleUnsubscribe(subscription, func() {
+		api.we.metrics.activeSubscriptions.Dec(1)
+	})
 	return subscription, nil
 }
 
@@ -101,6 +105,7 @@ func (api *FilterAPI) Logs(ctx context.Context, crit common.FilterCriteria) (*rp
 
 	dedupeBuffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
 	subscription := subNotifier.CreateSubscription()
+	api.we.metrics.activeSubscriptions.Inc(1)
 
 	unsubscribedByClient := atomic.Bool{}
 	unsubscribedByBackend := atomic.Bool{}
@@ -138,6 +143,7 @@ func (api *FilterAPI) Logs(ctx context.Context, crit common.FilterCriteria) (*rp
 	go subscriptioncommon.HandleUnsubscribe(subscription, func() {
 		unsubscribedByClient.Store(true)
 		api.closeConnections(backendSubscriptions, backendWSConnections)
+		api.we.metrics.activeSubscriptions.Dec(1)
 	})
 
 	return subscription, err
@@ -205,6 +211,7 @@ func (api *FilterAPI) GetLogs(ctx context.Context, crit common.FilterCriteria) (
 
 	res, err := withCache(
 		api.we.Cache,
+		api.we.metrics,
 		&CacheCfg{
 			CacheTypeDynamic: func() CacheStrategy {
 				if crit.ToBlock != nil && crit.ToBlock.Int64() > 0 {
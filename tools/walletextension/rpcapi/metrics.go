@@ -0,0 +1,21 @@
+package rpcapi
+
+import (
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+// gatewayMetrics are the metrics of the gateway business logic. The latency of each RPC method is recorded by the RPC
+// server and the rate limiter rejections by the rate limiter.
+type gatewayMetrics struct {
+	cacheHits           gethmetrics.Counter
+	cacheMisses         gethmetrics.Counter
+	activeSubscriptions gethmetrics.Gauge
+}
+
+func newGatewayMetrics(registry gethmetrics.Registry) *gatewayMetrics {
+	return &gatewayMetrics{
+		cacheHits:           gethmetrics.GetOrRegisterCounter("gateway/cache/hits", registry),
+		cacheMisses:         gethmetrics.GetOrRegisterCounter("gateway/cache/misses", registry),
+		activeSubscriptions: gethmetrics.GetOrRegisterGauge("gateway/subscriptions/active", registry),
+	}
+}
@@ -0,0 +1,94 @@
+package rpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	tenrpc "github.com/ten-protocol/go-ten/go/rpc"
+)
+
+// maxPersonalTransactionsPageSize is the largest page the enclave returns for a single account
+const maxPersonalTransactionsPageSize = 100
+
+type TenAPI struct {
+	we *Services
+}
+
+func NewTenAPI(we *Services) *TenAPI {
+	return &TenAPI{we}
+}
+
+// GetCrossChainProof returns the merkle proof required to finalise an L2->L1 message or value transfer.
+// The publication status changes as the bundle progresses, so the result is only cached until the next batch.
+func (api *TenAPI) GetCrossChainProof(ctx context.Context, messageHash gethcommon.Hash) (*common.CrossChainProof, error) {
+	return UnauthenticatedTenRPCCall[common.CrossChainProof](ctx, api.we, &CacheCfg{CacheType: LatestBatch}, tenrpc.GetCrossChainProof, messageHash)
+}
+
+// GetCrossChainMessages returns the cross chain messages sent or received by one of the accounts of the authenticated user.
+// The node authenticates the request with the viewing key of the account.
+func (api *TenAPI) GetCrossChainMessages(ctx context.Context, address gethcommon.Address, pagination common.QueryPagination) (*common.PersonalCrossChainMessageListingResponse, error) {
+	return ExecAuthRPC[common.PersonalCrossChainMessageListingResponse](ctx, api.we, &ExecCfg{account: &address, cacheCfg: &CacheCfg{CacheType: LatestBatch}}, tenrpc.GetCrossChainMessages, address, &pagination)
+}
+
+// GetPersonalTransactions returns a page of the transactions sent by all the accounts registered by the authenticated
+// user, latest first. The following page is requested with the returned cursor, which stays valid as new transactions
+// are added.
+func (api *TenAPI) GetPersonalTransactions(ctx context.Context, query common.PersonalTransactionsQuery) (*common.PersonalTransactionsPage, error) {
+	if query.Size < 1 || query.Size > maxPersonalTransactionsPageSize {
+		return nil, fmt.Errorf("size must be between 1 and %d", maxPersonalTransactionsPageSize)
+	}
+	userID, err := extractUserID(ctx, api.we)
+	if err != nil {
+		return nil, err
+	}
+	user, err := getUser(userID, api.we)
+	if err != nil {
+		return nil, err
+	}
+
+	// each account returns its own latest transactions before the cursor, so the page is the latest of all of them
+	page := &common.PersonalTransactionsPage{Receipts: types.Receipts{}}
+	mayHaveMore := false
+	for _, acct := range user.accounts {
+		params, err := json.Marshal(&common.ListPrivateTransactionsQueryParams{
+			Address:                    *acct.address,
+			Pagination:                 common.QueryPagination{Size: query.Size},
+			PersonalTransactionsFilter: query.PersonalTransactionsFilter,
+			Before:                     query.Cursor,
+			IncludeLogs:                query.IncludeLogs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal query params - %w", err)
+		}
+		resp, err := ExecAuthRPC[common.PrivateTransactionsQueryResponse](ctx, api.we, &ExecCfg{account: acct.address}, tenrpc.GetPersonalTransactions, string(params))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the transactions of %s - %w", acct.address.Hex(), err)
+		}
+		page.Receipts = append(page.Receipts, resp.Receipts...)
+		page.Total += resp.Total
+		// a full page from an account means there may be more transactions beyond it
+		mayHaveMore = mayHaveMore || uint(len(resp.Receipts)) == query.Size
+	}
+
+	sort.Slice(page.Receipts, func(i, j int) bool {
+		a, b := page.Receipts[i], page.Receipts[j]
+		if c := a.BlockNumber.Cmp(b.BlockNumber); c != 0 {
+			return c > 0
+		}
+		return a.TransactionIndex > b.TransactionIndex
+	})
+	if uint(len(page.Receipts)) > query.Size {
+		page.Receipts = page.Receipts[:query.Size]
+		mayHaveMore = true
+	}
+	if mayHaveMore && len(page.Receipts) > 0 {
+		last := page.Receipts[len(page.Receipts)-1]
+		page.NextCursor = &common.PersonalTransactionCursor{BatchHeight: last.BlockNumber.Uint64(), TxIndex: last.TransactionIndex}
+	}
+	return page, nil
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/status-im/keycard-go/hexutils"
 
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
 
@@ -49,6 +51,12 @@ const (
 
 var rpcNotImplemented = fmt.Errorf("rpc endpoint not implemented")
 
+// attributes of the spans of the requests
+const (
+	cacheHitAttr    = "gateway.cache_hit"
+	rateLimitedAttr = "gateway.rate_limited"
+)
+
 type ExecCfg struct {
 	// these 4 fields specify the account(s) that should make the backend call
 	account             *gethcommon.Address
@@ -78,12 +86,15 @@ func UnauthenticatedTenRPCCall[R any](ctx context.Context, w *Services, cfg *Cac
 	if ctx == nil {
 		return nil, errors.New("invalid call. nil Context")
 	}
+	// the trace of the request starts here and is carried to the node. The arguments are never added to it.
+	ctx, span := tracing.Start(ctx, method, attribute.Bool(cacheHitAttr, true))
 	audit(w, "RPC start method=%s args=%v", method, args)
 	requestStartTime := time.Now()
 	cacheArgs := []any{method}
 	cacheArgs = append(cacheArgs, args...)
 
-	res, err := withCache(w.Cache, cfg, generateCacheKey(cacheArgs), func() (*R, error) {
+	res, err := withCache(w.Cache, w.metrics, cfg, generateCacheKey(cacheArgs), func() (*R, error) {
+		span.SetAttributes(attribute.Bool(cacheHitAttr, false))
 		return withPlainRPCConnection(ctx, w, func(client *rpc.Client) (*R, error) {
 			var resp *R
 			var err error
@@ -97,10 +108,15 @@ func UnauthenticatedTenRPCCall[R any](ctx context.Context, w *Services, cfg *Cac
 		})
 	})
 	audit(w, "RPC call. method=%s args=%v result=%s error=%s time=%d", method, args, res, err, time.Since(requestStartTime).Milliseconds())
+	tracing.End(span, err)
 	return res, err
 }
 
-func ExecAuthRPC[R any](ctx context.Context, w *Services, cfg *ExecCfg, method string, args ...any) (*R, error) {
+func ExecAuthRPC[R any](ctx context.Context, w *Services, cfg *ExecCfg, method string, args ...any) (res *R, err error) {
+	// the trace of the request starts here and is carried to the node. The arguments and the user are never added
+	// to it, since the node forwards the request encrypted.
+	ctx, span := tracing.Start(ctx, method, attribute.Bool(cacheHitAttr, true))
+	defer func() { tracing.End(span, err) }()
 	audit(w, "RPC start method=%s args=%v", method, args)
 	requestStartTime := time.Now()
 	userID, err := extractUserID(ctx, w)
@@ -111,13 +127,15 @@ func ExecAuthRPC[R any](ctx context.Context, w *Services, cfg *ExecCfg, method s
 	rateLimitAllowed, requestUUID := w.RateLimiter.Allow(gethcommon.Address(userID))
 	defer w.RateLimiter.SetRequestEnd(gethcommon.Address(userID), requestUUID)
 	if !rateLimitAllowed {
+		span.SetAttributes(attribute.Bool(rateLimitedAttr, true))
 		return nil, fmt.Errorf("rate limit exceeded")
 	}
 
 	cacheArgs := []any{userID, method}
 	cacheArgs = append(cacheArgs, args...)
 
-	res, err := withCache(w.Cache, cfg.cacheCfg, generateCacheKey(cacheArgs), func() (*R, error) {
+	res, err = withCache(w.Cache, w.metrics, cfg.cacheCfg, generateCacheKey(cacheArgs), func() (*R, error) {
+		span.SetAttributes(attribute.Bool(cacheHitAttr, false))
 		user, err := getUser(userID, w)
 		if err != nil {
 			return nil, err
@@ -233,7 +251,7 @@ func generateCacheKey(params []any) []byte {
 	return hasher.Sum(nil)
 }
 
-func withCache[R any](cache cache.Cache, cfg *CacheCfg, cacheKey []byte, onCacheMiss func() (*R, error)) (*R, error) {
+func withCache[R any](cache cache.Cache, metrics *gatewayMetrics, cfg *CacheCfg, cacheKey []byte, onCacheMiss func() (*R, error)) (*R, error) {
 	if cfg == nil {
 		return onCacheMiss()
 	}
@@ -264,10 +282,12 @@ func withCache[R any](cache cache.Cache, cfg *CacheCfg, cacheKey []byte, onCache
 			if !ok {
 				return nil, fmt.Errorf("unexpected error. Invalid format cached. %v", cachedValue)
 			}
+			metrics.cacheHits.Inc(1)
 			return returnValue, nil
 		}
 	}
 
+	metrics.cacheMisses.Inc(1)
 	result, err := onCacheMiss()
 
 	// cache only non-nil values
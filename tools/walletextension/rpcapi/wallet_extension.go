@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/retry"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
@@ -45,6 +48,7 @@ type Services struct {
 	version      string
 	Cache        cache.Cache
 	RateLimiter  *ratelimiter.RateLimiter
+	metrics      *gatewayMetrics
 	// the OG maintains a connection pool of rpc connections to underlying nodes
 	rpcHTTPConnPool *pool.ObjectPool
 	rpcWSConnPool   *pool.ObjectPool
@@ -56,7 +60,7 @@ type NewHeadNotifier interface {
 	onNewHead(header *tencommon.BatchHeader)
 }
 
-func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.Storage, stopControl *stopcontrol.StopControl, version string, logger gethlog.Logger, config *common.Config) *Services {
+func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.Storage, stopControl *stopcontrol.StopControl, version string, logger gethlog.Logger, config *common.Config, metricsRegistry gethmetrics.Registry) *Services {
 	newGatewayCache, err := cache.NewCache(logger)
 	if err != nil {
 		logger.Error(fmt.Errorf("could not create cache. Cause: %w", err).Error())
@@ -92,7 +96,7 @@ func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.Storage
 	cfg := pool.NewDefaultPoolConfig()
 	cfg.MaxTotal = 200 // todo - what is the right number
 
-	rateLimiter := ratelimiter.NewRateLimiter(config.RateLimitUserComputeTime, config.RateLimitWindow, uint32(config.RateLimitMaxConcurrentRequests), logger)
+	rateLimiter := ratelimiter.NewRateLimiter(config.RateLimitUserComputeTime, config.RateLimitWindow, uint32(config.RateLimitMaxConcurrentRequests), metricsRegistry, logger)
 
 	services := Services{
 		HostAddrHTTP:    hostAddrHTTP,
@@ -103,6 +107,7 @@ func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.Storage
 		version:         version,
 		Cache:           newGatewayCache,
 		RateLimiter:     rateLimiter,
+		metrics:         newGatewayMetrics(metricsRegistry),
 		rpcHTTPConnPool: pool.NewObjectPool(context.Background(), factoryHTTP, cfg),
 		rpcWSConnPool:   pool.NewObjectPool(context.Background(), factoryWS, cfg),
 		Config:          config,
@@ -244,6 +249,37 @@ func (w *Services) UserHasAccount(userID []byte, address string) (bool, error) {
 	return found, nil
 }
 
+// GetUserAccounts returns the addresses of the accounts registered for given userID
+func (w *Services) GetUserAccounts(userID []byte) ([]gethcommon.Address, error) {
+	audit(w, "Listing accounts of user: %s", hexutils.BytesToHex(userID))
+	accounts, err := w.Storage.GetAccounts(userID)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error getting accounts for user (%s), %w", userID, err).Error())
+		return nil, err
+	}
+
+	addresses := make([]gethcommon.Address, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = gethcommon.BytesToAddress(account.AccountAddress)
+	}
+	return addresses, nil
+}
+
+// DeleteAccountFromUser deletes the account from the accounts registered for given userID
+func (w *Services) DeleteAccountFromUser(userID []byte, address gethcommon.Address) error {
+	audit(w, "Deleting account of user: %s, address: %s", hexutils.BytesToHex(userID), address.Hex())
+
+	err := w.Storage.DeleteAccount(userID, address.Bytes())
+	if err != nil {
+		if !errors.Is(err, errutil.ErrNotFound) {
+			w.Logger().Error(fmt.Errorf("error deleting account (%s) of user (%s), %w", address.Hex(), userID, err).Error())
+		}
+		return err
+	}
+	w.Cache.Remove(userCacheKey(userID))
+	return nil
+}
+
 // DeleteUser deletes user and accounts associated with user from the database for given userID
 func (w *Services) DeleteUser(userID []byte) error {
 	audit(w, "Deleting user: %s", hexutils.BytesToHex(userID))
@@ -102,6 +102,28 @@ func (m *MariaDB) AddAccount(userID []byte, accountAddress []byte, signature []b
 	return nil
 }
 
+func (m *MariaDB) DeleteAccount(userID []byte, accountAddress []byte) error {
+	stmt, err := m.db.Prepare("DELETE FROM accounts WHERE user_id = ? AND account_address = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.Exec(userID, accountAddress)
+	if err != nil {
+		return err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errutil.ErrNotFound
+	}
+
+	return nil
+}
+
 func (m *MariaDB) GetAccounts(userID []byte) ([]common.AccountDB, error) {
 	rows, err := m.db.Query("SELECT account_address, signature, signature_type FROM accounts WHERE user_id = ?", userID)
 	if err != nil {
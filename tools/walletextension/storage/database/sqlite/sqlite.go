@@ -136,6 +136,28 @@ func (s *Database) AddAccount(userID []byte, accountAddress []byte, signature []
 	return nil
 }
 
+func (s *Database) DeleteAccount(userID []byte, accountAddress []byte) error {
+	stmt, err := s.db.Prepare("DELETE FROM accounts WHERE user_id = ? AND account_address = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.Exec(userID, accountAddress)
+	if err != nil {
+		return err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errutil.ErrNotFound
+	}
+
+	return nil
+}
+
 func (s *Database) GetAccounts(userID []byte) ([]common.AccountDB, error) {
 	rows, err := s.db.Query("SELECT account_address, signature, signature_type FROM accounts WHERE user_id = ?", userID)
 	if err != nil {
@@ -17,6 +17,7 @@ type Storage interface {
 	GetUserPrivateKey(userID []byte) ([]byte, error)
 	AddAccount(userID []byte, accountAddress []byte, signature []byte, signatureType viewingkey.SignatureType) error
 	GetAccounts(userID []byte) ([]common.AccountDB, error)
+	DeleteAccount(userID []byte, accountAddress []byte) error
 	GetAllUsers() ([]common.UserDB, error)
 	StoreTransaction(rawTx string, userID []byte) error
 }
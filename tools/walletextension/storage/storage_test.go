@@ -15,6 +15,7 @@ var tests = map[string]func(storage Storage, t *testing.T){
 	"testAddAndGetUser":     testAddAndGetUser,
 	"testAddAndGetAccounts": testAddAndGetAccounts,
 	"testDeleteUser":        testDeleteUser,
+	"testDeleteAccount":     testDeleteAccount,
 	"testGetAllUsers":       testGetAllUsers,
 	"testStoringNewTx":      testStoringNewTx,
 }
@@ -124,6 +125,41 @@ func testDeleteUser(storage Storage, t *testing.T) {
 	}
 }
 
+func testDeleteAccount(storage Storage, t *testing.T) {
+	userID := []byte("testDeleteAccountUserID")
+	accountAddress1 := []byte("accountAddress1")
+	accountAddress2 := []byte("accountAddress2")
+
+	err := storage.AddUser(userID, []byte("privateKey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, address := range [][]byte{accountAddress1, accountAddress2} {
+		err = storage.AddAccount(userID, address, []byte("signature"), viewingkey.EIP712Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = storage.DeleteAccount(userID, accountAddress1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accounts, err := storage.GetAccounts(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || !bytes.Equal(accounts[0].AccountAddress, accountAddress2) {
+		t.Errorf("Expected only account 2 to remain, got %v", accounts)
+	}
+
+	err = storage.DeleteAccount(userID, accountAddress1)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Fatalf("Expected not found error when deleting a deleted account, got %v", err)
+	}
+}
+
 func testGetAllUsers(storage Storage, t *testing.T) {
 	initialUsers, err := storage.GetAllUsers()
 	if err != nil {
@@ -1,6 +1,7 @@
 package walletextension
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -14,7 +15,9 @@ import (
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/metrics"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage"
@@ -26,6 +29,8 @@ type Container struct {
 	rpcServer       node.Server
 	services        *rpcapi.Services
 	newHeadsService *subscription.NewHeadsService
+	metricsService  *metrics.Service
+	stopTracing     func(context.Context) error
 }
 
 func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Container {
@@ -45,8 +50,17 @@ func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Cont
 		version = "dev"
 	}
 
+	stopTracing, err := tracing.Setup("ten-gateway", config.TracingExporter, config.TracingEndpoint)
+	if err != nil {
+		logger.Crit("unable to set up the tracing of the requests", log.ErrKey, err)
+		os.Exit(1)
+	}
+
+	// the metrics are created before the services that register them, so they are enabled when registered
+	metricsService := metrics.New(config.MetricsEnabled, uint(config.MetricsHTTPPort), logger)
+
 	stopControl := stopcontrol.New()
-	walletExt := rpcapi.NewServices(hostRPCBindAddrHTTP, hostRPCBindAddrWS, databaseStorage, stopControl, version, logger, &config)
+	walletExt := rpcapi.NewServices(hostRPCBindAddrHTTP, hostRPCBindAddrWS, databaseStorage, stopControl, version, logger, &config, metricsService.Registry())
 	cfg := &node.RPCConfig{
 		EnableHTTP: true,
 		HTTPPort:   config.WalletExtensionPortHTTP,
@@ -86,6 +100,9 @@ func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Cont
 		}, {
 			Namespace: "web3",
 			Service:   rpcapi.NewWeb3API(walletExt),
+		}, {
+			Namespace: "ten",
+			Service:   rpcapi.NewTenAPI(walletExt),
 		},
 	})
 
@@ -94,6 +111,8 @@ func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Cont
 		rpcServer:       rpcServer,
 		newHeadsService: walletExt.NewHeadsService,
 		services:        walletExt,
+		metricsService:  metricsService,
+		stopTracing:     stopTracing,
 		logger:          logger,
 	}
 }
@@ -109,6 +128,8 @@ func (w *Container) Start() error {
 	if err != nil {
 		return err
 	}
+
+	w.metricsService.Start()
 	return nil
 }
 
@@ -126,5 +147,9 @@ func (w *Container) Stop() error {
 	}
 
 	w.services.Stop()
+	w.metricsService.Stop()
+	if err := w.stopTracing(context.Background()); err != nil {
+		w.logger.Warn("could not flush the request traces", log.ErrKey, err)
+	}
 	return nil
 }